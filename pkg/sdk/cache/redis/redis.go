@@ -2,14 +2,18 @@ package redis
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	goredis "github.com/redis/go-redis/v9"
 )
 
 const (
-	defaultTTL           = 1 * time.Hour
-	idempotencyKeyPrefix = "idempotency:"
+	defaultTTL            = 1 * time.Hour
+	idempotencyKeyPrefix  = "idempotency:"
+	revokedTokenKeyPrefix = "revoked-token:"
+	// revokedSubjectKeyPrefix keeps the time before which all tokens of the subject are revoked.
+	revokedSubjectKeyPrefix = "revoked-subject:"
 )
 
 // Idempotency is responsible to connect idempotency flow with redis.
@@ -61,3 +65,63 @@ func (i *Idempotency) Set(ctx context.Context, key string, value []byte, ttl tim
 	}
 	return i.client.Set(ctx, idempotencyKeyPrefix+key, value, ttl).Err()
 }
+
+// SetIfAbsent stores the value in Redis only if the key doesn't exist.
+// It returns true if the value is stored.
+func (i *Idempotency) SetIfAbsent(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	if ttl <= 0 {
+		ttl = i.ttl
+	}
+	return i.client.SetNX(ctx, idempotencyKeyPrefix+key, value, ttl).Result()
+}
+
+// Delete deletes the key from Redis.
+func (i *Idempotency) Delete(ctx context.Context, key string) error {
+	return i.client.Del(ctx, idempotencyKeyPrefix+key).Err()
+}
+
+// TokenRevocation is responsible to keep revoked access tokens in redis.
+// A revoked token is stored by its id (jti) and expires along with the token itself.
+// All tokens of a subject can be revoked at once by storing the time they are revoked at.
+type TokenRevocation struct {
+	client goredis.Cmdable
+}
+
+// NewTokenRevocation creates an instance of TokenRevocation.
+func NewTokenRevocation(client goredis.Cmdable) *TokenRevocation {
+	return &TokenRevocation{client: client}
+}
+
+// Revoke marks the token id as revoked for the given TTL.
+// The TTL should be the token's remaining lifetime, after which the token is rejected anyway.
+func (t *TokenRevocation) Revoke(ctx context.Context, id string, ttl time.Duration) error {
+	return t.client.Set(ctx, revokedTokenKeyPrefix+id, "1", ttl).Err()
+}
+
+// RevokeSubject revokes all tokens of the subject issued at or before revokedAt for the given TTL.
+// The TTL should be the token's lifetime, after which the tokens issued before are rejected anyway.
+func (t *TokenRevocation) RevokeSubject(ctx context.Context, subject string, revokedAt time.Time, ttl time.Duration) error {
+	return t.client.Set(ctx, revokedSubjectKeyPrefix+subject, revokedAt.Unix(), ttl).Err()
+}
+
+// IsRevoked checks whether the token id has been revoked,
+// or the token has been issued at or before its subject is revoked.
+// Both are read in one round trip.
+func (t *TokenRevocation) IsRevoked(ctx context.Context, id, subject string, issuedAt time.Time) (bool, error) {
+	vals, err := t.client.MGet(ctx, revokedTokenKeyPrefix+id, revokedSubjectKeyPrefix+subject).Result()
+	if err != nil {
+		return false, err
+	}
+	if vals[0] != nil {
+		return true, nil
+	}
+	val, ok := vals[1].(string)
+	if !ok {
+		return false, nil
+	}
+	revokedAt, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	return issuedAt.Unix() <= revokedAt, nil
+}
@@ -2,6 +2,7 @@ package redis_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -20,6 +21,11 @@ type IdempotencySuite struct {
 	mock redismock.ClientMock
 }
 
+type TokenRevocationSuite struct {
+	revocation *redis.TokenRevocation
+	mock       redismock.ClientMock
+}
+
 func TestNewIdempotency(t *testing.T) {
 	t.Run("successfully create an instance of Idempotency", func(t *testing.T) {
 		st := createIdempotencySuite()
@@ -83,6 +89,64 @@ func TestIdempotency_Get(t *testing.T) {
 	})
 }
 
+func TestIdempotency_SetIfAbsent(t *testing.T) {
+	key := "set-key"
+	expectedKey := "idempotency:" + key
+
+	t.Run("set nx returns error", func(t *testing.T) {
+		st := createIdempotencySuite()
+		st.mock.ExpectSetNX(expectedKey, []byte("1"), time.Hour).SetErr(assert.AnError)
+
+		ok, err := st.idp.SetIfAbsent(testCtx, key, []byte("1"), 0)
+
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("key already exists", func(t *testing.T) {
+		st := createIdempotencySuite()
+		st.mock.ExpectSetNX(expectedKey, []byte("1"), time.Minute).SetVal(false)
+
+		ok, err := st.idp.SetIfAbsent(testCtx, key, []byte("1"), time.Minute)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("key is stored", func(t *testing.T) {
+		st := createIdempotencySuite()
+		st.mock.ExpectSetNX(expectedKey, []byte("1"), time.Minute).SetVal(true)
+
+		ok, err := st.idp.SetIfAbsent(testCtx, key, []byte("1"), time.Minute)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestIdempotency_Delete(t *testing.T) {
+	key := "del-key"
+	expectedKey := "idempotency:" + key
+
+	t.Run("delete returns error", func(t *testing.T) {
+		st := createIdempotencySuite()
+		st.mock.ExpectDel(expectedKey).SetErr(assert.AnError)
+
+		err := st.idp.Delete(testCtx, key)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("delete returns success", func(t *testing.T) {
+		st := createIdempotencySuite()
+		st.mock.ExpectDel(expectedKey).SetVal(1)
+
+		err := st.idp.Delete(testCtx, key)
+
+		assert.NoError(t, err)
+	})
+}
+
 func createIdempotencySuite() *IdempotencySuite {
 	c, m := redismock.NewClientMock()
 	i := redis.NewIdempotency(c, time.Hour)
@@ -91,3 +155,135 @@ func createIdempotencySuite() *IdempotencySuite {
 		mock: m,
 	}
 }
+
+func TestNewTokenRevocation(t *testing.T) {
+	t.Run("successfully create an instance of TokenRevocation", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		assert.NotNil(t, st.revocation)
+	})
+}
+
+func TestTokenRevocation_Revoke(t *testing.T) {
+	id := "token-id"
+	expectedKey := "revoked-token:" + id
+
+	t.Run("revoke returns error", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectSet(expectedKey, "1", time.Minute).SetErr(assert.AnError)
+
+		err := st.revocation.Revoke(testCtx, id, time.Minute)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("revoke returns success", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectSet(expectedKey, "1", time.Minute).SetVal("OK")
+
+		err := st.revocation.Revoke(testCtx, id, time.Minute)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTokenRevocation_RevokeSubject(t *testing.T) {
+	subject := "user-id"
+	expectedKey := "revoked-subject:" + subject
+	revokedAt := time.Now()
+
+	t.Run("revoke subject returns error", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectSet(expectedKey, revokedAt.Unix(), time.Minute).SetErr(assert.AnError)
+
+		err := st.revocation.RevokeSubject(testCtx, subject, revokedAt, time.Minute)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("revoke subject returns success", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectSet(expectedKey, revokedAt.Unix(), time.Minute).SetVal("OK")
+
+		err := st.revocation.RevokeSubject(testCtx, subject, revokedAt, time.Minute)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTokenRevocation_IsRevoked(t *testing.T) {
+	id := "token-id"
+	subject := "user-id"
+	expectedTokenKey := "revoked-token:" + id
+	expectedSubjectKey := "revoked-subject:" + subject
+	issuedAt := time.Now()
+
+	t.Run("mget returns error", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetErr(assert.AnError)
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.Error(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("token is not revoked", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetVal([]any{nil, nil})
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.NoError(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("token is revoked", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetVal([]any{"1", nil})
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.NoError(t, err)
+		assert.True(t, res)
+	})
+
+	t.Run("subject revocation is invalid", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetVal([]any{nil, "invalid"})
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.Error(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("token is issued after its subject is revoked", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		revokedAt := strconv.FormatInt(issuedAt.Add(-time.Minute).Unix(), 10)
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetVal([]any{nil, revokedAt})
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.NoError(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("token is issued before its subject is revoked", func(t *testing.T) {
+		st := createTokenRevocationSuite()
+		revokedAt := strconv.FormatInt(issuedAt.Unix(), 10)
+		st.mock.ExpectMGet(expectedTokenKey, expectedSubjectKey).SetVal([]any{nil, revokedAt})
+
+		res, err := st.revocation.IsRevoked(testCtx, id, subject, issuedAt)
+
+		assert.NoError(t, err)
+		assert.True(t, res)
+	})
+}
+
+func createTokenRevocationSuite() *TokenRevocationSuite {
+	c, m := redismock.NewClientMock()
+	return &TokenRevocationSuite{
+		revocation: redis.NewTokenRevocation(c),
+		mock:       m,
+	}
+}
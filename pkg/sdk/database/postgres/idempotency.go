@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
+)
+
+const (
+	defaultIdempotencyTTL = 1 * time.Hour
+
+	queryGetIdempotency = `SELECT value FROM idempotency_keys WHERE key = $1 AND expires_at > $2`
+	querySetIdempotency = `INSERT INTO idempotency_keys (key, value, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at`
+	// the conflicting row is only replaced when it has expired, so an expired key can be reserved again
+	// without waiting for the cleanup.
+	querySetIdempotencyIfAbsent = `INSERT INTO idempotency_keys (key, value, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= $4`
+	queryDeleteIdempotency        = `DELETE FROM idempotency_keys WHERE key = $1`
+	queryDeleteExpiredIdempotency = `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+)
+
+// Idempotency is responsible to connect idempotency flow with PostgreSQL.
+// It expects table idempotency_keys (key TEXT PRIMARY KEY, value BYTEA NOT NULL, expires_at TIMESTAMP NOT NULL).
+// The queries run in the transaction found in the context, if any.
+// It implements interceptor.TransactionalIdempotencyStore, so the idempotency interceptor stores the response
+// in the same transaction as the business write when both use the same database.
+// The key is reserved outside of the transaction, so the same request can't be processed twice meanwhile.
+type Idempotency struct {
+	db        uow.Tr
+	txManager uow.TxManager
+	txGetter  uow.TxGetter
+	ttl       time.Duration
+}
+
+// NewIdempotency creates an instance of Idempotency.
+func NewIdempotency(db uow.Tr, txManager uow.TxManager, txGetter uow.TxGetter, ttl time.Duration) *Idempotency {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	return &Idempotency{db: db, txManager: txManager, txGetter: txGetter, ttl: ttl}
+}
+
+// WithinTransaction runs fn in a transaction which the queries in fn's context join.
+func (i *Idempotency) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return i.txManager.Do(ctx, fn)
+}
+
+// Get retrieves a response from PostgreSQL by key.
+// It returns nil if the key doesn't exist or has expired.
+func (i *Idempotency) Get(ctx context.Context, key string) ([]byte, error) {
+	var val []byte
+	err := i.tx(ctx).QueryRow(ctx, queryGetIdempotency, key, time.Now().UTC()).Scan(&val)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return val, err
+}
+
+// Set stores a response in PostgreSQL with the given key and TTL.
+func (i *Idempotency) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := i.tx(ctx).Exec(ctx, querySetIdempotency, key, value, i.expiresAt(ttl))
+	return err
+}
+
+// SetIfAbsent stores the value in PostgreSQL only if the key doesn't exist or has expired.
+// It returns true if the value is stored.
+func (i *Idempotency) SetIfAbsent(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	tag, err := i.tx(ctx).Exec(ctx, querySetIdempotencyIfAbsent, key, value, i.expiresAt(ttl), time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Delete deletes the key from PostgreSQL.
+func (i *Idempotency) Delete(ctx context.Context, key string) error {
+	_, err := i.tx(ctx).Exec(ctx, queryDeleteIdempotency, key)
+	return err
+}
+
+// DeleteExpired deletes all expired keys and returns the number of deleted keys.
+// Expired keys are already invisible to Get, so it only needs to be run periodically to keep the table small.
+func (i *Idempotency) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := i.tx(ctx).Exec(ctx, queryDeleteExpiredIdempotency, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (i *Idempotency) tx(ctx context.Context) uow.Tr {
+	return i.txGetter.DefaultTrOrDB(ctx, i.db)
+}
+
+func (i *Idempotency) expiresAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		ttl = i.ttl
+	}
+	return time.Now().UTC().Add(ttl)
+}
@@ -0,0 +1,248 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+)
+
+type IdempotencySuite struct {
+	db        pgxmock.PgxPoolIface
+	txManager *mock_uow.MockTxManager
+	getter    *mock_uow.MockTxGetter
+	idp       *postgres.Idempotency
+}
+
+func TestNewIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success create idempotency", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+
+		assert.NotNil(t, st.idp)
+	})
+}
+
+func TestIdempotency_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT value FROM idempotency_keys WHERE key = \$1 AND expires_at > \$2`
+
+	t.Run("key doesn't exist", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs("key", pgxmock.AnyArg()).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.idp.Get(testCtx, "key")
+
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs("key", pgxmock.AnyArg()).WillReturnError(assert.AnError)
+
+		res, err := st.idp.Get(testCtx, "key")
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get value", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs("key", pgxmock.AnyArg()).
+			WillReturnRows(pgxmock.NewRows([]string{"value"}).AddRow([]byte("value")))
+
+		res, err := st.idp.Get(testCtx, "key")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("value"), res)
+	})
+}
+
+func TestIdempotency_Set(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO idempotency_keys`
+
+	t.Run("set returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs("key", []byte("value"), pgxmock.AnyArg()).WillReturnError(assert.AnError)
+
+		err := st.idp.Set(testCtx, "key", []byte("value"), 0)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success set value", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, 0)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs("key", []byte("value"), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.idp.Set(testCtx, "key", []byte("value"), time.Minute)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestIdempotency_SetIfAbsent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO idempotency_keys .+ WHERE idempotency_keys.expires_at <= \$4`
+
+	t.Run("set if absent returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs("key", []byte("value"), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnError(assert.AnError)
+
+		ok, err := st.idp.SetIfAbsent(testCtx, "key", []byte("value"), time.Minute)
+
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("key already exists", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs("key", []byte("value"), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		ok, err := st.idp.SetIfAbsent(testCtx, "key", []byte("value"), time.Minute)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("key is stored", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs("key", []byte("value"), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		ok, err := st.idp.SetIfAbsent(testCtx, "key", []byte("value"), time.Minute)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestIdempotency_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `DELETE FROM idempotency_keys WHERE key = \$1`
+
+	t.Run("delete returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs("key").WillReturnError(assert.AnError)
+
+		err := st.idp.Delete(testCtx, "key")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success delete key", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs("key").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := st.idp.Delete(testCtx, "key")
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestIdempotency_DeleteExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= \$1`
+
+	t.Run("delete expired returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(pgxmock.AnyArg()).WillReturnError(assert.AnError)
+
+		n, err := st.idp.DeleteExpired(testCtx)
+
+		assert.Error(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("success delete expired keys", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("DELETE", 3))
+
+		n, err := st.idp.DeleteExpired(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+	})
+}
+
+func TestIdempotency_WithinTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("transaction returns error", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		fn := func(context.Context) error { return nil }
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).Return(assert.AnError)
+
+		err := st.idp.WithinTransaction(testCtx, fn)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success run in transaction", func(t *testing.T) {
+		st := createIdempotencySuite(t, ctrl, time.Hour)
+		called := false
+		fn := func(context.Context) error {
+			called = true
+			return nil
+		}
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+		err := st.idp.WithinTransaction(testCtx, fn)
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+func createIdempotencySuite(t *testing.T, ctrl *gomock.Controller, ttl time.Duration) *IdempotencySuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	m := mock_uow.NewMockTxManager(ctrl)
+	g := mock_uow.NewMockTxGetter(ctrl)
+	return &IdempotencySuite{
+		db:        pool,
+		txManager: m,
+		getter:    g,
+		idp:       postgres.NewIdempotency(pool, m, g, ttl),
+	}
+}
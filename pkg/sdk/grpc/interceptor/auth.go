@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/indrasaputra/arjuna/service/auth/entity"
 	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
 )
 
@@ -20,6 +23,10 @@ const (
 	HeaderKeyUserID = HeaderKey("X-User-ID")
 	// HeaderKeyEmail contains user's email.
 	HeaderKeyEmail = HeaderKey("X-User-Email")
+	// HeaderKeyTokenID contains access token's id (jti).
+	HeaderKeyTokenID = HeaderKey("X-Token-ID")
+	// HeaderKeyTokenExpiresAt contains access token's expiry time.
+	HeaderKeyTokenExpiresAt = HeaderKey("X-Token-Expires-At")
 )
 
 // HeaderKey represents a string for request header key.
@@ -42,25 +49,56 @@ func AuthBasic(user, pass string) func(context.Context) (context.Context, error)
 }
 
 // AuthBearer intercepts the request and check for bearer authorization.
+// The token's signature is verified against the key set.
+// If store is not nil, the token must not have been revoked.
 // If success, it will inject the claims to context.
-func AuthBearer(secret []byte) func(context.Context) (context.Context, error) {
+func AuthBearer(keys sdkauth.KeySet, store TokenRevocationStore) func(context.Context) (context.Context, error) {
 	return func(ctx context.Context) (context.Context, error) {
 		token, err := grpcauth.AuthFromMD(ctx, bearer)
 		if err != nil {
 			return ctx, status.Error(codes.Unauthenticated, "unauthenticated")
 		}
 
-		claims, err := sdkauth.ParseToken(token, secret)
+		claims, err := sdkauth.ParseToken(ctx, token, keys)
 		if err != nil {
 			return ctx, status.Error(codes.Unauthenticated, "unauthenticated")
 		}
+		if err = checkRevocation(ctx, store, claims); err != nil {
+			return ctx, err
+		}
 
 		ctx = context.WithValue(ctx, HeaderKeyUserID, claims.UserID)
 		ctx = context.WithValue(ctx, HeaderKeyEmail, claims.Email)
+		ctx = context.WithValue(ctx, HeaderKeyTokenID, claims.ID)
+		if claims.ExpiresAt != nil {
+			ctx = context.WithValue(ctx, HeaderKeyTokenExpiresAt, claims.ExpiresAt.Time)
+		}
 		return ctx, nil
 	}
 }
 
+// checkRevocation rejects revoked token.
+// Tokens without id were issued before revocation existed and can't be revoked.
+// Tokens without issued at time are treated as issued at the beginning, so they are revoked along with their subject.
+func checkRevocation(ctx context.Context, store TokenRevocationStore, claims *entity.Claims) error {
+	if store == nil || claims.ID == "" {
+		return nil
+	}
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+	revoked, err := store.IsRevoked(ctx, claims.ID, claims.UserID.String(), issuedAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthBearer] fail check token revocation", "error", err)
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+	if revoked {
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return nil
+}
+
 // ApplyMethod applies the interceptor to the given methods.
 func ApplyMethod(methods ...string) func(context.Context, interceptors.CallMeta) bool {
 	return func(_ context.Context, c interceptors.CallMeta) bool {
@@ -2,6 +2,8 @@ package interceptor
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -21,6 +23,10 @@ const (
 	IdempotencyKeyHeader = "x-idempotency-key"
 	// DefaultIdempotencyTTL is the default TTL for idempotency key in Redis.
 	DefaultIdempotencyTTL = 1 * time.Hour
+	// DefaultIdempotencyLockTTL is how long a key is reserved while its request is in progress.
+	// It must be longer than the slowest request, otherwise a duplicate can run once the reservation expires.
+	// It is kept well above the 1 minute workflow timeout of the services which wait for their workflows.
+	DefaultIdempotencyLockTTL = 5 * time.Minute
 )
 
 // IdempotencyStore defines the interface for storing and retrieving idempotency responses.
@@ -29,44 +35,152 @@ type IdempotencyStore interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	// Set stores a response in the store with the given key and TTL.
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetIfAbsent atomically stores the value only if the key doesn't exist.
+	// It returns true if the value is stored.
+	SetIfAbsent(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Delete deletes the key.
+	Delete(ctx context.Context, key string) error
+}
+
+// TransactionalIdempotencyStore defines an IdempotencyStore which shares its database with the handler.
+// Its writes in the context given to fn join the same transaction as the handler's writes.
+type TransactionalIdempotencyStore interface {
+	IdempotencyStore
+	// WithinTransaction runs fn in a transaction. It commits if fn returns nil, otherwise it rolls back.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 // IdempotencyUnaryServerInterceptor creates a unary server interceptor for idempotency check.
-// It extracts the idempotency key from the request metadata, checks if it exists in the store,
-// and returns the cached response if found. Otherwise, it proceeds with the request and stores the response.
-func IdempotencyUnaryServerInterceptor(store IdempotencyStore) grpc.UnaryServerInterceptor {
+// It extracts the idempotency key from the request metadata and atomically reserves it in the store.
+// Only the request that reserves the key runs the handler, then its response replaces the reservation.
+// Other requests with the same key get the cached response, or codes.Aborted if the first request is still in progress.
+// The key is bound to a fingerprint of the request, so reusing it with a different request returns codes.InvalidArgument.
+// If the key can't be reserved because the store fails, it returns codes.Unavailable instead of running the handler unguarded.
+// The lockTTL is how long the key is reserved, zero means DefaultIdempotencyLockTTL.
+// If the store implements TransactionalIdempotencyStore, the response is stored in the handler's transaction,
+// hence the handler's writes are committed if and only if its response is stored.
+func IdempotencyUnaryServerInterceptor(store IdempotencyStore, lockTTL time.Duration) grpc.UnaryServerInterceptor {
+	if lockTTL <= 0 {
+		lockTTL = DefaultIdempotencyLockTTL
+	}
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		idpKey, err := extractIdempotencyKeyHeader(ctx)
-		if err != nil {
+		if err != nil || idpKey == "" {
 			return handler(ctx, req)
 		}
 
 		key := fmt.Sprintf("%s:%s", info.FullMethod, idpKey)
+		fingerprint := requestFingerprint(ctx, req)
 
-		cachedResp, err := store.Get(ctx, key)
-		// if error is nil, use normal flow
-		// only when cache returns non error and has cached response, use cached response
-		if err == nil && cachedResp != nil {
-			resp, errResp := unmarshalCachedResponse(cachedResp)
-			if errResp == nil && resp.Error != nil {
-				return nil, resp.Error
-			}
-			if errResp == nil && resp.Response != nil {
-				return resp.Response, nil
-			}
+		marker, err := json.Marshal(&serializableResponse{InProgress: true, Fingerprint: fingerprint})
+		if err != nil {
+			return nil, grpcstatus.Error(codes.Internal, "failed to reserve idempotency key")
+		}
+
+		reserved, err := store.SetIfAbsent(ctx, key, marker, lockTTL)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Idempotency] failed to reserve key", "key", key, "error", err)
+			return nil, grpcstatus.Error(codes.Unavailable, "idempotency key can't be reserved, please retry")
+		}
+		if !reserved {
+			return replayResponse(ctx, store, key, fingerprint)
+		}
+		if txStore, ok := store.(TransactionalIdempotencyStore); ok {
+			return handleInTransaction(ctx, txStore, key, fingerprint, req, handler)
 		}
 
 		resp, err := handler(ctx, req)
 
-		if key != "" {
-			if cacheErr := cacheResponse(ctx, store, key, resp, err); cacheErr != nil {
-				slog.ErrorContext(ctx, "[Idempotency] failed to cache response", "key", key, "error", cacheErr)
-			}
+		if cacheErr := cacheResponse(ctx, store, key, fingerprint, resp, err); cacheErr != nil {
+			slog.ErrorContext(ctx, "[Idempotency] failed to cache response", "key", key, "error", cacheErr)
+			// release the reservation so the client can retry instead of waiting for it to expire
+			releaseKey(ctx, store, key)
 		}
 		return resp, err
 	}
 }
 
+// handleInTransaction runs the handler and stores its response in one transaction.
+// If the handler fails, its writes are rolled back and its error is stored on its own.
+// If the response can't be stored, the handler's writes are rolled back and the key is released.
+func handleInTransaction(ctx context.Context, store TransactionalIdempotencyStore, key, fingerprint string, req any, handler grpc.UnaryHandler) (any, error) {
+	var resp any
+	var handlerErr error
+	err := store.WithinTransaction(ctx, func(ctx context.Context) error {
+		resp, handlerErr = handler(ctx, req)
+		if handlerErr != nil {
+			return handlerErr
+		}
+		return cacheResponse(ctx, store, key, fingerprint, resp, nil)
+	})
+
+	if handlerErr != nil {
+		if cacheErr := cacheResponse(ctx, store, key, fingerprint, nil, handlerErr); cacheErr != nil {
+			slog.ErrorContext(ctx, "[Idempotency] failed to cache error", "key", key, "error", cacheErr)
+			releaseKey(ctx, store, key)
+		}
+		return nil, handlerErr
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[Idempotency] failed to cache response in transaction", "key", key, "error", err)
+		releaseKey(ctx, store, key)
+		return nil, grpcstatus.Error(codes.Unavailable, "response can't be stored, please retry")
+	}
+	return resp, nil
+}
+
+func releaseKey(ctx context.Context, store IdempotencyStore, key string) {
+	if err := store.Delete(ctx, key); err != nil {
+		slog.ErrorContext(ctx, "[Idempotency] failed to release key", "key", key, "error", err)
+	}
+}
+
+// replayResponse returns the response of the request that reserved the key.
+func replayResponse(ctx context.Context, store IdempotencyStore, key, fingerprint string) (any, error) {
+	cachedResp, err := store.Get(ctx, key)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Idempotency] failed to get cached response", "key", key, "error", err)
+		return nil, grpcstatus.Error(codes.Unavailable, "idempotency key can't be checked, please retry")
+	}
+	// the key is released between reservation and get, most likely because the first request failed to cache its response
+	if cachedResp == nil {
+		return nil, grpcstatus.Error(codes.Aborted, "request with the same idempotency key has just finished, please retry")
+	}
+
+	resp, err := unmarshalCachedResponse(cachedResp)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Idempotency] failed to unmarshal cached response", "key", key, "error", err)
+		return nil, grpcstatus.Error(codes.Internal, "failed to read cached response")
+	}
+	// entries cached without fingerprint can't be compared, so they are replayed as is
+	if resp.Fingerprint != "" && resp.Fingerprint != fingerprint {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "idempotency key is already used with a different request")
+	}
+	if resp.InProgress {
+		return nil, grpcstatus.Error(codes.Aborted, "request with the same idempotency key is still in progress")
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return resp.Response, nil
+}
+
+// requestFingerprint returns the hex encoded SHA-256 of the deterministically marshaled request.
+// It returns empty string if the request can't be fingerprinted.
+func requestFingerprint(ctx context.Context, req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Idempotency] failed to fingerprint request", "error", err)
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 func extractIdempotencyKeyHeader(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -85,8 +199,10 @@ func extractIdempotencyKeyHeader(ctx context.Context) (string, error) {
 
 // cachedResponse represents a cached response structure.
 type cachedResponse struct {
-	Response any
-	Error    error
+	Response    any
+	Error       error
+	Fingerprint string
+	InProgress  bool
 }
 
 // cacheableError represents an error that can be serialized and cached.
@@ -96,14 +212,16 @@ type cacheableError struct {
 
 // serializableResponse represents a serializable cached response.
 type serializableResponse struct {
-	Error    *cacheableError `json:"error,omitempty"`
-	TypeURL  string          `json:"type_url,omitempty"`
-	Response []byte          `json:"response,omitempty"`
+	Error       *cacheableError `json:"error,omitempty"`
+	TypeURL     string          `json:"type_url,omitempty"`
+	Fingerprint string          `json:"fingerprint,omitempty"`
+	Response    []byte          `json:"response,omitempty"`
+	InProgress  bool            `json:"in_progress,omitempty"`
 }
 
 // cacheResponse caches the response in the store.
-func cacheResponse(ctx context.Context, store IdempotencyStore, key string, resp any, err error) error {
-	sr := &serializableResponse{}
+func cacheResponse(ctx context.Context, store IdempotencyStore, key, fingerprint string, resp any, err error) error {
+	sr := &serializableResponse{Fingerprint: fingerprint}
 
 	if err != nil {
 		st, ok := grpcstatus.FromError(err)
@@ -158,7 +276,7 @@ func unmarshalCachedResponse(data []byte) (*cachedResponse, error) {
 		return nil, err
 	}
 
-	cr := &cachedResponse{}
+	cr := &cachedResponse{Fingerprint: sr.Fingerprint, InProgress: sr.InProgress}
 
 	if sr.Error != nil {
 		if len(sr.Error.StatusProto) > 0 {
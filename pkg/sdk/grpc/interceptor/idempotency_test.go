@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -24,42 +25,51 @@ import (
 func TestIdempotencyUnaryServerInterceptor(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	key := "/test.Service/Method:test-key"
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	inProgress := []byte(`{"in_progress":true}`)
 
 	t.Run("no idempotency key provided", func(t *testing.T) {
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
 			return &emptypb.Empty{}, nil
 		}
 
-		ctx := context.Background()
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(context.Background(), nil, info, handler)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 	})
 
-	t.Run("idempotency key provided but no cached response", func(t *testing.T) {
+	t.Run("empty idempotency key is ignored", func(t *testing.T) {
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
-		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(nil, nil)
-		store.EXPECT().
-			Set(gomock.Any(), "/test.Service/Method:test-key", gomock.Any(), interceptor.DefaultIdempotencyTTL).
-			Return(nil)
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
-			return &wrapperspb.StringValue{Value: "success"}, nil
+			return &emptypb.Empty{}, nil
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
+		resp, err := interceptorFunc(createIdempotencyContext(""), nil, info, handler)
 
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("idempotency key is reserved and response is cached", func(t *testing.T) {
+		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil),
+			store.EXPECT().Set(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(nil),
+		)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return &wrapperspb.StringValue{Value: "success"}, nil
+		}
+
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -69,23 +79,16 @@ func TestIdempotencyUnaryServerInterceptor(t *testing.T) {
 		testResp := &wrapperspb.StringValue{Value: "cached"}
 		cachedData := createCachedResponse(t, testResp, nil)
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(false, nil)
+		store.EXPECT().Get(gomock.Any(), key).Return(cachedData, nil)
 
-		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(cachedData, nil)
-
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
 			t.Fatal("Handler should not be called when cached response exists")
 			return nil, nil
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
-
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -98,22 +101,16 @@ func TestIdempotencyUnaryServerInterceptor(t *testing.T) {
 		testErr := grpcstatus.Error(codes.InvalidArgument, "test error")
 		cachedData := createCachedResponse(t, nil, testErr)
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
-		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(cachedData, nil)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(false, nil)
+		store.EXPECT().Get(gomock.Any(), key).Return(cachedData, nil)
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
 			t.Fatal("Handler should not be called")
 			return nil, nil
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
-
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
@@ -123,83 +120,264 @@ func TestIdempotencyUnaryServerInterceptor(t *testing.T) {
 		assert.Equal(t, "test error", st.Message())
 	})
 
-	t.Run("redis get error continues processing", func(t *testing.T) {
+	t.Run("concurrent duplicate is aborted while first request is in progress", func(t *testing.T) {
+		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(false, nil)
+		store.EXPECT().Get(gomock.Any(), key).Return(inProgress, nil)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Handler should not be called")
+			return nil, nil
+		}
+
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Aborted, grpcstatus.Code(err))
+	})
+
+	t.Run("duplicate is aborted when reservation is released before get", func(t *testing.T) {
+		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(false, nil)
+		store.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			t.Fatal("Handler should not be called")
+			return nil, nil
+		}
+
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Aborted, grpcstatus.Code(err))
+	})
+
+	t.Run("duplicate fails when cached response can't be read", func(t *testing.T) {
+		tests := []struct {
+			err    error
+			cached []byte
+			code   codes.Code
+		}{
+			{cached: nil, err: errors.New("redis error"), code: codes.Unavailable},
+			{cached: []byte("invalid"), err: nil, code: codes.Internal},
+		}
+
+		for _, test := range tests {
+			store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+			store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(false, nil)
+			store.EXPECT().Get(gomock.Any(), key).Return(test.cached, test.err)
+
+			interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+			handler := func(_ context.Context, _ any) (any, error) {
+				t.Fatal("Handler should not be called")
+				return nil, nil
+			}
+
+			resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
+
+			assert.Nil(t, resp)
+			assert.Equal(t, test.code, grpcstatus.Code(err))
+		}
+	})
+
+	t.Run("reserve error is unavailable and does not run the handler", func(t *testing.T) {
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
 		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(nil, errors.New("redis error"))
-		store.EXPECT().
-			Set(gomock.Any(), "/test.Service/Method:test-key", gomock.Any(), interceptor.DefaultIdempotencyTTL).
-			Return(nil)
+			SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).
+			Return(false, errors.New("redis error"))
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
-			return &emptypb.Empty{}, nil
+			t.Fatal("handler must not be called")
+			return nil, nil
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
+
+		assert.Equal(t, codes.Unavailable, grpcstatus.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("key is reserved for the configured lock ttl", func(t *testing.T) {
+		lockTTL := 3 * time.Minute
+		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, lockTTL).Return(true, nil),
+			store.EXPECT().Set(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(nil),
+		)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, lockTTL)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return &emptypb.Empty{}, nil
+		}
 
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 	})
 
-	t.Run("redis set error does not fail request", func(t *testing.T) {
+	t.Run("redis set error releases the key and does not fail request", func(t *testing.T) {
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
-		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(nil, nil)
-		store.EXPECT().
-			Set(gomock.Any(), "/test.Service/Method:test-key", gomock.Any(), interceptor.DefaultIdempotencyTTL).
-			Return(errors.New("redis error"))
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil),
+			store.EXPECT().Set(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(errors.New("redis error")),
+			store.EXPECT().Delete(gomock.Any(), key).Return(errors.New("redis error")),
+		)
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handler := func(_ context.Context, _ any) (any, error) {
 			return &emptypb.Empty{}, nil
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
-
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("idempotency key reused with a different request is rejected", func(t *testing.T) {
+		var marker, cached []byte
+		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyLockTTL).
+			DoAndReturn(func(_ context.Context, _ string, value []byte, _ time.Duration) (bool, error) {
+				marker = value
+				return true, nil
+			})
+		store.EXPECT().Set(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyTTL).
+			DoAndReturn(func(_ context.Context, _ string, value []byte, _ time.Duration) error {
+				cached = value
+				return nil
+			})
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return &wrapperspb.StringValue{Value: "success"}, nil
+		}
+		ctx := createIdempotencyContext("test-key")
+
+		_, err := interceptorFunc(ctx, &wrapperspb.Int64Value{Value: 100}, info, handler)
+		assert.NoError(t, err)
+
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyLockTTL).Return(false, nil).Times(3)
+		store.EXPECT().Get(gomock.Any(), key).Return(marker, nil)
+		store.EXPECT().Get(gomock.Any(), key).Return(cached, nil).Times(2)
+
+		resp, err := interceptorFunc(ctx, &wrapperspb.Int64Value{Value: 200}, info, handler)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, grpcstatus.Code(err))
+
+		resp, err = interceptorFunc(ctx, &wrapperspb.Int64Value{Value: 200}, info, handler)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, grpcstatus.Code(err))
+
+		resp, err = interceptorFunc(ctx, &wrapperspb.Int64Value{Value: 100}, info, handler)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&wrapperspb.StringValue{Value: "success"}, resp.(proto.Message)))
+	})
+
 	t.Run("handler error is cached", func(t *testing.T) {
 		store := mock_interceptor.NewMockIdempotencyStore(ctrl)
-		store.EXPECT().
-			Get(gomock.Any(), "/test.Service/Method:test-key").
-			Return(nil, nil)
-		store.EXPECT().
-			Set(gomock.Any(), "/test.Service/Method:test-key", gomock.Any(), interceptor.DefaultIdempotencyTTL).
-			Return(nil)
+		store.EXPECT().SetIfAbsent(gomock.Any(), key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil)
+		store.EXPECT().Set(gomock.Any(), key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(nil)
 
-		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store)
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
 		handlerErr := grpcstatus.Error(codes.NotFound, "not found")
 		handler := func(_ context.Context, _ any) (any, error) {
 			return nil, handlerErr
 		}
 
-		md := metadata.New(map[string]string{
-			interceptor.IdempotencyKeyHeader: "test-key",
-		})
-		ctx := metadata.NewIncomingContext(context.Background(), md)
-
-		resp, err := interceptorFunc(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+		resp, err := interceptorFunc(createIdempotencyContext("test-key"), nil, info, handler)
 
 		assert.Error(t, err)
 		assert.Nil(t, resp)
 	})
 }
 
+func TestIdempotencyUnaryServerInterceptor_Transactional(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	key := "/test.Service/Method:test-key"
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	inProgress := []byte(`{"in_progress":true}`)
+	ctx := createIdempotencyContext("test-key")
+	txCtx := context.WithValue(ctx, txCtxKey("tx"), true)
+	withinTransaction := func(_ context.Context, fn func(context.Context) error) error {
+		return fn(txCtx)
+	}
+
+	t.Run("response is stored in the handler's transaction", func(t *testing.T) {
+		store := mock_interceptor.NewMockTransactionalIdempotencyStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(ctx, key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil),
+			store.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(withinTransaction),
+			store.EXPECT().Set(txCtx, key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(nil),
+		)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(ctx context.Context, _ any) (any, error) {
+			assert.Equal(t, txCtx, ctx)
+			return &wrapperspb.StringValue{Value: "success"}, nil
+		}
+
+		resp, err := interceptorFunc(ctx, nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&wrapperspb.StringValue{Value: "success"}, resp.(proto.Message)))
+	})
+
+	t.Run("handler error is rolled back and cached outside the transaction", func(t *testing.T) {
+		store := mock_interceptor.NewMockTransactionalIdempotencyStore(ctrl)
+		handlerErr := grpcstatus.Error(codes.NotFound, "not found")
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(ctx, key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil),
+			store.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(withinTransaction),
+			store.EXPECT().Set(ctx, key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(nil),
+		)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return nil, handlerErr
+		}
+
+		resp, err := interceptorFunc(ctx, nil, info, handler)
+
+		assert.Equal(t, handlerErr, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("store error rolls back the handler and releases the key", func(t *testing.T) {
+		store := mock_interceptor.NewMockTransactionalIdempotencyStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().SetIfAbsent(ctx, key, inProgress, interceptor.DefaultIdempotencyLockTTL).Return(true, nil),
+			store.EXPECT().WithinTransaction(ctx, gomock.Any()).DoAndReturn(withinTransaction),
+			store.EXPECT().Set(txCtx, key, gomock.Any(), interceptor.DefaultIdempotencyTTL).Return(errors.New("db error")),
+			store.EXPECT().Delete(ctx, key).Return(nil),
+		)
+
+		interceptorFunc := interceptor.IdempotencyUnaryServerInterceptor(store, 0)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return &emptypb.Empty{}, nil
+		}
+
+		resp, err := interceptorFunc(ctx, nil, info, handler)
+
+		assert.Equal(t, codes.Unavailable, grpcstatus.Code(err))
+		assert.Nil(t, resp)
+	})
+}
+
+type txCtxKey string
+
+func createIdempotencyContext(key string) context.Context {
+	md := metadata.New(map[string]string{
+		interceptor.IdempotencyKeyHeader: key,
+	})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
 func createCachedResponse(t *testing.T, resp any, respErr error) []byte {
 	t.Helper()
 
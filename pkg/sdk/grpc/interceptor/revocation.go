@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRevocationCacheTTL is the default time a revocation check result is kept in memory.
+	DefaultRevocationCacheTTL = 5 * time.Second
+
+	revocationCacheSweepSize = 10000
+)
+
+// TokenRevocationStore defines the interface to check whether an access token has been revoked.
+type TokenRevocationStore interface {
+	// IsRevoked checks whether the token id (jti) has been revoked,
+	// or the token has been issued at or before all tokens of its subject are revoked.
+	IsRevoked(ctx context.Context, id, subject string, issuedAt time.Time) (bool, error)
+}
+
+type revocationEntry struct {
+	expiresAt time.Time
+	revoked   bool
+}
+
+// RevocationCache keeps the result of TokenRevocationStore in memory for a short time,
+// so the bearer check does not cost a network hop on every call.
+// Revoked results never change, so only a token revoked after being cached as valid
+// can pass the check, and only until the entry expires.
+type RevocationCache struct {
+	store   TokenRevocationStore
+	entries map[string]revocationEntry
+	ttl     time.Duration
+	mu      sync.RWMutex
+}
+
+// NewRevocationCache creates an instance of RevocationCache.
+// If ttl is not positive, DefaultRevocationCacheTTL is used.
+func NewRevocationCache(store TokenRevocationStore, ttl time.Duration) *RevocationCache {
+	if ttl <= 0 {
+		ttl = DefaultRevocationCacheTTL
+	}
+	return &RevocationCache{store: store, ttl: ttl, entries: make(map[string]revocationEntry)}
+}
+
+// IsRevoked checks whether the token has been revoked.
+// It consults the underlying store only when the id is not in memory or the entry has expired.
+// The entry is kept by the token id since the subject and issued at time never change for the same token.
+func (r *RevocationCache) IsRevoked(ctx context.Context, id, subject string, issuedAt time.Time) (bool, error) {
+	now := time.Now()
+
+	r.mu.RLock()
+	entry, ok := r.entries[id]
+	r.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := r.store.IsRevoked(ctx, id, subject, issuedAt)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	if len(r.entries) >= revocationCacheSweepSize {
+		r.sweep(now)
+	}
+	r.entries[id] = revocationEntry{revoked: revoked, expiresAt: now.Add(r.ttl)}
+	r.mu.Unlock()
+	return revoked, nil
+}
+
+// sweep removes expired entries. It must be called with the lock held.
+func (r *RevocationCache) sweep(now time.Time) {
+	for id, entry := range r.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(r.entries, id)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
+	mock_interceptor "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/grpc/interceptor"
+)
+
+func TestNewRevocationCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of RevocationCache", func(t *testing.T) {
+		store := mock_interceptor.NewMockTokenRevocationStore(ctrl)
+		cache := interceptor.NewRevocationCache(store, 0)
+		assert.NotNil(t, cache)
+	})
+}
+
+func TestRevocationCache_IsRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	issuedAt := time.Now()
+
+	t.Run("store returns error", func(t *testing.T) {
+		store := mock_interceptor.NewMockTokenRevocationStore(ctrl)
+		store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(false, assert.AnError)
+		cache := interceptor.NewRevocationCache(store, time.Minute)
+
+		res, err := cache.IsRevoked(ctx, "jti", "user", issuedAt)
+
+		assert.Error(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("error is not cached", func(t *testing.T) {
+		store := mock_interceptor.NewMockTokenRevocationStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(false, assert.AnError),
+			store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(true, nil),
+		)
+		cache := interceptor.NewRevocationCache(store, time.Minute)
+
+		_, _ = cache.IsRevoked(ctx, "jti", "user", issuedAt)
+		res, err := cache.IsRevoked(ctx, "jti", "user", issuedAt)
+
+		assert.NoError(t, err)
+		assert.True(t, res)
+	})
+
+	t.Run("result is served from memory within ttl", func(t *testing.T) {
+		store := mock_interceptor.NewMockTokenRevocationStore(ctrl)
+		store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(true, nil).Times(1)
+		cache := interceptor.NewRevocationCache(store, time.Minute)
+
+		for range 3 {
+			res, err := cache.IsRevoked(ctx, "jti", "user", issuedAt)
+
+			assert.NoError(t, err)
+			assert.True(t, res)
+		}
+	})
+
+	t.Run("expired result is checked again", func(t *testing.T) {
+		store := mock_interceptor.NewMockTokenRevocationStore(ctrl)
+		gomock.InOrder(
+			store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(false, nil),
+			store.EXPECT().IsRevoked(ctx, "jti", "user", issuedAt).Return(true, nil),
+		)
+		cache := interceptor.NewRevocationCache(store, time.Millisecond)
+
+		res, err := cache.IsRevoked(ctx, "jti", "user", issuedAt)
+		assert.NoError(t, err)
+		assert.False(t, res)
+
+		time.Sleep(2 * time.Millisecond)
+
+		res, err = cache.IsRevoked(ctx, "jti", "user", issuedAt)
+		assert.NoError(t, err)
+		assert.True(t, res)
+	})
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	sdklog "github.com/indrasaputra/arjuna/pkg/sdk/log"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
 )
 
 const (
@@ -46,6 +47,8 @@ type Server struct {
 // Config represents server's config.
 type Config struct {
 	IdempotencyStore          interceptor.IdempotencyStore
+	TokenRevocationStore      interceptor.TokenRevocationStore
+	KeySet                    sdkauth.KeySet
 	Name                      string
 	Port                      string
 	Username                  string
@@ -53,7 +56,9 @@ type Config struct {
 	AppliedBearerAuthMethods  []string
 	AppliedBasicAuthMethods   []string
 	AppliedIdempotencyMethods []string
-	Secret                    []byte
+	// IdempotencyLockTTL is how long an idempotency key is reserved while its request is in progress.
+	// Zero means interceptor.DefaultIdempotencyLockTTL.
+	IdempotencyLockTTL time.Duration
 }
 
 // newGrpc creates an instance of Server.
@@ -170,12 +175,12 @@ func defaultUnaryServerInterceptors(logger *slog.Logger, cfg *Config) []grpc.Una
 		logging.UnaryServerInterceptor(interceptor.SlogLogger(logger), opts...),
 		grpc_prometheus.UnaryServerInterceptor,
 		selector.UnaryServerInterceptor(auth.UnaryServerInterceptor(interceptor.AuthBasic(cfg.Username, cfg.Password)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBasicAuthMethods...))),
-		selector.UnaryServerInterceptor(auth.UnaryServerInterceptor(interceptor.AuthBearer(cfg.Secret)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBearerAuthMethods...))),
+		selector.UnaryServerInterceptor(auth.UnaryServerInterceptor(interceptor.AuthBearer(cfg.KeySet, cfg.TokenRevocationStore)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBearerAuthMethods...))),
 	}
 
 	if cfg.IdempotencyStore != nil && len(cfg.AppliedIdempotencyMethods) > 0 {
 		idempotencyInterceptor := selector.UnaryServerInterceptor(
-			interceptor.IdempotencyUnaryServerInterceptor(cfg.IdempotencyStore),
+			interceptor.IdempotencyUnaryServerInterceptor(cfg.IdempotencyStore, cfg.IdempotencyLockTTL),
 			selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedIdempotencyMethods...)),
 		)
 		interceptors = append(interceptors, idempotencyInterceptor)
@@ -194,7 +199,7 @@ func defaultStreamServerInterceptors(logger *slog.Logger, cfg *Config) []grpc.St
 		logging.StreamServerInterceptor(interceptor.SlogLogger(logger), opts...),
 		grpc_prometheus.StreamServerInterceptor,
 		selector.StreamServerInterceptor(auth.StreamServerInterceptor(interceptor.AuthBasic(cfg.Username, cfg.Password)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBasicAuthMethods...))),
-		selector.StreamServerInterceptor(auth.StreamServerInterceptor(interceptor.AuthBearer(cfg.Secret)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBearerAuthMethods...))),
+		selector.StreamServerInterceptor(auth.StreamServerInterceptor(interceptor.AuthBearer(cfg.KeySet, cfg.TokenRevocationStore)), selector.MatchFunc(interceptor.ApplyMethod(cfg.AppliedBearerAuthMethods...))),
 	}
 }
 
@@ -0,0 +1,24 @@
+// Package outbox provides a generic transactional outbox backed by PostgreSQL.
+//
+// Messages are enqueued in the same transaction as the business write,
+// then a relayer claims and hands them to the handler registered for their topic.
+// It expects the following table to exist.
+//
+//	CREATE TYPE outbox_status AS ENUM ('READY', 'PROCESSED', 'DELIVERED', 'FAILED', 'DEAD_LETTER');
+//
+//	CREATE TABLE IF NOT EXISTS outbox (
+//	    id UUID PRIMARY KEY,
+//	    topic TEXT NOT NULL,
+//	    payload JSONB NOT NULL,
+//	    status OUTBOX_STATUS NOT NULL DEFAULT 'READY',
+//	    attempts INTEGER NOT NULL DEFAULT 0,
+//	    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+//	    last_error TEXT,
+//	    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+//	    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+//	);
+//
+//	CREATE INDEX IF NOT EXISTS index_on_outbox_on_status_and_next_attempt_at ON outbox USING btree (
+//	    status, next_attempt_at
+//	);
+package outbox
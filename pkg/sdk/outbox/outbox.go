@@ -0,0 +1,188 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
+)
+
+const (
+	messageColumns = `id, topic, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at`
+
+	queryEnqueue = `INSERT INTO outbox (id, topic, payload, status, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, 'READY', $4, $4, $4)`
+	// the due messages are claimed and marked as processed until the lease expires in one statement,
+	// so the row locks are released as soon as it finishes.
+	queryClaim = `UPDATE outbox SET status = 'PROCESSED', next_attempt_at = $2, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status IN ('READY', 'FAILED', 'PROCESSED') AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + messageColumns
+	queryMarkDelivered = `UPDATE outbox SET status = 'DELIVERED', payload = payload - COALESCE($2::TEXT[], '{}'), updated_at = NOW()
+		WHERE id = $1`
+	queryMarkAttempt = `UPDATE outbox SET status = $1, attempts = attempts + 1, next_attempt_at = $2, last_error = $3, updated_at = NOW()
+		WHERE id = $4`
+	queryListDeadLetter = `SELECT ` + messageColumns + ` FROM outbox
+		WHERE status = 'DEAD_LETTER' ORDER BY updated_at ASC LIMIT $1`
+	queryRequeue = `UPDATE outbox SET status = 'READY', attempts = 0, next_attempt_at = NOW(), last_error = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = 'DEAD_LETTER'`
+)
+
+var (
+	// ErrNotFound is returned when the message doesn't exist or isn't in the expected status.
+	ErrNotFound = errors.New("outbox message not found")
+)
+
+// Status enumerates outbox message status.
+type Status string
+
+var (
+	// StatusReady means ready to be picked up.
+	StatusReady Status = "READY"
+	// StatusProcessed means claimed by a relayer and being processed.
+	// The relayer holds it until next attempt time, after that it can be claimed again.
+	StatusProcessed Status = "PROCESSED"
+	// StatusDelivered means successfully handled.
+	StatusDelivered Status = "DELIVERED"
+	// StatusFailed means failure and will be retried after next attempt time.
+	StatusFailed Status = "FAILED"
+	// StatusDeadLetter means it keeps failing until the maximum attempts and won't be retried.
+	// It needs to be requeued manually.
+	StatusDeadLetter Status = "DEAD_LETTER"
+)
+
+// Message defines an outbox message.
+type Message struct {
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Topic         string
+	Status        Status
+	LastError     string
+	Payload       json.RawMessage
+	ID            uuid.UUID
+	Attempts      int32
+}
+
+// Decode unmarshals message's payload into v.
+func (m *Message) Decode(v any) error {
+	return json.Unmarshal(m.Payload, v)
+}
+
+// Store is responsible to connect outbox message with outbox table in PostgreSQL.
+// The queries run in the transaction found in the context, if any.
+type Store struct {
+	db       uow.Tr
+	txGetter uow.TxGetter
+}
+
+// NewStore creates an instance of Store.
+func NewStore(db uow.Tr, txGetter uow.TxGetter) *Store {
+	return &Store{db: db, txGetter: txGetter}
+}
+
+// Enqueue stores payload as a message of the topic.
+// Call it inside uow.TxManager.Do to enqueue the message atomically with the business write.
+// The payload is marshaled to JSON.
+func (s *Store) Enqueue(ctx context.Context, topic string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	_, err = s.tx(ctx).Exec(ctx, queryEnqueue, id, topic, data, time.Now().UTC())
+	return err
+}
+
+// Claim marks at most limit due messages as processed until leaseUntil and returns them.
+// Ready messages, failed messages whose next attempt is due, and processed messages whose lease has expired are due.
+// Messages locked by other relayers are skipped, so several relayers can claim concurrently.
+func (s *Store) Claim(ctx context.Context, limit uint, leaseUntil time.Time) ([]*Message, error) {
+	rows, err := s.tx(ctx).Query(ctx, queryClaim, int32(limit), leaseUntil)
+	if err != nil {
+		return nil, err
+	}
+	return collectMessages(rows)
+}
+
+// MarkDelivered marks the message as delivered.
+// The redacted keys are removed from the payload since they are no longer needed once it is handled.
+func (s *Store) MarkDelivered(ctx context.Context, id uuid.UUID, redactedKeys []string) error {
+	_, err := s.tx(ctx).Exec(ctx, queryMarkDelivered, id, redactedKeys)
+	return err
+}
+
+// MarkFailed marks the message as failed and counts the attempt.
+// The message will be claimed again at nextAttemptAt.
+func (s *Store) MarkFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, reason string) error {
+	_, err := s.tx(ctx).Exec(ctx, queryMarkAttempt, StatusFailed, nextAttemptAt, reason, id)
+	return err
+}
+
+// MarkDeadLetter marks the message as dead letter and counts the attempt.
+// The message won't be claimed again until it is requeued.
+func (s *Store) MarkDeadLetter(ctx context.Context, id uuid.UUID, reason string) error {
+	_, err := s.tx(ctx).Exec(ctx, queryMarkAttempt, StatusDeadLetter, time.Now().UTC(), reason, id)
+	return err
+}
+
+// ListDeadLetter returns at most limit dead-lettered messages, the oldest first.
+func (s *Store) ListDeadLetter(ctx context.Context, limit uint) ([]*Message, error) {
+	rows, err := s.tx(ctx).Query(ctx, queryListDeadLetter, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	return collectMessages(rows)
+}
+
+// Requeue makes the dead-lettered message ready to be claimed again with its attempts reset.
+// It returns ErrNotFound if the message doesn't exist or isn't dead-lettered.
+func (s *Store) Requeue(ctx context.Context, id uuid.UUID) error {
+	tag, err := s.tx(ctx).Exec(ctx, queryRequeue, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) tx(ctx context.Context) uow.Tr {
+	return s.txGetter.DefaultTrOrDB(ctx, s.db)
+}
+
+func collectMessages(rows pgx.Rows) ([]*Message, error) {
+	defer rows.Close()
+
+	var messages []*Message
+	for rows.Next() {
+		var (
+			m         Message
+			lastError *string
+		)
+		if err := rows.Scan(&m.ID, &m.Topic, &m.Payload, &m.Status, &m.Attempts, &m.NextAttemptAt, &lastError, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if lastError != nil {
+			m.LastError = *lastError
+		}
+		messages = append(messages, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
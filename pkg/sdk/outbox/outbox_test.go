@@ -0,0 +1,303 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/outbox"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+)
+
+var (
+	testCtx        = context.Background()
+	messageColumns = []string{"id", "topic", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "updated_at"}
+)
+
+type StoreSuite struct {
+	db     pgxmock.PgxPoolIface
+	getter *mock_uow.MockTxGetter
+	store  *outbox.Store
+}
+
+func TestNewStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success create store", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+
+		assert.NotNil(t, st.store)
+	})
+}
+
+func TestMessage_Decode(t *testing.T) {
+	t.Run("success decode payload", func(t *testing.T) {
+		msg := &outbox.Message{Payload: json.RawMessage(`{"amount":"10"}`)}
+		var res map[string]string
+
+		err := msg.Decode(&res)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10", res["amount"])
+	})
+}
+
+func TestStore_Enqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO outbox \(id, topic, payload, status, next_attempt_at, created_at, updated_at\)`
+
+	t.Run("payload can't be marshaled", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+
+		err := st.store.Enqueue(testCtx, "topic", make(chan int))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("insert returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), "topic", []byte(`{"id":1}`), pgxmock.AnyArg()).
+			WillReturnError(assert.AnError)
+
+		err := st.store.Enqueue(testCtx, "topic", map[string]int{"id": 1})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success enqueue", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), "topic", []byte(`{"id":1}`), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.store.Enqueue(testCtx, "topic", map[string]int{"id": 1})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestStore_Claim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE outbox SET status = 'PROCESSED', next_attempt_at = \$2.+FOR UPDATE SKIP LOCKED.+RETURNING`
+	leaseUntil := time.Now().UTC().Add(time.Minute)
+
+	t.Run("claim returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(int32(10), leaseUntil).WillReturnError(assert.AnError)
+
+		res, err := st.store.Claim(testCtx, 10, leaseUntil)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("row can't be scanned", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(int32(10), leaseUntil).
+			WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uuid.Must(uuid.NewV7())))
+
+		res, err := st.store.Claim(testCtx, 10, leaseUntil)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success claim", func(t *testing.T) {
+		now := time.Now()
+		reason := "unavailable"
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(int32(10), leaseUntil).
+			WillReturnRows(pgxmock.NewRows(messageColumns).
+				AddRow(uuid.Must(uuid.NewV7()), "topic", json.RawMessage(`{}`), outbox.StatusProcessed, int32(0), now, nil, now, now).
+				AddRow(uuid.Must(uuid.NewV7()), "topic", json.RawMessage(`{}`), outbox.StatusProcessed, int32(2), now, &reason, now, now))
+
+		res, err := st.store.Claim(testCtx, 10, leaseUntil)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Empty(t, res[0].LastError)
+		assert.Equal(t, reason, res[1].LastError)
+		assert.Equal(t, int32(2), res[1].Attempts)
+	})
+}
+
+func TestStore_MarkDelivered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE outbox SET status = 'DELIVERED', payload = payload - COALESCE\(\$2::TEXT\[\], '{}'\)`
+	id := uuid.Must(uuid.NewV7())
+	keys := []string{"secret"}
+
+	t.Run("mark delivered returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(id, keys).WillReturnError(assert.AnError)
+
+		err := st.store.MarkDelivered(testCtx, id, keys)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success mark delivered", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(id, keys).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.store.MarkDelivered(testCtx, id, keys)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestStore_MarkFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE outbox SET status = \$1, attempts = attempts \+ 1`
+	id := uuid.Must(uuid.NewV7())
+	next := time.Now().Add(time.Minute)
+
+	t.Run("mark failed returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(outbox.StatusFailed, next, "reason", id).WillReturnError(assert.AnError)
+
+		err := st.store.MarkFailed(testCtx, id, next, "reason")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success mark failed", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(outbox.StatusFailed, next, "reason", id).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.store.MarkFailed(testCtx, id, next, "reason")
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestStore_MarkDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE outbox SET status = \$1, attempts = attempts \+ 1`
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("mark dead letter returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(outbox.StatusDeadLetter, pgxmock.AnyArg(), "reason", id).WillReturnError(assert.AnError)
+
+		err := st.store.MarkDeadLetter(testCtx, id, "reason")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success mark dead letter", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(outbox.StatusDeadLetter, pgxmock.AnyArg(), "reason", id).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.store.MarkDeadLetter(testCtx, id, "reason")
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestStore_ListDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT .+ FROM outbox WHERE status = 'DEAD_LETTER' ORDER BY updated_at ASC LIMIT \$1`
+
+	t.Run("list returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(int32(10)).WillReturnError(assert.AnError)
+
+		res, err := st.store.ListDeadLetter(testCtx, 10)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success list dead letter", func(t *testing.T) {
+		now := time.Now()
+		reason := "unavailable"
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(int32(10)).
+			WillReturnRows(pgxmock.NewRows(messageColumns).
+				AddRow(uuid.Must(uuid.NewV7()), "topic", json.RawMessage(`{}`), outbox.StatusDeadLetter, int32(10), now, &reason, now, now))
+
+		res, err := st.store.ListDeadLetter(testCtx, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, outbox.StatusDeadLetter, res[0].Status)
+	})
+}
+
+func TestStore_Requeue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE outbox SET status = 'READY', attempts = 0`
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("requeue returns error", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		err := st.store.Requeue(testCtx, id)
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("message is not dead-lettered", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(id).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := st.store.Requeue(testCtx, id)
+
+		assert.ErrorIs(t, err, outbox.ErrNotFound)
+	})
+
+	t.Run("success requeue", func(t *testing.T) {
+		st := createStoreSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(id).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.store.Requeue(testCtx, id)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createStoreSuite(t *testing.T, ctrl *gomock.Controller) *StoreSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	return &StoreSuite{
+		db:     pool,
+		getter: g,
+		store:  outbox.NewStore(pool, g),
+	}
+}
@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultBatchSize is the default number of messages claimed in one relay.
+	DefaultBatchSize = uint(10)
+	// DefaultConcurrency is the default number of messages handled concurrently.
+	DefaultConcurrency = 5
+	// DefaultMaxAttempts is the default number of attempts before a message is dead-lettered.
+	DefaultMaxAttempts = int32(10)
+	// DefaultBackoffBase is the default delay before the first retry.
+	DefaultBackoffBase = 1 * time.Second
+	// DefaultBackoffMax is the default maximum delay between retries.
+	DefaultBackoffMax = 5 * time.Minute
+	// DefaultInterval is the default delay between relays when there is no more due message.
+	DefaultInterval = 1 * time.Second
+	// DefaultClaimLease is the default time a claimed message is held before it can be claimed again.
+	DefaultClaimLease = 5 * time.Minute
+)
+
+// permanentError marks an error that won't go away by retrying.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps err so the relayer dead-letters the message right away instead of retrying it.
+// Handlers use it when retrying the message can't succeed.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent tells whether err is wrapped by Permanent.
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// RelayStore defines the interface to claim and settle outbox messages.
+type RelayStore interface {
+	// Claim marks at most limit due messages as processed until leaseUntil and returns them.
+	Claim(ctx context.Context, limit uint, leaseUntil time.Time) ([]*Message, error)
+	// MarkDelivered marks the message as delivered and removes the redacted keys from its payload.
+	MarkDelivered(ctx context.Context, id uuid.UUID, redactedKeys []string) error
+	// MarkFailed marks the message as failed to be retried at nextAttemptAt.
+	MarkFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, reason string) error
+	// MarkDeadLetter marks the message as dead letter so it won't be retried.
+	MarkDeadLetter(ctx context.Context, id uuid.UUID, reason string) error
+}
+
+// Handler defines the interface to handle outbox message of a topic.
+type Handler interface {
+	// Handle handles the message.
+	// Returning error makes the message retried later, unless the error is wrapped by Permanent.
+	Handle(ctx context.Context, msg *Message) error
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as Handler.
+type HandlerFunc func(ctx context.Context, msg *Message) error
+
+// Handle calls f(ctx, msg).
+func (f HandlerFunc) Handle(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+// Config holds the settings of Relayer.
+// Zero values fall back to the defaults.
+type Config struct {
+	// BatchSize is the number of messages claimed in one relay.
+	BatchSize uint
+	// Concurrency is the number of messages handled concurrently.
+	Concurrency int
+	// MaxAttempts is the number of attempts before a message is dead-lettered.
+	MaxAttempts int32
+	// BackoffBase is the delay before the first retry. It is doubled on each following retry.
+	BackoffBase time.Duration
+	// BackoffMax is the maximum delay between retries.
+	BackoffMax time.Duration
+	// Interval is the delay between relays when there is no more due message.
+	Interval time.Duration
+	// ClaimLease is the time a claimed message is held before it can be claimed again.
+	// It must be longer than handling a batch takes.
+	ClaimLease time.Duration
+}
+
+// route holds the handler of a topic and the payload keys removed once it is delivered.
+type route struct {
+	handler      Handler
+	redactedKeys []string
+}
+
+// Relayer claims due messages and hands them to the handler registered for their topic.
+type Relayer struct {
+	store    RelayStore
+	handlers map[string]route
+	config   Config
+}
+
+// NewRelayer creates an instance of Relayer.
+func NewRelayer(store RelayStore, cfg Config) *Relayer {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = DefaultBackoffBase
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = DefaultBackoffMax
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.ClaimLease <= 0 {
+		cfg.ClaimLease = DefaultClaimLease
+	}
+	return &Relayer{
+		store:    store,
+		handlers: make(map[string]route),
+		config:   cfg,
+	}
+}
+
+// Handle registers the handler for the topic.
+// The redacted keys are removed from the payload once the message is delivered,
+// so data only the handler needs, such as secrets, isn't kept in the outbox.
+// It must be called before Run or RelayOnce.
+func (r *Relayer) Handle(topic string, h Handler, redactedKeys ...string) {
+	r.handlers[topic] = route{handler: h, redactedKeys: redactedKeys}
+}
+
+// Run relays messages until ctx is done.
+// It relays again right away when a full batch is claimed, otherwise it waits for the interval.
+func (r *Relayer) Run(ctx context.Context) error {
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Outbox-Run] fail relay", "error", err)
+		}
+		if err == nil && n >= int(r.config.BatchSize) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.Interval):
+		}
+	}
+}
+
+// RelayOnce claims a batch of due messages and handles them concurrently.
+// A message is only delivered once its handler succeeds. If the relayer stops before that,
+// the message is claimed again after the lease expires.
+// It returns the number of claimed messages.
+func (r *Relayer) RelayOnce(ctx context.Context) (int, error) {
+	messages, err := r.store.Claim(ctx, r.config.BatchSize, time.Now().UTC().Add(r.config.ClaimLease))
+	if err != nil {
+		return 0, err
+	}
+
+	g := &errgroup.Group{}
+	g.SetLimit(r.config.Concurrency)
+	for _, msg := range messages {
+		g.Go(func() error {
+			if err := r.relay(ctx, msg); err != nil {
+				slog.ErrorContext(ctx, "[Outbox-RelayOnce] fail settle message", "id", msg.ID, "topic", msg.Topic, "error", err)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return len(messages), nil
+}
+
+func (r *Relayer) relay(ctx context.Context, msg *Message) error {
+	rt, ok := r.handlers[msg.Topic]
+	if !ok {
+		return r.store.MarkDeadLetter(ctx, msg.ID, fmt.Sprintf("no handler for topic %s", msg.Topic))
+	}
+
+	if err := rt.handler.Handle(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "[Outbox-relay] fail handle message", "id", msg.ID, "topic", msg.Topic, "error", err)
+		return r.markFailed(ctx, msg, err)
+	}
+	return r.store.MarkDelivered(ctx, msg.ID, rt.redactedKeys)
+}
+
+// markFailed schedules the message to be retried with exponential backoff,
+// or dead-letters it once it reaches the maximum attempts or fails permanently.
+func (r *Relayer) markFailed(ctx context.Context, msg *Message, cause error) error {
+	if IsPermanent(cause) {
+		slog.WarnContext(ctx, "[Outbox-relay] message fails permanently", "id", msg.ID, "topic", msg.Topic, "error", cause)
+		return r.store.MarkDeadLetter(ctx, msg.ID, cause.Error())
+	}
+	attempts := msg.Attempts + 1
+	if attempts >= r.config.MaxAttempts {
+		slog.WarnContext(ctx, "[Outbox-relay] message reaches maximum attempts", "id", msg.ID, "topic", msg.Topic, "attempts", attempts)
+		return r.store.MarkDeadLetter(ctx, msg.ID, cause.Error())
+	}
+	return r.store.MarkFailed(ctx, msg.ID, time.Now().UTC().Add(r.backoff(attempts)), cause.Error())
+}
+
+// backoff returns the delay before the next attempt.
+// It doubles the base delay for each previous attempt, capped at the maximum delay.
+func (r *Relayer) backoff(attempts int32) time.Duration {
+	delay := r.config.BackoffBase
+	for i := int32(1); i < attempts && delay < r.config.BackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, r.config.BackoffMax)
+}
@@ -0,0 +1,258 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/outbox"
+	mock_outbox "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/outbox"
+)
+
+const (
+	testTopic = "wallet.topup"
+)
+
+var (
+	testRedactedKeys = []string{"secret"}
+)
+
+var (
+	testRelayerConfig = outbox.Config{
+		BatchSize:   3,
+		Concurrency: 2,
+		MaxAttempts: 5,
+		BackoffBase: time.Second,
+		BackoffMax:  5 * time.Second,
+		Interval:    time.Millisecond,
+	}
+)
+
+type RelayerSuite struct {
+	relayer *outbox.Relayer
+	store   *mock_outbox.MockRelayStore
+	handler *mock_outbox.MockHandler
+}
+
+func TestNewRelayer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success create relayer", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+
+		assert.NotNil(t, st.relayer)
+	})
+}
+
+func TestHandlerFunc_Handle(t *testing.T) {
+	t.Run("handler func calls the function", func(t *testing.T) {
+		called := false
+		h := outbox.HandlerFunc(func(_ context.Context, _ *outbox.Message) error {
+			called = true
+			return nil
+		})
+
+		err := h.Handle(testCtx, &outbox.Message{})
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+func TestPermanent(t *testing.T) {
+	t.Run("nil error stays nil", func(t *testing.T) {
+		assert.NoError(t, outbox.Permanent(nil))
+	})
+
+	t.Run("wrapped error is permanent and keeps its cause", func(t *testing.T) {
+		err := outbox.Permanent(assert.AnError)
+
+		assert.True(t, outbox.IsPermanent(err))
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, assert.AnError.Error(), err.Error())
+	})
+
+	t.Run("plain error isn't permanent", func(t *testing.T) {
+		assert.False(t, outbox.IsPermanent(assert.AnError))
+	})
+}
+
+func TestRelayer_RelayOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("claim returns error", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return(nil, assert.AnError)
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.Error(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("message without handler is dead-lettered", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		msg := createTestMessage("unknown")
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return([]*outbox.Message{msg}, nil)
+		st.store.EXPECT().MarkDeadLetter(testCtx, msg.ID, "no handler for topic unknown").Return(nil)
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("all messages are delivered", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		messages := []*outbox.Message{createTestMessage(testTopic), createTestMessage(testTopic), createTestMessage(testTopic)}
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return(messages, nil)
+		for _, msg := range messages {
+			st.handler.EXPECT().Handle(testCtx, msg).Return(nil)
+			st.store.EXPECT().MarkDelivered(testCtx, msg.ID, testRedactedKeys).Return(nil)
+		}
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(messages), n)
+	})
+
+	t.Run("mark delivered returns error", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		msg := createTestMessage(testTopic)
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return([]*outbox.Message{msg}, nil)
+		st.handler.EXPECT().Handle(testCtx, msg).Return(nil)
+		st.store.EXPECT().MarkDelivered(testCtx, msg.ID, testRedactedKeys).Return(assert.AnError)
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("failed message is retried with exponential backoff", func(t *testing.T) {
+		tests := []struct {
+			attempts int32
+			delay    time.Duration
+		}{
+			{attempts: 0, delay: time.Second},
+			{attempts: 1, delay: 2 * time.Second},
+			{attempts: 2, delay: 4 * time.Second},
+			{attempts: 3, delay: 5 * time.Second},
+		}
+
+		for _, test := range tests {
+			st := createRelayerSuite(ctrl)
+			msg := createTestMessage(testTopic)
+			msg.Attempts = test.attempts
+			st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return([]*outbox.Message{msg}, nil)
+			st.handler.EXPECT().Handle(testCtx, msg).Return(assert.AnError)
+			start := time.Now().UTC()
+			st.store.EXPECT().MarkFailed(testCtx, msg.ID, gomock.Any(), assert.AnError.Error()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, next time.Time, _ string) error {
+					assert.WithinRange(t, next, start.Add(test.delay), time.Now().UTC().Add(test.delay))
+					return nil
+				})
+
+			_, err := st.relayer.RelayOnce(testCtx)
+
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("message is dead-lettered when it reaches maximum attempts", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		msg := createTestMessage(testTopic)
+		msg.Attempts = 4
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return([]*outbox.Message{msg}, nil)
+		st.handler.EXPECT().Handle(testCtx, msg).Return(assert.AnError)
+		st.store.EXPECT().MarkDeadLetter(testCtx, msg.ID, assert.AnError.Error()).Return(nil)
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("message is dead-lettered right away when it fails permanently", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		msg := createTestMessage(testTopic)
+		st.store.EXPECT().Claim(testCtx, uint(3), gomock.Any()).Return([]*outbox.Message{msg}, nil)
+		st.handler.EXPECT().Handle(testCtx, msg).Return(outbox.Permanent(assert.AnError))
+		st.store.EXPECT().MarkDeadLetter(testCtx, msg.ID, assert.AnError.Error()).Return(nil)
+
+		n, err := st.relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("default config is used", func(t *testing.T) {
+		store := mock_outbox.NewMockRelayStore(ctrl)
+		relayer := outbox.NewRelayer(store, outbox.Config{})
+		start := time.Now().UTC()
+		store.EXPECT().Claim(testCtx, outbox.DefaultBatchSize, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, leaseUntil time.Time) ([]*outbox.Message, error) {
+				assert.WithinRange(t, leaseUntil, start.Add(outbox.DefaultClaimLease), time.Now().UTC().Add(outbox.DefaultClaimLease))
+				return nil, nil
+			})
+
+		n, err := relayer.RelayOnce(testCtx)
+
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestRelayer_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("run relays until context is done", func(t *testing.T) {
+		st := createRelayerSuite(ctrl)
+		ctx, cancel := context.WithCancel(testCtx)
+		messages := []*outbox.Message{createTestMessage(testTopic), createTestMessage(testTopic), createTestMessage(testTopic)}
+
+		gomock.InOrder(
+			st.store.EXPECT().Claim(ctx, uint(3), gomock.Any()).Return(nil, assert.AnError),
+			st.store.EXPECT().Claim(ctx, uint(3), gomock.Any()).Return(messages, nil),
+			st.store.EXPECT().Claim(ctx, uint(3), gomock.Any()).DoAndReturn(func(_ context.Context, _ uint, _ time.Time) ([]*outbox.Message, error) {
+				cancel()
+				return nil, nil
+			}),
+		)
+		st.handler.EXPECT().Handle(ctx, gomock.Any()).Return(nil).Times(len(messages))
+		st.store.EXPECT().MarkDelivered(ctx, gomock.Any(), testRedactedKeys).Return(nil).Times(len(messages))
+
+		err := st.relayer.Run(ctx)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func createTestMessage(topic string) *outbox.Message {
+	return &outbox.Message{
+		ID:      uuid.Must(uuid.NewV7()),
+		Topic:   topic,
+		Status:  outbox.StatusProcessed,
+		Payload: []byte(`{}`),
+	}
+}
+
+func createRelayerSuite(ctrl *gomock.Controller) *RelayerSuite {
+	s := mock_outbox.NewMockRelayStore(ctrl)
+	h := mock_outbox.NewMockHandler(ctrl)
+	r := outbox.NewRelayer(s, testRelayerConfig)
+	r.Handle(testTopic, h, testRedactedKeys...)
+	return &RelayerSuite{
+		relayer: r,
+		store:   s,
+		handler: h,
+	}
+}
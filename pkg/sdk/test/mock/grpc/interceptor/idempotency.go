@@ -41,6 +41,20 @@ func (m *MockIdempotencyStore) EXPECT() *MockIdempotencyStoreMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockIdempotencyStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyStoreMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyStore)(nil).Delete), ctx, key)
+}
+
 // Get mocks base method.
 func (m *MockIdempotencyStore) Get(ctx context.Context, key string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +83,114 @@ func (mr *MockIdempotencyStoreMockRecorder) Set(ctx, key, value, ttl any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIdempotencyStore)(nil).Set), ctx, key, value, ttl)
 }
+
+// SetIfAbsent mocks base method.
+func (m *MockIdempotencyStore) SetIfAbsent(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIfAbsent", ctx, key, value, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIfAbsent indicates an expected call of SetIfAbsent.
+func (mr *MockIdempotencyStoreMockRecorder) SetIfAbsent(ctx, key, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfAbsent", reflect.TypeOf((*MockIdempotencyStore)(nil).SetIfAbsent), ctx, key, value, ttl)
+}
+
+// MockTransactionalIdempotencyStore is a mock of TransactionalIdempotencyStore interface.
+type MockTransactionalIdempotencyStore struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockTransactionalIdempotencyStoreMockRecorder
+}
+
+// MockTransactionalIdempotencyStoreMockRecorder is the mock recorder for MockTransactionalIdempotencyStore.
+type MockTransactionalIdempotencyStoreMockRecorder struct {
+	mock *MockTransactionalIdempotencyStore
+}
+
+// NewMockTransactionalIdempotencyStore creates a new mock instance.
+func NewMockTransactionalIdempotencyStore(ctrl *gomock.Controller) *MockTransactionalIdempotencyStore {
+	mock := &MockTransactionalIdempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockTransactionalIdempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionalIdempotencyStore) EXPECT() *MockTransactionalIdempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTransactionalIdempotencyStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTransactionalIdempotencyStoreMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransactionalIdempotencyStore)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockTransactionalIdempotencyStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTransactionalIdempotencyStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactionalIdempotencyStore)(nil).Get), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockTransactionalIdempotencyStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockTransactionalIdempotencyStoreMockRecorder) Set(ctx, key, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockTransactionalIdempotencyStore)(nil).Set), ctx, key, value, ttl)
+}
+
+// SetIfAbsent mocks base method.
+func (m *MockTransactionalIdempotencyStore) SetIfAbsent(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIfAbsent", ctx, key, value, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIfAbsent indicates an expected call of SetIfAbsent.
+func (mr *MockTransactionalIdempotencyStoreMockRecorder) SetIfAbsent(ctx, key, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfAbsent", reflect.TypeOf((*MockTransactionalIdempotencyStore)(nil).SetIfAbsent), ctx, key, value, ttl)
+}
+
+// WithinTransaction mocks base method.
+func (m *MockTransactionalIdempotencyStore) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTransaction indicates an expected call of WithinTransaction.
+func (mr *MockTransactionalIdempotencyStoreMockRecorder) WithinTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTransaction", reflect.TypeOf((*MockTransactionalIdempotencyStore)(nil).WithinTransaction), ctx, fn)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pkg/sdk/outbox/relayer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pkg/sdk/outbox/relayer.go -destination=./pkg/sdk/test/mock//outbox/relayer.go
+//
+
+// Package mock_outbox is a generated GoMock package.
+package mock_outbox
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	outbox "github.com/indrasaputra/arjuna/pkg/sdk/outbox"
+)
+
+// MockRelayStore is a mock of RelayStore interface.
+type MockRelayStore struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockRelayStoreMockRecorder
+}
+
+// MockRelayStoreMockRecorder is the mock recorder for MockRelayStore.
+type MockRelayStoreMockRecorder struct {
+	mock *MockRelayStore
+}
+
+// NewMockRelayStore creates a new mock instance.
+func NewMockRelayStore(ctrl *gomock.Controller) *MockRelayStore {
+	mock := &MockRelayStore{ctrl: ctrl}
+	mock.recorder = &MockRelayStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayStore) EXPECT() *MockRelayStoreMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockRelayStore) Claim(ctx context.Context, limit uint, leaseUntil time.Time) ([]*outbox.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, limit, leaseUntil)
+	ret0, _ := ret[0].([]*outbox.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockRelayStoreMockRecorder) Claim(ctx, limit, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockRelayStore)(nil).Claim), ctx, limit, leaseUntil)
+}
+
+// MarkDeadLetter mocks base method.
+func (m *MockRelayStore) MarkDeadLetter(ctx context.Context, id uuid.UUID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeadLetter", ctx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeadLetter indicates an expected call of MarkDeadLetter.
+func (mr *MockRelayStoreMockRecorder) MarkDeadLetter(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeadLetter", reflect.TypeOf((*MockRelayStore)(nil).MarkDeadLetter), ctx, id, reason)
+}
+
+// MarkDelivered mocks base method.
+func (m *MockRelayStore) MarkDelivered(ctx context.Context, id uuid.UUID, redactedKeys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, id, redactedKeys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockRelayStoreMockRecorder) MarkDelivered(ctx, id, redactedKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockRelayStore)(nil).MarkDelivered), ctx, id, redactedKeys)
+}
+
+// MarkFailed mocks base method.
+func (m *MockRelayStore) MarkFailed(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id, nextAttemptAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockRelayStoreMockRecorder) MarkFailed(ctx, id, nextAttemptAt, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockRelayStore)(nil).MarkFailed), ctx, id, nextAttemptAt, reason)
+}
+
+// MockHandler is a mock of Handler interface.
+type MockHandler struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockHandlerMockRecorder
+}
+
+// MockHandlerMockRecorder is the mock recorder for MockHandler.
+type MockHandlerMockRecorder struct {
+	mock *MockHandler
+}
+
+// NewMockHandler creates a new mock instance.
+func NewMockHandler(ctrl *gomock.Controller) *MockHandler {
+	mock := &MockHandler{ctrl: ctrl}
+	mock.recorder = &MockHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockHandler) Handle(ctx context.Context, msg *outbox.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockHandlerMockRecorder) Handle(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockHandler)(nil).Handle), ctx, msg)
+}
@@ -17,6 +17,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -54,6 +55,8 @@ const (
 	AuthErrorCode_AUTH_ERROR_CODE_INVALID_CREDENTIAL AuthErrorCode = 9
 	// Data is not found.
 	AuthErrorCode_AUTH_ERROR_CODE_NOT_FOUND AuthErrorCode = 10
+	// Refresh token is invalid, expired, or already used.
+	AuthErrorCode_AUTH_ERROR_CODE_INVALID_REFRESH_TOKEN AuthErrorCode = 11
 )
 
 // Enum value maps for AuthErrorCode.
@@ -70,19 +73,21 @@ var (
 		8:  "AUTH_ERROR_CODE_ALREADY_EXISTS",
 		9:  "AUTH_ERROR_CODE_INVALID_CREDENTIAL",
 		10: "AUTH_ERROR_CODE_NOT_FOUND",
+		11: "AUTH_ERROR_CODE_INVALID_REFRESH_TOKEN",
 	}
 	AuthErrorCode_value = map[string]int32{
-		"AUTH_ERROR_CODE_UNSPECIFIED":        0,
-		"AUTH_ERROR_CODE_INTERNAL":           1,
-		"AUTH_ERROR_CODE_EMPTY_FIELD":        2,
-		"AUTH_ERROR_CODE_UNAUTHORIZED":       3,
-		"AUTH_ERROR_CODE_INVALID_ARGUMENT":   4,
-		"AUTH_ERROR_CODE_EMPTY_ACCOUNT":      5,
-		"AUTH_ERROR_CODE_INVALID_EMAIL":      6,
-		"AUTH_ERROR_CODE_INVALID_PASSWORD":   7,
-		"AUTH_ERROR_CODE_ALREADY_EXISTS":     8,
-		"AUTH_ERROR_CODE_INVALID_CREDENTIAL": 9,
-		"AUTH_ERROR_CODE_NOT_FOUND":          10,
+		"AUTH_ERROR_CODE_UNSPECIFIED":           0,
+		"AUTH_ERROR_CODE_INTERNAL":              1,
+		"AUTH_ERROR_CODE_EMPTY_FIELD":           2,
+		"AUTH_ERROR_CODE_UNAUTHORIZED":          3,
+		"AUTH_ERROR_CODE_INVALID_ARGUMENT":      4,
+		"AUTH_ERROR_CODE_EMPTY_ACCOUNT":         5,
+		"AUTH_ERROR_CODE_INVALID_EMAIL":         6,
+		"AUTH_ERROR_CODE_INVALID_PASSWORD":      7,
+		"AUTH_ERROR_CODE_ALREADY_EXISTS":        8,
+		"AUTH_ERROR_CODE_INVALID_CREDENTIAL":    9,
+		"AUTH_ERROR_CODE_NOT_FOUND":             10,
+		"AUTH_ERROR_CODE_INVALID_REFRESH_TOKEN": 11,
 	}
 )
 
@@ -205,30 +210,564 @@ func (x *LoginResponse) GetData() *Token {
 	return nil
 }
 
+// RefreshTokenRequest represents request for refresh token.
+type RefreshTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refresh_token represents refresh token from previous login or refresh.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RefreshTokenResponse represents response from refresh token.
+type RefreshTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents token.
+	Data          *Token `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefreshTokenResponse) GetData() *Token {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// LogoutRequest represents request for logout.
+type LogoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refresh_token represents refresh token to revoke along with the access token.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// LogoutResponse represents response from logout.
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+// GetJWKSRequest represents request for get JSON web key set.
+type GetJWKSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJWKSRequest) Reset() {
+	*x = GetJWKSRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJWKSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSRequest) ProtoMessage() {}
+
+func (x *GetJWKSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSRequest.ProtoReflect.Descriptor instead.
+func (*GetJWKSRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+// GetJWKSResponse represents response from get JSON web key set.
+// It follows JWKS format (RFC 7517).
+type GetJWKSResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// keys represents public keys.
+	Keys          []*JSONWebKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJWKSResponse) Reset() {
+	*x = GetJWKSResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSResponse) ProtoMessage() {}
+
+func (x *GetJWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSResponse.ProtoReflect.Descriptor instead.
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetJWKSResponse) GetKeys() []*JSONWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// JSONWebKey represents a public key in JWK format.
+type JSONWebKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kty represents key type, either RSA or OKP.
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	// kid represents key id.
+	Kid string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	// use represents key usage.
+	Use string `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	// alg represents signing algorithm, either RS256 or EdDSA.
+	Alg string `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	// crv represents curve of OKP key.
+	Crv *string `protobuf:"bytes,5,opt,name=crv,proto3,oneof" json:"crv,omitempty"`
+	// x represents public key of OKP key.
+	X *string `protobuf:"bytes,6,opt,name=x,proto3,oneof" json:"x,omitempty"`
+	// n represents modulus of RSA key.
+	N *string `protobuf:"bytes,7,opt,name=n,proto3,oneof" json:"n,omitempty"`
+	// e represents exponent of RSA key.
+	E             *string `protobuf:"bytes,8,opt,name=e,proto3,oneof" json:"e,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JSONWebKey) Reset() {
+	*x = JSONWebKey{}
+	mi := &file_api_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JSONWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONWebKey) ProtoMessage() {}
+
+func (x *JSONWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONWebKey.ProtoReflect.Descriptor instead.
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JSONWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetCrv() string {
+	if x != nil && x.Crv != nil {
+		return *x.Crv
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetX() string {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetN() string {
+	if x != nil && x.N != nil {
+		return *x.N
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetE() string {
+	if x != nil && x.E != nil {
+		return *x.E
+	}
+	return ""
+}
+
 // RegisterAccountRequest represents request for account registration.
 type RegisterAccountRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Account represents account to register.
-	Account       *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Account represents account to register.
+	Account       *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAccountRequest) Reset() {
+	*x = RegisterAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAccountRequest) ProtoMessage() {}
+
+func (x *RegisterAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAccountRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegisterAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// RegisterAccountResponse represents response for account registration.
+type RegisterAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAccountResponse) Reset() {
+	*x = RegisterAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAccountResponse) ProtoMessage() {}
+
+func (x *RegisterAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAccountResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+// DeleteAccountRequest represents request for account deletion.
+type DeleteAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the account to delete.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeleteAccountResponse represents response for account deletion.
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+// SoftDeleteAccountRequest represents request for account soft deletion.
+type SoftDeleteAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the account to soft-delete.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterAccountRequest) Reset() {
-	*x = RegisterAccountRequest{}
-	mi := &file_api_v1_auth_proto_msgTypes[2]
+func (x *SoftDeleteAccountRequest) Reset() {
+	*x = SoftDeleteAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterAccountRequest) String() string {
+func (x *SoftDeleteAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterAccountRequest) ProtoMessage() {}
+func (*SoftDeleteAccountRequest) ProtoMessage() {}
 
-func (x *RegisterAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[2]
+func (x *SoftDeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,40 +778,298 @@ func (x *RegisterAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterAccountRequest.ProtoReflect.Descriptor instead.
-func (*RegisterAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SoftDeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*SoftDeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RegisterAccountRequest) GetAccount() *Account {
+func (x *SoftDeleteAccountRequest) GetUserId() string {
 	if x != nil {
-		return x.Account
+		return x.UserId
+	}
+	return ""
+}
+
+// SoftDeleteAccountResponse represents response for account soft deletion.
+type SoftDeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftDeleteAccountResponse) Reset() {
+	*x = SoftDeleteAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftDeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteAccountResponse) ProtoMessage() {}
+
+func (x *SoftDeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*SoftDeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+// RestoreAccountRequest represents request for account restoration.
+type RestoreAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the account to restore.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RestoreAccountResponse represents response for account restoration.
+type RestoreAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountResponse) Reset() {
+	*x = RestoreAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountResponse) ProtoMessage() {}
+
+func (x *RestoreAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+// GetAccountRequest represents request for get account.
+type GetAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the account to get.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetAccountResponse represents response for get account.
+type GetAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents account.
+	Data          *Account `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAccountResponse) GetData() *Account {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-// RegisterAccountResponse represents response for account registration.
-type RegisterAccountResponse struct {
+// EraseAccountRequest represents request for account erasure.
+type EraseAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the account to erase.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseAccountRequest) Reset() {
+	*x = EraseAccountRequest{}
+	mi := &file_api_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseAccountRequest) ProtoMessage() {}
+
+func (x *EraseAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseAccountRequest.ProtoReflect.Descriptor instead.
+func (*EraseAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EraseAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// EraseAccountResponse represents response for account erasure.
+type EraseAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterAccountResponse) Reset() {
-	*x = RegisterAccountResponse{}
-	mi := &file_api_v1_auth_proto_msgTypes[3]
+func (x *EraseAccountResponse) Reset() {
+	*x = EraseAccountResponse{}
+	mi := &file_api_v1_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterAccountResponse) String() string {
+func (x *EraseAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterAccountResponse) ProtoMessage() {}
+func (*EraseAccountResponse) ProtoMessage() {}
 
-func (x *RegisterAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[3]
+func (x *EraseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,9 +1080,9 @@ func (x *RegisterAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterAccountResponse.ProtoReflect.Descriptor instead.
-func (*RegisterAccountResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EraseAccountResponse.ProtoReflect.Descriptor instead.
+func (*EraseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{20}
 }
 
 // Account represents account.
@@ -298,14 +1095,19 @@ type Account struct {
 	// email represents user's email.
 	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	// password represents user's password
-	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// password_hash represents bcrypt hash of user's password.
+	// It is used instead of password, so the caller doesn't need to keep the password in plaintext.
+	PasswordHash string `protobuf:"bytes,5,opt,name=password_hash,proto3" json:"password_hash,omitempty"`
+	// created_at represents when the account was created.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
 	*x = Account{}
-	mi := &file_api_v1_auth_proto_msgTypes[4]
+	mi := &file_api_v1_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +1119,7 @@ func (x *Account) String() string {
 func (*Account) ProtoMessage() {}
 
 func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[4]
+	mi := &file_api_v1_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +1132,7 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Account.ProtoReflect.Descriptor instead.
 func (*Account) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Account) GetId() string {
@@ -361,6 +1163,20 @@ func (x *Account) GetPassword() string {
 	return ""
 }
 
+func (x *Account) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // Credential represents login credential.
 type Credential struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -374,7 +1190,7 @@ type Credential struct {
 
 func (x *Credential) Reset() {
 	*x = Credential{}
-	mi := &file_api_v1_auth_proto_msgTypes[5]
+	mi := &file_api_v1_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +1202,7 @@ func (x *Credential) String() string {
 func (*Credential) ProtoMessage() {}
 
 func (x *Credential) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[5]
+	mi := &file_api_v1_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +1215,7 @@ func (x *Credential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Credential.ProtoReflect.Descriptor instead.
 func (*Credential) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Credential) GetEmail() string {
@@ -429,7 +1245,7 @@ type Token struct {
 
 func (x *Token) Reset() {
 	*x = Token{}
-	mi := &file_api_v1_auth_proto_msgTypes[6]
+	mi := &file_api_v1_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +1257,7 @@ func (x *Token) String() string {
 func (*Token) ProtoMessage() {}
 
 func (x *Token) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[6]
+	mi := &file_api_v1_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +1270,7 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Token.ProtoReflect.Descriptor instead.
 func (*Token) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Token) GetAccessToken() string {
@@ -495,7 +1311,7 @@ type AuthError struct {
 
 func (x *AuthError) Reset() {
 	*x = AuthError{}
-	mi := &file_api_v1_auth_proto_msgTypes[7]
+	mi := &file_api_v1_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +1323,7 @@ func (x *AuthError) String() string {
 func (*AuthError) ProtoMessage() {}
 
 func (x *AuthError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_proto_msgTypes[7]
+	mi := &file_api_v1_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +1336,7 @@ func (x *AuthError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthError.ProtoReflect.Descriptor instead.
 func (*AuthError) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AuthError) GetErrorCode() AuthErrorCode {
@@ -534,21 +1350,65 @@ var File_api_v1_auth_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x11api/v1/auth.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"G\n" +
+	"\x11api/v1/auth.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"G\n" +
 	"\fLoginRequest\x127\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2\x12.api.v1.CredentialB\x03\xe0A\x02R\n" +
 	"credential\"2\n" +
 	"\rLoginResponse\x12!\n" +
-	"\x04data\x18\x01 \x01(\v2\r.api.v1.TokenR\x04data\"C\n" +
+	"\x04data\x18\x01 \x01(\v2\r.api.v1.TokenR\x04data\"@\n" +
+	"\x13RefreshTokenRequest\x12)\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x03\xe0A\x02R\rrefresh_token\"9\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.api.v1.TokenR\x04data\"5\n" +
+	"\rLogoutRequest\x12$\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\rrefresh_token\"\x10\n" +
+	"\x0eLogoutResponse\"\x10\n" +
+	"\x0eGetJWKSRequest\"9\n" +
+	"\x0fGetJWKSResponse\x12&\n" +
+	"\x04keys\x18\x01 \x03(\v2\x12.api.v1.JSONWebKeyR\x04keys\"\xbe\x01\n" +
+	"\n" +
+	"JSONWebKey\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03kid\x18\x02 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03use\x18\x03 \x01(\tR\x03use\x12\x10\n" +
+	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\x15\n" +
+	"\x03crv\x18\x05 \x01(\tH\x00R\x03crv\x88\x01\x01\x12\x11\n" +
+	"\x01x\x18\x06 \x01(\tH\x01R\x01x\x88\x01\x01\x12\x11\n" +
+	"\x01n\x18\a \x01(\tH\x02R\x01n\x88\x01\x01\x12\x11\n" +
+	"\x01e\x18\b \x01(\tH\x03R\x01e\x88\x01\x01B\x06\n" +
+	"\x04_crvB\x04\n" +
+	"\x02_xB\x04\n" +
+	"\x02_nB\x04\n" +
+	"\x02_e\"C\n" +
 	"\x16RegisterAccountRequest\x12)\n" +
 	"\aaccount\x18\x01 \x01(\v2\x0f.api.v1.AccountR\aaccount\"\x19\n" +
-	"\x17RegisterAccountResponse\"\xd8\x02\n" +
+	"\x17RegisterAccountResponse\"0\n" +
+	"\x14DeleteAccountRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x17\n" +
+	"\x15DeleteAccountResponse\"4\n" +
+	"\x18SoftDeleteAccountRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x1b\n" +
+	"\x19SoftDeleteAccountResponse\"1\n" +
+	"\x15RestoreAccountRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x18\n" +
+	"\x16RestoreAccountResponse\"-\n" +
+	"\x11GetAccountRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"9\n" +
+	"\x12GetAccountResponse\x12#\n" +
+	"\x04data\x18\x01 \x01(\v2\x0f.api.v1.AccountR\x04data\"/\n" +
+	"\x13EraseAccountRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x16\n" +
+	"\x14EraseAccountResponse\"\xf0\x03\n" +
 	"\aAccount\x12A\n" +
 	"\x02id\x18\x01 \x01(\tB1\x92A(J&\"01917a0c-475e-7d4a-9ec1-a56d14d78569\"\xe0A\x02\xe0A\x03R\x02id\x12\\\n" +
 	"\auser_id\x18\x02 \x01(\tBB\x92A<2\tUser's idJ&\"01917a0c-cdfe-7696-9259-1c9821c8fd73\"\xa2\x02\x06string\xe0A\x02R\auser_id\x12a\n" +
 	"\x05email\x18\x03 \x01(\tBK\x92AE2\fUser's emailJ\x12\"email@domain.com\"\x8a\x01 ^[\\w-\\.]+@([\\w-]+\\.)+[\\w-]{2,4}$\xe0A\x02R\x05email\x12I\n" +
-	"\bpassword\x18\x04 \x01(\tB-\x92A*2\x0fUser's passwordJ\x0e\"weakPassword\"\xa2\x02\x06stringR\bpassword\"\xbd\x01\n" +
+	"\bpassword\x18\x04 \x01(\tB-\x92A*2\x0fUser's passwordJ\x0e\"weakPassword\"\xa2\x02\x06stringR\bpassword\x12U\n" +
+	"\rpassword_hash\x18\x05 \x01(\tB/\x92A)2\x1eBcrypt hash of user's password\xa2\x02\x06string\xe0A\x04R\rpassword_hash\x12?\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"created_at\"\xbd\x01\n" +
 	"\n" +
 	"Credential\x12a\n" +
 	"\x05email\x18\x01 \x01(\tBK\x92AE2\fUser's emailJ\x12\"email@domain.com\"\x8a\x01 ^[\\w-\\.]+@([\\w-]+\\.)+[\\w-]{2,4}$\xe0A\x02R\x05email\x12L\n" +
@@ -560,7 +1420,7 @@ const file_api_v1_auth_proto_rawDesc = "" +
 	"\x18refresh_token_expires_in\x18\x04 \x01(\rB\x06\xe0A\x02\xe0A\x03R\x18refresh_token_expires_in\"A\n" +
 	"\tAuthError\x124\n" +
 	"\n" +
-	"error_code\x18\x01 \x01(\x0e2\x15.api.v1.AuthErrorCodeR\terrorCode*\x8e\x03\n" +
+	"error_code\x18\x01 \x01(\x0e2\x15.api.v1.AuthErrorCodeR\terrorCode*\xb9\x03\n" +
 	"\rAuthErrorCode\x12\x1f\n" +
 	"\x1bAUTH_ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18AUTH_ERROR_CODE_INTERNAL\x10\x01\x12\x1f\n" +
@@ -573,12 +1433,25 @@ const file_api_v1_auth_proto_rawDesc = "" +
 	"\x1eAUTH_ERROR_CODE_ALREADY_EXISTS\x10\b\x12&\n" +
 	"\"AUTH_ERROR_CODE_INVALID_CREDENTIAL\x10\t\x12\x1d\n" +
 	"\x19AUTH_ERROR_CODE_NOT_FOUND\x10\n" +
-	"2\x8a\x02\n" +
+	"\x12)\n" +
+	"%AUTH_ERROR_CODE_INVALID_REFRESH_TOKEN\x10\v2\xee\a\n" +
 	"\vAuthService\x12h\n" +
 	"\x05Login\x12\x14.api.v1.LoginRequest\x1a\x15.api.v1.LoginResponse\"2\x92A\r\n" +
 	"\x04Auth*\x05Login\x82\xd3\xe4\x93\x02\x1c:\n" +
-	"credential\"\x0e/v1/auth/login\x12T\n" +
-	"\x0fRegisterAccount\x12\x1e.api.v1.RegisterAccountRequest\x1a\x1f.api.v1.RegisterAccountResponse\"\x00\x1a;\x92A8\x126This service provides all use cases to work with auth.B\x8d\x02\x92A\xcf\x01\x12\x95\x01\n" +
+	"credential\"\x0e/v1/auth/login\x12\x83\x01\n" +
+	"\fRefreshToken\x12\x1b.api.v1.RefreshTokenRequest\x1a\x1c.api.v1.RefreshTokenResponse\"8\x92A\x14\n" +
+	"\x04Auth*\fRefreshToken\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/token/refresh\x12d\n" +
+	"\x06Logout\x12\x15.api.v1.LogoutRequest\x1a\x16.api.v1.LogoutResponse\"+\x92A\x0e\n" +
+	"\x04Auth*\x06Logout\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12c\n" +
+	"\aGetJWKS\x12\x16.api.v1.GetJWKSRequest\x1a\x17.api.v1.GetJWKSResponse\"'\x92A\x0f\n" +
+	"\x04Auth*\aGetJWKS\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/auth/jwks\x12T\n" +
+	"\x0fRegisterAccount\x12\x1e.api.v1.RegisterAccountRequest\x1a\x1f.api.v1.RegisterAccountResponse\"\x00\x12N\n" +
+	"\rDeleteAccount\x12\x1c.api.v1.DeleteAccountRequest\x1a\x1d.api.v1.DeleteAccountResponse\"\x00\x12Z\n" +
+	"\x11SoftDeleteAccount\x12 .api.v1.SoftDeleteAccountRequest\x1a!.api.v1.SoftDeleteAccountResponse\"\x00\x12Q\n" +
+	"\x0eRestoreAccount\x12\x1d.api.v1.RestoreAccountRequest\x1a\x1e.api.v1.RestoreAccountResponse\"\x00\x12E\n" +
+	"\n" +
+	"GetAccount\x12\x19.api.v1.GetAccountRequest\x1a\x1a.api.v1.GetAccountResponse\"\x00\x12K\n" +
+	"\fEraseAccount\x12\x1b.api.v1.EraseAccountRequest\x1a\x1c.api.v1.EraseAccountResponse\"\x00\x1a;\x92A8\x126This service provides all use cases to work with auth.B\x8d\x02\x92A\xcf\x01\x12\x95\x01\n" +
 	"\bAuth API\"0\n" +
 	"\rIndra Saputra\x12\x1fhttps://github.com/indrasaputra*P\n" +
 	"\x14BSD 3-Clause License\x128https://github.com/indrasaputra/arjuna/blob/main/LICENSE2\x051.0.0\x1a\x0elocalhost:8000*\x01\x012\x10application/json:\x10application/jsonZ8github.com/indrasaputra/arjuna/service/auth/api/v1;apiv1b\x06proto3"
@@ -596,32 +1469,70 @@ func file_api_v1_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_api_v1_auth_proto_goTypes = []any{
-	(AuthErrorCode)(0),              // 0: api.v1.AuthErrorCode
-	(*LoginRequest)(nil),            // 1: api.v1.LoginRequest
-	(*LoginResponse)(nil),           // 2: api.v1.LoginResponse
-	(*RegisterAccountRequest)(nil),  // 3: api.v1.RegisterAccountRequest
-	(*RegisterAccountResponse)(nil), // 4: api.v1.RegisterAccountResponse
-	(*Account)(nil),                 // 5: api.v1.Account
-	(*Credential)(nil),              // 6: api.v1.Credential
-	(*Token)(nil),                   // 7: api.v1.Token
-	(*AuthError)(nil),               // 8: api.v1.AuthError
+	(AuthErrorCode)(0),                // 0: api.v1.AuthErrorCode
+	(*LoginRequest)(nil),              // 1: api.v1.LoginRequest
+	(*LoginResponse)(nil),             // 2: api.v1.LoginResponse
+	(*RefreshTokenRequest)(nil),       // 3: api.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),      // 4: api.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),             // 5: api.v1.LogoutRequest
+	(*LogoutResponse)(nil),            // 6: api.v1.LogoutResponse
+	(*GetJWKSRequest)(nil),            // 7: api.v1.GetJWKSRequest
+	(*GetJWKSResponse)(nil),           // 8: api.v1.GetJWKSResponse
+	(*JSONWebKey)(nil),                // 9: api.v1.JSONWebKey
+	(*RegisterAccountRequest)(nil),    // 10: api.v1.RegisterAccountRequest
+	(*RegisterAccountResponse)(nil),   // 11: api.v1.RegisterAccountResponse
+	(*DeleteAccountRequest)(nil),      // 12: api.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),     // 13: api.v1.DeleteAccountResponse
+	(*SoftDeleteAccountRequest)(nil),  // 14: api.v1.SoftDeleteAccountRequest
+	(*SoftDeleteAccountResponse)(nil), // 15: api.v1.SoftDeleteAccountResponse
+	(*RestoreAccountRequest)(nil),     // 16: api.v1.RestoreAccountRequest
+	(*RestoreAccountResponse)(nil),    // 17: api.v1.RestoreAccountResponse
+	(*GetAccountRequest)(nil),         // 18: api.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),        // 19: api.v1.GetAccountResponse
+	(*EraseAccountRequest)(nil),       // 20: api.v1.EraseAccountRequest
+	(*EraseAccountResponse)(nil),      // 21: api.v1.EraseAccountResponse
+	(*Account)(nil),                   // 22: api.v1.Account
+	(*Credential)(nil),                // 23: api.v1.Credential
+	(*Token)(nil),                     // 24: api.v1.Token
+	(*AuthError)(nil),                 // 25: api.v1.AuthError
+	(*timestamppb.Timestamp)(nil),     // 26: google.protobuf.Timestamp
 }
 var file_api_v1_auth_proto_depIdxs = []int32{
-	6, // 0: api.v1.LoginRequest.credential:type_name -> api.v1.Credential
-	7, // 1: api.v1.LoginResponse.data:type_name -> api.v1.Token
-	5, // 2: api.v1.RegisterAccountRequest.account:type_name -> api.v1.Account
-	0, // 3: api.v1.AuthError.error_code:type_name -> api.v1.AuthErrorCode
-	1, // 4: api.v1.AuthService.Login:input_type -> api.v1.LoginRequest
-	3, // 5: api.v1.AuthService.RegisterAccount:input_type -> api.v1.RegisterAccountRequest
-	2, // 6: api.v1.AuthService.Login:output_type -> api.v1.LoginResponse
-	4, // 7: api.v1.AuthService.RegisterAccount:output_type -> api.v1.RegisterAccountResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	23, // 0: api.v1.LoginRequest.credential:type_name -> api.v1.Credential
+	24, // 1: api.v1.LoginResponse.data:type_name -> api.v1.Token
+	24, // 2: api.v1.RefreshTokenResponse.data:type_name -> api.v1.Token
+	9,  // 3: api.v1.GetJWKSResponse.keys:type_name -> api.v1.JSONWebKey
+	22, // 4: api.v1.RegisterAccountRequest.account:type_name -> api.v1.Account
+	22, // 5: api.v1.GetAccountResponse.data:type_name -> api.v1.Account
+	26, // 6: api.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: api.v1.AuthError.error_code:type_name -> api.v1.AuthErrorCode
+	1,  // 8: api.v1.AuthService.Login:input_type -> api.v1.LoginRequest
+	3,  // 9: api.v1.AuthService.RefreshToken:input_type -> api.v1.RefreshTokenRequest
+	5,  // 10: api.v1.AuthService.Logout:input_type -> api.v1.LogoutRequest
+	7,  // 11: api.v1.AuthService.GetJWKS:input_type -> api.v1.GetJWKSRequest
+	10, // 12: api.v1.AuthService.RegisterAccount:input_type -> api.v1.RegisterAccountRequest
+	12, // 13: api.v1.AuthService.DeleteAccount:input_type -> api.v1.DeleteAccountRequest
+	14, // 14: api.v1.AuthService.SoftDeleteAccount:input_type -> api.v1.SoftDeleteAccountRequest
+	16, // 15: api.v1.AuthService.RestoreAccount:input_type -> api.v1.RestoreAccountRequest
+	18, // 16: api.v1.AuthService.GetAccount:input_type -> api.v1.GetAccountRequest
+	20, // 17: api.v1.AuthService.EraseAccount:input_type -> api.v1.EraseAccountRequest
+	2,  // 18: api.v1.AuthService.Login:output_type -> api.v1.LoginResponse
+	4,  // 19: api.v1.AuthService.RefreshToken:output_type -> api.v1.RefreshTokenResponse
+	6,  // 20: api.v1.AuthService.Logout:output_type -> api.v1.LogoutResponse
+	8,  // 21: api.v1.AuthService.GetJWKS:output_type -> api.v1.GetJWKSResponse
+	11, // 22: api.v1.AuthService.RegisterAccount:output_type -> api.v1.RegisterAccountResponse
+	13, // 23: api.v1.AuthService.DeleteAccount:output_type -> api.v1.DeleteAccountResponse
+	15, // 24: api.v1.AuthService.SoftDeleteAccount:output_type -> api.v1.SoftDeleteAccountResponse
+	17, // 25: api.v1.AuthService.RestoreAccount:output_type -> api.v1.RestoreAccountResponse
+	19, // 26: api.v1.AuthService.GetAccount:output_type -> api.v1.GetAccountResponse
+	21, // 27: api.v1.AuthService.EraseAccount:output_type -> api.v1.EraseAccountResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_proto_init() }
@@ -629,13 +1540,14 @@ func file_api_v1_auth_proto_init() {
 	if File_api_v1_auth_proto != nil {
 		return
 	}
+	file_api_v1_auth_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_proto_rawDesc), len(file_api_v1_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
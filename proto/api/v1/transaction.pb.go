@@ -27,6 +27,74 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TransactionStatus enumerates the lifecycle of a transaction.
+// A transaction moves from pending to processing, then ends as completed or failed.
+// A completed transaction becomes reversed once it is fully refunded.
+type TransactionStatus int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0
+	// Transaction is recorded, but the balance hasn't been moved.
+	TransactionStatus_TRANSACTION_STATUS_PENDING TransactionStatus = 1
+	// Balance is being moved.
+	TransactionStatus_TRANSACTION_STATUS_PROCESSING TransactionStatus = 2
+	// Balance has been moved.
+	TransactionStatus_TRANSACTION_STATUS_COMPLETED TransactionStatus = 3
+	// Balance can't be moved.
+	TransactionStatus_TRANSACTION_STATUS_FAILED TransactionStatus = 4
+	// Transaction has been fully refunded.
+	TransactionStatus_TRANSACTION_STATUS_REVERSED TransactionStatus = 5
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_PENDING",
+		2: "TRANSACTION_STATUS_PROCESSING",
+		3: "TRANSACTION_STATUS_COMPLETED",
+		4: "TRANSACTION_STATUS_FAILED",
+		5: "TRANSACTION_STATUS_REVERSED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"TRANSACTION_STATUS_PENDING":     1,
+		"TRANSACTION_STATUS_PROCESSING":  2,
+		"TRANSACTION_STATUS_COMPLETED":   3,
+		"TRANSACTION_STATUS_FAILED":      4,
+		"TRANSACTION_STATUS_REVERSED":    5,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_transaction_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_transaction_proto_enumTypes[0]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{0}
+}
+
 // TransactionErrorCode enumerates transaction error code.
 type TransactionErrorCode int32
 
@@ -48,29 +116,65 @@ const (
 	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_AMOUNT TransactionErrorCode = 6
 	// Idempotency key is missing.
 	TransactionErrorCode_TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY TransactionErrorCode = 7
+	// Transfer is rejected by wallet, e.g. insufficient balance.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_TRANSFER_REJECTED TransactionErrorCode = 8
+	// Filter is invalid, e.g. malformed cursor or inverted range.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_FILTER TransactionErrorCode = 9
+	// Currency is not a supported ISO-4217 currency code.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_CURRENCY TransactionErrorCode = 10
+	// Transaction is not found.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_NOT_FOUND TransactionErrorCode = 11
+	// Transaction has been fully refunded, or the refund exceeds the amount which hasn't been refunded.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_ALREADY_REFUNDED TransactionErrorCode = 12
+	// Transaction can't be refunded by the user, e.g. the user is not the receiver, it is a refund, or it is not completed.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_NOT_REFUNDABLE TransactionErrorCode = 13
+	// Transaction can't move from its current status to the requested status.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_STATUS_TRANSITION TransactionErrorCode = 14
+	// Quote id is not a valid uuid.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_QUOTE TransactionErrorCode = 15
+	// Hold id is not a valid uuid or it is used with quote id.
+	TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_HOLD TransactionErrorCode = 16
 )
 
 // Enum value maps for TransactionErrorCode.
 var (
 	TransactionErrorCode_name = map[int32]string{
-		0: "TRANSACTION_ERROR_CODE_UNSPECIFIED",
-		1: "TRANSACTION_ERROR_CODE_INTERNAL",
-		2: "TRANSACTION_ERROR_CODE_ALREADY_EXISTS",
-		3: "TRANSACTION_ERROR_CODE_EMPTY_TRANSACTION",
-		4: "TRANSACTION_ERROR_CODE_INVALID_SENDER",
-		5: "TRANSACTION_ERROR_CODE_INVALID_RECEIVER",
-		6: "TRANSACTION_ERROR_CODE_INVALID_AMOUNT",
-		7: "TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY",
+		0:  "TRANSACTION_ERROR_CODE_UNSPECIFIED",
+		1:  "TRANSACTION_ERROR_CODE_INTERNAL",
+		2:  "TRANSACTION_ERROR_CODE_ALREADY_EXISTS",
+		3:  "TRANSACTION_ERROR_CODE_EMPTY_TRANSACTION",
+		4:  "TRANSACTION_ERROR_CODE_INVALID_SENDER",
+		5:  "TRANSACTION_ERROR_CODE_INVALID_RECEIVER",
+		6:  "TRANSACTION_ERROR_CODE_INVALID_AMOUNT",
+		7:  "TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY",
+		8:  "TRANSACTION_ERROR_CODE_TRANSFER_REJECTED",
+		9:  "TRANSACTION_ERROR_CODE_INVALID_FILTER",
+		10: "TRANSACTION_ERROR_CODE_INVALID_CURRENCY",
+		11: "TRANSACTION_ERROR_CODE_NOT_FOUND",
+		12: "TRANSACTION_ERROR_CODE_ALREADY_REFUNDED",
+		13: "TRANSACTION_ERROR_CODE_NOT_REFUNDABLE",
+		14: "TRANSACTION_ERROR_CODE_INVALID_STATUS_TRANSITION",
+		15: "TRANSACTION_ERROR_CODE_INVALID_QUOTE",
+		16: "TRANSACTION_ERROR_CODE_INVALID_HOLD",
 	}
 	TransactionErrorCode_value = map[string]int32{
-		"TRANSACTION_ERROR_CODE_UNSPECIFIED":             0,
-		"TRANSACTION_ERROR_CODE_INTERNAL":                1,
-		"TRANSACTION_ERROR_CODE_ALREADY_EXISTS":          2,
-		"TRANSACTION_ERROR_CODE_EMPTY_TRANSACTION":       3,
-		"TRANSACTION_ERROR_CODE_INVALID_SENDER":          4,
-		"TRANSACTION_ERROR_CODE_INVALID_RECEIVER":        5,
-		"TRANSACTION_ERROR_CODE_INVALID_AMOUNT":          6,
-		"TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY": 7,
+		"TRANSACTION_ERROR_CODE_UNSPECIFIED":               0,
+		"TRANSACTION_ERROR_CODE_INTERNAL":                  1,
+		"TRANSACTION_ERROR_CODE_ALREADY_EXISTS":            2,
+		"TRANSACTION_ERROR_CODE_EMPTY_TRANSACTION":         3,
+		"TRANSACTION_ERROR_CODE_INVALID_SENDER":            4,
+		"TRANSACTION_ERROR_CODE_INVALID_RECEIVER":          5,
+		"TRANSACTION_ERROR_CODE_INVALID_AMOUNT":            6,
+		"TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY":   7,
+		"TRANSACTION_ERROR_CODE_TRANSFER_REJECTED":         8,
+		"TRANSACTION_ERROR_CODE_INVALID_FILTER":            9,
+		"TRANSACTION_ERROR_CODE_INVALID_CURRENCY":          10,
+		"TRANSACTION_ERROR_CODE_NOT_FOUND":                 11,
+		"TRANSACTION_ERROR_CODE_ALREADY_REFUNDED":          12,
+		"TRANSACTION_ERROR_CODE_NOT_REFUNDABLE":            13,
+		"TRANSACTION_ERROR_CODE_INVALID_STATUS_TRANSITION": 14,
+		"TRANSACTION_ERROR_CODE_INVALID_QUOTE":             15,
+		"TRANSACTION_ERROR_CODE_INVALID_HOLD":              16,
 	}
 )
 
@@ -85,11 +189,11 @@ func (x TransactionErrorCode) String() string {
 }
 
 func (TransactionErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_transaction_proto_enumTypes[0].Descriptor()
+	return file_api_v1_transaction_proto_enumTypes[1].Descriptor()
 }
 
 func (TransactionErrorCode) Type() protoreflect.EnumType {
-	return &file_api_v1_transaction_proto_enumTypes[0]
+	return &file_api_v1_transaction_proto_enumTypes[1]
 }
 
 func (x TransactionErrorCode) Number() protoreflect.EnumNumber {
@@ -98,7 +202,7 @@ func (x TransactionErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransactionErrorCode.Descriptor instead.
 func (TransactionErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_transaction_proto_rawDescGZIP(), []int{0}
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{1}
 }
 
 // CreateTransactionRequest represents request for create transaction.
@@ -193,26 +297,516 @@ func (x *CreateTransactionResponse) GetData() *Transaction {
 	return nil
 }
 
-// Transaction represents transaction.
-type Transaction struct {
+// RefundTransactionRequest represents request for refund transaction.
+type RefundTransactionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id represents unique id.
+	// id represents the id of the transaction to be refunded.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// sender_id represents sender's id.
-	SenderId string `protobuf:"bytes,2,opt,name=sender_id,proto3" json:"sender_id,omitempty"`
-	// receiver_id represents receiver's id.
-	ReceiverId string `protobuf:"bytes,3,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
-	// amount represents amount.
-	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// created_at represents when the transaction was created.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// amount represents the amount to be refunded.
+	// It must not be greater than the transaction's amount which hasn't been refunded. Leave it empty to refund the whole remaining amount.
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTransactionRequest) Reset() {
+	*x = RefundTransactionRequest{}
+	mi := &file_api_v1_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransactionRequest) ProtoMessage() {}
+
+func (x *RefundTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RefundTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// RefundTransactionResponse represents response from refund transaction.
+type RefundTransactionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents the refund transaction.
+	Data          *Transaction `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTransactionResponse) Reset() {
+	*x = RefundTransactionResponse{}
+	mi := &file_api_v1_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransactionResponse) ProtoMessage() {}
+
+func (x *RefundTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransactionResponse.ProtoReflect.Descriptor instead.
+func (*RefundTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundTransactionResponse) GetData() *Transaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListMyTransactionsRequest represents request for list my transactions.
+type ListMyTransactionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAfter   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,proto3" json:"created_after,omitempty"`
+	CreatedBefore  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,proto3" json:"created_before,omitempty"`
+	Cursor         string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	CounterpartyId string                 `protobuf:"bytes,3,opt,name=counterparty_id,proto3" json:"counterparty_id,omitempty"`
+	MinAmount      string                 `protobuf:"bytes,6,opt,name=min_amount,proto3" json:"min_amount,omitempty"`
+	MaxAmount      string                 `protobuf:"bytes,7,opt,name=max_amount,proto3" json:"max_amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	Limit          uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsRequest) Reset() {
+	*x = ListMyTransactionsRequest{}
+	mi := &file_api_v1_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsRequest) ProtoMessage() {}
+
+func (x *ListMyTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMyTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetCounterpartyId() string {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsRequest) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ListMyTransactionsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// ListMyTransactionsResponse represents response from list my transactions.
+type ListMyTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of transaction data.
+	Data []*Transaction `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// next_cursor represents cursor to get the next page.
+	// It is empty when there is no more transaction.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTransactionsResponse) Reset() {
+	*x = ListMyTransactionsResponse{}
+	mi := &file_api_v1_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTransactionsResponse) ProtoMessage() {}
+
+func (x *ListMyTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMyTransactionsResponse) GetData() []*Transaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListMyTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ListUserTransactionsRequest represents request for list user transactions.
+type ListUserTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTransactionsRequest) Reset() {
+	*x = ListUserTransactionsRequest{}
+	mi := &file_api_v1_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTransactionsRequest) ProtoMessage() {}
+
+func (x *ListUserTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUserTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// ListUserTransactionsResponse represents response from list user transactions.
+type ListUserTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of transaction data.
+	Data []*Transaction `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// next_cursor represents cursor to get the next page.
+	// It is empty when there is no more transaction.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTransactionsResponse) Reset() {
+	*x = ListUserTransactionsResponse{}
+	mi := &file_api_v1_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTransactionsResponse) ProtoMessage() {}
+
+func (x *ListUserTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserTransactionsResponse) GetData() []*Transaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListUserTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ListStaleTransactionsRequest represents request for list stale transactions.
+type ListStaleTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OlderThan     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=older_than,proto3" json:"older_than,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	Status        TransactionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.TransactionStatus" json:"status,omitempty"`
+	Limit         uint32            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleTransactionsRequest) Reset() {
+	*x = ListStaleTransactionsRequest{}
+	mi := &file_api_v1_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleTransactionsRequest) ProtoMessage() {}
+
+func (x *ListStaleTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaleTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListStaleTransactionsRequest) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListStaleTransactionsRequest) GetOlderThan() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OlderThan
+	}
+	return nil
+}
+
+func (x *ListStaleTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListStaleTransactionsResponse represents response from list stale transactions.
+type ListStaleTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of transaction data.
+	Data          []*Transaction `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ListStaleTransactionsResponse) Reset() {
+	*x = ListStaleTransactionsResponse{}
+	mi := &file_api_v1_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleTransactionsResponse) ProtoMessage() {}
+
+func (x *ListStaleTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaleTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListStaleTransactionsResponse) GetData() []*Transaction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Transaction represents transaction.
+type Transaction struct {
+	ReversedAt            *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=reversed_at,proto3" json:"reversed_at,omitempty"`
+	CompletedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=completed_at,proto3" json:"completed_at,omitempty"`
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	FailedAt              *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=failed_at,proto3" json:"failed_at,omitempty"`
+	ProcessingAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=processing_at,proto3" json:"processing_at,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	SenderId              string                 `protobuf:"bytes,2,opt,name=sender_id,proto3" json:"sender_id,omitempty"`
+	QuoteId               string                 `protobuf:"bytes,16,opt,name=quote_id,proto3" json:"quote_id,omitempty"`
+	OriginalTransactionId string                 `protobuf:"bytes,9,opt,name=original_transaction_id,proto3" json:"original_transaction_id,omitempty"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount                string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SenderWalletId        string                 `protobuf:"bytes,6,opt,name=sender_wallet_id,proto3" json:"sender_wallet_id,omitempty"`
+	ReceiverId            string                 `protobuf:"bytes,3,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
+	FailureReason         string                 `protobuf:"bytes,11,opt,name=failure_reason,proto3" json:"failure_reason,omitempty"`
+	ReceiverWalletId      string                 `protobuf:"bytes,7,opt,name=receiver_wallet_id,proto3" json:"receiver_wallet_id,omitempty"`
+	HoldId                string                 `protobuf:"bytes,17,opt,name=hold_id,proto3" json:"hold_id,omitempty"`
+	Currency              string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	Status                TransactionStatus `protobuf:"varint,10,opt,name=status,proto3,enum=api.v1.TransactionStatus" json:"status,omitempty"`
+	sizeCache             protoimpl.SizeCache
+}
+
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_api_v1_transaction_proto_msgTypes[2]
+	mi := &file_api_v1_transaction_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +818,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transaction_proto_msgTypes[2]
+	mi := &file_api_v1_transaction_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +831,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_api_v1_transaction_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Transaction) GetId() string {
@@ -275,6 +869,90 @@ func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Transaction) GetSenderWalletId() string {
+	if x != nil {
+		return x.SenderWalletId
+	}
+	return ""
+}
+
+func (x *Transaction) GetReceiverWalletId() string {
+	if x != nil {
+		return x.ReceiverWalletId
+	}
+	return ""
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetOriginalTransactionId() string {
+	if x != nil {
+		return x.OriginalTransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Transaction) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Transaction) GetProcessingAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessingAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetReversedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *Transaction) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
 // TransactionError represents message for any error happening in transaction service.
 type TransactionError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -285,7 +963,7 @@ type TransactionError struct {
 
 func (x *TransactionError) Reset() {
 	*x = TransactionError{}
-	mi := &file_api_v1_transaction_proto_msgTypes[3]
+	mi := &file_api_v1_transaction_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +975,7 @@ func (x *TransactionError) String() string {
 func (*TransactionError) ProtoMessage() {}
 
 func (x *TransactionError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_transaction_proto_msgTypes[3]
+	mi := &file_api_v1_transaction_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +988,7 @@ func (x *TransactionError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionError.ProtoReflect.Descriptor instead.
 func (*TransactionError) Descriptor() ([]byte, []int) {
-	return file_api_v1_transaction_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_transaction_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TransactionError) GetErrorCode() TransactionErrorCode {
@@ -328,18 +1006,75 @@ const file_api_v1_transaction_proto_rawDesc = "" +
 	"\x18CreateTransactionRequest\x125\n" +
 	"\vtransaction\x18\x01 \x01(\v2\x13.api.v1.TransactionR\vtransaction\"D\n" +
 	"\x19CreateTransactionResponse\x12'\n" +
-	"\x04data\x18\x01 \x01(\v2\x13.api.v1.TransactionR\x04data\"\x9c\x03\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.api.v1.TransactionR\x04data\"_\n" +
+	"\x18RefundTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\x06amount\x18\x02 \x01(\tB\x1b\x92A\x182\x0fRefund's amountJ\x05\"5.5\"R\x06amount\"D\n" +
+	"\x19RefundTransactionResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.api.v1.TransactionR\x04data\"\xd5\x02\n" +
+	"\x19ListMyTransactionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12(\n" +
+	"\x0fcounterparty_id\x18\x03 \x01(\tR\x0fcounterparty_id\x12@\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreated_after\x12B\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecreated_before\x12\x1e\n" +
+	"\n" +
+	"min_amount\x18\x06 \x01(\tR\n" +
+	"min_amount\x12\x1e\n" +
+	"\n" +
+	"max_amount\x18\a \x01(\tR\n" +
+	"max_amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"q\n" +
+	"\x1aListMyTransactionsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.api.v1.TransactionB\x03\xe0A\x03R\x04data\x12%\n" +
+	"\vnext_cursor\x18\x02 \x01(\tB\x03\xe0A\x03R\vnext_cursor\"e\n" +
+	"\x1bListUserTransactionsRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"s\n" +
+	"\x1cListUserTransactionsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.api.v1.TransactionB\x03\xe0A\x03R\x04data\x12%\n" +
+	"\vnext_cursor\x18\x02 \x01(\tB\x03\xe0A\x03R\vnext_cursor\"\xa3\x01\n" +
+	"\x1cListStaleTransactionsRequest\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.api.v1.TransactionStatusR\x06status\x12:\n" +
+	"\n" +
+	"older_than\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"older_than\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"M\n" +
+	"\x1dListStaleTransactionsResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.api.v1.TransactionB\x03\xe0A\x03R\x04data\"\xc0\n" +
+	"\n" +
 	"\vTransaction\x12>\n" +
-	"\x02id\x18\x01 \x01(\tB.\x92A(J&\"01917a0c-cdfe-7d3c-b0fc-ea83671bf6d9\"\xe0A\x03R\x02id\x12d\n" +
-	"\tsender_id\x18\x02 \x01(\tBF\x92AC2\x19Transaction's sender's idJ&\"01917a0c-cdfe-7ce9-a9aa-a921cfd6c289\"R\tsender_id\x12j\n" +
+	"\x02id\x18\x01 \x01(\tB.\x92A(J&\"01917a0c-cdfe-7d3c-b0fc-ea83671bf6d9\"\xe0A\x03R\x02id\x12g\n" +
+	"\tsender_id\x18\x02 \x01(\tBI\x92AC2\x19Transaction's sender's idJ&\"01917a0c-cdfe-7ce9-a9aa-a921cfd6c289\"\xe0A\x03R\tsender_id\x12j\n" +
 	"\vreceiver_id\x18\x03 \x01(\tBH\x92AE2\x1bTransaction's receiver's idJ&\"01917a0c-cdfe-7f72-9b8f-12c3480d5baf\"R\vreceiver_id\x12:\n" +
 	"\x06amount\x18\x04 \x01(\tB\"\x92A\x1f2\x14Transaction's amountJ\a\"10.23\"R\x06amount\x12?\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"created_at\"O\n" +
+	"created_at\x12y\n" +
+	"\x10sender_wallet_id\x18\x06 \x01(\tBM\x92AJ2 Transaction's sender's wallet idJ&\"0191884e-0af5-7fe2-9b8c-4cfda36eed64\"R\x10sender_wallet_id\x12\x7f\n" +
+	"\x12receiver_wallet_id\x18\a \x01(\tBO\x92AL2\"Transaction's receiver's wallet idJ&\"0191884e-0af5-7efc-9e16-db28115e5609\"R\x12receiver_wallet_id\x12>\n" +
+	"\bcurrency\x18\b \x01(\tB\"\x92A\x1f2\x16Transaction's currencyJ\x05\"IDR\"R\bcurrency\x12=\n" +
+	"\x17original_transaction_id\x18\t \x01(\tB\x03\xe0A\x03R\x17original_transaction_id\x126\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x19.api.v1.TransactionStatusB\x03\xe0A\x03R\x06status\x12+\n" +
+	"\x0efailure_reason\x18\v \x01(\tB\x03\xe0A\x03R\x0efailure_reason\x12E\n" +
+	"\rprocessing_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\rprocessing_at\x12C\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\fcompleted_at\x12=\n" +
+	"\tfailed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tfailed_at\x12A\n" +
+	"\vreversed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vreversed_at\x12V\n" +
+	"\bquote_id\x18\x10 \x01(\tB:\x92A72\rFX quote's idJ&\"01917a10-1086-7a3e-8d2f-6f1c2b9e4a10\"R\bquote_id\x12Y\n" +
+	"\ahold_id\x18\x11 \x01(\tB?\x92A<2\x12Wallet's hold's idJ&\"01917a10-1086-7b21-9f4e-2c8d5a6e3b71\"R\ahold_id\"O\n" +
 	"\x10TransactionError\x12;\n" +
 	"\n" +
-	"error_code\x18\x01 \x01(\x0e2\x1c.api.v1.TransactionErrorCodeR\terrorCode*\xf3\x02\n" +
+	"error_code\x18\x01 \x01(\x0e2\x1c.api.v1.TransactionErrorCodeR\terrorCode*\xdc\x01\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dTRANSACTION_STATUS_PROCESSING\x10\x02\x12 \n" +
+	"\x1cTRANSACTION_STATUS_COMPLETED\x10\x03\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_FAILED\x10\x04\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_REVERSED\x10\x05*\x80\x06\n" +
 	"\x14TransactionErrorCode\x12&\n" +
 	"\"TRANSACTION_ERROR_CODE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fTRANSACTION_ERROR_CODE_INTERNAL\x10\x01\x12)\n" +
@@ -348,14 +1083,37 @@ const file_api_v1_transaction_proto_rawDesc = "" +
 	"%TRANSACTION_ERROR_CODE_INVALID_SENDER\x10\x04\x12+\n" +
 	"'TRANSACTION_ERROR_CODE_INVALID_RECEIVER\x10\x05\x12)\n" +
 	"%TRANSACTION_ERROR_CODE_INVALID_AMOUNT\x10\x06\x122\n" +
-	".TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY\x10\a2\xb4\x02\n" +
+	".TRANSACTION_ERROR_CODE_MISSING_IDEMPOTENCY_KEY\x10\a\x12,\n" +
+	"(TRANSACTION_ERROR_CODE_TRANSFER_REJECTED\x10\b\x12)\n" +
+	"%TRANSACTION_ERROR_CODE_INVALID_FILTER\x10\t\x12+\n" +
+	"'TRANSACTION_ERROR_CODE_INVALID_CURRENCY\x10\n" +
+	"\x12$\n" +
+	" TRANSACTION_ERROR_CODE_NOT_FOUND\x10\v\x12+\n" +
+	"'TRANSACTION_ERROR_CODE_ALREADY_REFUNDED\x10\f\x12)\n" +
+	"%TRANSACTION_ERROR_CODE_NOT_REFUNDABLE\x10\r\x124\n" +
+	"0TRANSACTION_ERROR_CODE_INVALID_STATUS_TRANSITION\x10\x0e\x12(\n" +
+	"$TRANSACTION_ERROR_CODE_INVALID_QUOTE\x10\x0f\x12'\n" +
+	"#TRANSACTION_ERROR_CODE_INVALID_HOLD\x10\x102\x8b\x04\n" +
 	"\x19TransactionCommandService\x12\xd2\x01\n" +
 	"\x11CreateTransaction\x12 .api.v1.CreateTransactionRequest\x1a!.api.v1.CreateTransactionResponse\"x\x92AP\n" +
 	"\vTransaction*\x11CreateTransactionr.\n" +
 	"\x13\n" +
 	"\rAuthorization\x18\x01(\x01\n" +
 	"\x17\n" +
-	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1f:\vtransaction\"\x10/v1/transactions\x1aB\x92A?\x12=This service provides all use cases to work with transaction.B\x9b\x02\x92A\xd6\x01\x12\x9c\x01\n" +
+	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1f:\vtransaction\"\x10/v1/transactions\x12\xd4\x01\n" +
+	"\x11RefundTransaction\x12 .api.v1.RefundTransactionRequest\x1a!.api.v1.RefundTransactionResponse\"z\x92AP\n" +
+	"\vTransaction*\x11RefundTransactionr.\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\n" +
+	"\x17\n" +
+	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/transactions/{id}/refund\x1aB\x92A?\x12=This service provides all use cases to work with transaction.2\xf8\x03\n" +
+	"\x17TransactionQueryService\x12\xb0\x01\n" +
+	"\x12ListMyTransactions\x12!.api.v1.ListMyTransactionsRequest\x1a\".api.v1.ListMyTransactionsResponse\"S\x92A8\n" +
+	"\vTransaction*\x12ListMyTransactionsr\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/transactions\x12c\n" +
+	"\x14ListUserTransactions\x12#.api.v1.ListUserTransactionsRequest\x1a$.api.v1.ListUserTransactionsResponse\"\x00\x12f\n" +
+	"\x15ListStaleTransactions\x12$.api.v1.ListStaleTransactionsRequest\x1a%.api.v1.ListStaleTransactionsResponse\"\x00\x1a]\x92AZ\x12XThis service provides basic query or data-retrieving use cases to work with transaction.B\x9b\x02\x92A\xd6\x01\x12\x9c\x01\n" +
 	"\x0fTransaction API\"0\n" +
 	"\rIndra Saputra\x12\x1fhttps://github.com/indrasaputra*P\n" +
 	"\x14BSD 3-Clause License\x128https://github.com/indrasaputra/arjuna/blob/main/LICENSE2\x051.0.0\x1a\x0elocalhost:8000*\x01\x012\x10application/json:\x10application/jsonZ?github.com/indrasaputra/arjuna/service/transaction/api/v1;apiv1b\x06proto3"
@@ -372,28 +1130,58 @@ func file_api_v1_transaction_proto_rawDescGZIP() []byte {
 	return file_api_v1_transaction_proto_rawDescData
 }
 
-var file_api_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_v1_transaction_proto_goTypes = []any{
-	(TransactionErrorCode)(0),         // 0: api.v1.TransactionErrorCode
-	(*CreateTransactionRequest)(nil),  // 1: api.v1.CreateTransactionRequest
-	(*CreateTransactionResponse)(nil), // 2: api.v1.CreateTransactionResponse
-	(*Transaction)(nil),               // 3: api.v1.Transaction
-	(*TransactionError)(nil),          // 4: api.v1.TransactionError
-	(*timestamppb.Timestamp)(nil),     // 5: google.protobuf.Timestamp
+	(TransactionStatus)(0),                // 0: api.v1.TransactionStatus
+	(TransactionErrorCode)(0),             // 1: api.v1.TransactionErrorCode
+	(*CreateTransactionRequest)(nil),      // 2: api.v1.CreateTransactionRequest
+	(*CreateTransactionResponse)(nil),     // 3: api.v1.CreateTransactionResponse
+	(*RefundTransactionRequest)(nil),      // 4: api.v1.RefundTransactionRequest
+	(*RefundTransactionResponse)(nil),     // 5: api.v1.RefundTransactionResponse
+	(*ListMyTransactionsRequest)(nil),     // 6: api.v1.ListMyTransactionsRequest
+	(*ListMyTransactionsResponse)(nil),    // 7: api.v1.ListMyTransactionsResponse
+	(*ListUserTransactionsRequest)(nil),   // 8: api.v1.ListUserTransactionsRequest
+	(*ListUserTransactionsResponse)(nil),  // 9: api.v1.ListUserTransactionsResponse
+	(*ListStaleTransactionsRequest)(nil),  // 10: api.v1.ListStaleTransactionsRequest
+	(*ListStaleTransactionsResponse)(nil), // 11: api.v1.ListStaleTransactionsResponse
+	(*Transaction)(nil),                   // 12: api.v1.Transaction
+	(*TransactionError)(nil),              // 13: api.v1.TransactionError
+	(*timestamppb.Timestamp)(nil),         // 14: google.protobuf.Timestamp
 }
 var file_api_v1_transaction_proto_depIdxs = []int32{
-	3, // 0: api.v1.CreateTransactionRequest.transaction:type_name -> api.v1.Transaction
-	3, // 1: api.v1.CreateTransactionResponse.data:type_name -> api.v1.Transaction
-	5, // 2: api.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	0, // 3: api.v1.TransactionError.error_code:type_name -> api.v1.TransactionErrorCode
-	1, // 4: api.v1.TransactionCommandService.CreateTransaction:input_type -> api.v1.CreateTransactionRequest
-	2, // 5: api.v1.TransactionCommandService.CreateTransaction:output_type -> api.v1.CreateTransactionResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: api.v1.CreateTransactionRequest.transaction:type_name -> api.v1.Transaction
+	12, // 1: api.v1.CreateTransactionResponse.data:type_name -> api.v1.Transaction
+	12, // 2: api.v1.RefundTransactionResponse.data:type_name -> api.v1.Transaction
+	14, // 3: api.v1.ListMyTransactionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	14, // 4: api.v1.ListMyTransactionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	12, // 5: api.v1.ListMyTransactionsResponse.data:type_name -> api.v1.Transaction
+	12, // 6: api.v1.ListUserTransactionsResponse.data:type_name -> api.v1.Transaction
+	0,  // 7: api.v1.ListStaleTransactionsRequest.status:type_name -> api.v1.TransactionStatus
+	14, // 8: api.v1.ListStaleTransactionsRequest.older_than:type_name -> google.protobuf.Timestamp
+	12, // 9: api.v1.ListStaleTransactionsResponse.data:type_name -> api.v1.Transaction
+	14, // 10: api.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: api.v1.Transaction.status:type_name -> api.v1.TransactionStatus
+	14, // 12: api.v1.Transaction.processing_at:type_name -> google.protobuf.Timestamp
+	14, // 13: api.v1.Transaction.completed_at:type_name -> google.protobuf.Timestamp
+	14, // 14: api.v1.Transaction.failed_at:type_name -> google.protobuf.Timestamp
+	14, // 15: api.v1.Transaction.reversed_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: api.v1.TransactionError.error_code:type_name -> api.v1.TransactionErrorCode
+	2,  // 17: api.v1.TransactionCommandService.CreateTransaction:input_type -> api.v1.CreateTransactionRequest
+	4,  // 18: api.v1.TransactionCommandService.RefundTransaction:input_type -> api.v1.RefundTransactionRequest
+	6,  // 19: api.v1.TransactionQueryService.ListMyTransactions:input_type -> api.v1.ListMyTransactionsRequest
+	8,  // 20: api.v1.TransactionQueryService.ListUserTransactions:input_type -> api.v1.ListUserTransactionsRequest
+	10, // 21: api.v1.TransactionQueryService.ListStaleTransactions:input_type -> api.v1.ListStaleTransactionsRequest
+	3,  // 22: api.v1.TransactionCommandService.CreateTransaction:output_type -> api.v1.CreateTransactionResponse
+	5,  // 23: api.v1.TransactionCommandService.RefundTransaction:output_type -> api.v1.RefundTransactionResponse
+	7,  // 24: api.v1.TransactionQueryService.ListMyTransactions:output_type -> api.v1.ListMyTransactionsResponse
+	9,  // 25: api.v1.TransactionQueryService.ListUserTransactions:output_type -> api.v1.ListUserTransactionsResponse
+	11, // 26: api.v1.TransactionQueryService.ListStaleTransactions:output_type -> api.v1.ListStaleTransactionsResponse
+	22, // [22:27] is the sub-list for method output_type
+	17, // [17:22] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_transaction_proto_init() }
@@ -406,10 +1194,10 @@ func file_api_v1_transaction_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_transaction_proto_rawDesc), len(file_api_v1_transaction_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_v1_transaction_proto_goTypes,
 		DependencyIndexes: file_api_v1_transaction_proto_depIdxs,
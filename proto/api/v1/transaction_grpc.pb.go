@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	TransactionCommandService_CreateTransaction_FullMethodName = "/api.v1.TransactionCommandService/CreateTransaction"
+	TransactionCommandService_RefundTransaction_FullMethodName = "/api.v1.TransactionCommandService/RefundTransaction"
 )
 
 // TransactionCommandServiceClient is the client API for TransactionCommandService service.
@@ -35,6 +36,12 @@ type TransactionCommandServiceClient interface {
 	//
 	// This endpoint creates a transaction.
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	// Refund Transaction
+	//
+	// This endpoint refunds a transaction by creating a reversing transaction linked to it.
+	// Only the receiver of the transaction can refund it, fully or partially, as long as the refunds don't exceed its amount.
+	// Transaction converted to another currency can't be refunded.
+	RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error)
 }
 
 type transactionCommandServiceClient struct {
@@ -55,6 +62,16 @@ func (c *transactionCommandServiceClient) CreateTransaction(ctx context.Context,
 	return out, nil
 }
 
+func (c *transactionCommandServiceClient) RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionCommandService_RefundTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionCommandServiceServer is the server API for TransactionCommandService service.
 // All implementations must embed UnimplementedTransactionCommandServiceServer
 // for forward compatibility.
@@ -65,6 +82,12 @@ type TransactionCommandServiceServer interface {
 	//
 	// This endpoint creates a transaction.
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	// Refund Transaction
+	//
+	// This endpoint refunds a transaction by creating a reversing transaction linked to it.
+	// Only the receiver of the transaction can refund it, fully or partially, as long as the refunds don't exceed its amount.
+	// Transaction converted to another currency can't be refunded.
+	RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error)
 	mustEmbedUnimplementedTransactionCommandServiceServer()
 }
 
@@ -78,6 +101,9 @@ type UnimplementedTransactionCommandServiceServer struct{}
 func (UnimplementedTransactionCommandServiceServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedTransactionCommandServiceServer) RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTransaction not implemented")
+}
 func (UnimplementedTransactionCommandServiceServer) mustEmbedUnimplementedTransactionCommandServiceServer() {
 }
 func (UnimplementedTransactionCommandServiceServer) testEmbeddedByValue() {}
@@ -118,6 +144,24 @@ func _TransactionCommandService_CreateTransaction_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionCommandService_RefundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServiceServer).RefundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionCommandService_RefundTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServiceServer).RefundTransaction(ctx, req.(*RefundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionCommandService_ServiceDesc is the grpc.ServiceDesc for TransactionCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -129,6 +173,217 @@ var TransactionCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTransaction",
 			Handler:    _TransactionCommandService_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "RefundTransaction",
+			Handler:    _TransactionCommandService_RefundTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/transaction.proto",
+}
+
+const (
+	TransactionQueryService_ListMyTransactions_FullMethodName    = "/api.v1.TransactionQueryService/ListMyTransactions"
+	TransactionQueryService_ListUserTransactions_FullMethodName  = "/api.v1.TransactionQueryService/ListUserTransactions"
+	TransactionQueryService_ListStaleTransactions_FullMethodName = "/api.v1.TransactionQueryService/ListStaleTransactions"
+)
+
+// TransactionQueryServiceClient is the client API for TransactionQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TransactionQueryService provides query service for transaction.
+type TransactionQueryServiceClient interface {
+	// List My Transactions
+	//
+	// This endpoint lists transactions sent or received by the authenticated user, newest first.
+	// Use next_cursor from the response as cursor to get the next page.
+	ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error)
+	// List User Transactions
+	//
+	// This endpoint lists transactions sent or received by a user, newest first, e.g. to export the user's data.
+	// Use next_cursor from the response as cursor to get the next page.
+	ListUserTransactions(ctx context.Context, in *ListUserTransactionsRequest, opts ...grpc.CallOption) (*ListUserTransactionsResponse, error)
+	// List Stale Transactions
+	//
+	// This endpoint lists transactions which have stayed in a status since before a time, oldest first,
+	// e.g. to reconcile transactions left pending or processing.
+	ListStaleTransactions(ctx context.Context, in *ListStaleTransactionsRequest, opts ...grpc.CallOption) (*ListStaleTransactionsResponse, error)
+}
+
+type transactionQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionQueryServiceClient(cc grpc.ClientConnInterface) TransactionQueryServiceClient {
+	return &transactionQueryServiceClient{cc}
+}
+
+func (c *transactionQueryServiceClient) ListMyTransactions(ctx context.Context, in *ListMyTransactionsRequest, opts ...grpc.CallOption) (*ListMyTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionQueryService_ListMyTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionQueryServiceClient) ListUserTransactions(ctx context.Context, in *ListUserTransactionsRequest, opts ...grpc.CallOption) (*ListUserTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionQueryService_ListUserTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionQueryServiceClient) ListStaleTransactions(ctx context.Context, in *ListStaleTransactionsRequest, opts ...grpc.CallOption) (*ListStaleTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaleTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionQueryService_ListStaleTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionQueryServiceServer is the server API for TransactionQueryService service.
+// All implementations must embed UnimplementedTransactionQueryServiceServer
+// for forward compatibility.
+//
+// TransactionQueryService provides query service for transaction.
+type TransactionQueryServiceServer interface {
+	// List My Transactions
+	//
+	// This endpoint lists transactions sent or received by the authenticated user, newest first.
+	// Use next_cursor from the response as cursor to get the next page.
+	ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error)
+	// List User Transactions
+	//
+	// This endpoint lists transactions sent or received by a user, newest first, e.g. to export the user's data.
+	// Use next_cursor from the response as cursor to get the next page.
+	ListUserTransactions(context.Context, *ListUserTransactionsRequest) (*ListUserTransactionsResponse, error)
+	// List Stale Transactions
+	//
+	// This endpoint lists transactions which have stayed in a status since before a time, oldest first,
+	// e.g. to reconcile transactions left pending or processing.
+	ListStaleTransactions(context.Context, *ListStaleTransactionsRequest) (*ListStaleTransactionsResponse, error)
+	mustEmbedUnimplementedTransactionQueryServiceServer()
+}
+
+// UnimplementedTransactionQueryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransactionQueryServiceServer struct{}
+
+func (UnimplementedTransactionQueryServiceServer) ListMyTransactions(context.Context, *ListMyTransactionsRequest) (*ListMyTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTransactions not implemented")
+}
+func (UnimplementedTransactionQueryServiceServer) ListUserTransactions(context.Context, *ListUserTransactionsRequest) (*ListUserTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTransactions not implemented")
+}
+func (UnimplementedTransactionQueryServiceServer) ListStaleTransactions(context.Context, *ListStaleTransactionsRequest) (*ListStaleTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleTransactions not implemented")
+}
+func (UnimplementedTransactionQueryServiceServer) mustEmbedUnimplementedTransactionQueryServiceServer() {
+}
+func (UnimplementedTransactionQueryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTransactionQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionQueryServiceServer will
+// result in compilation errors.
+type UnsafeTransactionQueryServiceServer interface {
+	mustEmbedUnimplementedTransactionQueryServiceServer()
+}
+
+func RegisterTransactionQueryServiceServer(s grpc.ServiceRegistrar, srv TransactionQueryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransactionQueryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransactionQueryService_ServiceDesc, srv)
+}
+
+func _TransactionQueryService_ListMyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionQueryServiceServer).ListMyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionQueryService_ListMyTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionQueryServiceServer).ListMyTransactions(ctx, req.(*ListMyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionQueryService_ListUserTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionQueryServiceServer).ListUserTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionQueryService_ListUserTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionQueryServiceServer).ListUserTransactions(ctx, req.(*ListUserTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionQueryService_ListStaleTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionQueryServiceServer).ListStaleTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionQueryService_ListStaleTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionQueryServiceServer).ListStaleTransactions(ctx, req.(*ListStaleTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionQueryService_ServiceDesc is the grpc.ServiceDesc for TransactionQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.TransactionQueryService",
+	HandlerType: (*TransactionQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMyTransactions",
+			Handler:    _TransactionQueryService_ListMyTransactions_Handler,
+		},
+		{
+			MethodName: "ListUserTransactions",
+			Handler:    _TransactionQueryService_ListUserTransactions_Handler,
+		},
+		{
+			MethodName: "ListStaleTransactions",
+			Handler:    _TransactionQueryService_ListStaleTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/transaction.proto",
@@ -17,6 +17,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,6 +28,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RegistrationState enumerates the state of user registration.
+type RegistrationState int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	RegistrationState_REGISTRATION_STATE_UNSPECIFIED RegistrationState = 0
+	// Registration is still in progress.
+	RegistrationState_REGISTRATION_STATE_PENDING RegistrationState = 1
+	// Registration is completed.
+	RegistrationState_REGISTRATION_STATE_COMPLETED RegistrationState = 2
+	// Registration is failed and won't be continued.
+	RegistrationState_REGISTRATION_STATE_FAILED RegistrationState = 3
+)
+
+// Enum value maps for RegistrationState.
+var (
+	RegistrationState_name = map[int32]string{
+		0: "REGISTRATION_STATE_UNSPECIFIED",
+		1: "REGISTRATION_STATE_PENDING",
+		2: "REGISTRATION_STATE_COMPLETED",
+		3: "REGISTRATION_STATE_FAILED",
+	}
+	RegistrationState_value = map[string]int32{
+		"REGISTRATION_STATE_UNSPECIFIED": 0,
+		"REGISTRATION_STATE_PENDING":     1,
+		"REGISTRATION_STATE_COMPLETED":   2,
+		"REGISTRATION_STATE_FAILED":      3,
+	}
+)
+
+func (x RegistrationState) Enum() *RegistrationState {
+	p := new(RegistrationState)
+	*p = x
+	return p
+}
+
+func (x RegistrationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegistrationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (RegistrationState) Type() protoreflect.EnumType {
+	return &file_api_v1_user_proto_enumTypes[0]
+}
+
+func (x RegistrationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegistrationState.Descriptor instead.
+func (RegistrationState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 // UserOutboxStatus enumerates user outbox status code.
 type UserOutboxStatus int32
 
@@ -73,11 +132,11 @@ func (x UserOutboxStatus) String() string {
 }
 
 func (UserOutboxStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_proto_enumTypes[0].Descriptor()
+	return file_api_v1_user_proto_enumTypes[1].Descriptor()
 }
 
 func (UserOutboxStatus) Type() protoreflect.EnumType {
-	return &file_api_v1_user_proto_enumTypes[0]
+	return &file_api_v1_user_proto_enumTypes[1]
 }
 
 func (x UserOutboxStatus) Number() protoreflect.EnumNumber {
@@ -86,7 +145,7 @@ func (x UserOutboxStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserOutboxStatus.Descriptor instead.
 func (UserOutboxStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{0}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{1}
 }
 
 // UserErrorCode enumerates user error code.
@@ -112,29 +171,51 @@ const (
 	UserErrorCode_USER_ERROR_CODE_NOT_FOUND UserErrorCode = 6
 	// Idempotency key is missing.
 	UserErrorCode_USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY UserErrorCode = 7
+	// User's password is invalid.
+	// It must not be empty and must be at most 72 bytes.
+	UserErrorCode_USER_ERROR_CODE_INVALID_PASSWORD UserErrorCode = 8
+	// User registration failed and every completed step has been compensated.
+	UserErrorCode_USER_ERROR_CODE_REGISTRATION_ROLLED_BACK UserErrorCode = 9
+	// User registration failed and some completed steps couldn't be compensated.
+	// The user may be left partially registered and needs manual clean up.
+	UserErrorCode_USER_ERROR_CODE_REGISTRATION_PARTIALLY_FAILED UserErrorCode = 10
+	// Soft-deleted user can't be restored since the grace period has passed.
+	UserErrorCode_USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED UserErrorCode = 11
+	// User has been changed since it was read, so the update is rejected.
+	UserErrorCode_USER_ERROR_CODE_UPDATE_CONFLICT UserErrorCode = 12
 )
 
 // Enum value maps for UserErrorCode.
 var (
 	UserErrorCode_name = map[int32]string{
-		0: "USER_ERROR_CODE_UNSPECIFIED",
-		1: "USER_ERROR_CODE_INTERNAL",
-		2: "USER_ERROR_CODE_EMPTY_USER",
-		3: "USER_ERROR_CODE_ALREADY_EXISTS",
-		4: "USER_ERROR_CODE_INVALID_NAME",
-		5: "USER_ERROR_CODE_INVALID_EMAIL",
-		6: "USER_ERROR_CODE_NOT_FOUND",
-		7: "USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY",
+		0:  "USER_ERROR_CODE_UNSPECIFIED",
+		1:  "USER_ERROR_CODE_INTERNAL",
+		2:  "USER_ERROR_CODE_EMPTY_USER",
+		3:  "USER_ERROR_CODE_ALREADY_EXISTS",
+		4:  "USER_ERROR_CODE_INVALID_NAME",
+		5:  "USER_ERROR_CODE_INVALID_EMAIL",
+		6:  "USER_ERROR_CODE_NOT_FOUND",
+		7:  "USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY",
+		8:  "USER_ERROR_CODE_INVALID_PASSWORD",
+		9:  "USER_ERROR_CODE_REGISTRATION_ROLLED_BACK",
+		10: "USER_ERROR_CODE_REGISTRATION_PARTIALLY_FAILED",
+		11: "USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED",
+		12: "USER_ERROR_CODE_UPDATE_CONFLICT",
 	}
 	UserErrorCode_value = map[string]int32{
-		"USER_ERROR_CODE_UNSPECIFIED":             0,
-		"USER_ERROR_CODE_INTERNAL":                1,
-		"USER_ERROR_CODE_EMPTY_USER":              2,
-		"USER_ERROR_CODE_ALREADY_EXISTS":          3,
-		"USER_ERROR_CODE_INVALID_NAME":            4,
-		"USER_ERROR_CODE_INVALID_EMAIL":           5,
-		"USER_ERROR_CODE_NOT_FOUND":               6,
-		"USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY": 7,
+		"USER_ERROR_CODE_UNSPECIFIED":                   0,
+		"USER_ERROR_CODE_INTERNAL":                      1,
+		"USER_ERROR_CODE_EMPTY_USER":                    2,
+		"USER_ERROR_CODE_ALREADY_EXISTS":                3,
+		"USER_ERROR_CODE_INVALID_NAME":                  4,
+		"USER_ERROR_CODE_INVALID_EMAIL":                 5,
+		"USER_ERROR_CODE_NOT_FOUND":                     6,
+		"USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY":       7,
+		"USER_ERROR_CODE_INVALID_PASSWORD":              8,
+		"USER_ERROR_CODE_REGISTRATION_ROLLED_BACK":      9,
+		"USER_ERROR_CODE_REGISTRATION_PARTIALLY_FAILED": 10,
+		"USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED":        11,
+		"USER_ERROR_CODE_UPDATE_CONFLICT":               12,
 	}
 )
 
@@ -149,11 +230,11 @@ func (x UserErrorCode) String() string {
 }
 
 func (UserErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_proto_enumTypes[1].Descriptor()
+	return file_api_v1_user_proto_enumTypes[2].Descriptor()
 }
 
 func (UserErrorCode) Type() protoreflect.EnumType {
-	return &file_api_v1_user_proto_enumTypes[1]
+	return &file_api_v1_user_proto_enumTypes[2]
 }
 
 func (x UserErrorCode) Number() protoreflect.EnumNumber {
@@ -162,7 +243,7 @@ func (x UserErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserErrorCode.Descriptor instead.
 func (UserErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_user_proto_rawDescGZIP(), []int{2}
 }
 
 // RegisterUserRequest represents request for register user.
@@ -340,28 +421,29 @@ func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
-// GetAllUsersRequest represents request for get all users.
-type GetAllUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// SoftDeleteUserRequest represents request for soft delete user.
+type SoftDeleteUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents user's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-	Limit         uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllUsersRequest) Reset() {
-	*x = GetAllUsersRequest{}
+func (x *SoftDeleteUserRequest) Reset() {
+	*x = SoftDeleteUserRequest{}
 	mi := &file_api_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUsersRequest) String() string {
+func (x *SoftDeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUsersRequest) ProtoMessage() {}
+func (*SoftDeleteUserRequest) ProtoMessage() {}
 
-func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *SoftDeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,41 +455,39 @@ func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SoftDeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*SoftDeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetAllUsersRequest) GetLimit() uint32 {
+func (x *SoftDeleteUserRequest) GetId() string {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-// GetAllUsersResponse represents response from get all users.
-type GetAllUsersResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// data represents an array of user data.
-	Data          []*User `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+// SoftDeleteUserResponse represents response from soft delete user.
+type SoftDeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllUsersResponse) Reset() {
-	*x = GetAllUsersResponse{}
+func (x *SoftDeleteUserResponse) Reset() {
+	*x = SoftDeleteUserResponse{}
 	mi := &file_api_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUsersResponse) String() string {
+func (x *SoftDeleteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUsersResponse) ProtoMessage() {}
+func (*SoftDeleteUserResponse) ProtoMessage() {}
 
-func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *SoftDeleteUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,51 +499,34 @@ func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SoftDeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*SoftDeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetAllUsersResponse) GetData() []*User {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// User represents a user data.
-type User struct {
+// RestoreUserRequest represents request for restore user.
+type RestoreUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id represents a user's id.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// email represents a user's email.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// password represents the user's password.
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	// name represents a user's name.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// created_at represents when the user was registered.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	// updated_at represents when the user was last updated.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// id represents user's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
 	mi := &file_api_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *RestoreUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*RestoreUserRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,80 +538,82 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *User) GetId() string {
+func (x *RestoreUserRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// RestoreUserResponse represents response from restore user.
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[7]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
-// UserOutbox represents a user outbox data.
-type UserOutbox struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payload       *User                  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// UpdateProfileRequest represents request for update profile.
+type UpdateProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name represents the user's new name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// updated_at represents when the profile was last updated as read by the user.
+	// It is used to detect concurrent change.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
-	Status        UserOutboxStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.UserOutboxStatus" json:"status,omitempty"`
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOutbox) Reset() {
-	*x = UserOutbox{}
-	mi := &file_api_v1_user_proto_msgTypes[7]
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserOutbox) String() string {
+func (x *UpdateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOutbox) ProtoMessage() {}
+func (*UpdateProfileRequest) ProtoMessage() {}
 
-func (x *UserOutbox) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[7]
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,69 +624,93 @@ func (x *UserOutbox) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOutbox.ProtoReflect.Descriptor instead.
-func (*UserOutbox) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UserOutbox) GetId() string {
+func (x *UpdateProfileRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserOutbox) GetStatus() UserOutboxStatus {
+func (x *UpdateProfileRequest) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.UpdatedAt
 	}
-	return UserOutboxStatus_USER_OUTBOX_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *UserOutbox) GetPayload() *User {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+// UpdateProfileResponse represents response from update profile.
+type UpdateProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents the updated user.
+	Data          *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOutbox) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[9]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserOutbox) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateProfileResponse) GetData() *User {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Data
 	}
 	return nil
 }
 
-// UserError represents message for any error happening in user.
-type UserError struct {
+// EraseMyDataRequest represents request for erase my data.
+type EraseMyDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
-	ErrorCode     UserErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=api.v1.UserErrorCode" json:"error_code,omitempty"`
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserError) Reset() {
-	*x = UserError{}
-	mi := &file_api_v1_user_proto_msgTypes[8]
+func (x *EraseMyDataRequest) Reset() {
+	*x = EraseMyDataRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserError) String() string {
+func (x *EraseMyDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserError) ProtoMessage() {}
+func (*EraseMyDataRequest) ProtoMessage() {}
 
-func (x *UserError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_proto_msgTypes[8]
+func (x *EraseMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,41 +721,810 @@ func (x *UserError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserError.ProtoReflect.Descriptor instead.
-func (*UserError) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EraseMyDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UserError) GetErrorCode() UserErrorCode {
+// EraseMyDataResponse represents response from erase my data.
+type EraseMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseMyDataResponse) Reset() {
+	*x = EraseMyDataResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseMyDataResponse) ProtoMessage() {}
+
+func (x *EraseMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[11]
 	if x != nil {
-		return x.ErrorCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return UserErrorCode_USER_ERROR_CODE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-var File_api_v1_user_proto protoreflect.FileDescriptor
+// Deprecated: Use EraseMyDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{11}
+}
 
-const file_api_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x11api/v1/user.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"<\n" +
-	"\x13RegisterUserRequest\x12%\n" +
-	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserB\x03\xe0A\x02R\x04user\"8\n" +
-	"\x14RegisterUserResponse\x12 \n" +
-	"\x04data\x18\x01 \x01(\v2\f.api.v1.UserR\x04data\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteUserResponse\"*\n" +
-	"\x12GetAllUsersRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\rR\x05limit\"7\n" +
-	"\x13GetAllUsersResponse\x12 \n" +
-	"\x04data\x18\x01 \x03(\v2\f.api.v1.UserR\x04data\"\xc3\x03\n" +
-	"\x04User\x12>\n" +
-	"\x02id\x18\x01 \x01(\tB.\x92A(J&\"01917a0c-cdfe-7e4f-9692-635eb6a6f358\"\xe0A\x03R\x02id\x12g\n" +
-	"\x05email\x18\x02 \x01(\tBQ\x92AK2\fuser's emailJ\x10\"first@user.com\"\x8a\x01 ^[\\w-\\.]+@([\\w-]+\\.)+[\\w-]{2,4}$\xd2\x01\x05email\xe0A\x02R\x05email\x12S\n" +
-	"\bpassword\x18\x03 \x01(\tB7\x92A.2\x0fuser's passwordJ\x12\"WEAKpassword123?\"\xa2\x02\x06string\xe0A\x02\xe0A\x04R\bpassword\x12;\n" +
-	"\x04name\x18\x04 \x01(\tB'\x92A!2\vuser's nameJ\f\"First User\"x\xff\x01\x80\x01\x01\xe0A\x02R\x04name\x12?\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+// ExportMyDataRequest represents request for export my data.
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+// ExportMyDataResponse represents response from export my data.
+type ExportMyDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents the archive of the user's data.
+	Data          *structpb.Struct `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportMyDataResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetAllUsersRequest represents request for get all users.
+type GetAllUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	Limit         uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllUsersRequest) Reset() {
+	*x = GetAllUsersRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllUsersRequest) ProtoMessage() {}
+
+func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAllUsersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetAllUsersResponse represents response from get all users.
+type GetAllUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of user data.
+	Data          []*User `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllUsersResponse) Reset() {
+	*x = GetAllUsersResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllUsersResponse) ProtoMessage() {}
+
+func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAllUsersResponse) GetData() []*User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetUserByIDRequest represents request for get user by id.
+type GetUserByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents user's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIDRequest) Reset() {
+	*x = GetUserByIDRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIDRequest) ProtoMessage() {}
+
+func (x *GetUserByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetUserByIDResponse represents response from get user by id.
+type GetUserByIDResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents user.
+	Data          *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIDResponse) Reset() {
+	*x = GetUserByIDResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIDResponse) ProtoMessage() {}
+
+func (x *GetUserByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByIDResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserByIDResponse) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetMeRequest represents request for get me.
+type GetMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+// GetMeResponse represents response from get me.
+type GetMeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents the authenticated user.
+	Data          *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetMeResponse) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetRegistrationStatusRequest represents request for get registration status.
+type GetRegistrationStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents user's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationStatusRequest) Reset() {
+	*x = GetRegistrationStatusRequest{}
+	mi := &file_api_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationStatusRequest) ProtoMessage() {}
+
+func (x *GetRegistrationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRegistrationStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetRegistrationStatusResponse represents response from get registration status.
+type GetRegistrationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents registration status.
+	Data          *RegistrationStatus `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationStatusResponse) Reset() {
+	*x = GetRegistrationStatusResponse{}
+	mi := &file_api_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationStatusResponse) ProtoMessage() {}
+
+func (x *GetRegistrationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRegistrationStatusResponse) GetData() *RegistrationStatus {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// RegistrationStatus represents the status of user registration.
+type RegistrationStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	State         RegistrationState `protobuf:"varint,1,opt,name=state,proto3,enum=api.v1.RegistrationState" json:"state,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationStatus) Reset() {
+	*x = RegistrationStatus{}
+	mi := &file_api_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationStatus) ProtoMessage() {}
+
+func (x *RegistrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationStatus.ProtoReflect.Descriptor instead.
+func (*RegistrationStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RegistrationStatus) GetState() RegistrationState {
+	if x != nil {
+		return x.State
+	}
+	return RegistrationState_REGISTRATION_STATE_UNSPECIFIED
+}
+
+func (x *RegistrationStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// User represents a user data.
+type User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents a user's id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// email represents a user's email.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// password represents the user's password.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// name represents a user's name.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// created_at represents when the user was registered.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// updated_at represents when the user was last updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// UserOutbox represents a user outbox data.
+type UserOutbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       *User                  `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	Status        UserOutboxStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.UserOutboxStatus" json:"status,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOutbox) Reset() {
+	*x = UserOutbox{}
+	mi := &file_api_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOutbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOutbox) ProtoMessage() {}
+
+func (x *UserOutbox) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOutbox.ProtoReflect.Descriptor instead.
+func (*UserOutbox) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserOutbox) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserOutbox) GetStatus() UserOutboxStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserOutboxStatus_USER_OUTBOX_STATUS_UNSPECIFIED
+}
+
+func (x *UserOutbox) GetPayload() *User {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UserOutbox) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserOutbox) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// UserError represents message for any error happening in user.
+type UserError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	ErrorCode     UserErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=api.v1.UserErrorCode" json:"error_code,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserError) Reset() {
+	*x = UserError{}
+	mi := &file_api_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserError) ProtoMessage() {}
+
+func (x *UserError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserError.ProtoReflect.Descriptor instead.
+func (*UserError) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UserError) GetErrorCode() UserErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return UserErrorCode_USER_ERROR_CODE_UNSPECIFIED
+}
+
+var File_api_v1_user_proto protoreflect.FileDescriptor
+
+const file_api_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/v1/user.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"<\n" +
+	"\x13RegisterUserRequest\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\f.api.v1.UserB\x03\xe0A\x02R\x04user\"8\n" +
+	"\x14RegisterUserResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.UserR\x04data\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteUserResponse\"'\n" +
+	"\x15SoftDeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16SoftDeleteUserResponse\"$\n" +
+	"\x12RestoreUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13RestoreUserResponse\"\x93\x01\n" +
+	"\x14UpdateProfileRequest\x12:\n" +
+	"\x04name\x18\x01 \x01(\tB&\x92A 2\vuser's nameJ\f\"First User\"xd\x80\x01\x01\xe0A\x02R\x04name\x12?\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\n" +
+	"updated_at\"9\n" +
+	"\x15UpdateProfileResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.UserR\x04data\"\x14\n" +
+	"\x12EraseMyDataRequest\"\x15\n" +
+	"\x13EraseMyDataResponse\"\x15\n" +
+	"\x13ExportMyDataRequest\"H\n" +
+	"\x14ExportMyDataResponse\x120\n" +
+	"\x04data\x18\x01 \x01(\v2\x17.google.protobuf.StructB\x03\xe0A\x03R\x04data\"*\n" +
+	"\x12GetAllUsersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"7\n" +
+	"\x13GetAllUsersResponse\x12 \n" +
+	"\x04data\x18\x01 \x03(\v2\f.api.v1.UserR\x04data\"$\n" +
+	"\x12GetUserByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x13GetUserByIDResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.UserR\x04data\"\x0e\n" +
+	"\fGetMeRequest\"1\n" +
+	"\rGetMeResponse\x12 \n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.UserR\x04data\".\n" +
+	"\x1cGetRegistrationStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x1dGetRegistrationStatusResponse\x12.\n" +
+	"\x04data\x18\x01 \x01(\v2\x1a.api.v1.RegistrationStatusR\x04data\"]\n" +
+	"\x12RegistrationStatus\x12/\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x19.api.v1.RegistrationStateR\x05state\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xc2\x03\n" +
+	"\x04User\x12>\n" +
+	"\x02id\x18\x01 \x01(\tB.\x92A(J&\"01917a0c-cdfe-7e4f-9692-635eb6a6f358\"\xe0A\x03R\x02id\x12g\n" +
+	"\x05email\x18\x02 \x01(\tBQ\x92AK2\fuser's emailJ\x10\"first@user.com\"\x8a\x01 ^[\\w-\\.]+@([\\w-]+\\.)+[\\w-]{2,4}$\xd2\x01\x05email\xe0A\x02R\x05email\x12S\n" +
+	"\bpassword\x18\x03 \x01(\tB7\x92A.2\x0fuser's passwordJ\x12\"WEAKpassword123?\"\xa2\x02\x06string\xe0A\x02\xe0A\x04R\bpassword\x12:\n" +
+	"\x04name\x18\x04 \x01(\tB&\x92A 2\vuser's nameJ\f\"First User\"xd\x80\x01\x01\xe0A\x02R\x04name\x12?\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"created_at\x12?\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
@@ -684,13 +1542,18 @@ const file_api_v1_user_proto_rawDesc = "" +
 	"updated_at\"A\n" +
 	"\tUserError\x124\n" +
 	"\n" +
-	"error_code\x18\x01 \x01(\x0e2\x15.api.v1.UserErrorCodeR\terrorCode*\xb7\x01\n" +
+	"error_code\x18\x01 \x01(\x0e2\x15.api.v1.UserErrorCodeR\terrorCode*\x98\x01\n" +
+	"\x11RegistrationState\x12\"\n" +
+	"\x1eREGISTRATION_STATE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aREGISTRATION_STATE_PENDING\x10\x01\x12 \n" +
+	"\x1cREGISTRATION_STATE_COMPLETED\x10\x02\x12\x1d\n" +
+	"\x19REGISTRATION_STATE_FAILED\x10\x03*\xb7\x01\n" +
 	"\x10UserOutboxStatus\x12\"\n" +
 	"\x1eUSER_OUTBOX_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18USER_OUTBOX_STATUS_READY\x10\x01\x12 \n" +
 	"\x1cUSER_OUTBOX_STATUS_PROCESSED\x10\x02\x12 \n" +
 	"\x1cUSER_OUTBOX_STATUS_DELIVERED\x10\x03\x12\x1d\n" +
-	"\x19USER_OUTBOX_STATUS_FAILED\x10\x04*\xa3\x02\n" +
+	"\x19USER_OUTBOX_STATUS_FAILED\x10\x04*\xfb\x03\n" +
 	"\rUserErrorCode\x12\x1f\n" +
 	"\x1bUSER_ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18USER_ERROR_CODE_INTERNAL\x10\x01\x12\x1e\n" +
@@ -699,20 +1562,50 @@ const file_api_v1_user_proto_rawDesc = "" +
 	"\x1cUSER_ERROR_CODE_INVALID_NAME\x10\x04\x12!\n" +
 	"\x1dUSER_ERROR_CODE_INVALID_EMAIL\x10\x05\x12\x1d\n" +
 	"\x19USER_ERROR_CODE_NOT_FOUND\x10\x06\x12+\n" +
-	"'USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY\x10\a2\xcb\x02\n" +
+	"'USER_ERROR_CODE_MISSING_IDEMPOTENCY_KEY\x10\a\x12$\n" +
+	" USER_ERROR_CODE_INVALID_PASSWORD\x10\b\x12,\n" +
+	"(USER_ERROR_CODE_REGISTRATION_ROLLED_BACK\x10\t\x121\n" +
+	"-USER_ERROR_CODE_REGISTRATION_PARTIALLY_FAILED\x10\n" +
+	"\x12*\n" +
+	"&USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED\x10\v\x12#\n" +
+	"\x1fUSER_ERROR_CODE_UPDATE_CONFLICT\x10\f2\xf4\x04\n" +
 	"\x12UserCommandService\x12\x9d\x01\n" +
 	"\fRegisterUser\x12\x1b.api.v1.RegisterUserRequest\x1a\x1c.api.v1.RegisterUserResponse\"R\x92A/\n" +
 	"\x04User*\fRegisterUserr\x19\n" +
 	"\x17\n" +
-	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1a:\x04user\"\x12/v1/users/register\x1a\x94\x01\x92A\x90\x01\x12\x8d\x01This service provides basic command or state-changing use cases to work with user.A user is represented by an email as its unique identifier.2\xc0\x01\n" +
+	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1a:\x04user\"\x12/v1/users/register\x12\x94\x01\n" +
+	"\rUpdateProfile\x12\x1c.api.v1.UpdateProfileRequest\x1a\x1d.api.v1.UpdateProfileResponse\"F\x92A,\n" +
+	"\x04User*\rUpdateProfiler\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x11:\x01*2\f/v1/users/me\x12\x8f\x01\n" +
+	"\vEraseMyData\x12\x1a.api.v1.EraseMyDataRequest\x1a\x1b.api.v1.EraseMyDataResponse\"G\x92A*\n" +
+	"\x04User*\vEraseMyDatar\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x14\"\x12/v1/users/me/erase\x1a\x94\x01\x92A\x90\x01\x12\x8d\x01This service provides basic command or state-changing use cases to work with user.A user is represented by an email as its unique identifier.2\xdd\x02\n" +
 	"\x1aUserCommandInternalService\x12E\n" +
 	"\n" +
-	"DeleteUser\x12\x19.api.v1.DeleteUserRequest\x1a\x1a.api.v1.DeleteUserResponse\"\x00\x1a[\x92AX\x12VIt is the same as UserCommand but should be used internally and not exposed to public.2\xf3\x01\n" +
+	"DeleteUser\x12\x19.api.v1.DeleteUserRequest\x1a\x1a.api.v1.DeleteUserResponse\"\x00\x12Q\n" +
+	"\x0eSoftDeleteUser\x12\x1d.api.v1.SoftDeleteUserRequest\x1a\x1e.api.v1.SoftDeleteUserResponse\"\x00\x12H\n" +
+	"\vRestoreUser\x12\x1a.api.v1.RestoreUserRequest\x1a\x1b.api.v1.RestoreUserResponse\"\x00\x1a[\x92AX\x12VIt is the same as UserCommand but should be used internally and not exposed to public.2\xbe\x06\n" +
 	"\x10UserQueryService\x12\x86\x01\n" +
 	"\vGetAllUsers\x12\x1a.api.v1.GetAllUsersRequest\x1a\x1b.api.v1.GetAllUsersResponse\">\x92A*\n" +
 	"\x04User*\vGetAllUsersr\x15\n" +
 	"\x13\n" +
-	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x1aV\x92AS\x12QThis service provides basic query or data-retrieving use cases to work with user.B\x85\x02\x92A\xcf\x01\x12\x95\x01\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12\x8b\x01\n" +
+	"\vGetUserByID\x12\x1a.api.v1.GetUserByIDRequest\x1a\x1b.api.v1.GetUserByIDResponse\"C\x92A*\n" +
+	"\x04User*\vGetUserByIDr\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12q\n" +
+	"\x05GetMe\x12\x14.api.v1.GetMeRequest\x1a\x15.api.v1.GetMeResponse\";\x92A$\n" +
+	"\x04User*\x05GetMer\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/users/me\x12\xb0\x01\n" +
+	"\x15GetRegistrationStatus\x12$.api.v1.GetRegistrationStatusRequest\x1a%.api.v1.GetRegistrationStatusResponse\"J\x92A\x1d\n" +
+	"\x04User*\x15GetRegistrationStatus\x82\xd3\xe4\x93\x02$\x12\"/v1/users/{id}/registration-status\x12\x94\x01\n" +
+	"\fExportMyData\x12\x1b.api.v1.ExportMyDataRequest\x1a\x1c.api.v1.ExportMyDataResponse\"I\x92A+\n" +
+	"\x04User*\fExportMyDatar\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/users/me/export\x1aV\x92AS\x12QThis service provides basic query or data-retrieving use cases to work with user.B\x85\x02\x92A\xcf\x01\x12\x95\x01\n" +
 	"\bUser API\"0\n" +
 	"\rIndra Saputra\x12\x1fhttps://github.com/indrasaputra*P\n" +
 	"\x14BSD 3-Clause License\x128https://github.com/indrasaputra/arjuna/blob/main/LICENSE2\x051.0.0\x1a\x0elocalhost:8000*\x01\x012\x10application/json:\x10application/jsonZ0github.com/indrasaputra/arjuna/user/api/v1;apiv1b\x06proto3"
@@ -729,44 +1622,86 @@ func file_api_v1_user_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_proto_rawDescData
 }
 
-var file_api_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_v1_user_proto_goTypes = []any{
-	(UserOutboxStatus)(0),         // 0: api.v1.UserOutboxStatus
-	(UserErrorCode)(0),            // 1: api.v1.UserErrorCode
-	(*RegisterUserRequest)(nil),   // 2: api.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),  // 3: api.v1.RegisterUserResponse
-	(*DeleteUserRequest)(nil),     // 4: api.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),    // 5: api.v1.DeleteUserResponse
-	(*GetAllUsersRequest)(nil),    // 6: api.v1.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),   // 7: api.v1.GetAllUsersResponse
-	(*User)(nil),                  // 8: api.v1.User
-	(*UserOutbox)(nil),            // 9: api.v1.UserOutbox
-	(*UserError)(nil),             // 10: api.v1.UserError
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(RegistrationState)(0),                // 0: api.v1.RegistrationState
+	(UserOutboxStatus)(0),                 // 1: api.v1.UserOutboxStatus
+	(UserErrorCode)(0),                    // 2: api.v1.UserErrorCode
+	(*RegisterUserRequest)(nil),           // 3: api.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),          // 4: api.v1.RegisterUserResponse
+	(*DeleteUserRequest)(nil),             // 5: api.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),            // 6: api.v1.DeleteUserResponse
+	(*SoftDeleteUserRequest)(nil),         // 7: api.v1.SoftDeleteUserRequest
+	(*SoftDeleteUserResponse)(nil),        // 8: api.v1.SoftDeleteUserResponse
+	(*RestoreUserRequest)(nil),            // 9: api.v1.RestoreUserRequest
+	(*RestoreUserResponse)(nil),           // 10: api.v1.RestoreUserResponse
+	(*UpdateProfileRequest)(nil),          // 11: api.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),         // 12: api.v1.UpdateProfileResponse
+	(*EraseMyDataRequest)(nil),            // 13: api.v1.EraseMyDataRequest
+	(*EraseMyDataResponse)(nil),           // 14: api.v1.EraseMyDataResponse
+	(*ExportMyDataRequest)(nil),           // 15: api.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),          // 16: api.v1.ExportMyDataResponse
+	(*GetAllUsersRequest)(nil),            // 17: api.v1.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),           // 18: api.v1.GetAllUsersResponse
+	(*GetUserByIDRequest)(nil),            // 19: api.v1.GetUserByIDRequest
+	(*GetUserByIDResponse)(nil),           // 20: api.v1.GetUserByIDResponse
+	(*GetMeRequest)(nil),                  // 21: api.v1.GetMeRequest
+	(*GetMeResponse)(nil),                 // 22: api.v1.GetMeResponse
+	(*GetRegistrationStatusRequest)(nil),  // 23: api.v1.GetRegistrationStatusRequest
+	(*GetRegistrationStatusResponse)(nil), // 24: api.v1.GetRegistrationStatusResponse
+	(*RegistrationStatus)(nil),            // 25: api.v1.RegistrationStatus
+	(*User)(nil),                          // 26: api.v1.User
+	(*UserOutbox)(nil),                    // 27: api.v1.UserOutbox
+	(*UserError)(nil),                     // 28: api.v1.UserError
+	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 30: google.protobuf.Struct
 }
 var file_api_v1_user_proto_depIdxs = []int32{
-	8,  // 0: api.v1.RegisterUserRequest.user:type_name -> api.v1.User
-	8,  // 1: api.v1.RegisterUserResponse.data:type_name -> api.v1.User
-	8,  // 2: api.v1.GetAllUsersResponse.data:type_name -> api.v1.User
-	11, // 3: api.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	11, // 4: api.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: api.v1.UserOutbox.status:type_name -> api.v1.UserOutboxStatus
-	8,  // 6: api.v1.UserOutbox.payload:type_name -> api.v1.User
-	11, // 7: api.v1.UserOutbox.created_at:type_name -> google.protobuf.Timestamp
-	11, // 8: api.v1.UserOutbox.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: api.v1.UserError.error_code:type_name -> api.v1.UserErrorCode
-	2,  // 10: api.v1.UserCommandService.RegisterUser:input_type -> api.v1.RegisterUserRequest
-	4,  // 11: api.v1.UserCommandInternalService.DeleteUser:input_type -> api.v1.DeleteUserRequest
-	6,  // 12: api.v1.UserQueryService.GetAllUsers:input_type -> api.v1.GetAllUsersRequest
-	3,  // 13: api.v1.UserCommandService.RegisterUser:output_type -> api.v1.RegisterUserResponse
-	5,  // 14: api.v1.UserCommandInternalService.DeleteUser:output_type -> api.v1.DeleteUserResponse
-	7,  // 15: api.v1.UserQueryService.GetAllUsers:output_type -> api.v1.GetAllUsersResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	26, // 0: api.v1.RegisterUserRequest.user:type_name -> api.v1.User
+	26, // 1: api.v1.RegisterUserResponse.data:type_name -> api.v1.User
+	29, // 2: api.v1.UpdateProfileRequest.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 3: api.v1.UpdateProfileResponse.data:type_name -> api.v1.User
+	30, // 4: api.v1.ExportMyDataResponse.data:type_name -> google.protobuf.Struct
+	26, // 5: api.v1.GetAllUsersResponse.data:type_name -> api.v1.User
+	26, // 6: api.v1.GetUserByIDResponse.data:type_name -> api.v1.User
+	26, // 7: api.v1.GetMeResponse.data:type_name -> api.v1.User
+	25, // 8: api.v1.GetRegistrationStatusResponse.data:type_name -> api.v1.RegistrationStatus
+	0,  // 9: api.v1.RegistrationStatus.state:type_name -> api.v1.RegistrationState
+	29, // 10: api.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	29, // 11: api.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: api.v1.UserOutbox.status:type_name -> api.v1.UserOutboxStatus
+	26, // 13: api.v1.UserOutbox.payload:type_name -> api.v1.User
+	29, // 14: api.v1.UserOutbox.created_at:type_name -> google.protobuf.Timestamp
+	29, // 15: api.v1.UserOutbox.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: api.v1.UserError.error_code:type_name -> api.v1.UserErrorCode
+	3,  // 17: api.v1.UserCommandService.RegisterUser:input_type -> api.v1.RegisterUserRequest
+	11, // 18: api.v1.UserCommandService.UpdateProfile:input_type -> api.v1.UpdateProfileRequest
+	13, // 19: api.v1.UserCommandService.EraseMyData:input_type -> api.v1.EraseMyDataRequest
+	5,  // 20: api.v1.UserCommandInternalService.DeleteUser:input_type -> api.v1.DeleteUserRequest
+	7,  // 21: api.v1.UserCommandInternalService.SoftDeleteUser:input_type -> api.v1.SoftDeleteUserRequest
+	9,  // 22: api.v1.UserCommandInternalService.RestoreUser:input_type -> api.v1.RestoreUserRequest
+	17, // 23: api.v1.UserQueryService.GetAllUsers:input_type -> api.v1.GetAllUsersRequest
+	19, // 24: api.v1.UserQueryService.GetUserByID:input_type -> api.v1.GetUserByIDRequest
+	21, // 25: api.v1.UserQueryService.GetMe:input_type -> api.v1.GetMeRequest
+	23, // 26: api.v1.UserQueryService.GetRegistrationStatus:input_type -> api.v1.GetRegistrationStatusRequest
+	15, // 27: api.v1.UserQueryService.ExportMyData:input_type -> api.v1.ExportMyDataRequest
+	4,  // 28: api.v1.UserCommandService.RegisterUser:output_type -> api.v1.RegisterUserResponse
+	12, // 29: api.v1.UserCommandService.UpdateProfile:output_type -> api.v1.UpdateProfileResponse
+	14, // 30: api.v1.UserCommandService.EraseMyData:output_type -> api.v1.EraseMyDataResponse
+	6,  // 31: api.v1.UserCommandInternalService.DeleteUser:output_type -> api.v1.DeleteUserResponse
+	8,  // 32: api.v1.UserCommandInternalService.SoftDeleteUser:output_type -> api.v1.SoftDeleteUserResponse
+	10, // 33: api.v1.UserCommandInternalService.RestoreUser:output_type -> api.v1.RestoreUserResponse
+	18, // 34: api.v1.UserQueryService.GetAllUsers:output_type -> api.v1.GetAllUsersResponse
+	20, // 35: api.v1.UserQueryService.GetUserByID:output_type -> api.v1.GetUserByIDResponse
+	22, // 36: api.v1.UserQueryService.GetMe:output_type -> api.v1.GetMeResponse
+	24, // 37: api.v1.UserQueryService.GetRegistrationStatus:output_type -> api.v1.GetRegistrationStatusResponse
+	16, // 38: api.v1.UserQueryService.ExportMyData:output_type -> api.v1.ExportMyDataResponse
+	28, // [28:39] is the sub-list for method output_type
+	17, // [17:28] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_proto_init() }
@@ -779,8 +1714,8 @@ func file_api_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_proto_rawDesc), len(file_api_v1_user_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -22,7 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserCommandService_RegisterUser_FullMethodName = "/api.v1.UserCommandService/RegisterUser"
+	UserCommandService_RegisterUser_FullMethodName  = "/api.v1.UserCommandService/RegisterUser"
+	UserCommandService_UpdateProfile_FullMethodName = "/api.v1.UserCommandService/UpdateProfile"
+	UserCommandService_EraseMyData_FullMethodName   = "/api.v1.UserCommandService/EraseMyData"
 )
 
 // UserCommandServiceClient is the client API for UserCommandService service.
@@ -36,6 +38,18 @@ type UserCommandServiceClient interface {
 	// This endpoint registers a new user.
 	// The X-Idempotency-Key header must be present.
 	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	// Update Profile
+	//
+	// This endpoint updates the authenticated user's profile.
+	// The updated_at must be the one the user last read, so a concurrent change isn't overwritten.
+	// When the profile has been changed since then, it returns conflict and the profile must be read again.
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// Erase My Data
+	//
+	// This endpoint erases the authenticated user's personal data.
+	// The name, email and password are anonymized, the account can't log in anymore and the wallets are frozen.
+	// The wallets and transactions are kept since they are needed by the ledger. It can't be undone.
+	EraseMyData(ctx context.Context, in *EraseMyDataRequest, opts ...grpc.CallOption) (*EraseMyDataResponse, error)
 }
 
 type userCommandServiceClient struct {
@@ -56,6 +70,26 @@ func (c *userCommandServiceClient) RegisterUser(ctx context.Context, in *Registe
 	return out, nil
 }
 
+func (c *userCommandServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, UserCommandService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCommandServiceClient) EraseMyData(ctx context.Context, in *EraseMyDataRequest, opts ...grpc.CallOption) (*EraseMyDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseMyDataResponse)
+	err := c.cc.Invoke(ctx, UserCommandService_EraseMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserCommandServiceServer is the server API for UserCommandService service.
 // All implementations must embed UnimplementedUserCommandServiceServer
 // for forward compatibility.
@@ -67,6 +101,18 @@ type UserCommandServiceServer interface {
 	// This endpoint registers a new user.
 	// The X-Idempotency-Key header must be present.
 	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	// Update Profile
+	//
+	// This endpoint updates the authenticated user's profile.
+	// The updated_at must be the one the user last read, so a concurrent change isn't overwritten.
+	// When the profile has been changed since then, it returns conflict and the profile must be read again.
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// Erase My Data
+	//
+	// This endpoint erases the authenticated user's personal data.
+	// The name, email and password are anonymized, the account can't log in anymore and the wallets are frozen.
+	// The wallets and transactions are kept since they are needed by the ledger. It can't be undone.
+	EraseMyData(context.Context, *EraseMyDataRequest) (*EraseMyDataResponse, error)
 	mustEmbedUnimplementedUserCommandServiceServer()
 }
 
@@ -80,6 +126,12 @@ type UnimplementedUserCommandServiceServer struct{}
 func (UnimplementedUserCommandServiceServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
 }
+func (UnimplementedUserCommandServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUserCommandServiceServer) EraseMyData(context.Context, *EraseMyDataRequest) (*EraseMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseMyData not implemented")
+}
 func (UnimplementedUserCommandServiceServer) mustEmbedUnimplementedUserCommandServiceServer() {}
 func (UnimplementedUserCommandServiceServer) testEmbeddedByValue()                            {}
 
@@ -119,6 +171,42 @@ func _UserCommandService_RegisterUser_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserCommandService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCommandServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCommandService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCommandServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCommandService_EraseMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCommandServiceServer).EraseMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCommandService_EraseMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCommandServiceServer).EraseMyData(ctx, req.(*EraseMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserCommandService_ServiceDesc is the grpc.ServiceDesc for UserCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -130,13 +218,23 @@ var UserCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterUser",
 			Handler:    _UserCommandService_RegisterUser_Handler,
 		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UserCommandService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "EraseMyData",
+			Handler:    _UserCommandService_EraseMyData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user.proto",
 }
 
 const (
-	UserCommandInternalService_DeleteUser_FullMethodName = "/api.v1.UserCommandInternalService/DeleteUser"
+	UserCommandInternalService_DeleteUser_FullMethodName     = "/api.v1.UserCommandInternalService/DeleteUser"
+	UserCommandInternalService_SoftDeleteUser_FullMethodName = "/api.v1.UserCommandInternalService/SoftDeleteUser"
+	UserCommandInternalService_RestoreUser_FullMethodName    = "/api.v1.UserCommandInternalService/RestoreUser"
 )
 
 // UserCommandInternalServiceClient is the client API for UserCommandInternalService service.
@@ -151,6 +249,18 @@ type UserCommandInternalServiceClient interface {
 	// This endpoint deletes a new user.
 	// It is expected to be hidden or internal use only.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Soft Delete User
+	//
+	// This endpoint soft-deletes a user including the user's account and wallets.
+	// The account can't log in and the wallets are frozen until the user is restored.
+	// It is expected to be hidden or internal use only.
+	SoftDeleteUser(ctx context.Context, in *SoftDeleteUserRequest, opts ...grpc.CallOption) (*SoftDeleteUserResponse, error)
+	// Restore User
+	//
+	// This endpoint restores a soft-deleted user including the user's account and wallets.
+	// The user can only be restored within the grace period after the deletion.
+	// It is expected to be hidden or internal use only.
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 }
 
 type userCommandInternalServiceClient struct {
@@ -171,6 +281,26 @@ func (c *userCommandInternalServiceClient) DeleteUser(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *userCommandInternalServiceClient) SoftDeleteUser(ctx context.Context, in *SoftDeleteUserRequest, opts ...grpc.CallOption) (*SoftDeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SoftDeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserCommandInternalService_SoftDeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userCommandInternalServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, UserCommandInternalService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserCommandInternalServiceServer is the server API for UserCommandInternalService service.
 // All implementations must embed UnimplementedUserCommandInternalServiceServer
 // for forward compatibility.
@@ -183,6 +313,18 @@ type UserCommandInternalServiceServer interface {
 	// This endpoint deletes a new user.
 	// It is expected to be hidden or internal use only.
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Soft Delete User
+	//
+	// This endpoint soft-deletes a user including the user's account and wallets.
+	// The account can't log in and the wallets are frozen until the user is restored.
+	// It is expected to be hidden or internal use only.
+	SoftDeleteUser(context.Context, *SoftDeleteUserRequest) (*SoftDeleteUserResponse, error)
+	// Restore User
+	//
+	// This endpoint restores a soft-deleted user including the user's account and wallets.
+	// The user can only be restored within the grace period after the deletion.
+	// It is expected to be hidden or internal use only.
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	mustEmbedUnimplementedUserCommandInternalServiceServer()
 }
 
@@ -196,6 +338,12 @@ type UnimplementedUserCommandInternalServiceServer struct{}
 func (UnimplementedUserCommandInternalServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserCommandInternalServiceServer) SoftDeleteUser(context.Context, *SoftDeleteUserRequest) (*SoftDeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SoftDeleteUser not implemented")
+}
+func (UnimplementedUserCommandInternalServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
 func (UnimplementedUserCommandInternalServiceServer) mustEmbedUnimplementedUserCommandInternalServiceServer() {
 }
 func (UnimplementedUserCommandInternalServiceServer) testEmbeddedByValue() {}
@@ -236,6 +384,42 @@ func _UserCommandInternalService_DeleteUser_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserCommandInternalService_SoftDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoftDeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCommandInternalServiceServer).SoftDeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCommandInternalService_SoftDeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCommandInternalServiceServer).SoftDeleteUser(ctx, req.(*SoftDeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserCommandInternalService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserCommandInternalServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserCommandInternalService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserCommandInternalServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserCommandInternalService_ServiceDesc is the grpc.ServiceDesc for UserCommandInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -247,13 +431,25 @@ var UserCommandInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserCommandInternalService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "SoftDeleteUser",
+			Handler:    _UserCommandInternalService_SoftDeleteUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserCommandInternalService_RestoreUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user.proto",
 }
 
 const (
-	UserQueryService_GetAllUsers_FullMethodName = "/api.v1.UserQueryService/GetAllUsers"
+	UserQueryService_GetAllUsers_FullMethodName           = "/api.v1.UserQueryService/GetAllUsers"
+	UserQueryService_GetUserByID_FullMethodName           = "/api.v1.UserQueryService/GetUserByID"
+	UserQueryService_GetMe_FullMethodName                 = "/api.v1.UserQueryService/GetMe"
+	UserQueryService_GetRegistrationStatus_FullMethodName = "/api.v1.UserQueryService/GetRegistrationStatus"
+	UserQueryService_ExportMyData_FullMethodName          = "/api.v1.UserQueryService/ExportMyData"
 )
 
 // UserQueryServiceClient is the client API for UserQueryService service.
@@ -267,6 +463,25 @@ type UserQueryServiceClient interface {
 	// This endpoint gets all available users in the system.
 	// Currently, it only retrieves 10 users at most.
 	GetAllUsers(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error)
+	// Get User By ID
+	//
+	// This endpoint gets a user by its id.
+	GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*GetUserByIDResponse, error)
+	// Get Me
+	//
+	// This endpoint gets the authenticated user.
+	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
+	// Get Registration Status
+	//
+	// This endpoint gets the registration status of a user.
+	// Registration is completed asynchronously after register user returns,
+	// so it can be polled until the status is either completed or failed.
+	GetRegistrationStatus(ctx context.Context, in *GetRegistrationStatusRequest, opts ...grpc.CallOption) (*GetRegistrationStatusResponse, error)
+	// Export My Data
+	//
+	// This endpoint exports the authenticated user's data as a single JSON archive.
+	// The archive contains the profile, the account, the wallets and the transactions.
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
 }
 
 type userQueryServiceClient struct {
@@ -287,6 +502,46 @@ func (c *userQueryServiceClient) GetAllUsers(ctx context.Context, in *GetAllUser
 	return out, nil
 }
 
+func (c *userQueryServiceClient) GetUserByID(ctx context.Context, in *GetUserByIDRequest, opts ...grpc.CallOption) (*GetUserByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserByIDResponse)
+	err := c.cc.Invoke(ctx, UserQueryService_GetUserByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userQueryServiceClient) GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMeResponse)
+	err := c.cc.Invoke(ctx, UserQueryService_GetMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userQueryServiceClient) GetRegistrationStatus(ctx context.Context, in *GetRegistrationStatusRequest, opts ...grpc.CallOption) (*GetRegistrationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegistrationStatusResponse)
+	err := c.cc.Invoke(ctx, UserQueryService_GetRegistrationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userQueryServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, UserQueryService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserQueryServiceServer is the server API for UserQueryService service.
 // All implementations must embed UnimplementedUserQueryServiceServer
 // for forward compatibility.
@@ -298,6 +553,25 @@ type UserQueryServiceServer interface {
 	// This endpoint gets all available users in the system.
 	// Currently, it only retrieves 10 users at most.
 	GetAllUsers(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error)
+	// Get User By ID
+	//
+	// This endpoint gets a user by its id.
+	GetUserByID(context.Context, *GetUserByIDRequest) (*GetUserByIDResponse, error)
+	// Get Me
+	//
+	// This endpoint gets the authenticated user.
+	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
+	// Get Registration Status
+	//
+	// This endpoint gets the registration status of a user.
+	// Registration is completed asynchronously after register user returns,
+	// so it can be polled until the status is either completed or failed.
+	GetRegistrationStatus(context.Context, *GetRegistrationStatusRequest) (*GetRegistrationStatusResponse, error)
+	// Export My Data
+	//
+	// This endpoint exports the authenticated user's data as a single JSON archive.
+	// The archive contains the profile, the account, the wallets and the transactions.
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error)
 	mustEmbedUnimplementedUserQueryServiceServer()
 }
 
@@ -311,6 +585,18 @@ type UnimplementedUserQueryServiceServer struct{}
 func (UnimplementedUserQueryServiceServer) GetAllUsers(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllUsers not implemented")
 }
+func (UnimplementedUserQueryServiceServer) GetUserByID(context.Context, *GetUserByIDRequest) (*GetUserByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+}
+func (UnimplementedUserQueryServiceServer) GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMe not implemented")
+}
+func (UnimplementedUserQueryServiceServer) GetRegistrationStatus(context.Context, *GetRegistrationStatusRequest) (*GetRegistrationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationStatus not implemented")
+}
+func (UnimplementedUserQueryServiceServer) ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
 func (UnimplementedUserQueryServiceServer) mustEmbedUnimplementedUserQueryServiceServer() {}
 func (UnimplementedUserQueryServiceServer) testEmbeddedByValue()                          {}
 
@@ -350,6 +636,78 @@ func _UserQueryService_GetAllUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserQueryService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserQueryServiceServer).GetUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserQueryService_GetUserByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserQueryServiceServer).GetUserByID(ctx, req.(*GetUserByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserQueryService_GetMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserQueryServiceServer).GetMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserQueryService_GetMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserQueryServiceServer).GetMe(ctx, req.(*GetMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserQueryService_GetRegistrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserQueryServiceServer).GetRegistrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserQueryService_GetRegistrationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserQueryServiceServer).GetRegistrationStatus(ctx, req.(*GetRegistrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserQueryService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserQueryServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserQueryService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserQueryServiceServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserQueryService_ServiceDesc is the grpc.ServiceDesc for UserQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,6 +719,22 @@ var UserQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllUsers",
 			Handler:    _UserQueryService_GetAllUsers_Handler,
 		},
+		{
+			MethodName: "GetUserByID",
+			Handler:    _UserQueryService_GetUserByID_Handler,
+		},
+		{
+			MethodName: "GetMe",
+			Handler:    _UserQueryService_GetMe_Handler,
+		},
+		{
+			MethodName: "GetRegistrationStatus",
+			Handler:    _UserQueryService_GetRegistrationStatus_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _UserQueryService_ExportMyData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user.proto",
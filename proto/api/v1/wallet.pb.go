@@ -17,6 +17,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -26,6 +27,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// HoldStatus enumerates hold's status.
+type HoldStatus int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	HoldStatus_HOLD_STATUS_UNSPECIFIED HoldStatus = 0
+	// Amount is reserved.
+	HoldStatus_HOLD_STATUS_AUTHORIZED HoldStatus = 1
+	// Amount is transferred to the receiver.
+	HoldStatus_HOLD_STATUS_CAPTURED HoldStatus = 2
+	// Amount is released by request.
+	HoldStatus_HOLD_STATUS_VOIDED HoldStatus = 3
+	// Amount is released because the hold expired.
+	HoldStatus_HOLD_STATUS_EXPIRED HoldStatus = 4
+)
+
+// Enum value maps for HoldStatus.
+var (
+	HoldStatus_name = map[int32]string{
+		0: "HOLD_STATUS_UNSPECIFIED",
+		1: "HOLD_STATUS_AUTHORIZED",
+		2: "HOLD_STATUS_CAPTURED",
+		3: "HOLD_STATUS_VOIDED",
+		4: "HOLD_STATUS_EXPIRED",
+	}
+	HoldStatus_value = map[string]int32{
+		"HOLD_STATUS_UNSPECIFIED": 0,
+		"HOLD_STATUS_AUTHORIZED":  1,
+		"HOLD_STATUS_CAPTURED":    2,
+		"HOLD_STATUS_VOIDED":      3,
+		"HOLD_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x HoldStatus) Enum() *HoldStatus {
+	p := new(HoldStatus)
+	*p = x
+	return p
+}
+
+func (x HoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (HoldStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_wallet_proto_enumTypes[0]
+}
+
+func (x HoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldStatus.Descriptor instead.
+func (HoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{0}
+}
+
 // WalletErrorCode enumerates wallet error code.
 type WalletErrorCode int32
 
@@ -53,6 +116,28 @@ const (
 	WalletErrorCode_WALLET_ERROR_CODE_INSUFFICIENT_BALANCE WalletErrorCode = 11
 	// Transfer is invalid.
 	WalletErrorCode_WALLET_ERROR_CODE_INVALID_TRANSFER WalletErrorCode = 12
+	// Transfer with the reference has been applied.
+	WalletErrorCode_WALLET_ERROR_CODE_TRANSFER_APPLIED WalletErrorCode = 13
+	// Transfer with the reference has been cancelled and can't be applied.
+	WalletErrorCode_WALLET_ERROR_CODE_TRANSFER_CANCELLED WalletErrorCode = 14
+	// Wallet is not found.
+	WalletErrorCode_WALLET_ERROR_CODE_NOT_FOUND WalletErrorCode = 15
+	// Currency is not a supported ISO-4217 currency code.
+	WalletErrorCode_WALLET_ERROR_CODE_INVALID_CURRENCY WalletErrorCode = 16
+	// Currency of the wallets and the amount must be the same.
+	WalletErrorCode_WALLET_ERROR_CODE_CURRENCY_MISMATCH WalletErrorCode = 17
+	// Amount has more fractional digits than the currency's minor unit allows.
+	WalletErrorCode_WALLET_ERROR_CODE_INVALID_AMOUNT_PRECISION WalletErrorCode = 18
+	// FX quote is not found, has expired, has been used, or doesn't match the transfer.
+	WalletErrorCode_WALLET_ERROR_CODE_INVALID_QUOTE WalletErrorCode = 19
+	// Exchange rate of the currency pair is not available.
+	WalletErrorCode_WALLET_ERROR_CODE_RATE_NOT_FOUND WalletErrorCode = 20
+	// Hold instance is nil or empty.
+	WalletErrorCode_WALLET_ERROR_CODE_EMPTY_HOLD WalletErrorCode = 21
+	// Hold is not found.
+	WalletErrorCode_WALLET_ERROR_CODE_HOLD_NOT_FOUND WalletErrorCode = 22
+	// Hold has been captured, voided, or has expired.
+	WalletErrorCode_WALLET_ERROR_CODE_HOLD_CLOSED WalletErrorCode = 23
 )
 
 // Enum value maps for WalletErrorCode.
@@ -69,19 +154,41 @@ var (
 		10: "WALLET_ERROR_CODE_SAME_ACCOUNT",
 		11: "WALLET_ERROR_CODE_INSUFFICIENT_BALANCE",
 		12: "WALLET_ERROR_CODE_INVALID_TRANSFER",
+		13: "WALLET_ERROR_CODE_TRANSFER_APPLIED",
+		14: "WALLET_ERROR_CODE_TRANSFER_CANCELLED",
+		15: "WALLET_ERROR_CODE_NOT_FOUND",
+		16: "WALLET_ERROR_CODE_INVALID_CURRENCY",
+		17: "WALLET_ERROR_CODE_CURRENCY_MISMATCH",
+		18: "WALLET_ERROR_CODE_INVALID_AMOUNT_PRECISION",
+		19: "WALLET_ERROR_CODE_INVALID_QUOTE",
+		20: "WALLET_ERROR_CODE_RATE_NOT_FOUND",
+		21: "WALLET_ERROR_CODE_EMPTY_HOLD",
+		22: "WALLET_ERROR_CODE_HOLD_NOT_FOUND",
+		23: "WALLET_ERROR_CODE_HOLD_CLOSED",
 	}
 	WalletErrorCode_value = map[string]int32{
-		"WALLET_ERROR_CODE_UNSPECIFIED":             0,
-		"WALLET_ERROR_CODE_INTERNAL":                1,
-		"WALLET_ERROR_CODE_ALREADY_EXISTS":          2,
-		"WALLET_ERROR_CODE_EMPTY_WALLET":            3,
-		"WALLET_ERROR_CODE_INVALID_BALANCE":         6,
-		"WALLET_ERROR_CODE_MISSING_IDEMPOTENCY_KEY": 7,
-		"WALLET_ERROR_CODE_INVALID_USER":            8,
-		"WALLET_ERROR_CODE_INVALID_AMOUNT":          9,
-		"WALLET_ERROR_CODE_SAME_ACCOUNT":            10,
-		"WALLET_ERROR_CODE_INSUFFICIENT_BALANCE":    11,
-		"WALLET_ERROR_CODE_INVALID_TRANSFER":        12,
+		"WALLET_ERROR_CODE_UNSPECIFIED":              0,
+		"WALLET_ERROR_CODE_INTERNAL":                 1,
+		"WALLET_ERROR_CODE_ALREADY_EXISTS":           2,
+		"WALLET_ERROR_CODE_EMPTY_WALLET":             3,
+		"WALLET_ERROR_CODE_INVALID_BALANCE":          6,
+		"WALLET_ERROR_CODE_MISSING_IDEMPOTENCY_KEY":  7,
+		"WALLET_ERROR_CODE_INVALID_USER":             8,
+		"WALLET_ERROR_CODE_INVALID_AMOUNT":           9,
+		"WALLET_ERROR_CODE_SAME_ACCOUNT":             10,
+		"WALLET_ERROR_CODE_INSUFFICIENT_BALANCE":     11,
+		"WALLET_ERROR_CODE_INVALID_TRANSFER":         12,
+		"WALLET_ERROR_CODE_TRANSFER_APPLIED":         13,
+		"WALLET_ERROR_CODE_TRANSFER_CANCELLED":       14,
+		"WALLET_ERROR_CODE_NOT_FOUND":                15,
+		"WALLET_ERROR_CODE_INVALID_CURRENCY":         16,
+		"WALLET_ERROR_CODE_CURRENCY_MISMATCH":        17,
+		"WALLET_ERROR_CODE_INVALID_AMOUNT_PRECISION": 18,
+		"WALLET_ERROR_CODE_INVALID_QUOTE":            19,
+		"WALLET_ERROR_CODE_RATE_NOT_FOUND":           20,
+		"WALLET_ERROR_CODE_EMPTY_HOLD":               21,
+		"WALLET_ERROR_CODE_HOLD_NOT_FOUND":           22,
+		"WALLET_ERROR_CODE_HOLD_CLOSED":              23,
 	}
 )
 
@@ -96,11 +203,11 @@ func (x WalletErrorCode) String() string {
 }
 
 func (WalletErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_wallet_proto_enumTypes[0].Descriptor()
+	return file_api_v1_wallet_proto_enumTypes[1].Descriptor()
 }
 
 func (WalletErrorCode) Type() protoreflect.EnumType {
-	return &file_api_v1_wallet_proto_enumTypes[0]
+	return &file_api_v1_wallet_proto_enumTypes[1]
 }
 
 func (x WalletErrorCode) Number() protoreflect.EnumNumber {
@@ -109,7 +216,7 @@ func (x WalletErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WalletErrorCode.Descriptor instead.
 func (WalletErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_wallet_proto_rawDescGZIP(), []int{0}
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{1}
 }
 
 // CreateWalletRequest represents request for create wallet.
@@ -195,29 +302,31 @@ func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{1}
 }
 
-// TopupWalletRequest represents request for topup wallet.
-type TopupWalletRequest struct {
+// CreateFXQuoteRequest represents request for create fx quote.
+type CreateFXQuoteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// topup represents topup data.
-	Topup         *Topup `protobuf:"bytes,1,opt,name=topup,proto3" json:"topup,omitempty"`
+	// from_currency represents ISO-4217 currency code of the sender's wallet.
+	FromCurrency string `protobuf:"bytes,1,opt,name=from_currency,proto3" json:"from_currency,omitempty"`
+	// to_currency represents ISO-4217 currency code of the receiver's wallet.
+	ToCurrency    string `protobuf:"bytes,2,opt,name=to_currency,proto3" json:"to_currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopupWalletRequest) Reset() {
-	*x = TopupWalletRequest{}
+func (x *CreateFXQuoteRequest) Reset() {
+	*x = CreateFXQuoteRequest{}
 	mi := &file_api_v1_wallet_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopupWalletRequest) String() string {
+func (x *CreateFXQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopupWalletRequest) ProtoMessage() {}
+func (*CreateFXQuoteRequest) ProtoMessage() {}
 
-func (x *TopupWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateFXQuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,41 +338,48 @@ func (x *TopupWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopupWalletRequest.ProtoReflect.Descriptor instead.
-func (*TopupWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFXQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TopupWalletRequest) GetTopup() *Topup {
+func (x *CreateFXQuoteRequest) GetFromCurrency() string {
 	if x != nil {
-		return x.Topup
+		return x.FromCurrency
 	}
-	return nil
+	return ""
 }
 
-// TopupWalletResponse represents response from topup wallet.
-type TopupWalletResponse struct {
+func (x *CreateFXQuoteRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+// CreateFXQuoteResponse represents response from create fx quote.
+type CreateFXQuoteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// data represents wallet.
-	Data          *Wallet `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// data represents fx quote.
+	Data          *FXQuote `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopupWalletResponse) Reset() {
-	*x = TopupWalletResponse{}
+func (x *CreateFXQuoteResponse) Reset() {
+	*x = CreateFXQuoteResponse{}
 	mi := &file_api_v1_wallet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopupWalletResponse) String() string {
+func (x *CreateFXQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopupWalletResponse) ProtoMessage() {}
+func (*CreateFXQuoteResponse) ProtoMessage() {}
 
-func (x *TopupWalletResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateFXQuoteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,41 +391,41 @@ func (x *TopupWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopupWalletResponse.ProtoReflect.Descriptor instead.
-func (*TopupWalletResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFXQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateFXQuoteResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TopupWalletResponse) GetData() *Wallet {
+func (x *CreateFXQuoteResponse) GetData() *FXQuote {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// TransferBalanceRequest represents request for transfer balance.
-type TransferBalanceRequest struct {
+// FreezeUserWalletsRequest represents request for freeze user wallets.
+type FreezeUserWalletsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// transfer represents transfer data.
-	Transfer      *Transfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// user_id represents the owner of the wallets.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferBalanceRequest) Reset() {
-	*x = TransferBalanceRequest{}
+func (x *FreezeUserWalletsRequest) Reset() {
+	*x = FreezeUserWalletsRequest{}
 	mi := &file_api_v1_wallet_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferBalanceRequest) String() string {
+func (x *FreezeUserWalletsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferBalanceRequest) ProtoMessage() {}
+func (*FreezeUserWalletsRequest) ProtoMessage() {}
 
-func (x *TransferBalanceRequest) ProtoReflect() protoreflect.Message {
+func (x *FreezeUserWalletsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -321,39 +437,39 @@ func (x *TransferBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferBalanceRequest.ProtoReflect.Descriptor instead.
-func (*TransferBalanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeUserWalletsRequest.ProtoReflect.Descriptor instead.
+func (*FreezeUserWalletsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TransferBalanceRequest) GetTransfer() *Transfer {
+func (x *FreezeUserWalletsRequest) GetUserId() string {
 	if x != nil {
-		return x.Transfer
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// TransferBalanceResponse represents response from transfer balance.
-type TransferBalanceResponse struct {
+// FreezeUserWalletsResponse represents response from freeze user wallets.
+type FreezeUserWalletsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferBalanceResponse) Reset() {
-	*x = TransferBalanceResponse{}
+func (x *FreezeUserWalletsResponse) Reset() {
+	*x = FreezeUserWalletsResponse{}
 	mi := &file_api_v1_wallet_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferBalanceResponse) String() string {
+func (x *FreezeUserWalletsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferBalanceResponse) ProtoMessage() {}
+func (*FreezeUserWalletsResponse) ProtoMessage() {}
 
-func (x *TransferBalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *FreezeUserWalletsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,38 +481,34 @@ func (x *TransferBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferBalanceResponse.ProtoReflect.Descriptor instead.
-func (*TransferBalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeUserWalletsResponse.ProtoReflect.Descriptor instead.
+func (*FreezeUserWalletsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{5}
 }
 
-// Wallet represents wallet.
-type Wallet struct {
+// UnfreezeUserWalletsRequest represents request for unfreeze user wallets.
+type UnfreezeUserWalletsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id represents unique id.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// user_id represents user's id.
-	UserId string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
-	// balance represents balance.
-	Balance       string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// user_id represents the owner of the wallets.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Wallet) Reset() {
-	*x = Wallet{}
+func (x *UnfreezeUserWalletsRequest) Reset() {
+	*x = UnfreezeUserWalletsRequest{}
 	mi := &file_api_v1_wallet_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Wallet) String() string {
+func (x *UnfreezeUserWalletsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Wallet) ProtoMessage() {}
+func (*UnfreezeUserWalletsRequest) ProtoMessage() {}
 
-func (x *Wallet) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeUserWalletsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -408,57 +520,39 @@ func (x *Wallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
-func (*Wallet) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeUserWalletsRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeUserWalletsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Wallet) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Wallet) GetUserId() string {
+func (x *UnfreezeUserWalletsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Wallet) GetBalance() string {
-	if x != nil {
-		return x.Balance
-	}
-	return ""
-}
-
-// Topup represents topup.
-type Topup struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// wallet_id represents wallet's id.
-	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,proto3" json:"wallet_id,omitempty"`
-	// amount represents amount.
-	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+// UnfreezeUserWalletsResponse represents response from unfreeze user wallets.
+type UnfreezeUserWalletsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Topup) Reset() {
-	*x = Topup{}
+func (x *UnfreezeUserWalletsResponse) Reset() {
+	*x = UnfreezeUserWalletsResponse{}
 	mi := &file_api_v1_wallet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Topup) String() string {
+func (x *UnfreezeUserWalletsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topup) ProtoMessage() {}
+func (*UnfreezeUserWalletsResponse) ProtoMessage() {}
 
-func (x *Topup) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeUserWalletsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,56 +564,34 @@ func (x *Topup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Topup.ProtoReflect.Descriptor instead.
-func (*Topup) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeUserWalletsResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeUserWalletsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Topup) GetWalletId() string {
-	if x != nil {
-		return x.WalletId
-	}
-	return ""
-}
-
-func (x *Topup) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-// Transfer represents transfer.
-type Transfer struct {
+// TopupWalletRequest represents request for topup wallet.
+type TopupWalletRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// sender_id represents sender's id.
-	SenderId string `protobuf:"bytes,1,opt,name=sender_id,proto3" json:"sender_id,omitempty"`
-	// sender_wallet_id represents sender's wallet's id.
-	SenderWalletId string `protobuf:"bytes,2,opt,name=sender_wallet_id,proto3" json:"sender_wallet_id,omitempty"`
-	// receiver_id represents receiver's id.
-	ReceiverId string `protobuf:"bytes,3,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
-	// receiver_wallet_id represents receiver's wallet's id.
-	ReceiverWalletId string `protobuf:"bytes,4,opt,name=receiver_wallet_id,proto3" json:"receiver_wallet_id,omitempty"`
-	// amount represents amount.
-	Amount        string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// topup represents topup data.
+	Topup         *Topup `protobuf:"bytes,1,opt,name=topup,proto3" json:"topup,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transfer) Reset() {
-	*x = Transfer{}
+func (x *TopupWalletRequest) Reset() {
+	*x = TopupWalletRequest{}
 	mi := &file_api_v1_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transfer) String() string {
+func (x *TopupWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transfer) ProtoMessage() {}
+func (*TopupWalletRequest) ProtoMessage() {}
 
-func (x *Transfer) ProtoReflect() protoreflect.Message {
+func (x *TopupWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,69 +603,88 @@ func (x *Transfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
-func (*Transfer) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopupWalletRequest.ProtoReflect.Descriptor instead.
+func (*TopupWalletRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_wallet_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Transfer) GetSenderId() string {
+func (x *TopupWalletRequest) GetTopup() *Topup {
 	if x != nil {
-		return x.SenderId
+		return x.Topup
 	}
-	return ""
+	return nil
 }
 
-func (x *Transfer) GetSenderWalletId() string {
-	if x != nil {
-		return x.SenderWalletId
-	}
-	return ""
+// TopupWalletResponse represents response from topup wallet.
+type TopupWalletResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents wallet.
+	Data          *Wallet `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transfer) GetReceiverId() string {
-	if x != nil {
-		return x.ReceiverId
-	}
-	return ""
+func (x *TopupWalletResponse) Reset() {
+	*x = TopupWalletResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transfer) GetReceiverWalletId() string {
+func (x *TopupWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopupWalletResponse) ProtoMessage() {}
+
+func (x *TopupWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[9]
 	if x != nil {
-		return x.ReceiverWalletId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Transfer) GetAmount() string {
+// Deprecated: Use TopupWalletResponse.ProtoReflect.Descriptor instead.
+func (*TopupWalletResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TopupWalletResponse) GetData() *Wallet {
 	if x != nil {
-		return x.Amount
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// WalletError represents message for any error happening in wallet service.
-type WalletError struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// TransferBalanceRequest represents request for transfer balance.
+type TransferBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transfer represents transfer data.
+	Transfer      *Transfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 	unknownFields protoimpl.UnknownFields
-	ErrorCode     WalletErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=api.v1.WalletErrorCode" json:"error_code,omitempty"`
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WalletError) Reset() {
-	*x = WalletError{}
-	mi := &file_api_v1_wallet_proto_msgTypes[9]
+func (x *TransferBalanceRequest) Reset() {
+	*x = TransferBalanceRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WalletError) String() string {
+func (x *TransferBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletError) ProtoMessage() {}
+func (*TransferBalanceRequest) ProtoMessage() {}
 
-func (x *WalletError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_wallet_proto_msgTypes[9]
+func (x *TransferBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,9 +695,1368 @@ func (x *WalletError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletError.ProtoReflect.Descriptor instead.
-func (*WalletError) Descriptor() ([]byte, []int) {
-	return file_api_v1_wallet_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TransferBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TransferBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferBalanceRequest) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// TransferBalanceResponse represents response from transfer balance.
+type TransferBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// conversion represents the applied conversion.
+	// It is empty if both wallets have the same currency.
+	Conversion    *Conversion `protobuf:"bytes,1,opt,name=conversion,proto3" json:"conversion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferBalanceResponse) Reset() {
+	*x = TransferBalanceResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferBalanceResponse) ProtoMessage() {}
+
+func (x *TransferBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferBalanceResponse.ProtoReflect.Descriptor instead.
+func (*TransferBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferBalanceResponse) GetConversion() *Conversion {
+	if x != nil {
+		return x.Conversion
+	}
+	return nil
+}
+
+// ResolveTransferRequest represents request for resolve transfer.
+type ResolveTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reference_id represents the transfer's reference.
+	ReferenceId   string `protobuf:"bytes,1,opt,name=reference_id,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTransferRequest) Reset() {
+	*x = ResolveTransferRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTransferRequest) ProtoMessage() {}
+
+func (x *ResolveTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTransferRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolveTransferRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+// ResolveTransferResponse represents response from resolve transfer.
+type ResolveTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+	Applied       bool `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *ResolveTransferResponse) Reset() {
+	*x = ResolveTransferResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTransferResponse) ProtoMessage() {}
+
+func (x *ResolveTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTransferResponse.ProtoReflect.Descriptor instead.
+func (*ResolveTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResolveTransferResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// AuthorizeHoldRequest represents request for authorize hold.
+type AuthorizeHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hold represents hold data.
+	Hold          *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeHoldRequest) Reset() {
+	*x = AuthorizeHoldRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeHoldRequest) ProtoMessage() {}
+
+func (x *AuthorizeHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeHoldRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuthorizeHoldRequest) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// AuthorizeHoldResponse represents response from authorize hold.
+type AuthorizeHoldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents hold.
+	Data          *Hold `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeHoldResponse) Reset() {
+	*x = AuthorizeHoldResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeHoldResponse) ProtoMessage() {}
+
+func (x *AuthorizeHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeHoldResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuthorizeHoldResponse) GetData() *Hold {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CaptureHoldRequest represents request for capture hold.
+type CaptureHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents hold's id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// amount represents the amount to be captured.
+	// It must not be greater than the held amount.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// user_id represents the hold's owner.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// wallet_id represents the hold's wallet.
+	WalletId string `protobuf:"bytes,4,opt,name=wallet_id,proto3" json:"wallet_id,omitempty"`
+	// receiver_id represents the hold's receiver.
+	ReceiverId string `protobuf:"bytes,5,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
+	// receiver_wallet_id represents the hold's receiver's wallet.
+	ReceiverWalletId string `protobuf:"bytes,6,opt,name=receiver_wallet_id,proto3" json:"receiver_wallet_id,omitempty"`
+	// reference_id represents the capture's reference, e.g. transaction's id.
+	// A reference is applied at most once.
+	ReferenceId   string `protobuf:"bytes,7,opt,name=reference_id,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CaptureHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetReceiverWalletId() string {
+	if x != nil {
+		return x.ReceiverWalletId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+// CaptureHoldResponse represents response from capture hold.
+type CaptureHoldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents hold.
+	Data          *Hold `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CaptureHoldResponse) GetData() *Hold {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// VoidHoldRequest represents request for void hold.
+type VoidHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents hold's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidHoldRequest) Reset() {
+	*x = VoidHoldRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldRequest) ProtoMessage() {}
+
+func (x *VoidHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldRequest.ProtoReflect.Descriptor instead.
+func (*VoidHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VoidHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// VoidHoldResponse represents response from void hold.
+type VoidHoldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents hold.
+	Data          *Hold `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidHoldResponse) Reset() {
+	*x = VoidHoldResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldResponse) ProtoMessage() {}
+
+func (x *VoidHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldResponse.ProtoReflect.Descriptor instead.
+func (*VoidHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VoidHoldResponse) GetData() *Hold {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetWalletRequest represents request for get wallet.
+type GetWalletRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents wallet's id.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetWalletRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetWalletResponse represents response from get wallet.
+type GetWalletResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents wallet.
+	Data          *Wallet `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetWalletResponse) GetData() *Wallet {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListMyWalletsRequest represents request for list my wallets.
+type ListMyWalletsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	Limit         uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyWalletsRequest) Reset() {
+	*x = ListMyWalletsRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWalletsRequest) ProtoMessage() {}
+
+func (x *ListMyWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMyWalletsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListMyWalletsResponse represents response from list my wallets.
+type ListMyWalletsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of wallet data.
+	Data          []*Wallet `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyWalletsResponse) Reset() {
+	*x = ListMyWalletsResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWalletsResponse) ProtoMessage() {}
+
+func (x *ListMyWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMyWalletsResponse) GetData() []*Wallet {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListUserWalletsRequest represents request for list user wallets.
+type ListUserWalletsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id represents the owner of the wallets.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWalletsRequest) Reset() {
+	*x = ListUserWalletsRequest{}
+	mi := &file_api_v1_wallet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWalletsRequest) ProtoMessage() {}
+
+func (x *ListUserWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUserWalletsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListUserWalletsResponse represents response from list user wallets.
+type ListUserWalletsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data represents an array of wallet data.
+	Data          []*Wallet `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWalletsResponse) Reset() {
+	*x = ListUserWalletsResponse{}
+	mi := &file_api_v1_wallet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWalletsResponse) ProtoMessage() {}
+
+func (x *ListUserWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserWalletsResponse) GetData() []*Wallet {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Wallet represents wallet.
+type Wallet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents unique id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id represents user's id.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// balance represents balance.
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// currency represents ISO-4217 currency code of the balance.
+	// It is IDR if not set.
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// available_balance represents balance which can be spent.
+	// It is the balance minus the held balance.
+	AvailableBalance string `protobuf:"bytes,5,opt,name=available_balance,proto3" json:"available_balance,omitempty"`
+	// held_balance represents balance reserved by authorized holds.
+	HeldBalance   string `protobuf:"bytes,6,opt,name=held_balance,proto3" json:"held_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_api_v1_wallet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Wallet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Wallet) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Wallet) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Wallet) GetAvailableBalance() string {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return ""
+}
+
+func (x *Wallet) GetHeldBalance() string {
+	if x != nil {
+		return x.HeldBalance
+	}
+	return ""
+}
+
+// Topup represents topup.
+type Topup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// wallet_id represents wallet's id.
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,proto3" json:"wallet_id,omitempty"`
+	// amount represents amount.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// currency represents ISO-4217 currency code of the amount.
+	// It must be the same as wallet's currency and is IDR if not set.
+	Currency      string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Topup) Reset() {
+	*x = Topup{}
+	mi := &file_api_v1_wallet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topup) ProtoMessage() {}
+
+func (x *Topup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topup.ProtoReflect.Descriptor instead.
+func (*Topup) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Topup) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *Topup) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Topup) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Transfer represents transfer.
+type Transfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sender_id represents sender's id.
+	SenderId string `protobuf:"bytes,1,opt,name=sender_id,proto3" json:"sender_id,omitempty"`
+	// sender_wallet_id represents sender's wallet's id.
+	SenderWalletId string `protobuf:"bytes,2,opt,name=sender_wallet_id,proto3" json:"sender_wallet_id,omitempty"`
+	// receiver_id represents receiver's id.
+	ReceiverId string `protobuf:"bytes,3,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
+	// receiver_wallet_id represents receiver's wallet's id.
+	ReceiverWalletId string `protobuf:"bytes,4,opt,name=receiver_wallet_id,proto3" json:"receiver_wallet_id,omitempty"`
+	// amount represents amount.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reference_id represents the id of the caller's record the transfer belongs to, e.g. transaction's id.
+	// If it is set, the reference is transferred at most once and its outcome can be resolved.
+	ReferenceId string `protobuf:"bytes,6,opt,name=reference_id,proto3" json:"reference_id,omitempty"`
+	// currency represents ISO-4217 currency code of the amount.
+	// It must be the same as both wallets' currency and is IDR if not set.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// quote_id represents fx quote's id.
+	// It is required if receiver's wallet has different currency from sender's wallet.
+	QuoteId       string `protobuf:"bytes,8,opt,name=quote_id,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_api_v1_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Transfer) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *Transfer) GetSenderWalletId() string {
+	if x != nil {
+		return x.SenderWalletId
+	}
+	return ""
+}
+
+func (x *Transfer) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *Transfer) GetReceiverWalletId() string {
+	if x != nil {
+		return x.ReceiverWalletId
+	}
+	return ""
+}
+
+func (x *Transfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Transfer) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Transfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transfer) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+// Hold represents an amount reserved from a wallet for a receiver.
+type Hold struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	Amount           string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	WalletId         string                 `protobuf:"bytes,3,opt,name=wallet_id,proto3" json:"wallet_id,omitempty"`
+	ReceiverId       string                 `protobuf:"bytes,4,opt,name=receiver_id,proto3" json:"receiver_id,omitempty"`
+	ReceiverWalletId string                 `protobuf:"bytes,5,opt,name=receiver_wallet_id,proto3" json:"receiver_wallet_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CapturedAmount   string                 `protobuf:"bytes,8,opt,name=captured_amount,proto3" json:"captured_amount,omitempty"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	Status           HoldStatus `protobuf:"varint,9,opt,name=status,proto3,enum=api.v1.HoldStatus" json:"status,omitempty"`
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_api_v1_wallet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Hold) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *Hold) GetReceiverId() string {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return ""
+}
+
+func (x *Hold) GetReceiverWalletId() string {
+	if x != nil {
+		return x.ReceiverWalletId
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Hold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Hold) GetCapturedAmount() string {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// FXQuote represents a locked exchange rate.
+type FXQuote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id represents unique id.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// from_currency represents ISO-4217 currency code to convert from.
+	FromCurrency string `protobuf:"bytes,2,opt,name=from_currency,proto3" json:"from_currency,omitempty"`
+	// to_currency represents ISO-4217 currency code to convert to.
+	ToCurrency string `protobuf:"bytes,3,opt,name=to_currency,proto3" json:"to_currency,omitempty"`
+	// rate represents how many units of to_currency one unit of from_currency gets.
+	Rate string `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// spread represents the fraction of the amount charged as fee, e.g. 0.005 means 0.5%.
+	Spread string `protobuf:"bytes,5,opt,name=spread,proto3" json:"spread,omitempty"`
+	// expires_at represents when the quote can't be used anymore.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FXQuote) Reset() {
+	*x = FXQuote{}
+	mi := &file_api_v1_wallet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FXQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FXQuote) ProtoMessage() {}
+
+func (x *FXQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FXQuote.ProtoReflect.Descriptor instead.
+func (*FXQuote) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FXQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FXQuote) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *FXQuote) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *FXQuote) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FXQuote) GetSpread() string {
+	if x != nil {
+		return x.Spread
+	}
+	return ""
+}
+
+func (x *FXQuote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Conversion represents the conversion applied in a cross-currency transfer.
+type Conversion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// quote_id represents the used fx quote's id.
+	QuoteId string `protobuf:"bytes,1,opt,name=quote_id,proto3" json:"quote_id,omitempty"`
+	// from_currency represents ISO-4217 currency code of the transfer amount.
+	FromCurrency string `protobuf:"bytes,2,opt,name=from_currency,proto3" json:"from_currency,omitempty"`
+	// to_currency represents ISO-4217 currency code of the converted amount.
+	ToCurrency string `protobuf:"bytes,3,opt,name=to_currency,proto3" json:"to_currency,omitempty"`
+	// rate represents the applied exchange rate.
+	Rate string `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// fee represents spread fee in from_currency which is deducted from the transfer amount.
+	Fee string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// converted_amount represents the amount received in to_currency.
+	ConvertedAmount string `protobuf:"bytes,6,opt,name=converted_amount,proto3" json:"converted_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Conversion) Reset() {
+	*x = Conversion{}
+	mi := &file_api_v1_wallet_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversion) ProtoMessage() {}
+
+func (x *Conversion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversion.ProtoReflect.Descriptor instead.
+func (*Conversion) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Conversion) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *Conversion) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *Conversion) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *Conversion) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *Conversion) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Conversion) GetConvertedAmount() string {
+	if x != nil {
+		return x.ConvertedAmount
+	}
+	return ""
+}
+
+// WalletError represents message for any error happening in wallet service.
+type WalletError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	ErrorCode     WalletErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=api.v1.WalletErrorCode" json:"error_code,omitempty"`
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletError) Reset() {
+	*x = WalletError{}
+	mi := &file_api_v1_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletError) ProtoMessage() {}
+
+func (x *WalletError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletError.ProtoReflect.Descriptor instead.
+func (*WalletError) Descriptor() ([]byte, []int) {
+	return file_api_v1_wallet_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *WalletError) GetErrorCode() WalletErrorCode {
@@ -620,33 +2070,126 @@ var File_api_v1_wallet_proto protoreflect.FileDescriptor
 
 const file_api_v1_wallet_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/wallet.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
+	"\x13api/v1/wallet.proto\x12\x06api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
 	"\x13CreateWalletRequest\x12+\n" +
 	"\x06wallet\x18\x01 \x01(\v2\x0e.api.v1.WalletB\x03\xe0A\x02R\x06wallet\"\x16\n" +
-	"\x14CreateWalletResponse\">\n" +
+	"\x14CreateWalletResponse\"h\n" +
+	"\x14CreateFXQuoteRequest\x12)\n" +
+	"\rfrom_currency\x18\x01 \x01(\tB\x03\xe0A\x02R\rfrom_currency\x12%\n" +
+	"\vto_currency\x18\x02 \x01(\tB\x03\xe0A\x02R\vto_currency\"A\n" +
+	"\x15CreateFXQuoteResponse\x12(\n" +
+	"\x04data\x18\x01 \x01(\v2\x0f.api.v1.FXQuoteB\x03\xe0A\x03R\x04data\"4\n" +
+	"\x18FreezeUserWalletsRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x1b\n" +
+	"\x19FreezeUserWalletsResponse\"6\n" +
+	"\x1aUnfreezeUserWalletsRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"\x1d\n" +
+	"\x1bUnfreezeUserWalletsResponse\">\n" +
 	"\x12TopupWalletRequest\x12(\n" +
 	"\x05topup\x18\x01 \x01(\v2\r.api.v1.TopupB\x03\xe0A\x02R\x05topup\">\n" +
 	"\x13TopupWalletResponse\x12'\n" +
 	"\x04data\x18\x01 \x01(\v2\x0e.api.v1.WalletB\x03\xe0A\x03R\x04data\"F\n" +
 	"\x16TransferBalanceRequest\x12,\n" +
-	"\btransfer\x18\x01 \x01(\v2\x10.api.v1.TransferR\btransfer\"\x19\n" +
-	"\x17TransferBalanceResponse\"\xe3\x01\n" +
+	"\btransfer\x18\x01 \x01(\v2\x10.api.v1.TransferR\btransfer\"R\n" +
+	"\x17TransferBalanceResponse\x127\n" +
+	"\n" +
+	"conversion\x18\x01 \x01(\v2\x12.api.v1.ConversionB\x03\xe0A\x03R\n" +
+	"conversion\"<\n" +
+	"\x16ResolveTransferRequest\x12\"\n" +
+	"\freference_id\x18\x01 \x01(\tR\freference_id\"8\n" +
+	"\x17ResolveTransferResponse\x12\x1d\n" +
+	"\aapplied\x18\x01 \x01(\bB\x03\xe0A\x03R\aapplied\"=\n" +
+	"\x14AuthorizeHoldRequest\x12%\n" +
+	"\x04hold\x18\x01 \x01(\v2\f.api.v1.HoldB\x03\xe0A\x02R\x04hold\">\n" +
+	"\x15AuthorizeHoldResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.HoldB\x03\xe0A\x03R\x04data\"\xaa\x02\n" +
+	"\x12CaptureHoldRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x128\n" +
+	"\x06amount\x18\x02 \x01(\tB \x92A\x1a2\x0fCaptured amountJ\a\"10.23\"\xe0A\x02R\x06amount\x12\x1d\n" +
+	"\auser_id\x18\x03 \x01(\tB\x03\xe0A\x02R\auser_id\x12!\n" +
+	"\twallet_id\x18\x04 \x01(\tB\x03\xe0A\x02R\twallet_id\x12%\n" +
+	"\vreceiver_id\x18\x05 \x01(\tB\x03\xe0A\x02R\vreceiver_id\x123\n" +
+	"\x12receiver_wallet_id\x18\x06 \x01(\tB\x03\xe0A\x02R\x12receiver_wallet_id\x12'\n" +
+	"\freference_id\x18\a \x01(\tB\x03\xe0A\x02R\freference_id\"<\n" +
+	"\x13CaptureHoldResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.HoldB\x03\xe0A\x03R\x04data\"&\n" +
+	"\x0fVoidHoldRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"9\n" +
+	"\x10VoidHoldResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\f.api.v1.HoldB\x03\xe0A\x03R\x04data\"'\n" +
+	"\x10GetWalletRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"<\n" +
+	"\x11GetWalletResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x0e.api.v1.WalletB\x03\xe0A\x03R\x04data\",\n" +
+	"\x14ListMyWalletsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"@\n" +
+	"\x15ListMyWalletsResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x0e.api.v1.WalletB\x03\xe0A\x03R\x04data\"2\n" +
+	"\x16ListUserWalletsRequest\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\tR\auser_id\"B\n" +
+	"\x17ListUserWalletsResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x0e.api.v1.WalletB\x03\xe0A\x03R\x04data\"\xfa\x02\n" +
 	"\x06Wallet\x12>\n" +
 	"\x02id\x18\x01 \x01(\tB.\x92A(J&\"01917a0c-cdfe-74dd-9d95-4d87b5d1f0b8\"\xe0A\x03R\x02id\x12\\\n" +
 	"\auser_id\x18\x02 \x01(\tBB\x92A<2\x12Wallet's user's idJ&\"01917a0c-cdfe-7aae-b311-a8c7c32f5c70\"\xe0A\x02R\auser_id\x12;\n" +
-	"\abalance\x18\x03 \x01(\tB!\x92A\x1b2\x10Wallet's balanceJ\a\"10.23\"\xe0A\x02R\abalance\"\x99\x01\n" +
+	"\abalance\x18\x03 \x01(\tB!\x92A\x1b2\x10Wallet's balanceJ\a\"10.23\"\xe0A\x02R\abalance\x129\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x1d\x92A\x1a2\x11Wallet's currencyJ\x05\"IDR\"R\bcurrency\x121\n" +
+	"\x11available_balance\x18\x05 \x01(\tB\x03\xe0A\x03R\x11available_balance\x12'\n" +
+	"\fheld_balance\x18\x06 \x01(\tB\x03\xe0A\x03R\fheld_balance\"\xd1\x01\n" +
 	"\x05Topup\x12Y\n" +
 	"\twallet_id\x18\x01 \x01(\tB;\x92A52\vWallet's idJ&\"01917a0c-cdfe-701e-9547-ed45a24d7c84\"\xe0A\x02R\twallet_id\x125\n" +
-	"\x06amount\x18\x02 \x01(\tB\x1d\x92A\x172\fTopup amountJ\a\"10.23\"\xe0A\x02R\x06amount\"\xea\x03\n" +
+	"\x06amount\x18\x02 \x01(\tB\x1d\x92A\x172\fTopup amountJ\a\"10.23\"\xe0A\x02R\x06amount\x126\n" +
+	"\bcurrency\x18\x03 \x01(\tB\x1a\x92A\x172\x0eTopup currencyJ\x05\"IDR\"R\bcurrency\"\xe4\x05\n" +
 	"\bTransfer\x12Y\n" +
 	"\tsender_id\x18\x01 \x01(\tB;\x92A52\vSender's idJ&\"01917a10-1086-74a6-8cfb-0074f65bebe3\"\xe0A\x02R\tsender_id\x12p\n" +
 	"\x10sender_wallet_id\x18\x02 \x01(\tBD\x92A>2\x14Sender's wallet's idJ&\"01917a10-1086-7faa-9c9e-0bf6a9cf6928\"\xe0A\x02R\x10sender_wallet_id\x12_\n" +
 	"\vreceiver_id\x18\x03 \x01(\tB=\x92A72\rReceiver's idJ&\"01917a10-1086-7c94-93c4-32de26621dae\"\xe0A\x02R\vreceiver_id\x12v\n" +
 	"\x12receiver_wallet_id\x18\x04 \x01(\tBF\x92A@2\x16Receiver's wallet's idJ&\"01917a10-1086-72df-818a-b72d663fb3b5\"\xe0A\x02R\x12receiver_wallet_id\x128\n" +
-	"\x06amount\x18\x05 \x01(\tB \x92A\x1a2\x0fTransfer amountJ\a\"10.23\"\xe0A\x02R\x06amount\"E\n" +
+	"\x06amount\x18\x05 \x01(\tB \x92A\x1a2\x0fTransfer amountJ\a\"10.23\"\xe0A\x02R\x06amount\x12e\n" +
+	"\freference_id\x18\x06 \x01(\tBA\x92A>2\x14Transfer's referenceJ&\"01917a10-1086-7d3b-9e44-5c2a1b8f3d21\"R\freference_id\x129\n" +
+	"\bcurrency\x18\a \x01(\tB\x1d\x92A\x1a2\x11Transfer currencyJ\x05\"IDR\"R\bcurrency\x12V\n" +
+	"\bquote_id\x18\b \x01(\tB:\x92A72\rFX quote's idJ&\"01917a10-1086-7a3e-8d2f-6f1c2b9e4a10\"R\bquote_id\"\xc0\x05\n" +
+	"\x04Hold\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\\\n" +
+	"\auser_id\x18\x02 \x01(\tBB\x92A<2\x12Wallet's user's idJ&\"01917a10-1086-74a6-8cfb-0074f65bebe3\"\xe0A\x02R\auser_id\x12^\n" +
+	"\twallet_id\x18\x03 \x01(\tB@\x92A:2\x10Held wallet's idJ&\"01917a10-1086-7faa-9c9e-0bf6a9cf6928\"\xe0A\x02R\twallet_id\x12_\n" +
+	"\vreceiver_id\x18\x04 \x01(\tB=\x92A72\rReceiver's idJ&\"01917a10-1086-7c94-93c4-32de26621dae\"\xe0A\x02R\vreceiver_id\x12v\n" +
+	"\x12receiver_wallet_id\x18\x05 \x01(\tBF\x92A@2\x16Receiver's wallet's idJ&\"01917a10-1086-72df-818a-b72d663fb3b5\"\xe0A\x02R\x12receiver_wallet_id\x124\n" +
+	"\x06amount\x18\x06 \x01(\tB\x1c\x92A\x162\vHeld amountJ\a\"10.23\"\xe0A\x02R\x06amount\x125\n" +
+	"\bcurrency\x18\a \x01(\tB\x19\x92A\x162\rHold currencyJ\x05\"IDR\"R\bcurrency\x12-\n" +
+	"\x0fcaptured_amount\x18\b \x01(\tB\x03\xe0A\x03R\x0fcaptured_amount\x12/\n" +
+	"\x06status\x18\t \x01(\x0e2\x12.api.v1.HoldStatusB\x03\xe0A\x03R\x06status\x12?\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expires_at\"\x8c\x02\n" +
+	"\aFXQuote\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12$\n" +
+	"\rfrom_currency\x18\x02 \x01(\tR\rfrom_currency\x12 \n" +
+	"\vto_currency\x18\x03 \x01(\tR\vto_currency\x121\n" +
+	"\x04rate\x18\x04 \x01(\tB\x1d\x92A\x1a2\rExchange rateJ\t\"16250.5\"R\x04rate\x125\n" +
+	"\x06spread\x18\x05 \x01(\tB\x1d\x92A\x1a2\x0fSpread fee rateJ\a\"0.005\"R\x06spread\x12:\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\"\xc2\x01\n" +
+	"\n" +
+	"Conversion\x12\x1a\n" +
+	"\bquote_id\x18\x01 \x01(\tR\bquote_id\x12$\n" +
+	"\rfrom_currency\x18\x02 \x01(\tR\rfrom_currency\x12 \n" +
+	"\vto_currency\x18\x03 \x01(\tR\vto_currency\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\tR\x03fee\x12*\n" +
+	"\x10converted_amount\x18\x06 \x01(\tR\x10converted_amount\"E\n" +
 	"\vWalletError\x126\n" +
 	"\n" +
-	"error_code\x18\x01 \x01(\x0e2\x17.api.v1.WalletErrorCodeR\terrorCode*\xb6\x03\n" +
+	"error_code\x18\x01 \x01(\x0e2\x17.api.v1.WalletErrorCodeR\terrorCode*\x90\x01\n" +
+	"\n" +
+	"HoldStatus\x12\x1b\n" +
+	"\x17HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16HOLD_STATUS_AUTHORIZED\x10\x01\x12\x18\n" +
+	"\x14HOLD_STATUS_CAPTURED\x10\x02\x12\x16\n" +
+	"\x12HOLD_STATUS_VOIDED\x10\x03\x12\x17\n" +
+	"\x13HOLD_STATUS_EXPIRED\x10\x04*\xe0\x06\n" +
 	"\x0fWalletErrorCode\x12!\n" +
 	"\x1dWALLET_ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aWALLET_ERROR_CODE_INTERNAL\x10\x01\x12$\n" +
@@ -659,21 +2202,47 @@ const file_api_v1_wallet_proto_rawDesc = "" +
 	"\x1eWALLET_ERROR_CODE_SAME_ACCOUNT\x10\n" +
 	"\x12*\n" +
 	"&WALLET_ERROR_CODE_INSUFFICIENT_BALANCE\x10\v\x12&\n" +
-	"\"WALLET_ERROR_CODE_INVALID_TRANSFER\x10\f2\x9f\x04\n" +
+	"\"WALLET_ERROR_CODE_INVALID_TRANSFER\x10\f\x12&\n" +
+	"\"WALLET_ERROR_CODE_TRANSFER_APPLIED\x10\r\x12(\n" +
+	"$WALLET_ERROR_CODE_TRANSFER_CANCELLED\x10\x0e\x12\x1f\n" +
+	"\x1bWALLET_ERROR_CODE_NOT_FOUND\x10\x0f\x12&\n" +
+	"\"WALLET_ERROR_CODE_INVALID_CURRENCY\x10\x10\x12'\n" +
+	"#WALLET_ERROR_CODE_CURRENCY_MISMATCH\x10\x11\x12.\n" +
+	"*WALLET_ERROR_CODE_INVALID_AMOUNT_PRECISION\x10\x12\x12#\n" +
+	"\x1fWALLET_ERROR_CODE_INVALID_QUOTE\x10\x13\x12$\n" +
+	" WALLET_ERROR_CODE_RATE_NOT_FOUND\x10\x14\x12 \n" +
+	"\x1cWALLET_ERROR_CODE_EMPTY_HOLD\x10\x15\x12$\n" +
+	" WALLET_ERROR_CODE_HOLD_NOT_FOUND\x10\x16\x12!\n" +
+	"\x1dWALLET_ERROR_CODE_HOLD_CLOSED\x10\x172\xbd\b\n" +
 	"\x14WalletCommandService\x12K\n" +
-	"\fCreateWallet\x12\x1b.api.v1.CreateWalletRequest\x1a\x1c.api.v1.CreateWalletResponse\"\x00\x12\xb1\x01\n" +
+	"\fCreateWallet\x12\x1b.api.v1.CreateWalletRequest\x1a\x1c.api.v1.CreateWalletResponse\"\x00\x12\x9f\x01\n" +
+	"\rCreateFXQuote\x12\x1c.api.v1.CreateFXQuoteRequest\x1a\x1d.api.v1.CreateFXQuoteResponse\"Q\x92A.\n" +
+	"\x06Wallet*\rCreateFXQuoter\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/wallets/fx-quotes\x12Z\n" +
+	"\x11FreezeUserWallets\x12 .api.v1.FreezeUserWalletsRequest\x1a!.api.v1.FreezeUserWalletsResponse\"\x00\x12`\n" +
+	"\x13UnfreezeUserWallets\x12\".api.v1.UnfreezeUserWalletsRequest\x1a#.api.v1.UnfreezeUserWalletsResponse\"\x00\x12\xb1\x01\n" +
 	"\vTopupWallet\x12\x1a.api.v1.TopupWalletRequest\x1a\x1b.api.v1.TopupWalletResponse\"i\x92AE\n" +
 	"\x06Wallet*\vTopupWalletr.\n" +
 	"\x13\n" +
 	"\rAuthorization\x18\x01(\x01\n" +
 	"\x17\n" +
-	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1b:\x05topup\x1a\x12/v1/wallets/topups\x12\xc6\x01\n" +
-	"\x0fTransferBalance\x12\x1e.api.v1.TransferBalanceRequest\x1a\x1f.api.v1.TransferBalanceResponse\"r\x92AH\n" +
-	"\x06Wallet*\x0eTransferWalletr.\n" +
+	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02\x1b:\x05topup\x1a\x12/v1/wallets/topups\x12T\n" +
+	"\x0fTransferBalance\x12\x1e.api.v1.TransferBalanceRequest\x1a\x1f.api.v1.TransferBalanceResponse\"\x00\x12T\n" +
+	"\x0fResolveTransfer\x12\x1e.api.v1.ResolveTransferRequest\x1a\x1f.api.v1.ResolveTransferResponse\"\x00\x12N\n" +
+	"\rAuthorizeHold\x12\x1c.api.v1.AuthorizeHoldRequest\x1a\x1d.api.v1.AuthorizeHoldResponse\"\x00\x12H\n" +
+	"\vCaptureHold\x12\x1a.api.v1.CaptureHoldRequest\x1a\x1b.api.v1.CaptureHoldResponse\"\x00\x12?\n" +
+	"\bVoidHold\x12\x17.api.v1.VoidHoldRequest\x1a\x18.api.v1.VoidHoldResponse\"\x00\x1a=\x92A:\x128This service provides all use cases to work with wallet.2\xe3\x03\n" +
+	"\x12WalletQueryService\x12\x87\x01\n" +
+	"\tGetWallet\x12\x18.api.v1.GetWalletRequest\x1a\x19.api.v1.GetWalletResponse\"E\x92A*\n" +
+	"\x06Wallet*\tGetWalletr\x15\n" +
 	"\x13\n" +
-	"\rAuthorization\x18\x01(\x01\n" +
-	"\x17\n" +
-	"\x11X-Idempotency-Key\x18\x01(\x01\x82\xd3\xe4\x93\x02!:\btransfer\x1a\x15/v1/wallets/transfers\x1a=\x92A:\x128This service provides all use cases to work with wallet.B\x91\x02\x92A\xd1\x01\x12\x97\x01\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/wallets/{id}\x12\x92\x01\n" +
+	"\rListMyWallets\x12\x1c.api.v1.ListMyWalletsRequest\x1a\x1d.api.v1.ListMyWalletsResponse\"D\x92A.\n" +
+	"\x06Wallet*\rListMyWalletsr\x15\n" +
+	"\x13\n" +
+	"\rAuthorization\x18\x01(\x01\x82\xd3\xe4\x93\x02\r\x12\v/v1/wallets\x12T\n" +
+	"\x0fListUserWallets\x12\x1e.api.v1.ListUserWalletsRequest\x1a\x1f.api.v1.ListUserWalletsResponse\"\x00\x1aX\x92AU\x12SThis service provides basic query or data-retrieving use cases to work with wallet.B\x91\x02\x92A\xd1\x01\x12\x97\x01\n" +
 	"\n" +
 	"Wallet API\"0\n" +
 	"\rIndra Saputra\x12\x1fhttps://github.com/indrasaputra*P\n" +
@@ -691,38 +2260,95 @@ func file_api_v1_wallet_proto_rawDescGZIP() []byte {
 	return file_api_v1_wallet_proto_rawDescData
 }
 
-var file_api_v1_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_api_v1_wallet_proto_goTypes = []any{
-	(WalletErrorCode)(0),            // 0: api.v1.WalletErrorCode
-	(*CreateWalletRequest)(nil),     // 1: api.v1.CreateWalletRequest
-	(*CreateWalletResponse)(nil),    // 2: api.v1.CreateWalletResponse
-	(*TopupWalletRequest)(nil),      // 3: api.v1.TopupWalletRequest
-	(*TopupWalletResponse)(nil),     // 4: api.v1.TopupWalletResponse
-	(*TransferBalanceRequest)(nil),  // 5: api.v1.TransferBalanceRequest
-	(*TransferBalanceResponse)(nil), // 6: api.v1.TransferBalanceResponse
-	(*Wallet)(nil),                  // 7: api.v1.Wallet
-	(*Topup)(nil),                   // 8: api.v1.Topup
-	(*Transfer)(nil),                // 9: api.v1.Transfer
-	(*WalletError)(nil),             // 10: api.v1.WalletError
+	(HoldStatus)(0),                     // 0: api.v1.HoldStatus
+	(WalletErrorCode)(0),                // 1: api.v1.WalletErrorCode
+	(*CreateWalletRequest)(nil),         // 2: api.v1.CreateWalletRequest
+	(*CreateWalletResponse)(nil),        // 3: api.v1.CreateWalletResponse
+	(*CreateFXQuoteRequest)(nil),        // 4: api.v1.CreateFXQuoteRequest
+	(*CreateFXQuoteResponse)(nil),       // 5: api.v1.CreateFXQuoteResponse
+	(*FreezeUserWalletsRequest)(nil),    // 6: api.v1.FreezeUserWalletsRequest
+	(*FreezeUserWalletsResponse)(nil),   // 7: api.v1.FreezeUserWalletsResponse
+	(*UnfreezeUserWalletsRequest)(nil),  // 8: api.v1.UnfreezeUserWalletsRequest
+	(*UnfreezeUserWalletsResponse)(nil), // 9: api.v1.UnfreezeUserWalletsResponse
+	(*TopupWalletRequest)(nil),          // 10: api.v1.TopupWalletRequest
+	(*TopupWalletResponse)(nil),         // 11: api.v1.TopupWalletResponse
+	(*TransferBalanceRequest)(nil),      // 12: api.v1.TransferBalanceRequest
+	(*TransferBalanceResponse)(nil),     // 13: api.v1.TransferBalanceResponse
+	(*ResolveTransferRequest)(nil),      // 14: api.v1.ResolveTransferRequest
+	(*ResolveTransferResponse)(nil),     // 15: api.v1.ResolveTransferResponse
+	(*AuthorizeHoldRequest)(nil),        // 16: api.v1.AuthorizeHoldRequest
+	(*AuthorizeHoldResponse)(nil),       // 17: api.v1.AuthorizeHoldResponse
+	(*CaptureHoldRequest)(nil),          // 18: api.v1.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),         // 19: api.v1.CaptureHoldResponse
+	(*VoidHoldRequest)(nil),             // 20: api.v1.VoidHoldRequest
+	(*VoidHoldResponse)(nil),            // 21: api.v1.VoidHoldResponse
+	(*GetWalletRequest)(nil),            // 22: api.v1.GetWalletRequest
+	(*GetWalletResponse)(nil),           // 23: api.v1.GetWalletResponse
+	(*ListMyWalletsRequest)(nil),        // 24: api.v1.ListMyWalletsRequest
+	(*ListMyWalletsResponse)(nil),       // 25: api.v1.ListMyWalletsResponse
+	(*ListUserWalletsRequest)(nil),      // 26: api.v1.ListUserWalletsRequest
+	(*ListUserWalletsResponse)(nil),     // 27: api.v1.ListUserWalletsResponse
+	(*Wallet)(nil),                      // 28: api.v1.Wallet
+	(*Topup)(nil),                       // 29: api.v1.Topup
+	(*Transfer)(nil),                    // 30: api.v1.Transfer
+	(*Hold)(nil),                        // 31: api.v1.Hold
+	(*FXQuote)(nil),                     // 32: api.v1.FXQuote
+	(*Conversion)(nil),                  // 33: api.v1.Conversion
+	(*WalletError)(nil),                 // 34: api.v1.WalletError
+	(*timestamppb.Timestamp)(nil),       // 35: google.protobuf.Timestamp
 }
 var file_api_v1_wallet_proto_depIdxs = []int32{
-	7, // 0: api.v1.CreateWalletRequest.wallet:type_name -> api.v1.Wallet
-	8, // 1: api.v1.TopupWalletRequest.topup:type_name -> api.v1.Topup
-	7, // 2: api.v1.TopupWalletResponse.data:type_name -> api.v1.Wallet
-	9, // 3: api.v1.TransferBalanceRequest.transfer:type_name -> api.v1.Transfer
-	0, // 4: api.v1.WalletError.error_code:type_name -> api.v1.WalletErrorCode
-	1, // 5: api.v1.WalletCommandService.CreateWallet:input_type -> api.v1.CreateWalletRequest
-	3, // 6: api.v1.WalletCommandService.TopupWallet:input_type -> api.v1.TopupWalletRequest
-	5, // 7: api.v1.WalletCommandService.TransferBalance:input_type -> api.v1.TransferBalanceRequest
-	2, // 8: api.v1.WalletCommandService.CreateWallet:output_type -> api.v1.CreateWalletResponse
-	4, // 9: api.v1.WalletCommandService.TopupWallet:output_type -> api.v1.TopupWalletResponse
-	6, // 10: api.v1.WalletCommandService.TransferBalance:output_type -> api.v1.TransferBalanceResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	28, // 0: api.v1.CreateWalletRequest.wallet:type_name -> api.v1.Wallet
+	32, // 1: api.v1.CreateFXQuoteResponse.data:type_name -> api.v1.FXQuote
+	29, // 2: api.v1.TopupWalletRequest.topup:type_name -> api.v1.Topup
+	28, // 3: api.v1.TopupWalletResponse.data:type_name -> api.v1.Wallet
+	30, // 4: api.v1.TransferBalanceRequest.transfer:type_name -> api.v1.Transfer
+	33, // 5: api.v1.TransferBalanceResponse.conversion:type_name -> api.v1.Conversion
+	31, // 6: api.v1.AuthorizeHoldRequest.hold:type_name -> api.v1.Hold
+	31, // 7: api.v1.AuthorizeHoldResponse.data:type_name -> api.v1.Hold
+	31, // 8: api.v1.CaptureHoldResponse.data:type_name -> api.v1.Hold
+	31, // 9: api.v1.VoidHoldResponse.data:type_name -> api.v1.Hold
+	28, // 10: api.v1.GetWalletResponse.data:type_name -> api.v1.Wallet
+	28, // 11: api.v1.ListMyWalletsResponse.data:type_name -> api.v1.Wallet
+	28, // 12: api.v1.ListUserWalletsResponse.data:type_name -> api.v1.Wallet
+	0,  // 13: api.v1.Hold.status:type_name -> api.v1.HoldStatus
+	35, // 14: api.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	35, // 15: api.v1.FXQuote.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: api.v1.WalletError.error_code:type_name -> api.v1.WalletErrorCode
+	2,  // 17: api.v1.WalletCommandService.CreateWallet:input_type -> api.v1.CreateWalletRequest
+	4,  // 18: api.v1.WalletCommandService.CreateFXQuote:input_type -> api.v1.CreateFXQuoteRequest
+	6,  // 19: api.v1.WalletCommandService.FreezeUserWallets:input_type -> api.v1.FreezeUserWalletsRequest
+	8,  // 20: api.v1.WalletCommandService.UnfreezeUserWallets:input_type -> api.v1.UnfreezeUserWalletsRequest
+	10, // 21: api.v1.WalletCommandService.TopupWallet:input_type -> api.v1.TopupWalletRequest
+	12, // 22: api.v1.WalletCommandService.TransferBalance:input_type -> api.v1.TransferBalanceRequest
+	14, // 23: api.v1.WalletCommandService.ResolveTransfer:input_type -> api.v1.ResolveTransferRequest
+	16, // 24: api.v1.WalletCommandService.AuthorizeHold:input_type -> api.v1.AuthorizeHoldRequest
+	18, // 25: api.v1.WalletCommandService.CaptureHold:input_type -> api.v1.CaptureHoldRequest
+	20, // 26: api.v1.WalletCommandService.VoidHold:input_type -> api.v1.VoidHoldRequest
+	22, // 27: api.v1.WalletQueryService.GetWallet:input_type -> api.v1.GetWalletRequest
+	24, // 28: api.v1.WalletQueryService.ListMyWallets:input_type -> api.v1.ListMyWalletsRequest
+	26, // 29: api.v1.WalletQueryService.ListUserWallets:input_type -> api.v1.ListUserWalletsRequest
+	3,  // 30: api.v1.WalletCommandService.CreateWallet:output_type -> api.v1.CreateWalletResponse
+	5,  // 31: api.v1.WalletCommandService.CreateFXQuote:output_type -> api.v1.CreateFXQuoteResponse
+	7,  // 32: api.v1.WalletCommandService.FreezeUserWallets:output_type -> api.v1.FreezeUserWalletsResponse
+	9,  // 33: api.v1.WalletCommandService.UnfreezeUserWallets:output_type -> api.v1.UnfreezeUserWalletsResponse
+	11, // 34: api.v1.WalletCommandService.TopupWallet:output_type -> api.v1.TopupWalletResponse
+	13, // 35: api.v1.WalletCommandService.TransferBalance:output_type -> api.v1.TransferBalanceResponse
+	15, // 36: api.v1.WalletCommandService.ResolveTransfer:output_type -> api.v1.ResolveTransferResponse
+	17, // 37: api.v1.WalletCommandService.AuthorizeHold:output_type -> api.v1.AuthorizeHoldResponse
+	19, // 38: api.v1.WalletCommandService.CaptureHold:output_type -> api.v1.CaptureHoldResponse
+	21, // 39: api.v1.WalletCommandService.VoidHold:output_type -> api.v1.VoidHoldResponse
+	23, // 40: api.v1.WalletQueryService.GetWallet:output_type -> api.v1.GetWalletResponse
+	25, // 41: api.v1.WalletQueryService.ListMyWallets:output_type -> api.v1.ListMyWalletsResponse
+	27, // 42: api.v1.WalletQueryService.ListUserWallets:output_type -> api.v1.ListUserWalletsResponse
+	30, // [30:43] is the sub-list for method output_type
+	17, // [17:30] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_wallet_proto_init() }
@@ -735,10 +2361,10 @@ func file_api_v1_wallet_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_wallet_proto_rawDesc), len(file_api_v1_wallet_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_v1_wallet_proto_goTypes,
 		DependencyIndexes: file_api_v1_wallet_proto_depIdxs,
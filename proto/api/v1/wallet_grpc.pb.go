@@ -22,9 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WalletCommandService_CreateWallet_FullMethodName    = "/api.v1.WalletCommandService/CreateWallet"
-	WalletCommandService_TopupWallet_FullMethodName     = "/api.v1.WalletCommandService/TopupWallet"
-	WalletCommandService_TransferBalance_FullMethodName = "/api.v1.WalletCommandService/TransferBalance"
+	WalletCommandService_CreateWallet_FullMethodName        = "/api.v1.WalletCommandService/CreateWallet"
+	WalletCommandService_CreateFXQuote_FullMethodName       = "/api.v1.WalletCommandService/CreateFXQuote"
+	WalletCommandService_FreezeUserWallets_FullMethodName   = "/api.v1.WalletCommandService/FreezeUserWallets"
+	WalletCommandService_UnfreezeUserWallets_FullMethodName = "/api.v1.WalletCommandService/UnfreezeUserWallets"
+	WalletCommandService_TopupWallet_FullMethodName         = "/api.v1.WalletCommandService/TopupWallet"
+	WalletCommandService_TransferBalance_FullMethodName     = "/api.v1.WalletCommandService/TransferBalance"
+	WalletCommandService_ResolveTransfer_FullMethodName     = "/api.v1.WalletCommandService/ResolveTransfer"
+	WalletCommandService_AuthorizeHold_FullMethodName       = "/api.v1.WalletCommandService/AuthorizeHold"
+	WalletCommandService_CaptureHold_FullMethodName         = "/api.v1.WalletCommandService/CaptureHold"
+	WalletCommandService_VoidHold_FullMethodName            = "/api.v1.WalletCommandService/VoidHold"
 )
 
 // WalletCommandServiceClient is the client API for WalletCommandService service.
@@ -37,6 +44,20 @@ type WalletCommandServiceClient interface {
 	//
 	// This endpoint creates a wallet.
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	// Create FX Quote
+	//
+	// This endpoint locks an exchange rate between two currencies for a short time.
+	// The quote can be used once to transfer between wallets of different currencies before it expires.
+	CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error)
+	// Freeze User Wallets
+	//
+	// This endpoint freezes all wallets owned by a user.
+	// Frozen wallet keeps its balance, but it can't be read, topped up, or used in transfer.
+	FreezeUserWallets(ctx context.Context, in *FreezeUserWalletsRequest, opts ...grpc.CallOption) (*FreezeUserWalletsResponse, error)
+	// Unfreeze User Wallets
+	//
+	// This endpoint unfreezes all wallets owned by a user.
+	UnfreezeUserWallets(ctx context.Context, in *UnfreezeUserWalletsRequest, opts ...grpc.CallOption) (*UnfreezeUserWalletsResponse, error)
 	// Topup Wallet
 	//
 	// This endpoint topups a wallet.
@@ -44,7 +65,31 @@ type WalletCommandServiceClient interface {
 	// Transfer Balance
 	//
 	// This endpoint transfers balance from one wallet to another wallet.
+	// Transfer between wallets of different currencies needs an FX quote.
 	TransferBalance(ctx context.Context, in *TransferBalanceRequest, opts ...grpc.CallOption) (*TransferBalanceResponse, error)
+	// Resolve Transfer
+	//
+	// This endpoint tells whether the transfer with the reference has been applied.
+	// If it hasn't, the reference is cancelled so the transfer can never be applied afterwards.
+	// It lets the caller decide the outcome of a transfer whose response is lost, e.g. timed out.
+	ResolveTransfer(ctx context.Context, in *ResolveTransferRequest, opts ...grpc.CallOption) (*ResolveTransferResponse, error)
+	// Authorize Hold
+	//
+	// This endpoint reserves an amount of a wallet's balance for a receiver.
+	// The amount is no longer available to be spent, but it stays in the wallet until the hold is captured.
+	// Hold which is neither captured nor voided is released when it expires.
+	// It is only called by other services, so the payer is taken from the request.
+	AuthorizeHold(ctx context.Context, in *AuthorizeHoldRequest, opts ...grpc.CallOption) (*AuthorizeHoldResponse, error)
+	// Capture Hold
+	//
+	// This endpoint transfers all or part of the held amount to the receiver.
+	// The remaining amount is released, so a hold can only be captured once.
+	// It is only called by the transaction service, so every capture has a transaction record.
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// Void Hold
+	//
+	// This endpoint releases the held amount back to the wallet's available balance.
+	VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error)
 }
 
 type walletCommandServiceClient struct {
@@ -65,6 +110,36 @@ func (c *walletCommandServiceClient) CreateWallet(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *walletCommandServiceClient) CreateFXQuote(ctx context.Context, in *CreateFXQuoteRequest, opts ...grpc.CallOption) (*CreateFXQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFXQuoteResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_CreateFXQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandServiceClient) FreezeUserWallets(ctx context.Context, in *FreezeUserWalletsRequest, opts ...grpc.CallOption) (*FreezeUserWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeUserWalletsResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_FreezeUserWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandServiceClient) UnfreezeUserWallets(ctx context.Context, in *UnfreezeUserWalletsRequest, opts ...grpc.CallOption) (*UnfreezeUserWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeUserWalletsResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_UnfreezeUserWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletCommandServiceClient) TopupWallet(ctx context.Context, in *TopupWalletRequest, opts ...grpc.CallOption) (*TopupWalletResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TopupWalletResponse)
@@ -85,6 +160,46 @@ func (c *walletCommandServiceClient) TransferBalance(ctx context.Context, in *Tr
 	return out, nil
 }
 
+func (c *walletCommandServiceClient) ResolveTransfer(ctx context.Context, in *ResolveTransferRequest, opts ...grpc.CallOption) (*ResolveTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveTransferResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_ResolveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandServiceClient) AuthorizeHold(ctx context.Context, in *AuthorizeHoldRequest, opts ...grpc.CallOption) (*AuthorizeHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeHoldResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_AuthorizeHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandServiceClient) VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidHoldResponse)
+	err := c.cc.Invoke(ctx, WalletCommandService_VoidHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletCommandServiceServer is the server API for WalletCommandService service.
 // All implementations must embed UnimplementedWalletCommandServiceServer
 // for forward compatibility.
@@ -95,6 +210,20 @@ type WalletCommandServiceServer interface {
 	//
 	// This endpoint creates a wallet.
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	// Create FX Quote
+	//
+	// This endpoint locks an exchange rate between two currencies for a short time.
+	// The quote can be used once to transfer between wallets of different currencies before it expires.
+	CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error)
+	// Freeze User Wallets
+	//
+	// This endpoint freezes all wallets owned by a user.
+	// Frozen wallet keeps its balance, but it can't be read, topped up, or used in transfer.
+	FreezeUserWallets(context.Context, *FreezeUserWalletsRequest) (*FreezeUserWalletsResponse, error)
+	// Unfreeze User Wallets
+	//
+	// This endpoint unfreezes all wallets owned by a user.
+	UnfreezeUserWallets(context.Context, *UnfreezeUserWalletsRequest) (*UnfreezeUserWalletsResponse, error)
 	// Topup Wallet
 	//
 	// This endpoint topups a wallet.
@@ -102,7 +231,31 @@ type WalletCommandServiceServer interface {
 	// Transfer Balance
 	//
 	// This endpoint transfers balance from one wallet to another wallet.
+	// Transfer between wallets of different currencies needs an FX quote.
 	TransferBalance(context.Context, *TransferBalanceRequest) (*TransferBalanceResponse, error)
+	// Resolve Transfer
+	//
+	// This endpoint tells whether the transfer with the reference has been applied.
+	// If it hasn't, the reference is cancelled so the transfer can never be applied afterwards.
+	// It lets the caller decide the outcome of a transfer whose response is lost, e.g. timed out.
+	ResolveTransfer(context.Context, *ResolveTransferRequest) (*ResolveTransferResponse, error)
+	// Authorize Hold
+	//
+	// This endpoint reserves an amount of a wallet's balance for a receiver.
+	// The amount is no longer available to be spent, but it stays in the wallet until the hold is captured.
+	// Hold which is neither captured nor voided is released when it expires.
+	// It is only called by other services, so the payer is taken from the request.
+	AuthorizeHold(context.Context, *AuthorizeHoldRequest) (*AuthorizeHoldResponse, error)
+	// Capture Hold
+	//
+	// This endpoint transfers all or part of the held amount to the receiver.
+	// The remaining amount is released, so a hold can only be captured once.
+	// It is only called by the transaction service, so every capture has a transaction record.
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// Void Hold
+	//
+	// This endpoint releases the held amount back to the wallet's available balance.
+	VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error)
 	mustEmbedUnimplementedWalletCommandServiceServer()
 }
 
@@ -116,12 +269,33 @@ type UnimplementedWalletCommandServiceServer struct{}
 func (UnimplementedWalletCommandServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
+func (UnimplementedWalletCommandServiceServer) CreateFXQuote(context.Context, *CreateFXQuoteRequest) (*CreateFXQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFXQuote not implemented")
+}
+func (UnimplementedWalletCommandServiceServer) FreezeUserWallets(context.Context, *FreezeUserWalletsRequest) (*FreezeUserWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeUserWallets not implemented")
+}
+func (UnimplementedWalletCommandServiceServer) UnfreezeUserWallets(context.Context, *UnfreezeUserWalletsRequest) (*UnfreezeUserWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeUserWallets not implemented")
+}
 func (UnimplementedWalletCommandServiceServer) TopupWallet(context.Context, *TopupWalletRequest) (*TopupWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TopupWallet not implemented")
 }
 func (UnimplementedWalletCommandServiceServer) TransferBalance(context.Context, *TransferBalanceRequest) (*TransferBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferBalance not implemented")
 }
+func (UnimplementedWalletCommandServiceServer) ResolveTransfer(context.Context, *ResolveTransferRequest) (*ResolveTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransfer not implemented")
+}
+func (UnimplementedWalletCommandServiceServer) AuthorizeHold(context.Context, *AuthorizeHoldRequest) (*AuthorizeHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeHold not implemented")
+}
+func (UnimplementedWalletCommandServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedWalletCommandServiceServer) VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidHold not implemented")
+}
 func (UnimplementedWalletCommandServiceServer) mustEmbedUnimplementedWalletCommandServiceServer() {}
 func (UnimplementedWalletCommandServiceServer) testEmbeddedByValue()                              {}
 
@@ -161,6 +335,60 @@ func _WalletCommandService_CreateWallet_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletCommandService_CreateFXQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFXQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).CreateFXQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_CreateFXQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).CreateFXQuote(ctx, req.(*CreateFXQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommandService_FreezeUserWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUserWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).FreezeUserWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_FreezeUserWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).FreezeUserWallets(ctx, req.(*FreezeUserWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommandService_UnfreezeUserWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeUserWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).UnfreezeUserWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_UnfreezeUserWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).UnfreezeUserWallets(ctx, req.(*UnfreezeUserWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletCommandService_TopupWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TopupWalletRequest)
 	if err := dec(in); err != nil {
@@ -197,6 +425,78 @@ func _WalletCommandService_TransferBalance_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletCommandService_ResolveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).ResolveTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_ResolveTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).ResolveTransfer(ctx, req.(*ResolveTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommandService_AuthorizeHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).AuthorizeHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_AuthorizeHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).AuthorizeHold(ctx, req.(*AuthorizeHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommandService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommandService_VoidHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServiceServer).VoidHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletCommandService_VoidHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServiceServer).VoidHold(ctx, req.(*VoidHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletCommandService_ServiceDesc is the grpc.ServiceDesc for WalletCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +508,18 @@ var WalletCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateWallet",
 			Handler:    _WalletCommandService_CreateWallet_Handler,
 		},
+		{
+			MethodName: "CreateFXQuote",
+			Handler:    _WalletCommandService_CreateFXQuote_Handler,
+		},
+		{
+			MethodName: "FreezeUserWallets",
+			Handler:    _WalletCommandService_FreezeUserWallets_Handler,
+		},
+		{
+			MethodName: "UnfreezeUserWallets",
+			Handler:    _WalletCommandService_UnfreezeUserWallets_Handler,
+		},
 		{
 			MethodName: "TopupWallet",
 			Handler:    _WalletCommandService_TopupWallet_Handler,
@@ -216,6 +528,226 @@ var WalletCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferBalance",
 			Handler:    _WalletCommandService_TransferBalance_Handler,
 		},
+		{
+			MethodName: "ResolveTransfer",
+			Handler:    _WalletCommandService_ResolveTransfer_Handler,
+		},
+		{
+			MethodName: "AuthorizeHold",
+			Handler:    _WalletCommandService_AuthorizeHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _WalletCommandService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "VoidHold",
+			Handler:    _WalletCommandService_VoidHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/wallet.proto",
+}
+
+const (
+	WalletQueryService_GetWallet_FullMethodName       = "/api.v1.WalletQueryService/GetWallet"
+	WalletQueryService_ListMyWallets_FullMethodName   = "/api.v1.WalletQueryService/ListMyWallets"
+	WalletQueryService_ListUserWallets_FullMethodName = "/api.v1.WalletQueryService/ListUserWallets"
+)
+
+// WalletQueryServiceClient is the client API for WalletQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WalletQueryService provides query service for wallet.
+type WalletQueryServiceClient interface {
+	// Get Wallet
+	//
+	// This endpoint gets a wallet owned by the authenticated user.
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	// List My Wallets
+	//
+	// This endpoint lists all wallets owned by the authenticated user.
+	// Currently, it only retrieves 10 wallets at most.
+	ListMyWallets(ctx context.Context, in *ListMyWalletsRequest, opts ...grpc.CallOption) (*ListMyWalletsResponse, error)
+	// List User Wallets
+	//
+	// This endpoint lists all wallets owned by a user, e.g. to export the user's data.
+	// The frozen wallets are included.
+	ListUserWallets(ctx context.Context, in *ListUserWalletsRequest, opts ...grpc.CallOption) (*ListUserWalletsResponse, error)
+}
+
+type walletQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletQueryServiceClient(cc grpc.ClientConnInterface) WalletQueryServiceClient {
+	return &walletQueryServiceClient{cc}
+}
+
+func (c *walletQueryServiceClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, WalletQueryService_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletQueryServiceClient) ListMyWallets(ctx context.Context, in *ListMyWalletsRequest, opts ...grpc.CallOption) (*ListMyWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyWalletsResponse)
+	err := c.cc.Invoke(ctx, WalletQueryService_ListMyWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletQueryServiceClient) ListUserWallets(ctx context.Context, in *ListUserWalletsRequest, opts ...grpc.CallOption) (*ListUserWalletsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWalletsResponse)
+	err := c.cc.Invoke(ctx, WalletQueryService_ListUserWallets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletQueryServiceServer is the server API for WalletQueryService service.
+// All implementations must embed UnimplementedWalletQueryServiceServer
+// for forward compatibility.
+//
+// WalletQueryService provides query service for wallet.
+type WalletQueryServiceServer interface {
+	// Get Wallet
+	//
+	// This endpoint gets a wallet owned by the authenticated user.
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	// List My Wallets
+	//
+	// This endpoint lists all wallets owned by the authenticated user.
+	// Currently, it only retrieves 10 wallets at most.
+	ListMyWallets(context.Context, *ListMyWalletsRequest) (*ListMyWalletsResponse, error)
+	// List User Wallets
+	//
+	// This endpoint lists all wallets owned by a user, e.g. to export the user's data.
+	// The frozen wallets are included.
+	ListUserWallets(context.Context, *ListUserWalletsRequest) (*ListUserWalletsResponse, error)
+	mustEmbedUnimplementedWalletQueryServiceServer()
+}
+
+// UnimplementedWalletQueryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletQueryServiceServer struct{}
+
+func (UnimplementedWalletQueryServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedWalletQueryServiceServer) ListMyWallets(context.Context, *ListMyWalletsRequest) (*ListMyWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyWallets not implemented")
+}
+func (UnimplementedWalletQueryServiceServer) ListUserWallets(context.Context, *ListUserWalletsRequest) (*ListUserWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserWallets not implemented")
+}
+func (UnimplementedWalletQueryServiceServer) mustEmbedUnimplementedWalletQueryServiceServer() {}
+func (UnimplementedWalletQueryServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeWalletQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletQueryServiceServer will
+// result in compilation errors.
+type UnsafeWalletQueryServiceServer interface {
+	mustEmbedUnimplementedWalletQueryServiceServer()
+}
+
+func RegisterWalletQueryServiceServer(s grpc.ServiceRegistrar, srv WalletQueryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWalletQueryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletQueryService_ServiceDesc, srv)
+}
+
+func _WalletQueryService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletQueryServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletQueryService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletQueryServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletQueryService_ListMyWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletQueryServiceServer).ListMyWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletQueryService_ListMyWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletQueryServiceServer).ListMyWallets(ctx, req.(*ListMyWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletQueryService_ListUserWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletQueryServiceServer).ListUserWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletQueryService_ListUserWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletQueryServiceServer).ListUserWallets(ctx, req.(*ListUserWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletQueryService_ServiceDesc is the grpc.ServiceDesc for WalletQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.WalletQueryService",
+	HandlerType: (*WalletQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWallet",
+			Handler:    _WalletQueryService_GetWallet_Handler,
+		},
+		{
+			MethodName: "ListMyWallets",
+			Handler:    _WalletQueryService_ListMyWallets_Handler,
+		},
+		{
+			MethodName: "ListUserWallets",
+			Handler:    _WalletQueryService_ListUserWallets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/wallet.proto",
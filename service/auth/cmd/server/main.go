@@ -17,7 +17,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	"github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/server"
 	sdklog "github.com/indrasaputra/arjuna/pkg/sdk/log"
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
@@ -65,22 +67,33 @@ func API(_ *cobra.Command, _ []string) {
 	checkError(err)
 	defer pool.Close()
 	queries := builder.BuildQueries(pool, uow.NewTxGetter())
+	txm, err := uow.NewTxManager(pool)
+	checkError(err)
+	redisClient, err := redis.NewRedisClient(cfg.Redis)
+	checkError(err)
+	revocation := redis.NewTokenRevocation(redisClient)
+	sgn, err := builder.BuildSigner(cfg.Token.SigningKeyPath, cfg.Token.VerificationKeyPaths)
+	checkError(err)
 
 	dep := &builder.Dependency{
-		Config:             cfg,
-		SigningKey:         cfg.Token.SecretKey,
-		ExpiryTimeInMinute: cfg.Token.ExpiryTimeInMinutes,
-		Queries:            queries,
+		Config:                    cfg,
+		Signer:                    sgn,
+		ExpiryTimeInMinute:        cfg.Token.ExpiryTimeInMinutes,
+		RefreshExpiryTimeInMinute: cfg.Token.RefreshExpiryTimeInMinutes,
+		Queries:                   queries,
+		TxManager:                 txm,
+		TokenRevocation:           revocation,
 	}
 
 	c := &server.Config{
 		Name:                     cfg.ServiceName,
 		Port:                     cfg.Port,
-		Secret:                   []byte(cfg.Token.SecretKey),
+		KeySet:                   sgn,
 		Username:                 cfg.Username,
 		Password:                 cfg.Password,
 		AppliedBearerAuthMethods: strings.Split(cfg.AppliedAuthBearer, ","),
 		AppliedBasicAuthMethods:  strings.Split(cfg.AppliedAuthBasic, ","),
+		TokenRevocationStore:     interceptor.NewRevocationCache(revocation, interceptor.DefaultRevocationCacheTTL),
 	}
 	srv := server.NewServer(c)
 	registerGrpcService(srv, dep)
@@ -0,0 +1,4 @@
+-- Create "refresh_tokens" table
+CREATE TABLE public.refresh_tokens (id uuid NOT NULL, family_id uuid NOT NULL, account_id uuid NOT NULL, token_hash text NOT NULL, expires_at timestamp NOT NULL, used_at timestamp NULL, revoked_at timestamp NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, created_by uuid NOT NULL, updated_by uuid NOT NULL, PRIMARY KEY (id), CONSTRAINT refresh_tokens_token_hash_key UNIQUE (token_hash));
+-- Create index "index_on_refresh_tokens_on_family_id" to table: "refresh_tokens"
+CREATE INDEX index_on_refresh_tokens_on_family_id ON public.refresh_tokens (family_id);
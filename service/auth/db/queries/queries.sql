@@ -4,4 +4,45 @@ VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: GetAccountByEmail :one
 SELECT * FROM accounts
-WHERE email = $1 LIMIT 1;
+WHERE email = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetAccountByID :one
+SELECT * FROM accounts
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetAccountByUserID :one
+SELECT * FROM accounts
+WHERE user_id = $1 LIMIT 1;
+
+-- name: DeleteAccountByUserID :exec
+DELETE FROM accounts
+WHERE user_id = $1;
+
+-- name: SoftDeleteAccountByUserID :exec
+UPDATE accounts SET deleted_at = NOW(), deleted_by = id, updated_at = NOW(), updated_by = id
+WHERE user_id = $1 AND deleted_at IS NULL;
+
+-- name: RestoreAccountByUserID :exec
+UPDATE accounts SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW(), updated_by = id
+WHERE user_id = $1 AND deleted_at IS NOT NULL;
+
+-- name: EraseAccountByUserID :exec
+UPDATE accounts SET email = @email, password = '', deleted_at = COALESCE(deleted_at, NOW()), deleted_by = COALESCE(deleted_by, id), updated_at = NOW(), updated_by = id
+WHERE user_id = @user_id;
+
+-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (id, family_id, account_id, token_hash, expires_at, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ConsumeRefreshToken :one
+UPDATE refresh_tokens SET used_at = @now::TIMESTAMP, updated_at = @now::TIMESTAMP
+WHERE token_hash = @token_hash AND used_at IS NULL AND revoked_at IS NULL AND expires_at > @now::TIMESTAMP
+RETURNING *;
+
+-- name: GetRefreshTokenByHash :one
+SELECT * FROM refresh_tokens
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens SET revoked_at = @now::TIMESTAMP, updated_at = @now::TIMESTAMP
+WHERE family_id = @family_id AND revoked_at IS NULL;
@@ -19,11 +19,27 @@ type Token struct {
 type Account struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// PasswordHash is the bcrypt hash of the password computed by the caller.
+	// It is used instead of Password when it is set.
+	PasswordHash string `json:"password_hash"`
 	Auditable
 	ID     uuid.UUID `json:"id"`
 	UserID uuid.UUID `json:"user_id"`
 }
 
+// RefreshToken represents a persisted refresh token.
+// Only the hash of the token is stored. Tokens issued from the same login share the same family.
+type RefreshToken struct {
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	Hash      string
+	Auditable
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	AccountID uuid.UUID
+}
+
 // Claims represents token claims.
 type Claims struct {
 	jwt.RegisteredClaims
@@ -32,6 +48,24 @@ type Claims struct {
 	UserID    uuid.UUID `json:"user_id"`
 }
 
+// JSONWebKey represents a public key to verify token in JWK format (RFC 7517).
+// Crv and X are only set for OKP keys, while N and E are only set for RSA keys.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JSONWebKeySet represents a set of JSONWebKey.
+type JSONWebKeySet struct {
+	Keys []*JSONWebKey `json:"keys"`
+}
+
 // Auditable defines logical data related to audit.
 type Auditable struct {
 	CreatedAt time.Time
@@ -143,6 +143,19 @@ func ErrNotFound() error {
 	return res.Err()
 }
 
+// ErrInvalidRefreshToken returns codes.Unauthenticated explained that the refresh token is invalid, expired, or already used.
+func ErrInvalidRefreshToken() error {
+	st := status.New(codes.Unauthenticated, "refresh token is invalid")
+	te := &apiv1.AuthError{
+		ErrorCode: apiv1.AuthErrorCode_AUTH_ERROR_CODE_INVALID_REFRESH_TOKEN,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
 func createBadRequest(details ...*errdetails.BadRequest_FieldViolation) *errdetails.BadRequest {
 	return &errdetails.BadRequest{
 		FieldViolations: details,
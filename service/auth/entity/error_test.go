@@ -90,3 +90,11 @@ func TestErrNotFound(t *testing.T) {
 		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
 	})
 }
+
+func TestErrInvalidRefreshToken(t *testing.T) {
+	t.Run("success get invalid refresh token error", func(t *testing.T) {
+		err := entity.ErrInvalidRefreshToken()
+
+		assert.Contains(t, err.Error(), "rpc error: code = Unauthenticated")
+	})
+}
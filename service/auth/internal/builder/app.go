@@ -1,6 +1,11 @@
 package builder
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdkredis "github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/auth/internal/config"
@@ -8,23 +13,51 @@ import (
 	"github.com/indrasaputra/arjuna/service/auth/internal/repository/db"
 	"github.com/indrasaputra/arjuna/service/auth/internal/repository/postgres"
 	"github.com/indrasaputra/arjuna/service/auth/internal/service"
+	"github.com/indrasaputra/arjuna/service/auth/internal/signer"
 )
 
 // Dependency holds any dependency to build full use cases.
 type Dependency struct {
-	Config             *config.Config
-	Queries            *db.Queries
-	SigningKey         string
-	ExpiryTimeInMinute int
+	Config                    *config.Config
+	Queries                   *db.Queries
+	TxManager                 uow.TxManager
+	TokenRevocation           *sdkredis.TokenRevocation
+	Signer                    *signer.Signer
+	ExpiryTimeInMinute        int
+	RefreshExpiryTimeInMinute int
 }
 
 // BuildAuthHandler builds auth handler including all of its dependencies.
 func BuildAuthHandler(dep *Dependency) (*handler.Auth, error) {
 	acc := postgres.NewAccount(dep.Queries)
-	auth := service.NewAuth(acc, []byte(dep.SigningKey), dep.ExpiryTimeInMinute)
+	token := postgres.NewRefreshToken(dep.Queries)
+	auth := service.NewAuth(acc, token, dep.TokenRevocation, dep.TxManager, dep.Signer, dep.ExpiryTimeInMinute, dep.RefreshExpiryTimeInMinute)
 	return handler.NewAuth(auth), nil
 }
 
+// BuildSigner builds token signer from PEM files.
+// verificationKeyPaths is comma-separated and may be empty.
+func BuildSigner(signingKeyPath, verificationKeyPaths string) (*signer.Signer, error) {
+	signingKey, err := os.ReadFile(filepath.Clean(signingKeyPath))
+	if err != nil {
+		return nil, err
+	}
+
+	var verificationKeys [][]byte
+	for _, path := range strings.Split(verificationKeyPaths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		key, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, err
+		}
+		verificationKeys = append(verificationKeys, key)
+	}
+	return signer.NewSigner(signingKey, verificationKeys...)
+}
+
 // BuildQueries builds sqlc queries.
 func BuildQueries(tr uow.Tr, getter uow.TxGetter) *db.Queries {
 	tx := sdkpostgres.NewTxDB(tr, getter)
@@ -25,6 +25,31 @@ func TestBuildAuthHandler(t *testing.T) {
 	})
 }
 
+func TestBuildSigner(t *testing.T) {
+	keyDir := "../../test/fixture/keys/"
+
+	t.Run("signing key file is not found", func(t *testing.T) {
+		sgn, err := builder.BuildSigner("not-found.pem", "")
+
+		assert.Error(t, err)
+		assert.Nil(t, sgn)
+	})
+
+	t.Run("verification key file is not found", func(t *testing.T) {
+		sgn, err := builder.BuildSigner(keyDir+"signing-key.pem", "not-found.pem")
+
+		assert.Error(t, err)
+		assert.Nil(t, sgn)
+	})
+
+	t.Run("success build signer", func(t *testing.T) {
+		sgn, err := builder.BuildSigner(keyDir+"next-signing-key.pem", keyDir+"signing-key.pub.pem, ")
+
+		assert.NoError(t, err)
+		assert.Len(t, sgn.KeySet().Keys, 2)
+	})
+}
+
 func TestBuildQueries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -5,6 +5,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
 
+	sdkrds "github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	sdkpg "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
 )
@@ -21,13 +22,21 @@ type Config struct {
 	Password          string `env:"PASSWORD,default=auth-password"`
 	AppliedAuthBearer string `env:"APPLIED_AUTH_BEARER"`
 	AppliedAuthBasic  string `env:"APPLIED_AUTH_BASIC"`
+	Redis             sdkrds.Config
 	Token             Token
 }
 
 // Token holds configuration for Token.
+//
+// SigningKeyPath is the path of PEM-encoded RSA or Ed25519 private key that signs new access tokens.
+// VerificationKeyPaths is comma-separated paths of PEM-encoded public keys that are still accepted.
+// To rotate keys without downtime, publish the new public key as verification key first,
+// then swap signing key and keep the old public key as verification key until its tokens expire.
 type Token struct {
-	SecretKey           string `env:"TOKEN_SECRET_KEY,required"`
-	ExpiryTimeInMinutes int    `env:"TOKEN_EXPIRY_TIME_IN_MINUTE,default=5"`
+	SigningKeyPath             string `env:"TOKEN_SIGNING_KEY_PATH,required"`
+	VerificationKeyPaths       string `env:"TOKEN_VERIFICATION_KEY_PATHS"`
+	ExpiryTimeInMinutes        int    `env:"TOKEN_EXPIRY_TIME_IN_MINUTE,default=5"`
+	RefreshExpiryTimeInMinutes int    `env:"TOKEN_REFRESH_EXPIRY_TIME_IN_MINUTE,default=10080"`
 }
 
 // NewConfig creates an instance of Config.
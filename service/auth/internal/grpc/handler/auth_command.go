@@ -4,9 +4,12 @@ import (
 	"context"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/auth/entity"
 	"github.com/indrasaputra/arjuna/service/auth/internal/service"
@@ -41,6 +44,47 @@ func (a *Auth) Login(ctx context.Context, request *apiv1.LoginRequest) (*apiv1.L
 	return &apiv1.LoginResponse{Data: createTokenProto(token)}, nil
 }
 
+// RefreshToken handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (a *Auth) RefreshToken(ctx context.Context, request *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
+	if request == nil || strings.TrimSpace(request.GetRefreshToken()) == "" {
+		return nil, entity.ErrEmptyField("refresh token")
+	}
+
+	token, err := a.auth.RefreshToken(ctx, strings.TrimSpace(request.GetRefreshToken()))
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-RefreshToken] refresh token fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.RefreshTokenResponse{Data: createTokenProto(token)}, nil
+}
+
+// Logout handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (a *Auth) Logout(ctx context.Context, request *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	userID, _ := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+	id, _ := ctx.Value(interceptor.HeaderKeyTokenID).(string)
+	exp, _ := ctx.Value(interceptor.HeaderKeyTokenExpiresAt).(time.Time)
+	if id == "" || userID == uuid.Nil {
+		return nil, entity.ErrUnauthorized()
+	}
+
+	err := a.auth.Logout(ctx, userID, id, exp, request.GetRefreshToken())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-Logout] logout fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.LogoutResponse{}, nil
+}
+
+// GetJWKS handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (a *Auth) GetJWKS(ctx context.Context, _ *apiv1.GetJWKSRequest) (*apiv1.GetJWKSResponse, error) {
+	jwks := a.auth.KeySet(ctx)
+	res := &apiv1.GetJWKSResponse{Keys: make([]*apiv1.JSONWebKey, 0, len(jwks.Keys))}
+	for _, key := range jwks.Keys {
+		res.Keys = append(res.Keys, createJSONWebKeyProto(key))
+	}
+	return res, nil
+}
+
 // RegisterAccount handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
 func (a *Auth) RegisterAccount(ctx context.Context, request *apiv1.RegisterAccountRequest) (*apiv1.RegisterAccountResponse, error) {
 	if err := validateRegisterAccountRequest(request); err != nil {
@@ -56,6 +100,93 @@ func (a *Auth) RegisterAccount(ctx context.Context, request *apiv1.RegisterAccou
 	return &apiv1.RegisterAccountResponse{}, nil
 }
 
+// DeleteAccount handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
+func (a *Auth) DeleteAccount(ctx context.Context, request *apiv1.DeleteAccountRequest) (*apiv1.DeleteAccountResponse, error) {
+	userID, err := parseUserID(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-DeleteAccount] user id invalid", "error", err)
+		return nil, err
+	}
+
+	if err := a.auth.DeleteAccount(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-DeleteAccount] delete fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.DeleteAccountResponse{}, nil
+}
+
+// SoftDeleteAccount handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
+func (a *Auth) SoftDeleteAccount(ctx context.Context, request *apiv1.SoftDeleteAccountRequest) (*apiv1.SoftDeleteAccountResponse, error) {
+	userID, err := parseUserID(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-SoftDeleteAccount] user id invalid", "error", err)
+		return nil, err
+	}
+
+	if err := a.auth.SoftDeleteAccount(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-SoftDeleteAccount] soft delete fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.SoftDeleteAccountResponse{}, nil
+}
+
+// RestoreAccount handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (a *Auth) RestoreAccount(ctx context.Context, request *apiv1.RestoreAccountRequest) (*apiv1.RestoreAccountResponse, error) {
+	userID, err := parseUserID(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-RestoreAccount] user id invalid", "error", err)
+		return nil, err
+	}
+
+	if err := a.auth.RestoreAccount(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-RestoreAccount] restore fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.RestoreAccountResponse{}, nil
+}
+
+// GetAccount handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (a *Auth) GetAccount(ctx context.Context, request *apiv1.GetAccountRequest) (*apiv1.GetAccountResponse, error) {
+	userID, err := parseUserID(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-GetAccount] user id invalid", "error", err)
+		return nil, err
+	}
+
+	account, err := a.auth.GetAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-GetAccount] get fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.GetAccountResponse{Data: createAccountProto(account)}, nil
+}
+
+// EraseAccount handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (a *Auth) EraseAccount(ctx context.Context, request *apiv1.EraseAccountRequest) (*apiv1.EraseAccountResponse, error) {
+	userID, err := parseUserID(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-EraseAccount] user id invalid", "error", err)
+		return nil, err
+	}
+
+	if err := a.auth.EraseAccount(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[AuthHandler-EraseAccount] erase fail", "error", err)
+		return nil, err
+	}
+	return &apiv1.EraseAccountResponse{}, nil
+}
+
+func parseUserID(id string) (uuid.UUID, error) {
+	if strings.TrimSpace(id) == "" {
+		return uuid.Nil, entity.ErrEmptyField("user id")
+	}
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, entity.ErrInvalidArgument("user id is invalid")
+	}
+	return userID, nil
+}
+
 func validateLoginRequest(request *apiv1.LoginRequest) error {
 	if request == nil || request.GetCredential() == nil {
 		return entity.ErrEmptyField("request body")
@@ -79,7 +210,7 @@ func validateRegisterAccountRequest(request *apiv1.RegisterAccountRequest) error
 	if strings.TrimSpace(request.GetAccount().GetEmail()) == "" {
 		return entity.ErrEmptyField("email")
 	}
-	if strings.TrimSpace(request.GetAccount().GetPassword()) == "" {
+	if strings.TrimSpace(request.GetAccount().GetPassword()) == "" && strings.TrimSpace(request.GetAccount().GetPasswordHash()) == "" {
 		return entity.ErrEmptyField("password")
 	}
 	return nil
@@ -87,9 +218,19 @@ func validateRegisterAccountRequest(request *apiv1.RegisterAccountRequest) error
 
 func createAccountFromRegisterAccountRequest(request *apiv1.RegisterAccountRequest) *entity.Account {
 	return &entity.Account{
-		UserID:   uuid.MustParse(request.GetAccount().GetUserId()),
-		Email:    request.GetAccount().GetEmail(),
-		Password: request.GetAccount().GetPassword(),
+		UserID:       uuid.MustParse(request.GetAccount().GetUserId()),
+		Email:        request.GetAccount().GetEmail(),
+		Password:     request.GetAccount().GetPassword(),
+		PasswordHash: request.GetAccount().GetPasswordHash(),
+	}
+}
+
+func createAccountProto(account *entity.Account) *apiv1.Account {
+	return &apiv1.Account{
+		Id:        account.ID.String(),
+		UserId:    account.UserID.String(),
+		Email:     account.Email,
+		CreatedAt: timestamppb.New(account.CreatedAt),
 	}
 }
 
@@ -101,3 +242,21 @@ func createTokenProto(token *entity.Token) *apiv1.Token {
 		RefreshTokenExpiresIn: token.RefreshTokenExpiresIn,
 	}
 }
+
+func createJSONWebKeyProto(key *entity.JSONWebKey) *apiv1.JSONWebKey {
+	res := &apiv1.JSONWebKey{
+		Kty: key.Kty,
+		Kid: key.Kid,
+		Use: key.Use,
+		Alg: key.Alg,
+	}
+	if key.Crv != "" {
+		res.Crv = &key.Crv
+		res.X = &key.X
+	}
+	if key.N != "" {
+		res.N = &key.N
+		res.E = &key.E
+	}
+	return res
+}
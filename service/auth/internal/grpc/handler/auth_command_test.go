@@ -1,12 +1,15 @@
 package handler_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/auth/entity"
 	"github.com/indrasaputra/arjuna/service/auth/internal/grpc/handler"
@@ -85,6 +88,121 @@ func TestAuth_Login(t *testing.T) {
 	})
 }
 
+func TestAuth_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		requests := []*apiv1.RefreshTokenRequest{
+			nil,
+			{RefreshToken: ""},
+			{RefreshToken: "  "},
+		}
+
+		st := createAuthSuite(ctrl)
+		for _, req := range requests {
+			res, err := st.handler.RefreshToken(testCtx, req)
+
+			assert.Error(t, err)
+			assert.Equal(t, entity.ErrEmptyField("refresh token"), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().RefreshToken(testCtx, "token").Return(nil, entity.ErrInvalidRefreshToken())
+
+		res, err := st.handler.RefreshToken(testCtx, &apiv1.RefreshTokenRequest{RefreshToken: "token"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success refresh token", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().RefreshToken(testCtx, "token").Return(&entity.Token{AccessToken: "access", RefreshToken: "refresh"}, nil)
+
+		res, err := st.handler.RefreshToken(testCtx, &apiv1.RefreshTokenRequest{RefreshToken: "token"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "access", res.GetData().GetAccessToken())
+		assert.Equal(t, "refresh", res.GetData().GetRefreshToken())
+	})
+}
+
+func TestAuth_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	exp := time.Now().Add(time.Minute)
+	ctx := context.WithValue(testCtx, interceptor.HeaderKeyUserID, testUserID)
+	ctx = context.WithValue(ctx, interceptor.HeaderKeyTokenID, "jti")
+	ctx = context.WithValue(ctx, interceptor.HeaderKeyTokenExpiresAt, exp)
+
+	t.Run("token id is missing", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		res, err := st.handler.Logout(testCtx, &apiv1.LogoutRequest{})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrUnauthorized(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user id is missing", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		ctx := context.WithValue(testCtx, interceptor.HeaderKeyTokenID, "jti")
+
+		res, err := st.handler.Logout(ctx, &apiv1.LogoutRequest{})
+
+		assert.Equal(t, entity.ErrUnauthorized(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().Logout(ctx, testUserID, "jti", exp, "").Return(assert.AnError)
+
+		res, err := st.handler.Logout(ctx, &apiv1.LogoutRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success logout", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().Logout(ctx, testUserID, "jti", exp, "refresh").Return(nil)
+
+		res, err := st.handler.Logout(ctx, &apiv1.LogoutRequest{RefreshToken: "refresh"})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestAuth_GetJWKS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success get jwks", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		jwks := &entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{
+			{Kty: "OKP", Kid: "ed", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+			{Kty: "RSA", Kid: "rsa", Use: "sig", Alg: "RS256", N: "n", E: "AQAB"},
+		}}
+		st.auth.EXPECT().KeySet(testCtx).Return(jwks)
+
+		res, err := st.handler.GetJWKS(testCtx, &apiv1.GetJWKSRequest{})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetKeys(), 2)
+		assert.Equal(t, "Ed25519", res.GetKeys()[0].GetCrv())
+		assert.Nil(t, res.GetKeys()[0].N)
+		assert.Equal(t, "AQAB", res.GetKeys()[1].GetE())
+		assert.Nil(t, res.GetKeys()[1].X)
+	})
+}
+
 func TestAuth_RegisterAccount(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -134,6 +252,253 @@ func TestAuth_RegisterAccount(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 	})
+
+	t.Run("success Register with password hash", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().Register(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, account *entity.Account) error {
+				assert.Equal(t, "hash", account.PasswordHash)
+				assert.Empty(t, account.Password)
+				return nil
+			})
+
+		req := &apiv1.RegisterAccountRequest{Account: &apiv1.Account{UserId: testUserIDString, Email: testEmail, PasswordHash: "hash"}}
+		res, err := st.handler.RegisterAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestAuth_DeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		tables := []struct {
+			request *apiv1.DeleteAccountRequest
+			err     error
+		}{
+			{request: nil, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.DeleteAccountRequest{UserId: " "}, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.DeleteAccountRequest{UserId: "not-a-uuid"}, err: entity.ErrInvalidArgument("user id is invalid")},
+		}
+
+		for _, table := range tables {
+			res, err := st.handler.DeleteAccount(testCtx, table.request)
+
+			assert.ErrorIs(t, err, table.err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().DeleteAccount(testCtx, uuid.MustParse(testUserIDString)).Return(assert.AnError)
+
+		req := &apiv1.DeleteAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.DeleteAccount(testCtx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success delete account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().DeleteAccount(testCtx, uuid.MustParse(testUserIDString)).Return(nil)
+
+		req := &apiv1.DeleteAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.DeleteAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestAuth_SoftDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		tables := []struct {
+			request *apiv1.SoftDeleteAccountRequest
+			err     error
+		}{
+			{request: nil, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.SoftDeleteAccountRequest{UserId: " "}, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.SoftDeleteAccountRequest{UserId: "not-a-uuid"}, err: entity.ErrInvalidArgument("user id is invalid")},
+		}
+
+		for _, table := range tables {
+			res, err := st.handler.SoftDeleteAccount(testCtx, table.request)
+
+			assert.ErrorIs(t, err, table.err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().SoftDeleteAccount(testCtx, uuid.MustParse(testUserIDString)).Return(assert.AnError)
+
+		req := &apiv1.SoftDeleteAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.SoftDeleteAccount(testCtx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success soft delete account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().SoftDeleteAccount(testCtx, uuid.MustParse(testUserIDString)).Return(nil)
+
+		req := &apiv1.SoftDeleteAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.SoftDeleteAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestAuth_RestoreAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		tables := []struct {
+			request *apiv1.RestoreAccountRequest
+			err     error
+		}{
+			{request: nil, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.RestoreAccountRequest{UserId: " "}, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.RestoreAccountRequest{UserId: "not-a-uuid"}, err: entity.ErrInvalidArgument("user id is invalid")},
+		}
+
+		for _, table := range tables {
+			res, err := st.handler.RestoreAccount(testCtx, table.request)
+
+			assert.ErrorIs(t, err, table.err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().RestoreAccount(testCtx, uuid.MustParse(testUserIDString)).Return(assert.AnError)
+
+		req := &apiv1.RestoreAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.RestoreAccount(testCtx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success restore account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().RestoreAccount(testCtx, uuid.MustParse(testUserIDString)).Return(nil)
+
+		req := &apiv1.RestoreAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.RestoreAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestAuth_GetAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		tables := []struct {
+			request *apiv1.GetAccountRequest
+			err     error
+		}{
+			{request: nil, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.GetAccountRequest{UserId: "not-a-uuid"}, err: entity.ErrInvalidArgument("user id is invalid")},
+		}
+
+		for _, table := range tables {
+			res, err := st.handler.GetAccount(testCtx, table.request)
+
+			assert.ErrorIs(t, err, table.err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().GetAccount(testCtx, uuid.MustParse(testUserIDString)).Return(nil, entity.ErrNotFound())
+
+		req := &apiv1.GetAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.GetAccount(testCtx, req)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := &entity.Account{UserID: uuid.MustParse(testUserIDString), Email: "first@account.com"}
+		st.auth.EXPECT().GetAccount(testCtx, uuid.MustParse(testUserIDString)).Return(account, nil)
+
+		req := &apiv1.GetAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.GetAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testUserIDString, res.GetData().GetUserId())
+		assert.Equal(t, "first@account.com", res.GetData().GetEmail())
+		assert.Empty(t, res.GetData().GetPassword())
+	})
+}
+
+func TestAuth_EraseAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("request is invalid", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		tables := []struct {
+			request *apiv1.EraseAccountRequest
+			err     error
+		}{
+			{request: nil, err: entity.ErrEmptyField("user id")},
+			{request: &apiv1.EraseAccountRequest{UserId: "not-a-uuid"}, err: entity.ErrInvalidArgument("user id is invalid")},
+		}
+
+		for _, table := range tables {
+			res, err := st.handler.EraseAccount(testCtx, table.request)
+
+			assert.ErrorIs(t, err, table.err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("auth service returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().EraseAccount(testCtx, uuid.MustParse(testUserIDString)).Return(assert.AnError)
+
+		req := &apiv1.EraseAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.EraseAccount(testCtx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success erase account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		st.auth.EXPECT().EraseAccount(testCtx, uuid.MustParse(testUserIDString)).Return(nil)
+
+		req := &apiv1.EraseAccountRequest{UserId: testUserIDString}
+		res, err := st.handler.EraseAccount(testCtx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
 }
 
 func createAuthSuite(ctrl *gomock.Controller) *AuthSuite {
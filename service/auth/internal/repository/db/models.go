@@ -22,3 +22,17 @@ type Account struct {
 	CreatedBy uuid.UUID
 	UpdatedBy uuid.UUID
 }
+
+type RefreshToken struct {
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	TokenHash string
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	AccountID uuid.UUID
+	CreatedBy uuid.UUID
+	UpdatedBy uuid.UUID
+}
@@ -12,6 +12,36 @@ import (
 	"github.com/google/uuid"
 )
 
+const consumeRefreshToken = `-- name: ConsumeRefreshToken :one
+UPDATE refresh_tokens SET used_at = $1::TIMESTAMP, updated_at = $1::TIMESTAMP
+WHERE token_hash = $2 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > $1::TIMESTAMP
+RETURNING id, family_id, account_id, token_hash, expires_at, used_at, revoked_at, created_at, updated_at, created_by, updated_by
+`
+
+type ConsumeRefreshTokenParams struct {
+	Now       time.Time
+	TokenHash string
+}
+
+func (q *Queries) ConsumeRefreshToken(ctx context.Context, arg ConsumeRefreshTokenParams) (*RefreshToken, error) {
+	row := q.db.QueryRow(ctx, consumeRefreshToken, arg.Now, arg.TokenHash)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.FamilyID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+	)
+	return &i, err
+}
+
 const createAccount = `-- name: CreateAccount :exec
 INSERT INTO accounts (id, user_id, email, password, created_at, updated_at, created_by, updated_by)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -42,9 +72,66 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) er
 	return err
 }
 
+const createRefreshToken = `-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (id, family_id, account_id, token_hash, expires_at, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateRefreshTokenParams struct {
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	TokenHash string
+	ID        uuid.UUID
+	FamilyID  uuid.UUID
+	AccountID uuid.UUID
+	CreatedBy uuid.UUID
+	UpdatedBy uuid.UUID
+}
+
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) error {
+	_, err := q.db.Exec(ctx, createRefreshToken,
+		arg.ID,
+		arg.FamilyID,
+		arg.AccountID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.CreatedBy,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const deleteAccountByUserID = `-- name: DeleteAccountByUserID :exec
+DELETE FROM accounts
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAccountByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountByUserID, userID)
+	return err
+}
+
+const eraseAccountByUserID = `-- name: EraseAccountByUserID :exec
+UPDATE accounts SET email = $1, password = '', deleted_at = COALESCE(deleted_at, NOW()), deleted_by = COALESCE(deleted_by, id), updated_at = NOW(), updated_by = id
+WHERE user_id = $2
+`
+
+type EraseAccountByUserIDParams struct {
+	Email  string
+	UserID uuid.UUID
+}
+
+func (q *Queries) EraseAccountByUserID(ctx context.Context, arg EraseAccountByUserIDParams) error {
+	_, err := q.db.Exec(ctx, eraseAccountByUserID, arg.Email, arg.UserID)
+	return err
+}
+
 const getAccountByEmail = `-- name: GetAccountByEmail :one
 SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts
-WHERE email = $1 LIMIT 1
+WHERE email = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAccountByEmail(ctx context.Context, email string) (*Account, error) {
@@ -64,3 +151,108 @@ func (q *Queries) GetAccountByEmail(ctx context.Context, email string) (*Account
 	)
 	return &i, err
 }
+
+const getAccountByID = `-- name: GetAccountByID :one
+SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetAccountByID(ctx context.Context, id uuid.UUID) (*Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByID, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+	)
+	return &i, err
+}
+
+const getAccountByUserID = `-- name: GetAccountByUserID :one
+SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts
+WHERE user_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAccountByUserID(ctx context.Context, userID uuid.UUID) (*Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByUserID, userID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+	)
+	return &i, err
+}
+
+const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
+SELECT id, family_id, account_id, token_hash, expires_at, used_at, revoked_at, created_at, updated_at, created_by, updated_by FROM refresh_tokens
+WHERE token_hash = $1 LIMIT 1
+`
+
+func (q *Queries) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByHash, tokenHash)
+	var i RefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.FamilyID,
+		&i.AccountID,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+	)
+	return &i, err
+}
+
+const restoreAccountByUserID = `-- name: RestoreAccountByUserID :exec
+UPDATE accounts SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW(), updated_by = id
+WHERE user_id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreAccountByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, restoreAccountByUserID, userID)
+	return err
+}
+
+const revokeRefreshTokenFamily = `-- name: RevokeRefreshTokenFamily :exec
+UPDATE refresh_tokens SET revoked_at = $1::TIMESTAMP, updated_at = $1::TIMESTAMP
+WHERE family_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeRefreshTokenFamilyParams struct {
+	Now      time.Time
+	FamilyID uuid.UUID
+}
+
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, arg RevokeRefreshTokenFamilyParams) error {
+	_, err := q.db.Exec(ctx, revokeRefreshTokenFamily, arg.Now, arg.FamilyID)
+	return err
+}
+
+const softDeleteAccountByUserID = `-- name: SoftDeleteAccountByUserID :exec
+UPDATE accounts SET deleted_at = NOW(), deleted_by = id, updated_at = NOW(), updated_by = id
+WHERE user_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteAccountByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, softDeleteAccountByUserID, userID)
+	return err
+}
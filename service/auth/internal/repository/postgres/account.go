@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
@@ -48,7 +49,38 @@ func (a *Account) Insert(ctx context.Context, account *entity.Account) error {
 	return nil
 }
 
+// DeleteByUserID deletes the account owned by the user.
+// It doesn't return error if the account doesn't exist.
+func (a *Account) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if err := a.queries.DeleteAccountByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-DeleteByUserID] fail delete account", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// SoftDeleteByUserID soft-deletes the account owned by the user.
+// It doesn't return error if the account doesn't exist or has been soft-deleted.
+func (a *Account) SoftDeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if err := a.queries.SoftDeleteAccountByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-SoftDeleteByUserID] fail soft delete account", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// RestoreByUserID restores the soft-deleted account owned by the user.
+// It doesn't return error if the account doesn't exist or isn't soft-deleted.
+func (a *Account) RestoreByUserID(ctx context.Context, userID uuid.UUID) error {
+	if err := a.queries.RestoreAccountByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-RestoreByUserID] fail restore account", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
 // GetByEmail gets an account by email.
+// Soft-deleted account is treated as not found.
 func (a *Account) GetByEmail(ctx context.Context, email string) (*entity.Account, error) {
 	account, err := a.queries.GetAccountByEmail(ctx, email)
 	if err == pgx.ErrNoRows {
@@ -66,3 +98,57 @@ func (a *Account) GetByEmail(ctx context.Context, email string) (*entity.Account
 		Password: account.Password,
 	}, nil
 }
+
+// GetByID gets an account by id.
+// Soft-deleted account is treated as not found.
+func (a *Account) GetByID(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
+	account, err := a.queries.GetAccountByID(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-GetByID] fail get account", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	return &entity.Account{
+		ID:       account.ID,
+		UserID:   account.UserID,
+		Email:    account.Email,
+		Password: account.Password,
+	}, nil
+}
+
+// GetByUserID gets the account owned by the user.
+// Soft-deleted account is included, so the data of a deleted user can still be exported.
+func (a *Account) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.Account, error) {
+	account, err := a.queries.GetAccountByUserID(ctx, userID)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-GetByUserID] fail get account", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	res := &entity.Account{
+		ID:       account.ID,
+		UserID:   account.UserID,
+		Email:    account.Email,
+		Password: account.Password,
+	}
+	res.CreatedAt = account.CreatedAt
+	res.UpdatedAt = account.UpdatedAt
+	return res, nil
+}
+
+// EraseByUserID replaces the email of the account owned by the user and removes its password.
+// The account is soft-deleted if it hasn't been. It doesn't return error if the account doesn't exist.
+func (a *Account) EraseByUserID(ctx context.Context, userID uuid.UUID, email string) error {
+	param := db.EraseAccountByUserIDParams{UserID: userID, Email: email}
+	if err := a.queries.EraseAccountByUserID(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresAccount-EraseByUserID] fail erase account", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
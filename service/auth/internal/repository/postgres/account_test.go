@@ -92,10 +92,127 @@ func TestAccount_Insert(t *testing.T) {
 	})
 }
 
+func TestAccount_DeleteByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `DELETE FROM accounts WHERE user_id = \$1`
+
+	t.Run("delete returns error", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnError(assert.AnError)
+
+		err := st.account.DeleteByUserID(testCtx, account.UserID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("account doesn't exist", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := st.account.DeleteByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success delete account", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := st.account.DeleteByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAccount_SoftDeleteByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE accounts SET deleted_at = NOW\(\), deleted_by = id, updated_at = NOW\(\), updated_by = id WHERE user_id = \$1 AND deleted_at IS NULL`
+
+	t.Run("soft delete returns error", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnError(assert.AnError)
+
+		err := st.account.SoftDeleteByUserID(testCtx, account.UserID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("account doesn't exist or has been soft-deleted", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := st.account.SoftDeleteByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success soft delete account", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.account.SoftDeleteByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAccount_RestoreByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE accounts SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW\(\), updated_by = id WHERE user_id = \$1 AND deleted_at IS NOT NULL`
+
+	t.Run("restore returns error", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnError(assert.AnError)
+
+		err := st.account.RestoreByUserID(testCtx, account.UserID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("account doesn't exist or isn't soft-deleted", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := st.account.RestoreByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success restore account", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.account.RestoreByUserID(testCtx, account.UserID)
+
+		assert.NoError(t, err)
+	})
+}
+
 func TestAccount_GetByEmail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	query := `SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts WHERE email = \$1 LIMIT 1`
+	query := `SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts WHERE email = \$1 AND deleted_at IS NULL LIMIT 1`
 
 	t.Run("get by email returns empty row", func(t *testing.T) {
 		acc := createTestAccount()
@@ -137,6 +254,136 @@ func TestAccount_GetByEmail(t *testing.T) {
 	})
 }
 
+func TestAccount_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts WHERE id = \$1 AND deleted_at IS NULL LIMIT 1`
+
+	t.Run("get by id returns empty row", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.ID).WillReturnError(sdkpostgres.ErrNotFound)
+
+		res, err := st.account.GetByID(testCtx, acc.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get by id returns error", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.ID).WillReturnError(assert.AnError)
+
+		res, err := st.account.GetByID(testCtx, acc.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success select by id", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.ID).WillReturnRows(
+			pgxmock.NewRows([]string{"id", "user_id", "email", "password", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
+				AddRow(acc.ID, acc.UserID, acc.Email, acc.Password, acc.CreatedAt, acc.UpdatedAt, acc.DeletedAt, acc.CreatedBy, acc.UpdatedBy, acc.DeletedBy))
+
+		res, err := st.account.GetByID(testCtx, acc.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Email, res.Email)
+	})
+}
+
+func TestAccount_GetByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, user_id, email, password, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM accounts WHERE user_id = \$1 LIMIT 1`
+
+	t.Run("get by user id returns empty row", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.UserID).WillReturnError(sdkpostgres.ErrNotFound)
+
+		res, err := st.account.GetByUserID(testCtx, acc.UserID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get by user id returns error", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.UserID).WillReturnError(assert.AnError)
+
+		res, err := st.account.GetByUserID(testCtx, acc.UserID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success select by user id", func(t *testing.T) {
+		acc := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(acc.UserID).WillReturnRows(
+			pgxmock.NewRows([]string{"id", "user_id", "email", "password", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
+				AddRow(acc.ID, acc.UserID, acc.Email, acc.Password, acc.CreatedAt, acc.UpdatedAt, acc.DeletedAt, acc.CreatedBy, acc.UpdatedBy, acc.DeletedBy))
+
+		res, err := st.account.GetByUserID(testCtx, acc.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Email, res.Email)
+		assert.Equal(t, acc.CreatedAt, res.CreatedAt)
+	})
+}
+
+func TestAccount_EraseByUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE accounts SET email = \$1, password = '', deleted_at = COALESCE\(deleted_at, NOW\(\)\), deleted_by = COALESCE\(deleted_by, id\), updated_at = NOW\(\), updated_by = id WHERE user_id = \$2`
+	email := "erased@erased.invalid"
+
+	t.Run("erase returns error", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(email, account.UserID).WillReturnError(assert.AnError)
+
+		err := st.account.EraseByUserID(testCtx, account.UserID, email)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("account doesn't exist", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(email, account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := st.account.EraseByUserID(testCtx, account.UserID, email)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success erase account", func(t *testing.T) {
+		account := createTestAccount()
+		st := createAccountSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(email, account.UserID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.account.EraseByUserID(testCtx, account.UserID, email)
+
+		assert.NoError(t, err)
+	})
+}
+
 func createTestAccount() *entity.Account {
 	return &entity.Account{
 		ID:       uuid.Must(uuid.NewV7()),
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	"github.com/indrasaputra/arjuna/service/auth/internal/repository/db"
+)
+
+// RefreshToken is responsible to connect refresh token entity with refresh_tokens table in PostgreSQL.
+type RefreshToken struct {
+	queries *db.Queries
+}
+
+// NewRefreshToken creates an instance of RefreshToken.
+func NewRefreshToken(q *db.Queries) *RefreshToken {
+	return &RefreshToken{queries: q}
+}
+
+// Insert inserts a refresh token to the database.
+func (r *RefreshToken) Insert(ctx context.Context, token *entity.RefreshToken) error {
+	if token == nil {
+		return entity.ErrInvalidRefreshToken()
+	}
+
+	param := db.CreateRefreshTokenParams{
+		ID:        token.ID,
+		FamilyID:  token.FamilyID,
+		AccountID: token.AccountID,
+		TokenHash: token.Hash,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+		UpdatedAt: token.UpdatedAt,
+		CreatedBy: token.CreatedBy,
+		UpdatedBy: token.UpdatedBy,
+	}
+	if err := r.queries.CreateRefreshToken(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresRefreshToken-Insert] fail insert refresh token", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Consume marks an active refresh token as used and returns it.
+// It returns not found error if the token doesn't exist, is expired, has been used, or has been revoked.
+// Since it is a single conditional update, two concurrent calls can't consume the same token.
+func (r *RefreshToken) Consume(ctx context.Context, hash string, now time.Time) (*entity.RefreshToken, error) {
+	param := db.ConsumeRefreshTokenParams{TokenHash: hash, Now: now}
+	token, err := r.queries.ConsumeRefreshToken(ctx, param)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresRefreshToken-Consume] fail consume refresh token", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createRefreshTokenFromModel(token), nil
+}
+
+// GetByHash gets a refresh token by its hash regardless of its state.
+func (r *RefreshToken) GetByHash(ctx context.Context, hash string) (*entity.RefreshToken, error) {
+	token, err := r.queries.GetRefreshTokenByHash(ctx, hash)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresRefreshToken-GetByHash] fail get refresh token", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createRefreshTokenFromModel(token), nil
+}
+
+// RevokeFamily revokes all refresh tokens in the family.
+func (r *RefreshToken) RevokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	param := db.RevokeRefreshTokenFamilyParams{FamilyID: familyID, Now: now}
+	if err := r.queries.RevokeRefreshTokenFamily(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresRefreshToken-RevokeFamily] fail revoke refresh token family", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+func createRefreshTokenFromModel(token *db.RefreshToken) *entity.RefreshToken {
+	res := &entity.RefreshToken{
+		ID:        token.ID,
+		FamilyID:  token.FamilyID,
+		AccountID: token.AccountID,
+		Hash:      token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		UsedAt:    token.UsedAt,
+		RevokedAt: token.RevokedAt,
+	}
+	res.CreatedAt = token.CreatedAt
+	res.UpdatedAt = token.UpdatedAt
+	res.CreatedBy = token.CreatedBy
+	res.UpdatedBy = token.UpdatedBy
+	return res
+}
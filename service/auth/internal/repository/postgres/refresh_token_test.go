@@ -0,0 +1,225 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	"github.com/indrasaputra/arjuna/service/auth/internal/repository/db"
+	"github.com/indrasaputra/arjuna/service/auth/internal/repository/postgres"
+)
+
+var (
+	refreshTokenColumns = []string{"id", "family_id", "account_id", "token_hash", "expires_at", "used_at", "revoked_at", "created_at", "updated_at", "created_by", "updated_by"}
+)
+
+type RefreshTokenSuite struct {
+	token  *postgres.RefreshToken
+	db     pgxmock.PgxPoolIface
+	getter *mock_uow.MockTxGetter
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of RefreshToken", func(t *testing.T) {
+		st := createRefreshTokenSuite(t, ctrl)
+		assert.NotNil(t, st.token)
+	})
+}
+
+func TestRefreshToken_Insert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO refresh_tokens \(id, family_id, account_id, token_hash, expires_at, created_at, updated_at, created_by, updated_by\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\)`
+
+	t.Run("nil refresh token is prohibited", func(t *testing.T) {
+		st := createRefreshTokenSuite(t, ctrl)
+
+		err := st.token.Insert(testCtx, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("insert returns error", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(token.ID, token.FamilyID, token.AccountID, token.Hash, token.ExpiresAt, token.CreatedAt, token.UpdatedAt, token.CreatedBy, token.UpdatedBy).
+			WillReturnError(assert.AnError)
+
+		err := st.token.Insert(testCtx, token)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success insert refresh token", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(token.ID, token.FamilyID, token.AccountID, token.Hash, token.ExpiresAt, token.CreatedAt, token.UpdatedAt, token.CreatedBy, token.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.token.Insert(testCtx, token)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestRefreshToken_Consume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE refresh_tokens SET used_at = \$1::TIMESTAMP, updated_at = \$1::TIMESTAMP WHERE token_hash = \$2 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > \$1::TIMESTAMP RETURNING`
+	now := time.Now().UTC()
+
+	t.Run("token can't be consumed", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(now, token.Hash).WillReturnError(sdkpostgres.ErrNotFound)
+
+		res, err := st.token.Consume(testCtx, token.Hash, now)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("consume returns error", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(now, token.Hash).WillReturnError(assert.AnError)
+
+		res, err := st.token.Consume(testCtx, token.Hash, now)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success consume token", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(now, token.Hash).WillReturnRows(
+			pgxmock.NewRows(refreshTokenColumns).
+				AddRow(token.ID, token.FamilyID, token.AccountID, token.Hash, token.ExpiresAt, &now, token.RevokedAt, token.CreatedAt, token.UpdatedAt, token.CreatedBy, token.UpdatedBy))
+
+		res, err := st.token.Consume(testCtx, token.Hash, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token.FamilyID, res.FamilyID)
+		assert.Equal(t, token.AccountID, res.AccountID)
+		assert.NotNil(t, res.UsedAt)
+	})
+}
+
+func TestRefreshToken_GetByHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, family_id, account_id, token_hash, expires_at, used_at, revoked_at, created_at, updated_at, created_by, updated_by FROM refresh_tokens WHERE token_hash = \$1 LIMIT 1`
+
+	t.Run("token is not found", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(token.Hash).WillReturnError(sdkpostgres.ErrNotFound)
+
+		res, err := st.token.GetByHash(testCtx, token.Hash)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get by hash returns error", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(token.Hash).WillReturnError(assert.AnError)
+
+		res, err := st.token.GetByHash(testCtx, token.Hash)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get by hash", func(t *testing.T) {
+		token := createTestRefreshToken()
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(token.Hash).WillReturnRows(
+			pgxmock.NewRows(refreshTokenColumns).
+				AddRow(token.ID, token.FamilyID, token.AccountID, token.Hash, token.ExpiresAt, token.UsedAt, token.RevokedAt, token.CreatedAt, token.UpdatedAt, token.CreatedBy, token.UpdatedBy))
+
+		res, err := st.token.GetByHash(testCtx, token.Hash)
+
+		assert.NoError(t, err)
+		assert.Equal(t, token.ID, res.ID)
+	})
+}
+
+func TestRefreshToken_RevokeFamily(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE refresh_tokens SET revoked_at = \$1::TIMESTAMP, updated_at = \$1::TIMESTAMP WHERE family_id = \$2 AND revoked_at IS NULL`
+	now := time.Now().UTC()
+
+	t.Run("revoke returns error", func(t *testing.T) {
+		familyID := uuid.Must(uuid.NewV7())
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(now, familyID).WillReturnError(assert.AnError)
+
+		err := st.token.RevokeFamily(testCtx, familyID, now)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success revoke family", func(t *testing.T) {
+		familyID := uuid.Must(uuid.NewV7())
+		st := createRefreshTokenSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(now, familyID).WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+		err := st.token.RevokeFamily(testCtx, familyID, now)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createTestRefreshToken() *entity.RefreshToken {
+	return &entity.RefreshToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		FamilyID:  uuid.Must(uuid.NewV7()),
+		AccountID: uuid.Must(uuid.NewV7()),
+		Hash:      "hash",
+		ExpiresAt: time.Now().Add(time.Hour).UTC(),
+	}
+}
+
+func createRefreshTokenSuite(t *testing.T, ctrl *gomock.Controller) *RefreshTokenSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	tx := sdkpostgres.NewTxDB(pool, g)
+	q := db.New(tx)
+	r := postgres.NewRefreshToken(q)
+	return &RefreshTokenSuite{
+		token:  r,
+		db:     pool,
+		getter: g,
+	}
+}
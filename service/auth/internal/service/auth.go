@@ -2,6 +2,12 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/mail"
 	"strings"
@@ -13,44 +19,121 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/auth/entity"
 )
 
 const (
-	tokenIssuer = "auth-service"
-	timeMinute  = 60
+	tokenIssuer       = "auth-service"
+	timeMinute        = 60
+	refreshTokenBytes = 32
+	// erasedEmailDomain uses reserved top level domain, so the erased email can never be delivered.
+	erasedEmailDomain = "erased.invalid"
 )
 
 // Authentication defines the interface to authenticate.
 type Authentication interface {
 	// Login logs in a user using email and password.
 	Login(ctx context.Context, email, password string) (*entity.Token, error)
+	// RefreshToken exchanges a refresh token with a new pair of tokens.
+	RefreshToken(ctx context.Context, token string) (*entity.Token, error)
+	// Logout revokes the user's access token and, if given, the refresh token's family.
+	Logout(ctx context.Context, userID uuid.UUID, tokenID string, expiresAt time.Time, refreshToken string) error
+	// KeySet returns public keys to verify access token.
+	KeySet(ctx context.Context) *entity.JSONWebKeySet
 	// Register registers an account.
 	Register(ctx context.Context, account *entity.Account) error
+	// DeleteAccount deletes the account owned by the user.
+	DeleteAccount(ctx context.Context, userID uuid.UUID) error
+	// SoftDeleteAccount soft-deletes the account owned by the user.
+	SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error
+	// RestoreAccount restores the soft-deleted account owned by the user.
+	RestoreAccount(ctx context.Context, userID uuid.UUID) error
+	// GetAccount gets the account owned by the user without its password.
+	GetAccount(ctx context.Context, userID uuid.UUID) (*entity.Account, error)
+	// EraseAccount anonymizes the account owned by the user.
+	EraseAccount(ctx context.Context, userID uuid.UUID) error
 }
 
 // AuthRepository defines the interface to authenticate.
 type AuthRepository interface {
 	// GetByEmail gets an account by email.
 	GetByEmail(ctx context.Context, email string) (*entity.Account, error)
+	// GetByID gets an account by id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Account, error)
 	// Insert inserts an account.
 	Insert(ctx context.Context, account *entity.Account) error
+	// DeleteByUserID deletes the account owned by the user.
+	// It must not return error if the account doesn't exist.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	// SoftDeleteByUserID soft-deletes the account owned by the user.
+	// It must not return error if the account doesn't exist or has been soft-deleted.
+	SoftDeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	// RestoreByUserID restores the soft-deleted account owned by the user.
+	// It must not return error if the account doesn't exist or isn't soft-deleted.
+	RestoreByUserID(ctx context.Context, userID uuid.UUID) error
+	// GetByUserID gets the account owned by the user, including the soft-deleted one.
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.Account, error)
+	// EraseByUserID replaces the email of the account owned by the user, removes its password and soft-deletes it.
+	// It must not return error if the account doesn't exist.
+	EraseByUserID(ctx context.Context, userID uuid.UUID, email string) error
+}
+
+// RefreshTokenRepository defines the interface to persist refresh token.
+type RefreshTokenRepository interface {
+	// Insert inserts a refresh token.
+	Insert(ctx context.Context, token *entity.RefreshToken) error
+	// Consume marks an active refresh token as used and returns it.
+	Consume(ctx context.Context, hash string, now time.Time) (*entity.RefreshToken, error)
+	// GetByHash gets a refresh token by its hash regardless of its state.
+	GetByHash(ctx context.Context, hash string) (*entity.RefreshToken, error)
+	// RevokeFamily revokes all refresh tokens in the family.
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error
+}
+
+// TokenRevocationRepository defines the interface to revoke access token.
+type TokenRevocationRepository interface {
+	// Revoke revokes the access token's id for the given TTL.
+	Revoke(ctx context.Context, id string, ttl time.Duration) error
+	// RevokeSubject revokes all access tokens of the subject issued at or before revokedAt for the given TTL.
+	RevokeSubject(ctx context.Context, subject string, revokedAt time.Time, ttl time.Duration) error
+}
+
+// TokenSigner defines the interface to sign access token.
+type TokenSigner interface {
+	// Sign signs the claims.
+	Sign(claims jwt.Claims) (string, error)
+	// KeySet returns public keys to verify the signature.
+	KeySet() *entity.JSONWebKeySet
 }
 
 // Auth is responsible for authentication.
 type Auth struct {
-	repo            AuthRepository
-	signingKey      []byte
-	tokenExpiration int
+	repo                   AuthRepository
+	tokenRepo              RefreshTokenRepository
+	revocationRepo         TokenRevocationRepository
+	txManager              uow.TxManager
+	signer                 TokenSigner
+	tokenExpiration        int
+	refreshTokenExpiration int
 }
 
 // NewAuth creates an instance of Auth.
-func NewAuth(repo AuthRepository, key []byte, exp int) *Auth {
-	return &Auth{repo: repo, tokenExpiration: exp, signingKey: key}
+// Both exp and refreshExp are in minutes.
+func NewAuth(repo AuthRepository, tokenRepo RefreshTokenRepository, revocationRepo TokenRevocationRepository, txm uow.TxManager, signer TokenSigner, exp, refreshExp int) *Auth {
+	return &Auth{
+		repo:                   repo,
+		tokenRepo:              tokenRepo,
+		revocationRepo:         revocationRepo,
+		txManager:              txm,
+		signer:                 signer,
+		tokenExpiration:        exp,
+		refreshTokenExpiration: refreshExp,
+	}
 }
 
 // Login logs in a user using email and password.
-// As of now, refresh token is not implemented and it only returns access token.
+// It returns an access token and a refresh token which starts a new token family.
 func (a *Auth) Login(ctx context.Context, email, password string) (*entity.Token, error) {
 	if err := validateLoginParams(email, password); err != nil {
 		slog.ErrorContext(ctx, "[Auth-Login] param invalid", "error", err)
@@ -68,7 +151,48 @@ func (a *Auth) Login(ctx context.Context, email, password string) (*entity.Token
 	if err != nil {
 		return nil, entity.ErrInvalidCredential()
 	}
-	return createAccessToken(account, a.signingKey, a.tokenExpiration)
+	return a.issueToken(ctx, account, generateUniqueID())
+}
+
+// RefreshToken exchanges a refresh token with a new pair of tokens.
+// A refresh token can only be used once. The new refresh token belongs to the same family as the old one.
+// If a used refresh token is presented again, it is treated as stolen and the whole family is revoked.
+func (a *Auth) RefreshToken(ctx context.Context, token string) (*entity.Token, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, entity.ErrEmptyField("refresh token")
+	}
+
+	hash := hashRefreshToken(token)
+	var res *entity.Token
+	err := a.txManager.Do(ctx, func(ctx context.Context) error {
+		current, err := a.tokenRepo.Consume(ctx, hash, time.Now().UTC())
+		if status.Code(err) == codes.NotFound {
+			return entity.ErrInvalidRefreshToken()
+		}
+		if err != nil {
+			return err
+		}
+
+		account, err := a.repo.GetByID(ctx, current.AccountID)
+		if status.Code(err) == codes.NotFound {
+			return entity.ErrInvalidRefreshToken()
+		}
+		if err != nil {
+			return err
+		}
+
+		res, err = a.issueToken(ctx, account, current.FamilyID)
+		return err
+	})
+	if errors.Is(err, entity.ErrInvalidRefreshToken()) {
+		a.revokeReusedFamily(ctx, hash)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-RefreshToken] fail refresh token", "error", err)
+		return nil, err
+	}
+	return res, nil
 }
 
 // Register registers an account.
@@ -82,11 +206,12 @@ func (a *Auth) Register(ctx context.Context, account *entity.Account) error {
 	setAccountID(account)
 	setAccountAuditableProperties(account)
 
-	hash, err := encryptPassword(ctx, account.Password)
+	hash, err := hashAccountPassword(ctx, account)
 	if err != nil {
 		return err
 	}
 	account.Password = hash
+	account.PasswordHash = ""
 
 	err = a.repo.Insert(ctx, account)
 	if err != nil {
@@ -96,6 +221,191 @@ func (a *Auth) Register(ctx context.Context, account *entity.Account) error {
 	return nil
 }
 
+// DeleteAccount deletes the account owned by the user.
+// Deleting an account that doesn't exist succeeds, so it is safe to retry.
+func (a *Auth) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrEmptyField("user id")
+	}
+	if err := a.repo.DeleteByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[Auth-DeleteAccount] fail delete from repository", "error", err)
+		return err
+	}
+	return nil
+}
+
+// SoftDeleteAccount soft-deletes the account owned by the user.
+// Soft-deleted account can't log in or refresh its token until it is restored,
+// and the access tokens that have been issued are revoked.
+// Soft-deleting an account that doesn't exist or has been soft-deleted succeeds, so it is safe to retry.
+func (a *Auth) SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrEmptyField("user id")
+	}
+	if err := a.repo.SoftDeleteByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[Auth-SoftDeleteAccount] fail soft delete from repository", "error", err)
+		return err
+	}
+	if err := a.revokeAccessTokens(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[Auth-SoftDeleteAccount] fail revoke access tokens", "error", err)
+		return err
+	}
+	return nil
+}
+
+// RestoreAccount restores the soft-deleted account owned by the user.
+// Restoring an account that doesn't exist or isn't soft-deleted succeeds, so it is safe to retry.
+func (a *Auth) RestoreAccount(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrEmptyField("user id")
+	}
+	if err := a.repo.RestoreByUserID(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[Auth-RestoreAccount] fail restore from repository", "error", err)
+		return err
+	}
+	return nil
+}
+
+// GetAccount gets the account owned by the user.
+// The password is removed, so it is safe to be returned to the caller.
+func (a *Auth) GetAccount(ctx context.Context, userID uuid.UUID) (*entity.Account, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrEmptyField("user id")
+	}
+	account, err := a.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-GetAccount] fail get from repository", "error", err)
+		return nil, err
+	}
+	account.Password = ""
+	return account, nil
+}
+
+// EraseAccount anonymizes the account owned by the user.
+// The email is replaced by one derived from the user id and the password is removed, so the account can't log in anymore.
+// Access tokens issued to the user are revoked, so the erased user can't keep using them until they expire.
+// Erasing an account that doesn't exist or has been erased succeeds, so it is safe to retry.
+func (a *Auth) EraseAccount(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrEmptyField("user id")
+	}
+	if err := a.repo.EraseByUserID(ctx, userID, createErasedEmail(userID)); err != nil {
+		slog.ErrorContext(ctx, "[Auth-EraseAccount] fail erase from repository", "error", err)
+		return err
+	}
+	if err := a.revokeAccessTokens(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[Auth-EraseAccount] fail revoke access tokens", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Logout revokes an access token until it expires.
+// If refresh token is given, its family is revoked so no new access token can be issued from the same login.
+// The refresh token must belong to the user who owns the access token.
+// Unknown refresh token is ignored since there is nothing to revoke.
+func (a *Auth) Logout(ctx context.Context, userID uuid.UUID, tokenID string, expiresAt time.Time, refreshToken string) error {
+	if tokenID == "" {
+		return entity.ErrInvalidArgument("token can't be revoked")
+	}
+
+	stored, err := a.getOwnedRefreshToken(ctx, userID, refreshToken)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-Logout] fail get refresh token", "error", err)
+		return err
+	}
+
+	if ttl := time.Until(expiresAt); ttl > 0 {
+		if err := a.revocationRepo.Revoke(ctx, tokenID, ttl); err != nil {
+			slog.ErrorContext(ctx, "[Auth-Logout] fail revoke access token", "error", err)
+			return entity.ErrInternal("fail revoke access token")
+		}
+	}
+
+	if stored == nil {
+		return nil
+	}
+	return a.tokenRepo.RevokeFamily(ctx, stored.FamilyID, time.Now().UTC())
+}
+
+// getOwnedRefreshToken gets the refresh token owned by the user.
+// It returns nil if the refresh token is empty or unknown.
+func (a *Auth) getOwnedRefreshToken(ctx context.Context, userID uuid.UUID, refreshToken string) (*entity.RefreshToken, error) {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return nil, nil
+	}
+	stored, err := a.tokenRepo.GetByHash(ctx, hashRefreshToken(refreshToken))
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := a.repo.GetByID(ctx, stored.AccountID)
+	if status.Code(err) == codes.NotFound {
+		return nil, entity.ErrInvalidRefreshToken()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if account.UserID != userID {
+		return nil, entity.ErrInvalidRefreshToken()
+	}
+	return stored, nil
+}
+
+// KeySet returns public keys to verify access token.
+func (a *Auth) KeySet(_ context.Context) *entity.JSONWebKeySet {
+	return a.signer.KeySet()
+}
+
+func (a *Auth) issueToken(ctx context.Context, account *entity.Account, familyID uuid.UUID) (*entity.Token, error) {
+	token, err := createAccessToken(account, a.signer, a.tokenExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := generateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	refresh := createRefreshToken(account, familyID, hashRefreshToken(plain), a.refreshTokenExpiration)
+	if err = a.tokenRepo.Insert(ctx, refresh); err != nil {
+		slog.ErrorContext(ctx, "[Auth-issueToken] fail save refresh token", "error", err)
+		return nil, err
+	}
+
+	token.RefreshToken = plain
+	token.RefreshTokenExpiresIn = uint32(a.refreshTokenExpiration * timeMinute)
+	return token, nil
+}
+
+// revokeAccessTokens revokes all access tokens of the user issued until now.
+// The revocation only needs to outlive the tokens, which expire after the token expiration.
+func (a *Auth) revokeAccessTokens(ctx context.Context, userID uuid.UUID) error {
+	ttl := time.Duration(a.tokenExpiration) * time.Minute
+	if err := a.revocationRepo.RevokeSubject(ctx, userID.String(), time.Now().UTC(), ttl); err != nil {
+		return entity.ErrInternal("fail revoke access tokens")
+	}
+	return nil
+}
+
+// revokeReusedFamily revokes the token's family if the token has been used or revoked before.
+// Expired or unknown tokens are simply rejected.
+func (a *Auth) revokeReusedFamily(ctx context.Context, hash string) {
+	stored, err := a.tokenRepo.GetByHash(ctx, hash)
+	if err != nil || (stored.UsedAt == nil && stored.RevokedAt == nil) {
+		return
+	}
+
+	slog.WarnContext(ctx, "[Auth-RefreshToken] refresh token reuse detected, revoking family", "family-id", stored.FamilyID, "account-id", stored.AccountID)
+	if err := a.tokenRepo.RevokeFamily(ctx, stored.FamilyID, time.Now().UTC()); err != nil {
+		slog.ErrorContext(ctx, "[Auth-RefreshToken] fail revoke refresh token family", "error", err)
+	}
+}
+
 func validateAccount(account *entity.Account) error {
 	if account == nil || account.UserID == uuid.Nil {
 		return entity.ErrEmptyAccount()
@@ -103,7 +413,7 @@ func validateAccount(account *entity.Account) error {
 	if _, err := mail.ParseAddress(account.Email); err != nil {
 		return entity.ErrInvalidEmail()
 	}
-	if account.Password == "" {
+	if account.Password == "" && account.PasswordHash == "" {
 		return entity.ErrInvalidPassword()
 	}
 	return nil
@@ -115,6 +425,7 @@ func sanitizeAccount(account *entity.Account) {
 	}
 	account.Email = strings.TrimSpace(account.Email)
 	account.Password = strings.TrimSpace(account.Password)
+	account.PasswordHash = strings.TrimSpace(account.PasswordHash)
 }
 
 func validateLoginParams(email, password string) error {
@@ -127,6 +438,11 @@ func validateLoginParams(email, password string) error {
 	return nil
 }
 
+// createErasedEmail creates an email that is unique for the user, since email must be unique, but doesn't identify the person.
+func createErasedEmail(userID uuid.UUID) string {
+	return fmt.Sprintf("erased-%s@%s", userID, erasedEmailDomain)
+}
+
 func setAccountID(account *entity.Account) {
 	account.ID = generateUniqueID()
 }
@@ -135,6 +451,19 @@ func generateUniqueID() uuid.UUID {
 	return uuid.Must(uuid.NewV7())
 }
 
+// hashAccountPassword returns the password hash given by the caller if it is a valid bcrypt hash.
+// Otherwise, it hashes the plaintext password.
+func hashAccountPassword(ctx context.Context, account *entity.Account) (string, error) {
+	if account.PasswordHash == "" {
+		return encryptPassword(ctx, account.Password)
+	}
+	if _, err := bcrypt.Cost([]byte(account.PasswordHash)); err != nil {
+		slog.ErrorContext(ctx, "[hashAccountPassword] password hash is not a bcrypt hash", "error", err)
+		return "", entity.ErrInvalidPassword()
+	}
+	return account.PasswordHash, nil
+}
+
 func encryptPassword(ctx context.Context, password string) (string, error) {
 	res, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -151,22 +480,51 @@ func setAccountAuditableProperties(account *entity.Account) {
 	account.UpdatedBy = account.ID
 }
 
-func createAccessToken(account *entity.Account, key []byte, exp int) (*entity.Token, error) {
+func createAccessToken(account *entity.Account, signer TokenSigner, exp int) (*entity.Token, error) {
 	claims := entity.Claims{
 		AccountID: account.ID,
 		UserID:    account.UserID,
 		Email:     account.Email,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        generateUniqueID().String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(exp) * time.Minute)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    tokenIssuer,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	res, err := token.SignedString(key)
+	res, err := signer.Sign(claims)
 	if err != nil {
 		return nil, entity.ErrInternal("fail signing token")
 	}
 	return &entity.Token{AccessToken: res, AccessTokenExpiresIn: uint32(exp * timeMinute)}, nil
 }
+
+func createRefreshToken(account *entity.Account, familyID uuid.UUID, hash string, exp int) *entity.RefreshToken {
+	now := time.Now().UTC()
+	token := &entity.RefreshToken{
+		ID:        generateUniqueID(),
+		FamilyID:  familyID,
+		AccountID: account.ID,
+		Hash:      hash,
+		ExpiresAt: now.Add(time.Duration(exp) * time.Minute),
+	}
+	token.CreatedAt = now
+	token.UpdatedAt = now
+	token.CreatedBy = account.ID
+	token.UpdatedBy = account.ID
+	return token
+}
+
+func generateRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", entity.ErrInternal("fail generating refresh token")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
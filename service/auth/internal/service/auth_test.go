@@ -3,28 +3,39 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/crypto/bcrypt"
 
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/auth/entity"
 	"github.com/indrasaputra/arjuna/service/auth/internal/service"
 	mock_service "github.com/indrasaputra/arjuna/service/auth/test/mock/service"
 )
 
+type ctxKey string
+
 var (
-	testCtx        = context.Background()
-	testEmail      = "email@email.com"
-	testPassword   = "password"
-	testSigningKey = "key"
-	testExpiry     = 5
+	testCtx           = context.Background()
+	testCtxTx         = context.WithValue(testCtx, ctxKey("tx"), true)
+	testEmail         = "email@email.com"
+	testPassword      = "password"
+	testAccessToken   = "access-token"
+	testExpiry        = 5
+	testRefreshExpiry = 60
+	testRefreshToken  = "refresh-token"
 )
 
 type AuthSuite struct {
-	auth *service.Auth
-	repo *mock_service.MockAuthRepository
+	auth           *service.Auth
+	repo           *mock_service.MockAuthRepository
+	tokenRepo      *mock_service.MockRefreshTokenRepository
+	revocationRepo *mock_service.MockTokenRevocationRepository
+	signer         *mock_service.MockTokenSigner
+	txManager      *mock_uow.MockTxManager
 }
 
 func TestNewAuth(t *testing.T) {
@@ -89,6 +100,35 @@ func TestAuth_Register(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("password hash is not a bcrypt hash", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		account.PasswordHash = "not-a-hash"
+
+		err := st.auth.Register(testCtx, account)
+
+		assert.ErrorIs(t, err, entity.ErrInvalidPassword())
+	})
+
+	t.Run("success register an account with password hash", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		hash, _ := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+		account.Password = ""
+		account.PasswordHash = string(hash)
+
+		st.repo.EXPECT().Insert(testCtx, account).
+			DoAndReturn(func(_ context.Context, acc *entity.Account) error {
+				assert.Equal(t, string(hash), acc.Password)
+				assert.Empty(t, acc.PasswordHash)
+				return nil
+			})
+
+		err := st.auth.Register(testCtx, account)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("account repo insert returns not found", func(t *testing.T) {
 		st := createAuthSuite(ctrl)
 		account := createTestAccount()
@@ -180,24 +220,583 @@ func TestAuth_Login(t *testing.T) {
 		assert.Nil(t, token)
 	})
 
+	t.Run("sign access token returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		acc := createTestAccount()
+		st.repo.EXPECT().GetByEmail(testCtx, testEmail).Return(acc, nil)
+		st.signer.EXPECT().Sign(gomock.Any()).Return("", assert.AnError)
+
+		token, err := st.auth.Login(testCtx, testEmail, testPassword)
+
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("refresh token repo insert returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		acc := createTestAccount()
+		st.repo.EXPECT().GetByEmail(testCtx, testEmail).Return(acc, nil)
+		st.signer.EXPECT().Sign(gomock.Any()).Return(testAccessToken, nil)
+		st.tokenRepo.EXPECT().Insert(testCtx, gomock.Any()).Return(assert.AnError)
+
+		token, err := st.auth.Login(testCtx, testEmail, testPassword)
+
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+
 	t.Run("success login", func(t *testing.T) {
 		st := createAuthSuite(ctrl)
 		acc := createTestAccount()
 		st.repo.EXPECT().GetByEmail(testCtx, testEmail).Return(acc, nil)
+		st.signer.EXPECT().Sign(gomock.Any()).Return(testAccessToken, nil)
+		st.tokenRepo.EXPECT().Insert(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, rt *entity.RefreshToken) error {
+				assert.Equal(t, acc.ID, rt.AccountID)
+				assert.NotEmpty(t, rt.Hash)
+				return nil
+			})
 
 		token, err := st.auth.Login(testCtx, testEmail, testPassword)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, token)
+		assert.Equal(t, testAccessToken, token.AccessToken)
+		assert.NotEmpty(t, token.RefreshToken)
+		assert.Equal(t, uint32(testRefreshExpiry*60), token.RefreshTokenExpiresIn)
+	})
+}
+
+func TestAuth_RefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty refresh token is prohibited", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		token, err := st.auth.RefreshToken(testCtx, " ")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyField("refresh token"), err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("refresh token is unknown or expired", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		stored := createTestRefreshToken()
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(nil, entity.ErrNotFound())
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, entity.ErrInvalidRefreshToken())
+		assert.Nil(t, token)
+	})
+
+	t.Run("used refresh token revokes the family", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		stored := createTestRefreshToken()
+		usedAt := time.Now().UTC()
+		stored.UsedAt = &usedAt
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(nil, entity.ErrNotFound())
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.tokenRepo.EXPECT().RevokeFamily(testCtx, stored.FamilyID, gomock.Any()).Return(nil)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, entity.ErrInvalidRefreshToken())
+		assert.Nil(t, token)
+	})
+
+	t.Run("revoke family fails but still rejects the token", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		stored := createTestRefreshToken()
+		revokedAt := time.Now().UTC()
+		stored.RevokedAt = &revokedAt
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(nil, entity.ErrNotFound())
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.tokenRepo.EXPECT().RevokeFamily(testCtx, stored.FamilyID, gomock.Any()).Return(assert.AnError)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, entity.ErrInvalidRefreshToken())
+		assert.Nil(t, token)
+	})
+
+	t.Run("consume returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("account is not found", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		stored := createTestRefreshToken()
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtxTx, stored.AccountID).Return(nil, entity.ErrNotFound())
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(nil, entity.ErrNotFound())
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, entity.ErrInvalidRefreshToken())
+		assert.Nil(t, token)
+	})
+
+	t.Run("get account returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		stored := createTestRefreshToken()
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtxTx, stored.AccountID).Return(nil, assert.AnError)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("insert new refresh token returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		acc := createTestAccount()
+		stored := createTestRefreshToken()
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtxTx, stored.AccountID).Return(acc, nil)
+		st.signer.EXPECT().Sign(gomock.Any()).Return(testAccessToken, nil)
+		st.tokenRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(assert.AnError)
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+
+	t.Run("success rotate refresh token", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		acc := createTestAccount()
+		stored := createTestRefreshToken()
+		expectTx(st)
+		st.tokenRepo.EXPECT().Consume(testCtxTx, gomock.Any(), gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtxTx, stored.AccountID).Return(acc, nil)
+		st.signer.EXPECT().Sign(gomock.Any()).Return(testAccessToken, nil)
+		st.tokenRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, rt *entity.RefreshToken) error {
+				assert.Equal(t, stored.FamilyID, rt.FamilyID)
+				assert.NotEqual(t, stored.Hash, rt.Hash)
+				return nil
+			})
+
+		token, err := st.auth.RefreshToken(testCtx, testRefreshToken)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, token)
+		assert.NotEmpty(t, token.AccessToken)
+		assert.NotEqual(t, testRefreshToken, token.RefreshToken)
+	})
+}
+
+func TestAuth_DeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		err := st.auth.DeleteAccount(testCtx, uuid.Nil)
+
+		assert.ErrorIs(t, err, entity.ErrEmptyField("user id"))
+	})
+
+	t.Run("account repo delete returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().DeleteByUserID(testCtx, userID).Return(entity.ErrInternal(""))
+
+		err := st.auth.DeleteAccount(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success delete an account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().DeleteByUserID(testCtx, userID).Return(nil)
+
+		err := st.auth.DeleteAccount(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuth_SoftDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		err := st.auth.SoftDeleteAccount(testCtx, uuid.Nil)
+
+		assert.ErrorIs(t, err, entity.ErrEmptyField("user id"))
+	})
+
+	t.Run("account repo soft delete returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().SoftDeleteByUserID(testCtx, userID).Return(entity.ErrInternal(""))
+
+		err := st.auth.SoftDeleteAccount(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("revoke access tokens returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().SoftDeleteByUserID(testCtx, userID).Return(nil)
+		st.revocationRepo.EXPECT().RevokeSubject(testCtx, userID.String(), gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		err := st.auth.SoftDeleteAccount(testCtx, userID)
+
+		assert.Equal(t, entity.ErrInternal("fail revoke access tokens"), err)
+	})
+
+	t.Run("success soft delete an account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().SoftDeleteByUserID(testCtx, userID).Return(nil)
+		st.revocationRepo.EXPECT().RevokeSubject(testCtx, userID.String(), gomock.Any(), time.Duration(testExpiry)*time.Minute).
+			DoAndReturn(func(_ context.Context, _ string, revokedAt time.Time, _ time.Duration) error {
+				assert.WithinDuration(t, time.Now(), revokedAt, time.Second)
+				return nil
+			})
+
+		err := st.auth.SoftDeleteAccount(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuth_RestoreAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		err := st.auth.RestoreAccount(testCtx, uuid.Nil)
+
+		assert.ErrorIs(t, err, entity.ErrEmptyField("user id"))
+	})
+
+	t.Run("account repo restore returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().RestoreByUserID(testCtx, userID).Return(entity.ErrInternal(""))
+
+		err := st.auth.RestoreAccount(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success restore an account", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().RestoreByUserID(testCtx, userID).Return(nil)
+
+		err := st.auth.RestoreAccount(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuth_GetAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		res, err := st.auth.GetAccount(testCtx, uuid.Nil)
+
+		assert.ErrorIs(t, err, entity.ErrEmptyField("user id"))
+		assert.Nil(t, res)
+	})
+
+	t.Run("account repo get returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().GetByUserID(testCtx, userID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.auth.GetAccount(testCtx, userID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get an account without password", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+		account := &entity.Account{UserID: userID, Email: "first@account.com", Password: "hash"}
+
+		st.repo.EXPECT().GetByUserID(testCtx, userID).Return(account, nil)
+
+		res, err := st.auth.GetAccount(testCtx, userID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "first@account.com", res.Email)
+		assert.Empty(t, res.Password)
+	})
+}
+
+func TestAuth_EraseAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+
+		err := st.auth.EraseAccount(testCtx, uuid.Nil)
+
+		assert.ErrorIs(t, err, entity.ErrEmptyField("user id"))
+	})
+
+	t.Run("account repo erase returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().EraseByUserID(testCtx, userID, gomock.Any()).Return(entity.ErrInternal(""))
+
+		err := st.auth.EraseAccount(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("revoke access tokens returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().EraseByUserID(testCtx, userID, gomock.Any()).Return(nil)
+		st.revocationRepo.EXPECT().RevokeSubject(testCtx, userID.String(), gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		err := st.auth.EraseAccount(testCtx, userID)
+
+		assert.Equal(t, entity.ErrInternal("fail revoke access tokens"), err)
+	})
+
+	t.Run("success erase an account using email derived from user id", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		userID := uuid.Must(uuid.NewV7())
+
+		st.repo.EXPECT().EraseByUserID(testCtx, userID, "erased-"+userID.String()+"@erased.invalid").Return(nil)
+		st.revocationRepo.EXPECT().RevokeSubject(testCtx, userID.String(), gomock.Any(), time.Duration(testExpiry)*time.Minute).Return(nil)
+
+		err := st.auth.EraseAccount(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuth_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tokenID := "jti"
+
+	t.Run("token without id can't be revoked", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+
+		err := st.auth.Logout(testCtx, account.UserID, "", time.Now().Add(time.Minute), "")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("revoke access token returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		st.revocationRepo.EXPECT().Revoke(testCtx, tokenID, gomock.Any()).Return(assert.AnError)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), "")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("expired access token needs no revocation", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(-time.Minute), "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success revoke access token only", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		st.revocationRepo.EXPECT().Revoke(testCtx, tokenID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, ttl time.Duration) error {
+				assert.True(t, ttl > 0 && ttl <= time.Minute)
+				return nil
+			})
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown refresh token is ignored", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(nil, entity.ErrNotFound())
+		st.revocationRepo.EXPECT().Revoke(testCtx, tokenID, gomock.Any()).Return(nil)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get refresh token returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(nil, assert.AnError)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("account of refresh token is not found", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		stored := createTestRefreshToken()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtx, stored.AccountID).Return(nil, entity.ErrNotFound())
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.Equal(t, entity.ErrInvalidRefreshToken(), err)
+	})
+
+	t.Run("get account of refresh token returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		stored := createTestRefreshToken()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtx, stored.AccountID).Return(nil, assert.AnError)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("refresh token belongs to another user", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		stored := createTestRefreshToken()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtx, stored.AccountID).Return(account, nil)
+
+		err := st.auth.Logout(testCtx, uuid.Must(uuid.NewV7()), tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.Equal(t, entity.ErrInvalidRefreshToken(), err)
+	})
+
+	t.Run("revoke refresh token family returns error", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		stored := createTestRefreshToken()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtx, stored.AccountID).Return(account, nil)
+		st.revocationRepo.EXPECT().Revoke(testCtx, tokenID, gomock.Any()).Return(nil)
+		st.tokenRepo.EXPECT().RevokeFamily(testCtx, stored.FamilyID, gomock.Any()).Return(assert.AnError)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success revoke access token and refresh token family", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		account := createTestAccount()
+		stored := createTestRefreshToken()
+		st.tokenRepo.EXPECT().GetByHash(testCtx, gomock.Any()).Return(stored, nil)
+		st.repo.EXPECT().GetByID(testCtx, stored.AccountID).Return(account, nil)
+		st.revocationRepo.EXPECT().Revoke(testCtx, tokenID, gomock.Any()).Return(nil)
+		st.tokenRepo.EXPECT().RevokeFamily(testCtx, stored.FamilyID, gomock.Any()).Return(nil)
+
+		err := st.auth.Logout(testCtx, account.UserID, tokenID, time.Now().Add(time.Minute), testRefreshToken)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuth_KeySet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success get key set", func(t *testing.T) {
+		st := createAuthSuite(ctrl)
+		jwks := &entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{{Kid: "kid"}}}
+		st.signer.EXPECT().KeySet().Return(jwks)
+
+		res := st.auth.KeySet(testCtx)
+
+		assert.Equal(t, jwks, res)
 	})
 }
 
 func createAuthSuite(ctrl *gomock.Controller) *AuthSuite {
 	r := mock_service.NewMockAuthRepository(ctrl)
-	a := service.NewAuth(r, []byte(testSigningKey), testExpiry)
+	tr := mock_service.NewMockRefreshTokenRepository(ctrl)
+	rr := mock_service.NewMockTokenRevocationRepository(ctrl)
+	m := mock_uow.NewMockTxManager(ctrl)
+	sg := mock_service.NewMockTokenSigner(ctrl)
+	a := service.NewAuth(r, tr, rr, m, sg, testExpiry, testRefreshExpiry)
 	return &AuthSuite{
-		auth: a,
-		repo: r,
+		auth:           a,
+		repo:           r,
+		tokenRepo:      tr,
+		revocationRepo: rr,
+		signer:         sg,
+		txManager:      m,
+	}
+}
+
+func expectTx(st *AuthSuite) {
+	st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+			return fn(testCtxTx)
+		})
+}
+
+func createTestRefreshToken() *entity.RefreshToken {
+	return &entity.RefreshToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		FamilyID:  uuid.Must(uuid.NewV7()),
+		AccountID: uuid.Must(uuid.NewV7()),
+		Hash:      "hash",
+		ExpiresAt: time.Now().Add(time.Hour).UTC(),
 	}
 }
 
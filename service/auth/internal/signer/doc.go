@@ -0,0 +1,2 @@
+// Package signer signs access tokens and publishes the public keys to verify them.
+package signer
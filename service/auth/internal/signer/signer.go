@@ -0,0 +1,119 @@
+package signer
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+)
+
+const (
+	headerKeyID   = "kid"
+	minRSAKeyBits = 2048
+)
+
+// Signer signs tokens with the current private key.
+// It also publishes the public keys that are still accepted, so keys can be rotated without downtime.
+type Signer struct {
+	key    crypto.Signer
+	method jwt.SigningMethod
+	keys   *sdkauth.StaticKeySet
+	jwks   *entity.JSONWebKeySet
+	kid    string
+}
+
+// NewSigner creates an instance of Signer.
+// signingKey is a PEM-encoded RSA or Ed25519 private key used to sign new tokens.
+// verificationKeys are PEM-encoded public keys that are accepted as well,
+// e.g. the previous signing key until its tokens expire, or the next signing key before it is used.
+func NewSigner(signingKey []byte, verificationKeys ...[]byte) (*Signer, error) {
+	key, method, err := parsePrivateKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+	current, err := sdkauth.NewJSONWebKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := &entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{current}}
+	for _, vk := range verificationKeys {
+		pub, err := parsePublicKey(vk)
+		if err != nil {
+			return nil, err
+		}
+		jwk, err := sdkauth.NewJSONWebKey(pub)
+		if err != nil {
+			return nil, err
+		}
+		if jwk.Kid != current.Kid {
+			jwks.Keys = append(jwks.Keys, jwk)
+		}
+	}
+
+	keys, err := sdkauth.NewStaticKeySet(jwks)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key: key, method: method, kid: current.Kid, jwks: jwks, keys: keys}, nil
+}
+
+// Sign signs the claims and sets the signing key's id as kid header.
+func (s *Signer) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header[headerKeyID] = s.kid
+	return token.SignedString(s.key)
+}
+
+// KeySet returns all public keys that are accepted, including the current signing key.
+func (s *Signer) KeySet() *entity.JSONWebKeySet {
+	return s.jwks
+}
+
+// PublicKey gets an accepted public key by its id.
+func (s *Signer) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	return s.keys.PublicKey(ctx, kid)
+}
+
+func parsePrivateKey(pem []byte) (crypto.Signer, jwt.SigningMethod, error) {
+	if key, err := jwt.ParseEdPrivateKeyFromPEM(pem); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, nil, entity.ErrInvalidArgument("unsupported signing key")
+		}
+		return signer, jwt.SigningMethodEdDSA, nil
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+	if err != nil {
+		return nil, nil, entity.ErrInvalidArgument("signing key must be a PEM-encoded RSA or Ed25519 private key")
+	}
+	if err = validateRSAKey(&key.PublicKey); err != nil {
+		return nil, nil, err
+	}
+	return key, jwt.SigningMethodRS256, nil
+}
+
+func parsePublicKey(pem []byte) (crypto.PublicKey, error) {
+	if key, err := jwt.ParseEdPublicKeyFromPEM(pem); err == nil {
+		return key, nil
+	}
+	key, err := jwt.ParseRSAPublicKeyFromPEM(pem)
+	if err != nil {
+		return nil, entity.ErrInvalidArgument("verification key must be a PEM-encoded RSA or Ed25519 public key")
+	}
+	if err = validateRSAKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func validateRSAKey(key *rsa.PublicKey) error {
+	if key.N.BitLen() < minRSAKeyBits {
+		return entity.ErrInvalidArgument("rsa key must be at least 2048 bits")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package signer_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	"github.com/indrasaputra/arjuna/service/auth/internal/signer"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+)
+
+const (
+	testKeyDir = "../../test/fixture/keys/"
+)
+
+func TestNewSigner(t *testing.T) {
+	t.Run("signing key is invalid", func(t *testing.T) {
+		s, err := signer.NewSigner([]byte("invalid"))
+
+		assert.Error(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("rsa signing key is too small", func(t *testing.T) {
+		s, err := signer.NewSigner(readKey(t, "rsa-weak-key.pem"))
+
+		assert.Error(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("verification key is invalid", func(t *testing.T) {
+		s, err := signer.NewSigner(readKey(t, "signing-key.pem"), []byte("invalid"))
+
+		assert.Error(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("success create signer with verification keys", func(t *testing.T) {
+		s, err := signer.NewSigner(
+			readKey(t, "signing-key.pem"),
+			readKey(t, "signing-key.pub.pem"),
+			readKey(t, "next-signing-key.pub.pem"),
+			readKey(t, "rsa-signing-key.pub.pem"),
+		)
+
+		assert.NoError(t, err)
+		assert.Len(t, s.KeySet().Keys, 3)
+	})
+}
+
+func TestSigner_Sign(t *testing.T) {
+	tests := map[string]string{
+		"EdDSA": "signing-key.pem",
+		"RS256": "rsa-signing-key.pem",
+	}
+
+	for alg, file := range tests {
+		t.Run("success sign with "+alg, func(t *testing.T) {
+			s, err := signer.NewSigner(readKey(t, file))
+			assert.NoError(t, err)
+
+			token, err := s.Sign(createTestClaims())
+			assert.NoError(t, err)
+
+			claims, err := sdkauth.ParseToken(t.Context(), token, s)
+			assert.NoError(t, err)
+			assert.Equal(t, "email@email.com", claims.Email)
+			assert.Equal(t, alg, s.KeySet().Keys[0].Alg)
+		})
+	}
+
+	t.Run("token signed by previous key is still accepted during rotation", func(t *testing.T) {
+		previous, err := signer.NewSigner(readKey(t, "signing-key.pem"))
+		assert.NoError(t, err)
+		current, err := signer.NewSigner(readKey(t, "next-signing-key.pem"), readKey(t, "signing-key.pub.pem"))
+		assert.NoError(t, err)
+
+		token, err := previous.Sign(createTestClaims())
+		assert.NoError(t, err)
+
+		_, err = sdkauth.ParseToken(t.Context(), token, current)
+		assert.NoError(t, err)
+	})
+
+	t.Run("token signed by removed key is rejected", func(t *testing.T) {
+		previous, err := signer.NewSigner(readKey(t, "signing-key.pem"))
+		assert.NoError(t, err)
+		current, err := signer.NewSigner(readKey(t, "next-signing-key.pem"))
+		assert.NoError(t, err)
+
+		token, err := previous.Sign(createTestClaims())
+		assert.NoError(t, err)
+
+		_, err = sdkauth.ParseToken(t.Context(), token, current)
+		assert.Error(t, err)
+	})
+}
+
+func readKey(t *testing.T, name string) []byte {
+	key, err := os.ReadFile(testKeyDir + name)
+	if err != nil {
+		t.Fatalf("fail read key: %v", err)
+	}
+	return key
+}
+
+func createTestClaims() *entity.Claims {
+	return &entity.Claims{
+		Email:  "email@email.com",
+		UserID: uuid.Must(uuid.NewV7()),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+}
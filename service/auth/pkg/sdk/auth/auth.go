@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -17,6 +18,7 @@ import (
 
 const (
 	headerAuthorization = "authorization"
+	headerKeyID         = "kid"
 )
 
 // Config defines configuration to work with Client.
@@ -49,26 +51,81 @@ func NewClient(cfg *Config) (*Client, error) {
 // Register registers an account.
 func (c *Client) Register(ctx context.Context, account *entity.Account) error {
 	req := &apiv1.RegisterAccountRequest{Account: &apiv1.Account{
-		UserId:   account.UserID.String(),
-		Email:    account.Email,
-		Password: account.Password,
+		UserId:       account.UserID.String(),
+		Email:        account.Email,
+		Password:     account.Password,
+		PasswordHash: account.PasswordHash,
 	}}
 
-	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
-	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
 
 	_, err := c.handler.RegisterAccount(ctx, req)
 	return err
 }
 
-// ParseToken parses the token.
-func ParseToken(tokenString string, secret []byte) (*entity.Claims, error) {
+// DeleteAccount deletes the account owned by the user.
+func (c *Client) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.DeleteAccountRequest{UserId: userID.String()}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
+
+	_, err := c.handler.DeleteAccount(ctx, req)
+	return err
+}
+
+// SoftDeleteAccount soft-deletes the account owned by the user.
+func (c *Client) SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.SoftDeleteAccountRequest{UserId: userID.String()}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
+
+	_, err := c.handler.SoftDeleteAccount(ctx, req)
+	return err
+}
+
+// RestoreAccount restores the soft-deleted account owned by the user.
+func (c *Client) RestoreAccount(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.RestoreAccountRequest{UserId: userID.String()}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
+
+	_, err := c.handler.RestoreAccount(ctx, req)
+	return err
+}
+
+// GetAccount gets the account owned by the user.
+func (c *Client) GetAccount(ctx context.Context, userID uuid.UUID) (*entity.Account, error) {
+	req := &apiv1.GetAccountRequest{UserId: userID.String()}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
+
+	resp, err := c.handler.GetAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &entity.Account{Email: resp.GetData().GetEmail()}
+	account.ID, _ = uuid.Parse(resp.GetData().GetId())
+	account.UserID, _ = uuid.Parse(resp.GetData().GetUserId())
+	account.CreatedAt = resp.GetData().GetCreatedAt().AsTime()
+	return account, nil
+}
+
+// EraseAccount anonymizes the account owned by the user.
+func (c *Client) EraseAccount(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.EraseAccountRequest{UserId: userID.String()}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", c.basicToken())))
+
+	_, err := c.handler.EraseAccount(ctx, req)
+	return err
+}
+
+// ParseToken parses the token and verifies its signature using the key pointed by token's kid header.
+// Only RS256 and EdDSA signed tokens are accepted.
+func ParseToken(ctx context.Context, tokenString string, keys KeySet) (*entity.Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &entity.Claims{}, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, entity.ErrInvalidArgument("unexpected signing method")
+		kid, _ := token.Header[headerKeyID].(string)
+		if kid == "" {
+			return nil, entity.ErrInvalidArgument("missing key id")
 		}
-		return secret, nil
-	})
+		return keys.PublicKey(ctx, kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 	if err != nil {
 		return nil, err
 	}
@@ -77,3 +134,7 @@ func ParseToken(tokenString string, secret []byte) (*entity.Claims, error) {
 	}
 	return nil, entity.ErrInternal("unknown claims type")
 }
+
+func (c *Client) basicToken() string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+}
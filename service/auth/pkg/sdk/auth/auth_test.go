@@ -1 +1,66 @@
 package auth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+)
+
+func TestParseToken(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	jwk, _ := sdkauth.NewJSONWebKey(pub)
+	keys, _ := sdkauth.NewStaticKeySet(&entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{jwk}})
+	claims := &entity.Claims{
+		Email:            "email@email.com",
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+	}
+
+	t.Run("hmac signed token is rejected", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = jwk.Kid
+		str, _ := token.SignedString([]byte("secret"))
+
+		res, err := sdkauth.ParseToken(t.Context(), str, keys)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("token without kid is rejected", func(t *testing.T) {
+		str, _ := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(priv)
+
+		res, err := sdkauth.ParseToken(t.Context(), str, keys)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("token with unknown kid is rejected", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+		token.Header["kid"] = "unknown"
+		str, _ := token.SignedString(priv)
+
+		res, err := sdkauth.ParseToken(t.Context(), str, keys)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success parse token", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+		token.Header["kid"] = jwk.Kid
+		str, _ := token.SignedString(priv)
+
+		res, err := sdkauth.ParseToken(t.Context(), str, keys)
+
+		assert.NoError(t, err)
+		assert.Equal(t, claims.Email, res.Email)
+	})
+}
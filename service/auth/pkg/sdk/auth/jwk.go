@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+)
+
+const (
+	keyTypeRSA      = "RSA"
+	keyTypeOKP      = "OKP"
+	curveEd25519    = "Ed25519"
+	keyUseSignature = "sig"
+)
+
+// NewJSONWebKey creates a JSONWebKey from RSA or Ed25519 public key.
+// The key id is the key's thumbprint (RFC 7638), so the same key always has the same id.
+func NewJSONWebKey(key crypto.PublicKey) (*entity.JSONWebKey, error) {
+	var jwk *entity.JSONWebKey
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		jwk = &entity.JSONWebKey{
+			Kty: keyTypeRSA,
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   encodeSegment(k.N.Bytes()),
+			E:   encodeSegment(big.NewInt(int64(k.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		jwk = &entity.JSONWebKey{
+			Kty: keyTypeOKP,
+			Alg: jwt.SigningMethodEdDSA.Alg(),
+			Crv: curveEd25519,
+			X:   encodeSegment(k),
+		}
+	default:
+		return nil, entity.ErrInvalidArgument("unsupported key type")
+	}
+	jwk.Use = keyUseSignature
+	jwk.Kid = thumbprint(jwk)
+	return jwk, nil
+}
+
+// PublicKey converts JSONWebKey to RSA or Ed25519 public key.
+func PublicKey(jwk *entity.JSONWebKey) (crypto.PublicKey, error) {
+	if jwk == nil {
+		return nil, entity.ErrInvalidArgument("empty key")
+	}
+
+	switch jwk.Kty {
+	case keyTypeRSA:
+		n, err := decodeSegment(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeSegment(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case keyTypeOKP:
+		if jwk.Crv != curveEd25519 {
+			return nil, entity.ErrInvalidArgument("unsupported curve")
+		}
+		x, err := decodeSegment(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, entity.ErrInvalidArgument("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, entity.ErrInvalidArgument("unsupported key type")
+	}
+}
+
+// thumbprint computes RFC 7638 thumbprint using the key's required members in lexicographic order.
+func thumbprint(jwk *entity.JSONWebKey) string {
+	var canonical string
+	switch jwk.Kty {
+	case keyTypeRSA:
+		canonical = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, jwk.E, jwk.Kty, jwk.N)
+	case keyTypeOKP:
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, jwk.Crv, jwk.Kty, jwk.X)
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return encodeSegment(sum[:])
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, entity.ErrInvalidArgument("invalid key encoding")
+	}
+	return b, nil
+}
@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+)
+
+func TestNewJSONWebKey(t *testing.T) {
+	t.Run("unsupported key type", func(t *testing.T) {
+		jwk, err := sdkauth.NewJSONWebKey("key")
+
+		assert.Error(t, err)
+		assert.Nil(t, jwk)
+	})
+
+	t.Run("success create ed25519 key", func(t *testing.T) {
+		pub, _, _ := ed25519.GenerateKey(rand.Reader)
+
+		jwk, err := sdkauth.NewJSONWebKey(pub)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "OKP", jwk.Kty)
+		assert.Equal(t, "EdDSA", jwk.Alg)
+		assert.Equal(t, "sig", jwk.Use)
+		assert.NotEmpty(t, jwk.Kid)
+
+		key, err := sdkauth.PublicKey(jwk)
+		assert.NoError(t, err)
+		assert.Equal(t, pub, key)
+	})
+
+	t.Run("success create rsa key", func(t *testing.T) {
+		priv, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+		jwk, err := sdkauth.NewJSONWebKey(&priv.PublicKey)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "RSA", jwk.Kty)
+		assert.Equal(t, "RS256", jwk.Alg)
+		assert.Equal(t, "AQAB", jwk.E)
+
+		key, err := sdkauth.PublicKey(jwk)
+		assert.NoError(t, err)
+		assert.Equal(t, &priv.PublicKey, key)
+	})
+
+	t.Run("key id is the rfc 7638 thumbprint", func(t *testing.T) {
+		// example from RFC 7638 section 3.1
+		jwk := &entity.JSONWebKey{
+			Kty: "RSA",
+			E:   "AQAB",
+			N:   "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		}
+
+		pub, err := sdkauth.PublicKey(jwk)
+		assert.NoError(t, err)
+		res, err := sdkauth.NewJSONWebKey(pub)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", res.Kid)
+	})
+}
+
+func TestPublicKey(t *testing.T) {
+	t.Run("key is invalid", func(t *testing.T) {
+		keys := []*entity.JSONWebKey{
+			nil,
+			{Kty: "EC"},
+			{Kty: "OKP", Crv: "X25519", X: "AQAB"},
+			{Kty: "OKP", Crv: "Ed25519", X: "AQAB"},
+			{Kty: "OKP", Crv: "Ed25519", X: "!"},
+			{Kty: "RSA", N: "", E: "AQAB"},
+			{Kty: "RSA", N: "AQAB", E: ""},
+		}
+
+		for _, jwk := range keys {
+			key, err := sdkauth.PublicKey(jwk)
+
+			assert.Error(t, err)
+			assert.Nil(t, key)
+		}
+	})
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+)
+
+const (
+	// DefaultKeySetTTL is the default time a fetched key set is considered fresh.
+	DefaultKeySetTTL = 15 * time.Minute
+
+	keySetMinRefreshInterval = 10 * time.Second
+	keySetHTTPTimeout        = 5 * time.Second
+)
+
+// KeySet provides public keys to verify token's signature.
+type KeySet interface {
+	// PublicKey gets public key by its id (kid).
+	PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// StaticKeySet is a KeySet that never changes.
+// It is used by the auth service itself since it owns the keys.
+type StaticKeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+// NewStaticKeySet creates an instance of StaticKeySet.
+func NewStaticKeySet(jwks *entity.JSONWebKeySet) (*StaticKeySet, error) {
+	keys, err := publicKeys(jwks)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticKeySet{keys: keys}, nil
+}
+
+// PublicKey gets public key by its id.
+func (s *StaticKeySet) PublicKey(_ context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, entity.ErrInvalidArgument("unknown key id")
+	}
+	return key, nil
+}
+
+// RemoteKeySet is a KeySet fetched from auth's JWKS endpoint and cached in memory.
+//
+// The key set is fetched again when it is older than TTL or when the token is signed with an unknown key,
+// which happens right after the auth service starts signing with a new key.
+// To protect the auth service, it is never fetched more than once every 10 seconds.
+// If the fetch fails, keys that are already known keep being used.
+// The fetch happens outside the lock, so keys that are still fresh are served while it is in flight,
+// and concurrent callers that need a refresh share the same fetch.
+type RemoteKeySet struct {
+	client    *http.Client
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	checkedAt time.Time
+	group     singleflight.Group
+	url       string
+	ttl       time.Duration
+	mu        sync.RWMutex
+}
+
+// NewRemoteKeySet creates an instance of RemoteKeySet.
+// If ttl is not positive, DefaultKeySetTTL is used.
+func NewRemoteKeySet(url string, ttl time.Duration) *RemoteKeySet {
+	if ttl <= 0 {
+		ttl = DefaultKeySetTTL
+	}
+	return &RemoteKeySet{
+		client: &http.Client{Timeout: keySetHTTPTimeout},
+		keys:   make(map[string]crypto.PublicKey),
+		url:    url,
+		ttl:    ttl,
+	}
+}
+
+// PublicKey gets public key by its id.
+func (r *RemoteKeySet) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.mu.RLock()
+	key, ok := r.keys[kid]
+	fresh := time.Since(r.fetchedAt) < r.ttl
+	r.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	// The fetch is shared, hence it must not be canceled by the caller that happens to start it.
+	if _, err, _ := r.group.Do(r.url, func() (any, error) { return nil, r.refresh(context.WithoutCancel(ctx)) }); err != nil {
+		slog.ErrorContext(ctx, "[RemoteKeySet-PublicKey] fail refresh key set", "error", err)
+	}
+
+	r.mu.RLock()
+	key, ok = r.keys[kid]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, entity.ErrInvalidArgument("unknown key id")
+	}
+	return key, nil
+}
+
+// refresh fetches the key set unless it has been fetched in the last interval.
+func (r *RemoteKeySet) refresh(ctx context.Context) error {
+	now := time.Now()
+	r.mu.Lock()
+	if now.Sub(r.checkedAt) < keySetMinRefreshInterval {
+		r.mu.Unlock()
+		return nil
+	}
+	r.checkedAt = now
+	r.mu.Unlock()
+
+	keys, err := r.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetchedAt = now
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *RemoteKeySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, entity.ErrInternal("unexpected key set response status " + resp.Status)
+	}
+
+	var jwks entity.JSONWebKeySet
+	if err = json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, err
+	}
+	return publicKeys(&jwks)
+}
+
+func publicKeys(jwks *entity.JSONWebKeySet) (map[string]crypto.PublicKey, error) {
+	if jwks == nil {
+		return nil, entity.ErrInvalidArgument("empty key set")
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := PublicKey(jwk)
+		if err != nil {
+			return nil, err
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,179 @@
+package auth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/auth/entity"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+)
+
+func TestNewStaticKeySet(t *testing.T) {
+	t.Run("key set is invalid", func(t *testing.T) {
+		ks, err := sdkauth.NewStaticKeySet(&entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{{Kty: "EC"}}})
+
+		assert.Error(t, err)
+		assert.Nil(t, ks)
+	})
+
+	t.Run("empty key set is prohibited", func(t *testing.T) {
+		ks, err := sdkauth.NewStaticKeySet(nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, ks)
+	})
+}
+
+func TestStaticKeySet_PublicKey(t *testing.T) {
+	jwk, pub := createTestJSONWebKey(t)
+	ks, err := sdkauth.NewStaticKeySet(&entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{jwk}})
+	assert.NoError(t, err)
+
+	t.Run("key is unknown", func(t *testing.T) {
+		key, err := ks.PublicKey(t.Context(), "unknown")
+
+		assert.Error(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("success get key", func(t *testing.T) {
+		key, err := ks.PublicKey(t.Context(), jwk.Kid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pub, key)
+	})
+}
+
+func TestRemoteKeySet_PublicKey(t *testing.T) {
+	t.Run("key set can't be fetched", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+		ks := sdkauth.NewRemoteKeySet(srv.URL, 0)
+
+		key, err := ks.PublicKey(t.Context(), "kid")
+
+		assert.Error(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("key set response is invalid", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"keys":[{"kty":"EC"}]}`))
+		}))
+		defer srv.Close()
+		ks := sdkauth.NewRemoteKeySet(srv.URL, 0)
+
+		key, err := ks.PublicKey(t.Context(), "kid")
+
+		assert.Error(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("key is fetched once and served from memory", func(t *testing.T) {
+		jwk, pub := createTestJSONWebKey(t)
+		var calls atomic.Int32
+		srv := createTestJWKSServer(t, &calls, jwk)
+		defer srv.Close()
+		ks := sdkauth.NewRemoteKeySet(srv.URL, time.Hour)
+
+		for range 3 {
+			key, err := ks.PublicKey(t.Context(), jwk.Kid)
+
+			assert.NoError(t, err)
+			assert.Equal(t, pub, key)
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("unknown key doesn't refetch more than once per interval", func(t *testing.T) {
+		jwk, _ := createTestJSONWebKey(t)
+		var calls atomic.Int32
+		srv := createTestJWKSServer(t, &calls, jwk)
+		defer srv.Close()
+		ks := sdkauth.NewRemoteKeySet(srv.URL, time.Hour)
+
+		for range 3 {
+			key, err := ks.PublicKey(t.Context(), "unknown")
+
+			assert.Error(t, err)
+			assert.Nil(t, key)
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("concurrent callers share one fetch", func(t *testing.T) {
+		jwk, pub := createTestJSONWebKey(t)
+		var calls atomic.Int32
+		body, err := json.Marshal(&entity.JSONWebKeySet{Keys: []*entity.JSONWebKey{jwk}})
+		assert.NoError(t, err)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write(body)
+		}))
+		defer srv.Close()
+		ks := sdkauth.NewRemoteKeySet(srv.URL, time.Hour)
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Go(func() {
+				key, err := ks.PublicKey(t.Context(), jwk.Kid)
+
+				assert.NoError(t, err)
+				assert.Equal(t, pub, key)
+			})
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("known key is still served when refresh fails", func(t *testing.T) {
+		jwk, pub := createTestJSONWebKey(t)
+		var calls atomic.Int32
+		srv := createTestJWKSServer(t, &calls, jwk)
+		ks := sdkauth.NewRemoteKeySet(srv.URL, time.Nanosecond)
+
+		_, err := ks.PublicKey(t.Context(), jwk.Kid)
+		assert.NoError(t, err)
+		srv.Close()
+
+		key, err := ks.PublicKey(t.Context(), jwk.Kid)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pub, key)
+	})
+}
+
+func createTestJSONWebKey(t *testing.T) (*entity.JSONWebKey, ed25519.PublicKey) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("fail generate key: %v", err)
+	}
+	jwk, err := sdkauth.NewJSONWebKey(pub)
+	if err != nil {
+		t.Fatalf("fail create jwk: %v", err)
+	}
+	return jwk, pub
+}
+
+func createTestJWKSServer(t *testing.T, calls *atomic.Int32, keys ...*entity.JSONWebKey) *httptest.Server {
+	body, err := json.Marshal(&entity.JSONWebKeySet{Keys: keys})
+	if err != nil {
+		t.Fatalf("fail marshal jwks: %v", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write(body)
+	}))
+}
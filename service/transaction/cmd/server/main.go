@@ -8,19 +8,27 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	"github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/server"
 	sdklog "github.com/indrasaputra/arjuna/pkg/sdk/log"
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/builder"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/config"
+	connwallet "github.com/indrasaputra/arjuna/service/transaction/internal/connection/wallet"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/grpc/handler"
+	orcact "github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/activity"
+	orcwork "github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/workflow"
+	pgrepo "github.com/indrasaputra/arjuna/service/transaction/internal/repository/postgres"
 )
 
 func main() {
@@ -31,6 +39,11 @@ func main() {
 		Short: "Run the API server.",
 		Run:   API,
 	})
+	command.AddCommand(&cobra.Command{
+		Use:   "worker",
+		Short: "Run the worker.",
+		Run:   Worker,
+	})
 
 	if err := command.Execute(); err != nil {
 		log.Fatal(err)
@@ -57,22 +70,28 @@ func API(_ *cobra.Command, _ []string) {
 	redisClient, err := redis.NewRedisClient(cfg.Redis)
 	checkError(err)
 	idempotencyStore := redis.NewIdempotency(redisClient, cfg.Redis.TTL)
+	temporalClient, err := builder.BuildTemporalClient(cfg.Temporal.Address)
+	checkError(err)
+	defer temporalClient.Close()
 
 	dep := &builder.Dependency{
-		Config:  cfg,
-		Queries: queries,
+		Config:         cfg,
+		TemporalClient: temporalClient,
+		Queries:        queries,
 	}
 
 	c := &server.Config{
 		Name:                      cfg.ServiceName,
 		Port:                      cfg.Port,
-		Secret:                    []byte(cfg.SecretKey),
+		KeySet:                    sdkauth.NewRemoteKeySet(cfg.JWKSURL, sdkauth.DefaultKeySetTTL),
 		Username:                  cfg.Username,
 		Password:                  cfg.Password,
 		AppliedBearerAuthMethods:  strings.Split(cfg.AppliedAuthBearer, ","),
 		AppliedBasicAuthMethods:   strings.Split(cfg.AppliedAuthBasic, ","),
 		AppliedIdempotencyMethods: strings.Split(cfg.AppliedIdempotency, ","),
 		IdempotencyStore:          idempotencyStore,
+		IdempotencyLockTTL:        cfg.IdempotencyLockTTL,
+		TokenRevocationStore:      interceptor.NewRevocationCache(redis.NewTokenRevocation(redisClient), interceptor.DefaultRevocationCacheTTL),
 	}
 	srv := server.NewServer(c)
 	registerGrpcService(srv, dep)
@@ -82,13 +101,57 @@ func API(_ *cobra.Command, _ []string) {
 	srv.GracefulStop()
 }
 
+// Worker is the entry point for running the worker server.
+func Worker(_ *cobra.Command, _ []string) {
+	ctx := context.Background()
+
+	cfg, err := config.NewConfig(".env")
+	checkError(err)
+
+	logger := sdklog.NewSlogLogger(cfg.ServiceName)
+	slog.SetDefault(logger)
+
+	_, err = trace.NewProvider(ctx, cfg.Tracer)
+	checkError(err)
+
+	temporalClient, err := builder.BuildTemporalClient(cfg.Temporal.Address)
+	checkError(err)
+	defer temporalClient.Close()
+	walletClient, err := builder.BuildWalletClient(cfg.WalletServiceHost, cfg.WalletServiceUsername, cfg.WalletServicePassword)
+	checkError(err)
+	pool, err := postgres.NewPgxPool(cfg.Postgres)
+	checkError(err)
+	defer pool.Close()
+	txm, err := uow.NewTxManager(pool)
+	checkError(err)
+	queries := builder.BuildQueries(pool, uow.NewTxGetter())
+
+	wc := connwallet.NewWallet(walletClient)
+	db := pgrepo.NewTransaction(queries)
+
+	act := orcact.NewCreateTransactionActivity(wc, db, txm)
+
+	w := worker.New(temporalClient, orcwork.TaskQueueCreateTransaction, worker.Options{
+		DisableRegistrationAliasing: true,
+	})
+	w.RegisterWorkflow(orcwork.CreateTransaction)
+	w.RegisterActivityWithOptions(act, activity.RegisterOptions{Name: "CreateTransactionActivity", SkipInvalidStructFunctions: true})
+
+	err = w.Run(worker.InterruptCh())
+	if err != nil {
+		log.Panic("Unable to start worker", err)
+	}
+}
+
 func registerGrpcService(srv *server.Server, dep *builder.Dependency) {
 	// start register all module's gRPC handlers
 	command := builder.BuildTransactionCommandHandler(dep)
+	query := builder.BuildTransactionQueryHandler(dep)
 	health := handler.NewHealth()
 
 	srv.AttachService(func(server *grpc.Server) {
 		apiv1.RegisterTransactionCommandServiceServer(server, command)
+		apiv1.RegisterTransactionQueryServiceServer(server, query)
 		grpc_health_v1.RegisterHealthServer(server, health)
 	})
 	// end of register all module's gRPC handlers
@@ -0,0 +1,6 @@
+-- Modify "transactions" table
+-- Existing transactions didn't record the wallets, and wallets live in another database,
+-- hence they are backfilled with nil uuid. The default is dropped so new transactions must set the wallets.
+ALTER TABLE public.transactions ADD COLUMN sender_wallet_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000', ADD COLUMN receiver_wallet_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+-- Modify "transactions" table
+ALTER TABLE public.transactions ALTER COLUMN sender_wallet_id DROP DEFAULT, ALTER COLUMN receiver_wallet_id DROP DEFAULT;
@@ -0,0 +1,4 @@
+-- Create index "index_on_transactions_on_receiver_id_and_id" to table: "transactions"
+CREATE INDEX index_on_transactions_on_receiver_id_and_id ON public.transactions (receiver_id, id);
+-- Create index "index_on_transactions_on_sender_id_and_id" to table: "transactions"
+CREATE INDEX index_on_transactions_on_sender_id_and_id ON public.transactions (sender_id, id);
@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE public.transactions ALTER COLUMN amount TYPE numeric(24, 4), ADD COLUMN currency character(3) NOT NULL DEFAULT 'IDR';
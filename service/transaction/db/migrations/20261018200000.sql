@@ -0,0 +1,4 @@
+-- Modify "transactions" table
+ALTER TABLE public.transactions ADD COLUMN original_transaction_id uuid NULL;
+-- Create index "index_on_transactions_on_original_transaction_id" to table: "transactions"
+CREATE INDEX index_on_transactions_on_original_transaction_id ON public.transactions (original_transaction_id);
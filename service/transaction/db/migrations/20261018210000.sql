@@ -0,0 +1,6 @@
+-- Create enum type "transaction_status"
+CREATE TYPE public.transaction_status AS ENUM ('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED', 'REVERSED');
+-- Modify "transactions" table
+ALTER TABLE public.transactions ADD COLUMN status public.transaction_status NOT NULL DEFAULT 'COMPLETED', ADD COLUMN failure_reason text NULL, ADD COLUMN processing_at timestamp NULL, ADD COLUMN completed_at timestamp NULL, ADD COLUMN failed_at timestamp NULL, ADD COLUMN reversed_at timestamp NULL;
+-- Create index "index_on_transactions_on_status_and_updated_at" to table: "transactions"
+CREATE INDEX index_on_transactions_on_status_and_updated_at ON public.transactions (status, updated_at);
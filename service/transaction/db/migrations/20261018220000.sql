@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE public.transactions ADD COLUMN quote_id uuid NULL;
@@ -0,0 +1,2 @@
+-- Backfill "completed_at" of the transactions completed before the lifecycle was recorded
+UPDATE public.transactions SET completed_at = created_at WHERE status = 'COMPLETED' AND completed_at IS NULL;
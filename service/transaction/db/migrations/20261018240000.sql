@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE public.transactions ADD COLUMN hold_id uuid NULL;
@@ -1,6 +1,40 @@
 -- name: CreateTransaction :exec
-INSERT INTO transactions (id, sender_id, receiver_id, amount, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+INSERT INTO transactions (id, sender_id, receiver_id, sender_wallet_id, receiver_wallet_id, amount, currency, original_transaction_id, quote_id, hold_id, status, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
 
 -- name: HardDeleteAllTransactions :exec
 DELETE FROM transactions;
+
+-- name: GetTransactionByID :one
+SELECT * FROM transactions WHERE id = $1;
+
+-- name: GetTransactionByIDForUpdate :one
+SELECT * FROM transactions WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE; --noqa
+
+-- name: GetRefundedAmount :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC(24, 4) AS amount
+FROM transactions WHERE original_transaction_id = @original_transaction_id AND status = ANY(@statuses::transaction_status[]);
+
+-- name: GetUserTransactions :many
+SELECT * FROM transactions
+WHERE (sender_id = @user_id OR receiver_id = @user_id)
+    AND (sqlc.narg('cursor')::UUID IS NULL OR id < sqlc.narg('cursor')::UUID)
+    AND (sqlc.narg('counterparty_id')::UUID IS NULL OR sender_id = sqlc.narg('counterparty_id')::UUID OR receiver_id = sqlc.narg('counterparty_id')::UUID)
+    AND (sqlc.narg('created_after')::TIMESTAMP IS NULL OR created_at >= sqlc.narg('created_after')::TIMESTAMP)
+    AND (sqlc.narg('created_before')::TIMESTAMP IS NULL OR created_at < sqlc.narg('created_before')::TIMESTAMP)
+    AND (sqlc.narg('min_amount')::NUMERIC IS NULL OR amount >= sqlc.narg('min_amount')::NUMERIC)
+    AND (sqlc.narg('max_amount')::NUMERIC IS NULL OR amount <= sqlc.narg('max_amount')::NUMERIC)
+    AND (sqlc.narg('currency')::CHAR(3) IS NULL OR currency = sqlc.narg('currency')::CHAR(3))
+ORDER BY id DESC
+LIMIT @page_limit;
+
+-- name: UpdateTransactionStatus :execrows
+UPDATE transactions
+SET status = @status, failure_reason = @failure_reason, processing_at = @processing_at, completed_at = @completed_at, failed_at = @failed_at, reversed_at = @reversed_at, updated_at = @updated_at
+WHERE id = @id AND status = @current_status;
+
+-- name: GetStaleTransactions :many
+SELECT * FROM transactions
+WHERE status = @status AND updated_at < @older_than
+ORDER BY updated_at ASC
+LIMIT @page_limit;
@@ -106,8 +106,145 @@ func ErrInvalidAmount() error {
 	return res.Err()
 }
 
+// ErrInvalidCurrency returns codes.InvalidArgument explained that the currency is not supported.
+func ErrInvalidCurrency() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "currency",
+		Description: "must be a supported ISO-4217 currency code",
+	})
+
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_CURRENCY,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrTransferRejected returns codes.FailedPrecondition explained that wallet rejects the transfer.
+func ErrTransferRejected(message string) error {
+	st := status.New(codes.FailedPrecondition, message)
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_TRANSFER_REJECTED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidFilter returns codes.InvalidArgument explained that the filter's field is invalid.
+func ErrInvalidFilter(field, description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_FILTER,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrNotFound returns codes.NotFound explained that the transaction is not found.
+func ErrNotFound() error {
+	st := status.New(codes.NotFound, "")
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrAlreadyRefunded returns codes.AlreadyExists explained that the transaction has no amount left to refund.
+func ErrAlreadyRefunded() error {
+	st := status.New(codes.AlreadyExists, "transaction has no amount left to refund")
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_ALREADY_REFUNDED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrNotRefundable returns codes.FailedPrecondition explained that the transaction can't be refunded.
+func ErrNotRefundable(message string) error {
+	st := status.New(codes.FailedPrecondition, message)
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_NOT_REFUNDABLE,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidStatusTransition returns codes.FailedPrecondition explained that the transaction can't move to the status.
+func ErrInvalidStatusTransition(from, to TransactionStatus) error {
+	st := status.Newf(codes.FailedPrecondition, "transaction can't move from %s to %s", from, to)
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_STATUS_TRANSITION,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
 func createBadRequest(details ...*errdetails.BadRequest_FieldViolation) *errdetails.BadRequest {
 	return &errdetails.BadRequest{
 		FieldViolations: details,
 	}
 }
+
+// ErrInvalidQuote returns codes.InvalidArgument explained that the quote id is invalid.
+func ErrInvalidQuote() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "quote_id",
+		Description: "must be a valid uuid",
+	})
+
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_QUOTE,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidHold returns codes.InvalidArgument explained that the hold id is invalid.
+func ErrInvalidHold() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "hold_id",
+		Description: "must be a valid uuid and can't be used with quote_id",
+	})
+
+	te := &apiv1.TransactionError{
+		ErrorCode: apiv1.TransactionErrorCode_TRANSACTION_ERROR_CODE_INVALID_HOLD,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
@@ -55,3 +55,76 @@ func TestErrInvalidAmount(t *testing.T) {
 		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
 	})
 }
+
+func TestErrInvalidCurrency(t *testing.T) {
+	t.Run("success get invalid currency error", func(t *testing.T) {
+		err := entity.ErrInvalidCurrency()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrTransferRejected(t *testing.T) {
+	t.Run("success get transfer rejected error", func(t *testing.T) {
+		err := entity.ErrTransferRejected("insufficient balance")
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
+
+func TestErrInvalidFilter(t *testing.T) {
+	t.Run("success get invalid filter error", func(t *testing.T) {
+		err := entity.ErrInvalidFilter("cursor", "must be a valid id")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrNotFound(t *testing.T) {
+	t.Run("success get not found error", func(t *testing.T) {
+		err := entity.ErrNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrAlreadyRefunded(t *testing.T) {
+	t.Run("success get already refunded error", func(t *testing.T) {
+		err := entity.ErrAlreadyRefunded()
+
+		assert.Contains(t, err.Error(), "rpc error: code = AlreadyExists")
+	})
+}
+
+func TestErrNotRefundable(t *testing.T) {
+	t.Run("success get not refundable error", func(t *testing.T) {
+		err := entity.ErrNotRefundable("refund can't be refunded")
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
+
+func TestErrInvalidStatusTransition(t *testing.T) {
+	t.Run("success get invalid status transition error", func(t *testing.T) {
+		err := entity.ErrInvalidStatusTransition(entity.TransactionStatusFailed, entity.TransactionStatusCompleted)
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+		assert.Contains(t, err.Error(), "transaction can't move from FAILED to COMPLETED")
+	})
+}
+
+func TestErrInvalidQuote(t *testing.T) {
+	t.Run("success get invalid quote error", func(t *testing.T) {
+		err := entity.ErrInvalidQuote()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidHold(t *testing.T) {
+	t.Run("success get invalid hold error", func(t *testing.T) {
+		err := entity.ErrInvalidHold()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
@@ -1,19 +1,152 @@
 package entity
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
+// TransactionStatus enumerates the lifecycle of a transaction.
+type TransactionStatus string
+
+const (
+	// TransactionStatusPending means the transaction is recorded, but the balance hasn't been moved.
+	TransactionStatusPending TransactionStatus = "PENDING"
+	// TransactionStatusProcessing means the balance is being moved.
+	TransactionStatusProcessing TransactionStatus = "PROCESSING"
+	// TransactionStatusCompleted means the balance has been moved.
+	TransactionStatusCompleted TransactionStatus = "COMPLETED"
+	// TransactionStatusFailed means the balance can't be moved.
+	TransactionStatusFailed TransactionStatus = "FAILED"
+	// TransactionStatusReversed means the transaction has been fully refunded.
+	TransactionStatusReversed TransactionStatus = "REVERSED"
+)
+
+// transactionStatusTransitions lists the statuses a transaction can move to from each status.
+// Failed and reversed are final.
+var transactionStatusTransitions = map[TransactionStatus][]TransactionStatus{
+	TransactionStatusPending:    {TransactionStatusProcessing, TransactionStatusFailed},
+	TransactionStatusProcessing: {TransactionStatusCompleted, TransactionStatusFailed},
+	TransactionStatusCompleted:  {TransactionStatusReversed},
+}
+
+// CanTransitionTo tells whether a transaction in this status can move to the next status.
+func (s TransactionStatus) CanTransitionTo(next TransactionStatus) bool {
+	return slices.Contains(transactionStatusTransitions[s], next)
+}
+
 // Transaction defines logical data related to transaction.
 type Transaction struct {
-	Amount decimal.Decimal
+	// OriginalTransactionID is the transaction refunded by this transaction.
+	// It is nil if this transaction is not a refund.
+	OriginalTransactionID *uuid.UUID
+	ProcessingAt          *time.Time
+	CompletedAt           *time.Time
+	FailedAt              *time.Time
+	ReversedAt            *time.Time
+	Amount                decimal.Decimal
+	Currency              string
+	Status                TransactionStatus
+	// FailureReason is empty unless the status is failed.
+	FailureReason string
 	Auditable
-	ID         uuid.UUID
-	SenderID   uuid.UUID
-	ReceiverID uuid.UUID
+	ID               uuid.UUID
+	SenderID         uuid.UUID
+	ReceiverID       uuid.UUID
+	SenderWalletID   uuid.UUID
+	ReceiverWalletID uuid.UUID
+	// QuoteID is the fx quote used to convert the amount to receiver's wallet currency.
+	// It is uuid.Nil if both wallets have the same currency.
+	QuoteID uuid.UUID
+	// HoldID is the wallet's hold captured by this transaction.
+	// It is uuid.Nil if the transaction transfers the balance directly.
+	HoldID uuid.UUID
+}
+
+// IsRefund tells whether the transaction refunds another transaction.
+func (t *Transaction) IsRefund() bool {
+	return t.OriginalTransactionID != nil
+}
+
+// IsConverted tells whether the transaction converts the amount to another currency using a fx quote.
+func (t *Transaction) IsConverted() bool {
+	return t.QuoteID != uuid.Nil
+}
+
+// IsCapture tells whether the transaction captures a wallet's hold instead of transferring the balance directly.
+func (t *Transaction) IsCapture() bool {
+	return t.HoldID != uuid.Nil
+}
+
+// Transition moves the transaction to the next status at the given time.
+// The reason is only recorded when the transaction fails.
+// It returns error if the current status can't move to the next status.
+func (t *Transaction) Transition(next TransactionStatus, at time.Time, reason string) error {
+	if !t.Status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition(t.Status, next)
+	}
+
+	t.Status = next
+	t.UpdatedAt = at
+	switch next {
+	case TransactionStatusProcessing:
+		t.ProcessingAt = &at
+	case TransactionStatusCompleted:
+		t.CompletedAt = &at
+	case TransactionStatusFailed:
+		t.FailedAt = &at
+		t.FailureReason = reason
+	case TransactionStatusReversed:
+		t.ReversedAt = &at
+	}
+	return nil
+}
+
+// CreateTransactionInput defines input for create transaction workflow.
+type CreateTransactionInput struct {
+	Transaction *Transaction
+}
+
+// TransitionTransactionInput defines input to move a transaction to another status.
+type TransitionTransactionInput struct {
+	Status        TransactionStatus
+	FailureReason string
+	ID            uuid.UUID
+}
+
+// CreateTransactionOutput defines output for create transaction workflow.
+type CreateTransactionOutput struct {
+	ID uuid.UUID
+}
+
+// TransactionFilter defines criteria to list user's transactions.
+// Nil field means the criterion is not applied.
+type TransactionFilter struct {
+	Cursor         *uuid.UUID
+	CounterpartyID *uuid.UUID
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	MinAmount      *decimal.Decimal
+	MaxAmount      *decimal.Decimal
+	Currency       *string
+	Limit          uint
+	UserID         uuid.UUID
+}
+
+// StaleTransactionFilter defines criteria to list transactions which have stayed in a status for too long.
+type StaleTransactionFilter struct {
+	OlderThan time.Time
+	Status    TransactionStatus
+	Limit     uint
+}
+
+// TransactionPage defines a page of transactions.
+// NextCursor is nil when there is no more transaction.
+type TransactionPage struct {
+	NextCursor   *uuid.UUID
+	Transactions []*Transaction
 }
 
 // Auditable defines logical data related to audit.
@@ -1 +1,121 @@
 package entity_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+func TestTransaction_IsRefund(t *testing.T) {
+	t.Run("transaction is not a refund", func(t *testing.T) {
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7())}
+
+		assert.False(t, trx.IsRefund())
+	})
+
+	t.Run("transaction is a refund", func(t *testing.T) {
+		id := uuid.Must(uuid.NewV7())
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), OriginalTransactionID: &id}
+
+		assert.True(t, trx.IsRefund())
+	})
+}
+
+func TestTransaction_IsCapture(t *testing.T) {
+	t.Run("transaction doesn't capture a hold", func(t *testing.T) {
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7())}
+
+		assert.False(t, trx.IsCapture())
+	})
+
+	t.Run("transaction captures a hold", func(t *testing.T) {
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), HoldID: uuid.Must(uuid.NewV7())}
+
+		assert.True(t, trx.IsCapture())
+	})
+}
+
+func TestTransactionStatus_CanTransitionTo(t *testing.T) {
+	statuses := []entity.TransactionStatus{
+		entity.TransactionStatusPending,
+		entity.TransactionStatusProcessing,
+		entity.TransactionStatusCompleted,
+		entity.TransactionStatusFailed,
+		entity.TransactionStatusReversed,
+	}
+	allowed := map[entity.TransactionStatus][]entity.TransactionStatus{
+		entity.TransactionStatusPending:    {entity.TransactionStatusProcessing, entity.TransactionStatusFailed},
+		entity.TransactionStatusProcessing: {entity.TransactionStatusCompleted, entity.TransactionStatusFailed},
+		entity.TransactionStatusCompleted:  {entity.TransactionStatusReversed},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(string(from)+" to "+string(to), func(t *testing.T) {
+				assert.Equal(t, slices.Contains(allowed[from], to), from.CanTransitionTo(to))
+			})
+		}
+	}
+}
+
+func TestTransaction_Transition(t *testing.T) {
+	now := time.Now()
+
+	t.Run("transition is not allowed", func(t *testing.T) {
+		trx := &entity.Transaction{Status: entity.TransactionStatusFailed}
+
+		err := trx.Transition(entity.TransactionStatusCompleted, now, "")
+
+		assert.Equal(t, entity.ErrInvalidStatusTransition(entity.TransactionStatusFailed, entity.TransactionStatusCompleted), err)
+		assert.Equal(t, entity.TransactionStatusFailed, trx.Status)
+		assert.Nil(t, trx.CompletedAt)
+	})
+
+	t.Run("success move to processing", func(t *testing.T) {
+		trx := &entity.Transaction{Status: entity.TransactionStatusPending}
+
+		err := trx.Transition(entity.TransactionStatusProcessing, now, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusProcessing, trx.Status)
+		assert.Equal(t, now, *trx.ProcessingAt)
+		assert.Equal(t, now, trx.UpdatedAt)
+	})
+
+	t.Run("success move to completed", func(t *testing.T) {
+		trx := &entity.Transaction{Status: entity.TransactionStatusProcessing}
+
+		err := trx.Transition(entity.TransactionStatusCompleted, now, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusCompleted, trx.Status)
+		assert.Equal(t, now, *trx.CompletedAt)
+	})
+
+	t.Run("success move to failed with the reason", func(t *testing.T) {
+		trx := &entity.Transaction{Status: entity.TransactionStatusProcessing}
+
+		err := trx.Transition(entity.TransactionStatusFailed, now, "insufficient balance")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusFailed, trx.Status)
+		assert.Equal(t, now, *trx.FailedAt)
+		assert.Equal(t, "insufficient balance", trx.FailureReason)
+	})
+
+	t.Run("success move to reversed", func(t *testing.T) {
+		trx := &entity.Transaction{Status: entity.TransactionStatusCompleted}
+
+		err := trx.Transition(entity.TransactionStatusReversed, now, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusReversed, trx.Status)
+		assert.Equal(t, now, *trx.ReversedAt)
+		assert.Empty(t, trx.FailureReason)
+	})
+}
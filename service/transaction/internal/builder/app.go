@@ -1,28 +1,60 @@
 package builder
 
 import (
+	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/config"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/grpc/handler"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/workflow"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/repository/db"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/repository/postgres"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/service"
+	sdkwallet "github.com/indrasaputra/arjuna/service/wallet/pkg/sdk/wallet"
 )
 
 // Dependency holds any dependency to build full use cases.
 type Dependency struct {
-	Config  *config.Config
-	Queries *db.Queries
+	Config         *config.Config
+	TemporalClient client.Client
+	Queries        *db.Queries
 }
 
 // BuildTransactionCommandHandler builds transaction command handler including all of its dependencies.
 func BuildTransactionCommandHandler(dep *Dependency) *handler.TransactionCommand {
+	w := workflow.NewCreateTransactionWorkflow(dep.TemporalClient)
+	p := postgres.NewTransaction(dep.Queries)
+
+	c := service.NewTransactionCreator(w)
+	r := service.NewTransactionRefunder(p, w)
+
+	return handler.NewTransactionCommand(c, r)
+}
+
+// BuildTransactionQueryHandler builds transaction query handler including all of its dependencies.
+func BuildTransactionQueryHandler(dep *Dependency) *handler.TransactionQuery {
 	p := postgres.NewTransaction(dep.Queries)
+	g := service.NewTransactionGetter(p)
+	return handler.NewTransactionQuery(g)
+}
 
-	c := service.NewTransactionCreator(p)
+// BuildTemporalClient builds temporal client.
+func BuildTemporalClient(address string) (client.Client, error) {
+	return client.Dial(client.Options{HostPort: address})
+}
 
-	return handler.NewTransactionCommand(c)
+// BuildWalletClient builds wallet service client.
+func BuildWalletClient(host, username, password string) (*sdkwallet.Client, error) {
+	dc := &sdkwallet.Config{
+		Host:     host,
+		Options:  []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		Username: username,
+		Password: password,
+	}
+	return sdkwallet.NewClient(dc)
 }
 
 // BuildQueries builds sqlc queries.
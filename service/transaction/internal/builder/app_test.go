@@ -24,6 +24,36 @@ func TestBuildTransactionCommandHandler(t *testing.T) {
 	})
 }
 
+func TestBuildTransactionQueryHandler(t *testing.T) {
+	t.Run("success create transaction query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			Config: &config.Config{},
+		}
+
+		handler := builder.BuildTransactionQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildTemporalClient(t *testing.T) {
+	t.Run("fail build a temporal client", func(t *testing.T) {
+		client, err := builder.BuildTemporalClient("localhost:7233")
+
+		assert.Error(t, err)
+		assert.Nil(t, client)
+	})
+}
+
+func TestBuildWalletClient(t *testing.T) {
+	t.Run("success build a wallet client", func(t *testing.T) {
+		client, err := builder.BuildWalletClient("localhost:8004", "user", "pass")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+}
+
 func TestBuildQueries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/joeshaw/envdecode"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
@@ -12,19 +14,31 @@ import (
 
 // Config holds configuration for the project.
 type Config struct {
-	Tracer             trace.Config
-	ServiceName        string `env:"SERVICE_NAME,default=transaction-server"`
-	AppEnv             string `env:"APP_ENV,default=development"`
-	Port               string `env:"PORT,default=8003"`
-	PrometheusPort     string `env:"PROMETHEUS_PORT,default=7003"`
-	Username           string `env:"USERNAME,default=transaction-user"`
-	Password           string `env:"PASSWORD,default=transaction-password"`
-	AppliedAuthBearer  string `env:"APPLIED_AUTH_BEARER"`
-	AppliedAuthBasic   string `env:"APPLIED_AUTH_BASIC"`
-	AppliedIdempotency string `env:"APPLIED_IDEMPOTENCY"`
-	SecretKey          string `env:"TOKEN_SECRET_KEY,required"`
-	Redis              sdkrds.Config
-	Postgres           sdkpg.Config
+	Tracer                trace.Config
+	Temporal              Temporal
+	ServiceName           string `env:"SERVICE_NAME,default=transaction-server"`
+	AppEnv                string `env:"APP_ENV,default=development"`
+	Port                  string `env:"PORT,default=8003"`
+	PrometheusPort        string `env:"PROMETHEUS_PORT,default=7003"`
+	Username              string `env:"USERNAME,default=transaction-user"`
+	Password              string `env:"PASSWORD,default=transaction-password"`
+	AppliedAuthBearer     string `env:"APPLIED_AUTH_BEARER"`
+	AppliedAuthBasic      string `env:"APPLIED_AUTH_BASIC"`
+	AppliedIdempotency    string `env:"APPLIED_IDEMPOTENCY"`
+	JWKSURL               string `env:"TOKEN_JWKS_URL,required"`
+	WalletServiceHost     string `env:"WALLET_SERVICE_HOST,required"`
+	WalletServiceUsername string `env:"WALLET_SERVICE_USERNAME"`
+	WalletServicePassword string `env:"WALLET_SERVICE_PASSWORD"`
+	Redis                 sdkrds.Config
+	Postgres              sdkpg.Config
+	// IdempotencyLockTTL is how long an idempotency key is reserved while its request is in progress.
+	// It must be longer than the transaction workflow's timeout.
+	IdempotencyLockTTL time.Duration `env:"IDEMPOTENCY_LOCK_TTL,default=5m"`
+}
+
+// Temporal holds configuration for Temporal.
+type Temporal struct {
+	Address string `env:"TEMPORAL_ADDRESS,default=localhost:7233"`
 }
 
 // Redis holds configuration for Redis.
@@ -0,0 +1,2 @@
+// Package wallet provides real connection to wallet service.
+package wallet
@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	enwallet "github.com/indrasaputra/arjuna/service/wallet/entity"
+	sdkwallet "github.com/indrasaputra/arjuna/service/wallet/pkg/sdk/wallet"
+)
+
+// Wallet is responsible to connect to wallet service.
+type Wallet struct {
+	client *sdkwallet.Client
+}
+
+// NewWallet creates an instance of Wallet.
+func NewWallet(c *sdkwallet.Client) *Wallet {
+	return &Wallet{client: c}
+}
+
+// TransferBalance transfers balance from sender's wallet to receiver's wallet.
+// Transaction's id is used as idempotency key and as transfer's reference, so the balance is moved at most once.
+// A transfer whose reference has been applied is treated as success.
+func (w *Wallet) TransferBalance(ctx context.Context, trx *entity.Transaction) error {
+	req := &enwallet.TransferWallet{
+		SenderID:         trx.SenderID,
+		SenderWalletID:   trx.SenderWalletID,
+		ReceiverID:       trx.ReceiverID,
+		ReceiverWalletID: trx.ReceiverWalletID,
+		Amount:           trx.Amount,
+		Currency:         trx.Currency,
+		QuoteID:          trx.QuoteID,
+		ReferenceID:      trx.ID,
+	}
+	err := w.client.TransferBalance(ctx, req, trx.ID.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-TransferBalance] fail call transfer balance", "error", err)
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	if status.Code(err) == codes.InvalidArgument {
+		return entity.ErrTransferRejected(status.Convert(err).Message())
+	}
+	return err
+}
+
+// CaptureHold captures the transaction's amount from the hold to receiver's wallet.
+// Transaction's id is used as idempotency key and as capture's reference, so the hold is captured at most once.
+// A capture whose reference has been applied is treated as success.
+func (w *Wallet) CaptureHold(ctx context.Context, trx *entity.Transaction) error {
+	req := &enwallet.CaptureHold{
+		HoldID:           trx.HoldID,
+		Amount:           trx.Amount,
+		UserID:           trx.SenderID,
+		WalletID:         trx.SenderWalletID,
+		ReceiverID:       trx.ReceiverID,
+		ReceiverWalletID: trx.ReceiverWalletID,
+		ReferenceID:      trx.ID,
+	}
+	err := w.client.CaptureHold(ctx, req, trx.ID.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-CaptureHold] fail call capture hold", "error", err)
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	if status.Code(err) == codes.InvalidArgument || status.Code(err) == codes.NotFound {
+		return entity.ErrTransferRejected(status.Convert(err).Message())
+	}
+	return err
+}
+
+// ResolveTransfer tells whether the transaction's transfer has been applied.
+// If it hasn't, wallet cancels it so the balance can never be moved afterwards.
+func (w *Wallet) ResolveTransfer(ctx context.Context, id uuid.UUID) (bool, error) {
+	applied, err := w.client.ResolveTransfer(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-ResolveTransfer] fail call resolve transfer", "error", err)
+	}
+	return applied, err
+}
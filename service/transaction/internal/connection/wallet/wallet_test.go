@@ -0,0 +1 @@
+package wallet_test
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/transaction/entity"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/service"
@@ -15,23 +16,39 @@ import (
 // TransactionCommand handles HTTP/2 gRPC request for state-changing transaction.
 type TransactionCommand struct {
 	apiv1.UnimplementedTransactionCommandServiceServer
-	creator service.CreateTransaction
+	creator  service.CreateTransaction
+	refunder service.RefundTransaction
 }
 
 // NewTransactionCommand creates an instance of TransactionCommand.
-func NewTransactionCommand(c service.CreateTransaction) *TransactionCommand {
-	return &TransactionCommand{creator: c}
+func NewTransactionCommand(c service.CreateTransaction, r service.RefundTransaction) *TransactionCommand {
+	return &TransactionCommand{creator: c, refunder: r}
 }
 
 // CreateTransaction handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
 func (tc *TransactionCommand) CreateTransaction(ctx context.Context, request *apiv1.CreateTransactionRequest) (*apiv1.CreateTransactionResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
 	if request == nil || request.GetTransaction() == nil {
 		slog.ErrorContext(ctx, "[TransactionCommand-CreateTransaction] empty or nil transaction")
 		return nil, entity.ErrEmptyTransaction()
 	}
 
+	quoteID, err := parseQuoteID(request.GetTransaction().GetQuoteId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionCommand-CreateTransaction] invalid quote id", "error", err)
+		return nil, entity.ErrInvalidQuote()
+	}
+	holdID, err := parseHoldID(request.GetTransaction().GetHoldId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionCommand-CreateTransaction] invalid hold id", "error", err)
+		return nil, entity.ErrInvalidHold()
+	}
+
 	amount, _ := decimal.NewFromString(request.GetTransaction().GetAmount())
-	id, err := tc.creator.Create(ctx, createTransactionFromCreateTransactionRequest(request, amount))
+	trx := createTransactionFromCreateTransactionRequest(request, userID, amount, quoteID)
+	trx.HoldID = holdID
+	id, err := tc.creator.Create(ctx, trx)
 	if err != nil {
 		slog.ErrorContext(ctx, "[TransactionCommand-CreateTransaction] fail register transaction", "error", err)
 		return nil, err
@@ -39,10 +56,68 @@ func (tc *TransactionCommand) CreateTransaction(ctx context.Context, request *ap
 	return &apiv1.CreateTransactionResponse{Data: &apiv1.Transaction{Id: id.String()}}, nil
 }
 
-func createTransactionFromCreateTransactionRequest(request *apiv1.CreateTransactionRequest, amount decimal.Decimal) *entity.Transaction {
+// RefundTransaction handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (tc *TransactionCommand) RefundTransaction(ctx context.Context, request *apiv1.RefundTransactionRequest) (*apiv1.RefundTransactionResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionCommand-RefundTransaction] invalid transaction id", "error", err)
+		return nil, entity.ErrNotFound()
+	}
+	amount, err := parseRefundAmount(request.GetAmount())
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionCommand-RefundTransaction] invalid amount", "error", err)
+		return nil, entity.ErrInvalidAmount()
+	}
+
+	refund, err := tc.refunder.Refund(ctx, userID, id, amount)
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionCommand-RefundTransaction] fail refund transaction", "error", err)
+		return nil, err
+	}
+	return &apiv1.RefundTransactionResponse{Data: createProtoTransaction(refund)}, nil
+}
+
+// parseRefundAmount parses the amount of a refund.
+// Empty amount means the whole amount is refunded.
+func parseRefundAmount(amount string) (decimal.Decimal, error) {
+	if amount == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(amount)
+}
+
+// parseQuoteID parses the fx quote's id.
+// Empty quote id means the transfer doesn't convert the amount.
+func parseQuoteID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+// parseHoldID parses the wallet's hold's id.
+// Empty hold id means the transfer doesn't capture a hold.
+func parseHoldID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+func createTransactionFromCreateTransactionRequest(request *apiv1.CreateTransactionRequest, userID uuid.UUID, amount decimal.Decimal, quoteID uuid.UUID) *entity.Transaction {
+	// invalid ids are left as uuid.Nil and rejected by the service's validation
+	receiverID, _ := uuid.Parse(request.GetTransaction().GetReceiverId())
+	senderWalletID, _ := uuid.Parse(request.GetTransaction().GetSenderWalletId())
+	receiverWalletID, _ := uuid.Parse(request.GetTransaction().GetReceiverWalletId())
 	return &entity.Transaction{
-		SenderID:   uuid.MustParse(request.GetTransaction().GetSenderId()),
-		ReceiverID: uuid.MustParse(request.GetTransaction().GetReceiverId()),
-		Amount:     amount,
+		SenderID:         userID,
+		ReceiverID:       receiverID,
+		SenderWalletID:   senderWalletID,
+		ReceiverWalletID: receiverWalletID,
+		Amount:           amount,
+		Currency:         request.GetTransaction().GetCurrency(),
+		QuoteID:          quoteID,
 	}
 }
@@ -1,21 +1,30 @@
 package handler_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/transaction/entity"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/grpc/handler"
 	mock_service "github.com/indrasaputra/arjuna/service/transaction/test/mock/service"
 )
 
+var (
+	testUserID      = uuid.Must(uuid.NewV7())
+	testCtxWithAuth = context.WithValue(testCtx, interceptor.HeaderKeyUserID, testUserID)
+)
+
 type TransactionCommandSuite struct {
-	handler *handler.TransactionCommand
-	creator *mock_service.MockCreateTransaction
+	handler  *handler.TransactionCommand
+	creator  *mock_service.MockCreateTransaction
+	refunder *mock_service.MockRefundTransaction
 }
 
 func TestNewTransactionCommand(t *testing.T) {
@@ -35,7 +44,7 @@ func TestTransactionCommand_CreateTransaction(t *testing.T) {
 	t.Run("nil request is prohibited", func(t *testing.T) {
 		st := createTransactionCommandSuite(ctrl)
 
-		res, err := st.handler.CreateTransaction(testCtx, nil)
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrEmptyTransaction(), err)
@@ -45,20 +54,59 @@ func TestTransactionCommand_CreateTransaction(t *testing.T) {
 	t.Run("empty transaction is prohibited", func(t *testing.T) {
 		st := createTransactionCommandSuite(ctrl)
 
-		res, err := st.handler.CreateTransaction(testCtx, &apiv1.CreateTransactionRequest{})
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, &apiv1.CreateTransactionRequest{})
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrEmptyTransaction(), err)
 		assert.Nil(t, res)
 	})
 
+	t.Run("quote id is invalid", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		request := &apiv1.CreateTransactionRequest{
+			Transaction: &apiv1.Transaction{
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
+				QuoteId:          "invalid",
+			},
+		}
+
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("hold id is invalid", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		request := &apiv1.CreateTransactionRequest{
+			Transaction: &apiv1.Transaction{
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
+				HoldId:           "invalid",
+			},
+		}
+
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidHold(), err)
+		assert.Nil(t, res)
+	})
+
 	t.Run("transaction service returns error", func(t *testing.T) {
 		st := createTransactionCommandSuite(ctrl)
 		request := &apiv1.CreateTransactionRequest{
 			Transaction: &apiv1.Transaction{
-				SenderId:   uuid.Must(uuid.NewV7()).String(),
-				ReceiverId: uuid.Must(uuid.NewV7()).String(),
-				Amount:     "10.23",
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
 			},
 		}
 
@@ -68,12 +116,13 @@ func TestTransactionCommand_CreateTransaction(t *testing.T) {
 			entity.ErrInvalidSender(),
 			entity.ErrInvalidReceiver(),
 			entity.ErrInvalidAmount(),
+			entity.ErrTransferRejected(""),
 			assert.AnError,
 		}
 		for _, errRet := range errors {
-			st.creator.EXPECT().Create(testCtx, gomock.Any()).Return(uuid.Must(uuid.NewV7()), errRet)
+			st.creator.EXPECT().Create(testCtxWithAuth, gomock.Any()).Return(uuid.Must(uuid.NewV7()), errRet)
 
-			res, err := st.handler.CreateTransaction(testCtx, request)
+			res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
 
 			assert.Error(t, err)
 			assert.Equal(t, errRet, err)
@@ -84,28 +133,159 @@ func TestTransactionCommand_CreateTransaction(t *testing.T) {
 	t.Run("success create transaction", func(t *testing.T) {
 		st := createTransactionCommandSuite(ctrl)
 		id := uuid.Must(uuid.NewV7())
-		st.creator.EXPECT().Create(testCtx, gomock.Any()).Return(id, nil)
+		st.creator.EXPECT().Create(testCtxWithAuth, gomock.Any()).
+			DoAndReturn(func(_ context.Context, trx *entity.Transaction) (uuid.UUID, error) {
+				assert.Equal(t, testUserID, trx.SenderID)
+				assert.Equal(t, "USD", trx.Currency)
+				assert.Equal(t, uuid.Nil, trx.QuoteID)
+				assert.Equal(t, uuid.Nil, trx.HoldID)
+				return id, nil
+			})
+		request := &apiv1.CreateTransactionRequest{
+			Transaction: &apiv1.Transaction{
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
+				Currency:         "USD",
+			},
+		}
+
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, id.String(), res.Data.GetId())
+	})
+
+	t.Run("success create transaction with fx quote", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		quoteID := uuid.Must(uuid.NewV7())
+		st.creator.EXPECT().Create(testCtxWithAuth, gomock.Any()).
+			DoAndReturn(func(_ context.Context, trx *entity.Transaction) (uuid.UUID, error) {
+				assert.Equal(t, quoteID, trx.QuoteID)
+				return id, nil
+			})
 		request := &apiv1.CreateTransactionRequest{
 			Transaction: &apiv1.Transaction{
-				SenderId:   uuid.Must(uuid.NewV7()).String(),
-				ReceiverId: uuid.Must(uuid.NewV7()).String(),
-				Amount:     "10.23",
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
+				Currency:         "USD",
+				QuoteId:          quoteID.String(),
 			},
 		}
 
-		res, err := st.handler.CreateTransaction(testCtx, request)
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, id.String(), res.Data.GetId())
 	})
+
+	t.Run("success create transaction capturing a hold", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		holdID := uuid.Must(uuid.NewV7())
+		st.creator.EXPECT().Create(testCtxWithAuth, gomock.Any()).
+			DoAndReturn(func(_ context.Context, trx *entity.Transaction) (uuid.UUID, error) {
+				assert.Equal(t, holdID, trx.HoldID)
+				return id, nil
+			})
+		request := &apiv1.CreateTransactionRequest{
+			Transaction: &apiv1.Transaction{
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Amount:           "10.23",
+				HoldId:           holdID.String(),
+			},
+		}
+
+		res, err := st.handler.CreateTransaction(testCtxWithAuth, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, id.String(), res.Data.GetId())
+	})
+}
+
+func TestTransactionCommand_RefundTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("transaction id is invalid", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		requests := []*apiv1.RefundTransactionRequest{nil, {}, {Id: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.RefundTransaction(testCtxWithAuth, request)
+
+			assert.Equal(t, entity.ErrNotFound(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("amount is invalid", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+
+		res, err := st.handler.RefundTransaction(testCtxWithAuth, &apiv1.RefundTransactionRequest{Id: id.String(), Amount: "ten"})
+
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("refunder returns error", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+
+		errors := []error{
+			entity.ErrNotFound(),
+			entity.ErrAlreadyRefunded(),
+			entity.ErrNotRefundable(""),
+			entity.ErrTransferRejected(""),
+			assert.AnError,
+		}
+		for _, errRet := range errors {
+			st.refunder.EXPECT().Refund(testCtxWithAuth, testUserID, id, decimal.Zero).Return(nil, errRet)
+
+			res, err := st.handler.RefundTransaction(testCtxWithAuth, &apiv1.RefundTransactionRequest{Id: id.String()})
+
+			assert.Equal(t, errRet, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success refund transaction", func(t *testing.T) {
+		st := createTransactionCommandSuite(ctrl)
+		refund := &entity.Transaction{
+			ID:                    uuid.Must(uuid.NewV7()),
+			SenderID:              testUserID,
+			ReceiverID:            uuid.Must(uuid.NewV7()),
+			Amount:                decimal.RequireFromString("5.5"),
+			Currency:              "IDR",
+			OriginalTransactionID: &id,
+		}
+		st.refunder.EXPECT().Refund(testCtxWithAuth, testUserID, id, decimal.RequireFromString("5.5")).Return(refund, nil)
+
+		res, err := st.handler.RefundTransaction(testCtxWithAuth, &apiv1.RefundTransactionRequest{Id: id.String(), Amount: "5.5"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, refund.ID.String(), res.GetData().GetId())
+		assert.Equal(t, "5.5", res.GetData().GetAmount())
+		assert.Equal(t, id.String(), res.GetData().GetOriginalTransactionId())
+	})
 }
 
 func createTransactionCommandSuite(ctrl *gomock.Controller) *TransactionCommandSuite {
 	c := mock_service.NewMockCreateTransaction(ctrl)
-	h := handler.NewTransactionCommand(c)
+	r := mock_service.NewMockRefundTransaction(ctrl)
+	h := handler.NewTransactionCommand(c, r)
 	return &TransactionCommandSuite{
-		handler: h,
-		creator: c,
+		handler:  h,
+		creator:  c,
+		refunder: r,
 	}
 }
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/service"
+)
+
+// TransactionQuery handles HTTP/2 gRPC request for retrieving transaction.
+type TransactionQuery struct {
+	apiv1.UnimplementedTransactionQueryServiceServer
+	getter service.GetTransaction
+}
+
+// NewTransactionQuery creates an instance of TransactionQuery.
+func NewTransactionQuery(getter service.GetTransaction) *TransactionQuery {
+	return &TransactionQuery{getter: getter}
+}
+
+// ListMyTransactions handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (tq *TransactionQuery) ListMyTransactions(ctx context.Context, request *apiv1.ListMyTransactionsRequest) (*apiv1.ListMyTransactionsResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	if request == nil {
+		return nil, entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+
+	filter, err := createTransactionFilterFromListMyTransactionsRequest(request, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := tq.getter.GetAllByUser(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionQuery-ListMyTransactions] fail list transactions", "error", err)
+		return nil, err
+	}
+	return createListMyTransactionsResponse(page), nil
+}
+
+// ListUserTransactions handles HTTP/2 gRPC request for listing transactions of any user.
+// It is meant for internal use by other services.
+func (tq *TransactionQuery) ListUserTransactions(ctx context.Context, request *apiv1.ListUserTransactionsRequest) (*apiv1.ListUserTransactionsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+
+	filter, err := createTransactionFilterFromListUserTransactionsRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := tq.getter.GetAllByUser(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionQuery-ListUserTransactions] fail list transactions", "error", err)
+		return nil, err
+	}
+
+	resp := createListMyTransactionsResponse(page)
+	return &apiv1.ListUserTransactionsResponse{Data: resp.GetData(), NextCursor: resp.GetNextCursor()}, nil
+}
+
+// ListStaleTransactions handles HTTP/2 gRPC request for listing transactions which have stayed in a status for too long.
+// It is meant for internal use, e.g. to reconcile transactions left pending or processing.
+func (tq *TransactionQuery) ListStaleTransactions(ctx context.Context, request *apiv1.ListStaleTransactionsRequest) (*apiv1.ListStaleTransactionsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+
+	trxs, err := tq.getter.GetStale(ctx, createStaleTransactionFilterFromListStaleTransactionsRequest(request))
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionQuery-ListStaleTransactions] fail list stale transactions", "error", err)
+		return nil, err
+	}
+
+	resp := &apiv1.ListStaleTransactionsResponse{}
+	for _, trx := range trxs {
+		resp.Data = append(resp.Data, createProtoTransaction(trx))
+	}
+	return resp, nil
+}
+
+func createTransactionFilterFromListMyTransactionsRequest(request *apiv1.ListMyTransactionsRequest, userID uuid.UUID) (*entity.TransactionFilter, error) {
+	filter := &entity.TransactionFilter{
+		UserID: userID,
+		Limit:  uint(request.GetLimit()),
+	}
+
+	if request.GetCursor() != "" {
+		cursor, err := uuid.Parse(request.GetCursor())
+		if err != nil {
+			return nil, entity.ErrInvalidFilter("cursor", "must be a valid id")
+		}
+		filter.Cursor = &cursor
+	}
+	if request.GetCounterpartyId() != "" {
+		counterpartyID, err := uuid.Parse(request.GetCounterpartyId())
+		if err != nil {
+			return nil, entity.ErrInvalidFilter("counterparty_id", "must be a valid id")
+		}
+		filter.CounterpartyID = &counterpartyID
+	}
+	if request.GetCreatedAfter() != nil {
+		createdAfter := request.GetCreatedAfter().AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+	if request.GetCreatedBefore() != nil {
+		createdBefore := request.GetCreatedBefore().AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+	if request.GetMinAmount() != "" {
+		minAmount, err := decimal.NewFromString(request.GetMinAmount())
+		if err != nil {
+			return nil, entity.ErrInvalidFilter("min_amount", "must be numeric")
+		}
+		filter.MinAmount = &minAmount
+	}
+	if request.GetMaxAmount() != "" {
+		maxAmount, err := decimal.NewFromString(request.GetMaxAmount())
+		if err != nil {
+			return nil, entity.ErrInvalidFilter("max_amount", "must be numeric")
+		}
+		filter.MaxAmount = &maxAmount
+	}
+	if request.GetCurrency() != "" {
+		currency := request.GetCurrency()
+		filter.Currency = &currency
+	}
+	return filter, nil
+}
+
+func createTransactionFilterFromListUserTransactionsRequest(request *apiv1.ListUserTransactionsRequest) (*entity.TransactionFilter, error) {
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		return nil, entity.ErrInvalidFilter("user_id", "must be a valid id")
+	}
+	filter := &entity.TransactionFilter{
+		UserID: userID,
+		Limit:  uint(request.GetLimit()),
+	}
+
+	if request.GetCursor() != "" {
+		cursor, err := uuid.Parse(request.GetCursor())
+		if err != nil {
+			return nil, entity.ErrInvalidFilter("cursor", "must be a valid id")
+		}
+		filter.Cursor = &cursor
+	}
+	return filter, nil
+}
+
+func createStaleTransactionFilterFromListStaleTransactionsRequest(request *apiv1.ListStaleTransactionsRequest) *entity.StaleTransactionFilter {
+	// unset status and time are left empty and rejected by the service's validation
+	filter := &entity.StaleTransactionFilter{
+		Status: createTransactionStatus(request.GetStatus()),
+		Limit:  uint(request.GetLimit()),
+	}
+	if request.GetOlderThan() != nil {
+		filter.OlderThan = request.GetOlderThan().AsTime()
+	}
+	return filter
+}
+
+func createListMyTransactionsResponse(page *entity.TransactionPage) *apiv1.ListMyTransactionsResponse {
+	resp := &apiv1.ListMyTransactionsResponse{}
+	for _, trx := range page.Transactions {
+		resp.Data = append(resp.Data, createProtoTransaction(trx))
+	}
+	if page.NextCursor != nil {
+		resp.NextCursor = page.NextCursor.String()
+	}
+	return resp
+}
+
+func createProtoTransaction(trx *entity.Transaction) *apiv1.Transaction {
+	res := &apiv1.Transaction{
+		Id:               trx.ID.String(),
+		SenderId:         trx.SenderID.String(),
+		ReceiverId:       trx.ReceiverID.String(),
+		SenderWalletId:   trx.SenderWalletID.String(),
+		ReceiverWalletId: trx.ReceiverWalletID.String(),
+		Amount:           trx.Amount.String(),
+		Currency:         trx.Currency,
+		Status:           createProtoTransactionStatus(trx.Status),
+		FailureReason:    trx.FailureReason,
+		CreatedAt:        timestamppb.New(trx.CreatedAt),
+		ProcessingAt:     createProtoTimestamp(trx.ProcessingAt),
+		CompletedAt:      createProtoTimestamp(trx.CompletedAt),
+		FailedAt:         createProtoTimestamp(trx.FailedAt),
+		ReversedAt:       createProtoTimestamp(trx.ReversedAt),
+	}
+	if trx.IsRefund() {
+		res.OriginalTransactionId = trx.OriginalTransactionID.String()
+	}
+	if trx.IsConverted() {
+		res.QuoteId = trx.QuoteID.String()
+	}
+	if trx.IsCapture() {
+		res.HoldId = trx.HoldID.String()
+	}
+	return res
+}
+
+func createProtoTransactionStatus(status entity.TransactionStatus) apiv1.TransactionStatus {
+	switch status {
+	case entity.TransactionStatusPending:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case entity.TransactionStatusProcessing:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_PROCESSING
+	case entity.TransactionStatusCompleted:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_COMPLETED
+	case entity.TransactionStatusFailed:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_FAILED
+	case entity.TransactionStatusReversed:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_REVERSED
+	default:
+		return apiv1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+func createTransactionStatus(status apiv1.TransactionStatus) entity.TransactionStatus {
+	switch status {
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		return entity.TransactionStatusPending
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_PROCESSING:
+		return entity.TransactionStatusProcessing
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_COMPLETED:
+		return entity.TransactionStatusCompleted
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_FAILED:
+		return entity.TransactionStatusFailed
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_REVERSED:
+		return entity.TransactionStatusReversed
+	default:
+		return ""
+	}
+}
+
+func createProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,270 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/grpc/handler"
+	mock_service "github.com/indrasaputra/arjuna/service/transaction/test/mock/service"
+)
+
+type TransactionQuerySuite struct {
+	handler *handler.TransactionQuery
+	getter  *mock_service.MockGetTransaction
+}
+
+func TestNewTransactionQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of TransactionQuery", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		assert.NotNil(t, st.handler)
+	})
+}
+
+func TestTransactionQuery_ListMyTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+
+		res, err := st.handler.ListMyTransactions(testCtxWithAuth, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("malformed filter is prohibited", func(t *testing.T) {
+		requests := []*apiv1.ListMyTransactionsRequest{
+			{Cursor: "invalid"},
+			{CounterpartyId: "invalid"},
+			{MinAmount: "invalid"},
+			{MaxAmount: "invalid"},
+		}
+
+		for _, request := range requests {
+			st := createTransactionQuerySuite(ctrl)
+
+			res, err := st.handler.ListMyTransactions(testCtxWithAuth, request)
+
+			assert.Error(t, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, &entity.TransactionFilter{UserID: testUserID, Limit: 5}).Return(nil, entity.ErrInternal(""))
+
+		res, err := st.handler.ListMyTransactions(testCtxWithAuth, &apiv1.ListMyTransactionsRequest{Limit: 5})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success list transactions with all filters", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		cursor := uuid.Must(uuid.NewV7())
+		counterpartyID := uuid.Must(uuid.NewV7())
+		createdAfter := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		createdBefore := createdAfter.Add(24 * time.Hour)
+		minAmount := decimal.RequireFromString("1.5")
+		maxAmount := decimal.RequireFromString("100")
+		currency := "IDR"
+		expected := &entity.TransactionFilter{
+			UserID:         testUserID,
+			Cursor:         &cursor,
+			CounterpartyID: &counterpartyID,
+			CreatedAfter:   &createdAfter,
+			CreatedBefore:  &createdBefore,
+			MinAmount:      &minAmount,
+			MaxAmount:      &maxAmount,
+			Currency:       &currency,
+			Limit:          2,
+		}
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), SenderID: testUserID, ReceiverID: counterpartyID, Amount: decimal.NewFromInt(10), Currency: "IDR"}
+		next := trx.ID
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, expected).Return(&entity.TransactionPage{Transactions: []*entity.Transaction{trx}, NextCursor: &next}, nil)
+
+		res, err := st.handler.ListMyTransactions(testCtxWithAuth, &apiv1.ListMyTransactionsRequest{
+			Limit:          2,
+			Cursor:         cursor.String(),
+			CounterpartyId: counterpartyID.String(),
+			CreatedAfter:   timestamppb.New(createdAfter),
+			CreatedBefore:  timestamppb.New(createdBefore),
+			MinAmount:      "1.5",
+			MaxAmount:      "100",
+			Currency:       "IDR",
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetData(), 1)
+		assert.Equal(t, trx.ID.String(), res.GetData()[0].GetId())
+		assert.Equal(t, trx.Currency, res.GetData()[0].GetCurrency())
+		assert.Equal(t, "10", res.GetData()[0].GetAmount())
+		assert.Equal(t, next.String(), res.GetNextCursor())
+	})
+
+	t.Run("transaction's lifecycle is returned", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		now := time.Now().UTC()
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), Amount: decimal.NewFromInt(10), Currency: "IDR", Status: entity.TransactionStatusPending}
+		_ = trx.Transition(entity.TransactionStatusProcessing, now, "")
+		_ = trx.Transition(entity.TransactionStatusFailed, now, "insufficient balance")
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, gomock.Any()).Return(&entity.TransactionPage{Transactions: []*entity.Transaction{trx}}, nil)
+
+		res, err := st.handler.ListMyTransactions(testCtxWithAuth, &apiv1.ListMyTransactionsRequest{})
+
+		assert.NoError(t, err)
+		data := res.GetData()[0]
+		assert.Equal(t, apiv1.TransactionStatus_TRANSACTION_STATUS_FAILED, data.GetStatus())
+		assert.Equal(t, "insufficient balance", data.GetFailureReason())
+		assert.Equal(t, now, data.GetProcessingAt().AsTime())
+		assert.Equal(t, now, data.GetFailedAt().AsTime())
+		assert.Nil(t, data.GetCompletedAt())
+		assert.Nil(t, data.GetReversedAt())
+	})
+
+	t.Run("last page has empty next cursor", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, gomock.Any()).Return(&entity.TransactionPage{}, nil)
+
+		res, err := st.handler.ListMyTransactions(testCtxWithAuth, &apiv1.ListMyTransactionsRequest{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.GetData())
+		assert.Empty(t, res.GetNextCursor())
+	})
+}
+
+func TestTransactionQuery_ListUserTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+
+		res, err := st.handler.ListUserTransactions(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("malformed filter is prohibited", func(t *testing.T) {
+		requests := []*apiv1.ListUserTransactionsRequest{
+			{UserId: "invalid"},
+			{UserId: testUserID.String(), Cursor: "invalid"},
+		}
+
+		for _, request := range requests {
+			st := createTransactionQuerySuite(ctrl)
+
+			res, err := st.handler.ListUserTransactions(testCtx, request)
+
+			assert.Error(t, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUser(testCtx, &entity.TransactionFilter{UserID: testUserID, Limit: 5}).Return(nil, entity.ErrInternal(""))
+
+		res, err := st.handler.ListUserTransactions(testCtx, &apiv1.ListUserTransactionsRequest{UserId: testUserID.String(), Limit: 5})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success list user transactions", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		cursor := uuid.Must(uuid.NewV7())
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), SenderID: testUserID, Amount: decimal.NewFromInt(10)}
+		next := trx.ID
+		expected := &entity.TransactionFilter{UserID: testUserID, Cursor: &cursor, Limit: 2}
+		st.getter.EXPECT().GetAllByUser(testCtx, expected).Return(&entity.TransactionPage{Transactions: []*entity.Transaction{trx}, NextCursor: &next}, nil)
+
+		res, err := st.handler.ListUserTransactions(testCtx, &apiv1.ListUserTransactionsRequest{UserId: testUserID.String(), Limit: 2, Cursor: cursor.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetData(), 1)
+		assert.Equal(t, trx.ID.String(), res.GetData()[0].GetId())
+		assert.Equal(t, next.String(), res.GetNextCursor())
+	})
+
+	t.Run("last page has empty next cursor", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUser(testCtx, gomock.Any()).Return(&entity.TransactionPage{}, nil)
+
+		res, err := st.handler.ListUserTransactions(testCtx, &apiv1.ListUserTransactionsRequest{UserId: testUserID.String()})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.GetData())
+		assert.Empty(t, res.GetNextCursor())
+	})
+}
+
+func TestTransactionQuery_ListStaleTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	olderThan := time.Now().Add(-time.Hour).UTC()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+
+		res, err := st.handler.ListStaleTransactions(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		st.getter.EXPECT().GetStale(testCtx, &entity.StaleTransactionFilter{}).Return(nil, entity.ErrInvalidFilter("status", "must be set"))
+
+		res, err := st.handler.ListStaleTransactions(testCtx, &apiv1.ListStaleTransactionsRequest{})
+
+		assert.Equal(t, entity.ErrInvalidFilter("status", "must be set"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success list stale transactions", func(t *testing.T) {
+		st := createTransactionQuerySuite(ctrl)
+		trx := &entity.Transaction{ID: uuid.Must(uuid.NewV7()), Amount: decimal.NewFromInt(10), Status: entity.TransactionStatusProcessing}
+		expected := &entity.StaleTransactionFilter{Status: entity.TransactionStatusProcessing, OlderThan: olderThan, Limit: 3}
+		st.getter.EXPECT().GetStale(testCtx, expected).Return([]*entity.Transaction{trx}, nil)
+		request := &apiv1.ListStaleTransactionsRequest{
+			Status:    apiv1.TransactionStatus_TRANSACTION_STATUS_PROCESSING,
+			OlderThan: timestamppb.New(olderThan),
+			Limit:     3,
+		}
+
+		res, err := st.handler.ListStaleTransactions(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetData(), 1)
+		assert.Equal(t, trx.ID.String(), res.GetData()[0].GetId())
+		assert.Equal(t, apiv1.TransactionStatus_TRANSACTION_STATUS_PROCESSING, res.GetData()[0].GetStatus())
+	})
+}
+
+func createTransactionQuerySuite(ctrl *gomock.Controller) *TransactionQuerySuite {
+	g := mock_service.NewMockGetTransaction(ctrl)
+	h := handler.NewTransactionQuery(g)
+	return &TransactionQuerySuite{
+		handler: h,
+		getter:  g,
+	}
+}
@@ -0,0 +1,2 @@
+// Package activity defines activity to be used in the flow using Temporal.io.
+package activity
@@ -0,0 +1,169 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/workflow"
+)
+
+// CreateTransactionWalletConnection defines interface to move balance in 3rd party.
+type CreateTransactionWalletConnection interface {
+	// TransferBalance transfers balance from sender's wallet to receiver's wallet in 3rd party.
+	TransferBalance(ctx context.Context, trx *entity.Transaction) error
+	// CaptureHold captures the transaction's amount from sender's hold to receiver's wallet in 3rd party.
+	CaptureHold(ctx context.Context, trx *entity.Transaction) error
+	// ResolveTransfer tells whether the transaction's transfer or capture has been applied in 3rd party.
+	// If it hasn't, the transfer must never be applied afterwards.
+	ResolveTransfer(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// CreateTransactionDatabase defines interface to create transaction in database.
+type CreateTransactionDatabase interface {
+	// Insert inserts a transaction.
+	Insert(ctx context.Context, trx *entity.Transaction) error
+	// GetByID gets a transaction by its id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
+	// GetByIDForUpdate gets a transaction by its id and locks it until the database transaction ends.
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
+	// GetRefundedAmount sums the amount of the transaction's refunds in any of the statuses.
+	GetRefundedAmount(ctx context.Context, id uuid.UUID, statuses ...entity.TransactionStatus) (decimal.Decimal, error)
+	// UpdateStatus saves the transaction's status only if it is still in the from status.
+	UpdateStatus(ctx context.Context, trx *entity.Transaction, from entity.TransactionStatus) error
+}
+
+// CreateTransactionActivity is responsible to execute create transaction workflow.
+type CreateTransactionActivity struct {
+	walletConn CreateTransactionWalletConnection
+	database   CreateTransactionDatabase
+	txManager  uow.TxManager
+}
+
+// NewCreateTransactionActivity creates an instance of CreateTransactionActivity.
+func NewCreateTransactionActivity(wc CreateTransactionWalletConnection, db CreateTransactionDatabase, tx uow.TxManager) *CreateTransactionActivity {
+	return &CreateTransactionActivity{walletConn: wc, database: db, txManager: tx}
+}
+
+// InsertTransaction inserts transaction to database.
+// A refund is only inserted if the original transaction is completed
+// and the refund, together with the original's other refunds which haven't failed, doesn't exceed the original's amount.
+func (c *CreateTransactionActivity) InsertTransaction(ctx context.Context, trx *entity.Transaction) error {
+	var err error
+	if trx.IsRefund() {
+		err = c.insertRefund(ctx, trx)
+	} else {
+		err = c.database.Insert(ctx, trx)
+	}
+	if errors.Is(err, entity.ErrAlreadyExists()) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), workflow.ErrNonRetryableTransactionExist, err)
+	}
+	if errors.Is(err, entity.ErrAlreadyRefunded()) {
+		return temporal.NewNonRetryableApplicationError(status.Convert(err).Message(), workflow.ErrNonRetryableAlreadyRefunded, err)
+	}
+	return err
+}
+
+// insertRefund locks the original transaction, so concurrent refunds of the same transaction
+// are checked against each other's amount.
+func (c *CreateTransactionActivity) insertRefund(ctx context.Context, trx *entity.Transaction) error {
+	return c.txManager.Do(ctx, func(ctx context.Context) error {
+		original, err := c.database.GetByIDForUpdate(ctx, *trx.OriginalTransactionID)
+		if err != nil {
+			slog.ErrorContext(ctx, "[CreateTransactionActivity-insertRefund] fail get original transaction", "id", *trx.OriginalTransactionID, "error", err)
+			return err
+		}
+		if original.Status != entity.TransactionStatusCompleted {
+			return entity.ErrAlreadyRefunded()
+		}
+
+		refunded, err := c.database.GetRefundedAmount(ctx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted)
+		if err != nil {
+			slog.ErrorContext(ctx, "[CreateTransactionActivity-insertRefund] fail get refunded amount", "id", original.ID, "error", err)
+			return err
+		}
+		if refunded.Add(trx.Amount).GreaterThan(original.Amount) {
+			return entity.ErrAlreadyRefunded()
+		}
+		return c.database.Insert(ctx, trx)
+	})
+}
+
+// TransferBalance moves the balance in wallet service.
+// A transaction which captures a hold moves the balance by capturing the hold instead.
+func (c *CreateTransactionActivity) TransferBalance(ctx context.Context, trx *entity.Transaction) error {
+	var err error
+	if trx.IsCapture() {
+		err = c.walletConn.CaptureHold(ctx, trx)
+	} else {
+		err = c.walletConn.TransferBalance(ctx, trx)
+	}
+	if status.Code(err) == codes.FailedPrecondition {
+		return temporal.NewNonRetryableApplicationError(status.Convert(err).Message(), workflow.ErrNonRetryableTransferRejected, err)
+	}
+	return err
+}
+
+// ResolveTransfer tells whether the transaction's transfer has been applied in wallet service.
+// It is safe to retry since the outcome, once resolved, never changes.
+func (c *CreateTransactionActivity) ResolveTransfer(ctx context.Context, id uuid.UUID) (bool, error) {
+	return c.walletConn.ResolveTransfer(ctx, id)
+}
+
+// ReverseTransaction marks the transaction as reversed once its completed refunds add up to its amount.
+// A transaction which is only partially refunded stays completed.
+// It is safe to retry since a transaction already reversed is left as it is.
+func (c *CreateTransactionActivity) ReverseTransaction(ctx context.Context, id uuid.UUID) error {
+	trx, err := c.database.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[CreateTransactionActivity-ReverseTransaction] fail get transaction", "id", id, "error", err)
+		return err
+	}
+	if trx.Status == entity.TransactionStatusReversed {
+		return nil
+	}
+
+	refunded, err := c.database.GetRefundedAmount(ctx, id, entity.TransactionStatusCompleted)
+	if err != nil {
+		slog.ErrorContext(ctx, "[CreateTransactionActivity-ReverseTransaction] fail get refunded amount", "id", id, "error", err)
+		return err
+	}
+	if refunded.LessThan(trx.Amount) {
+		return nil
+	}
+	return c.TransitionTransaction(ctx, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusReversed})
+}
+
+// TransitionTransaction moves the transaction to the requested status.
+// It is safe to retry since a transaction already in the requested status is left as it is.
+func (c *CreateTransactionActivity) TransitionTransaction(ctx context.Context, input *entity.TransitionTransactionInput) error {
+	trx, err := c.database.GetByID(ctx, input.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[CreateTransactionActivity-TransitionTransaction] fail get transaction", "id", input.ID, "error", err)
+		return err
+	}
+	if trx.Status == input.Status {
+		return nil
+	}
+
+	from := trx.Status
+	if err = trx.Transition(input.Status, time.Now().UTC(), input.FailureReason); err == nil {
+		err = c.database.UpdateStatus(ctx, trx, from)
+	}
+	if errors.Is(err, entity.ErrInvalidStatusTransition(from, input.Status)) {
+		return temporal.NewNonRetryableApplicationError(status.Convert(err).Message(), workflow.ErrNonRetryableInvalidStatusTransition, err)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[CreateTransactionActivity-TransitionTransaction] fail update transaction's status", "id", input.ID, "error", err)
+	}
+	return err
+}
@@ -0,0 +1,436 @@
+package activity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/mock/gomock"
+
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/activity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/workflow"
+	mock_activity "github.com/indrasaputra/arjuna/service/transaction/test/mock/orchestration/temporal/activity"
+)
+
+type ctxKey string
+
+var (
+	testCtx   = context.Background()
+	testCtxTx = context.WithValue(testCtx, ctxKey("tx"), true)
+)
+
+type CreateTransactionActivitySuite struct {
+	activity *activity.CreateTransactionActivity
+
+	wallet    *mock_activity.MockCreateTransactionWalletConnection
+	db        *mock_activity.MockCreateTransactionDatabase
+	txManager *mock_uow.MockTxManager
+}
+
+func TestNewCreateTransactionActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of CreateTransactionActivity", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		assert.NotNil(t, st.activity)
+	})
+}
+
+func TestCreateTransactionActivity_InsertTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("transaction already exists", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.db.EXPECT().Insert(testCtx, trx).Return(entity.ErrAlreadyExists())
+
+		err := st.activity.InsertTransaction(testCtx, trx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("database returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.db.EXPECT().Insert(testCtx, trx).Return(entity.ErrInternal(""))
+
+		err := st.activity.InsertTransaction(testCtx, trx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success insert transaction", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.db.EXPECT().Insert(testCtx, trx).Return(nil)
+
+		err := st.activity.InsertTransaction(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get original transaction of refund returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		original, refund := createTestRefund(decimal.NewFromInt(4))
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.db.EXPECT().GetByIDForUpdate(testCtxTx, original.ID).Return(nil, entity.ErrInternal(""))
+
+		err := st.activity.InsertTransaction(testCtx, refund)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("original transaction of refund is not completed", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		original, refund := createTestRefund(decimal.NewFromInt(4))
+		original.Status = entity.TransactionStatusReversed
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.db.EXPECT().GetByIDForUpdate(testCtxTx, original.ID).Return(original, nil)
+
+		err := st.activity.InsertTransaction(testCtx, refund)
+
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, err, &appErr)
+		assert.True(t, appErr.NonRetryable())
+		assert.Equal(t, workflow.ErrNonRetryableAlreadyRefunded, appErr.Type())
+	})
+
+	t.Run("get refunded amount returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		original, refund := createTestRefund(decimal.NewFromInt(4))
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.db.EXPECT().GetByIDForUpdate(testCtxTx, original.ID).Return(original, nil)
+		st.db.EXPECT().GetRefundedAmount(testCtxTx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).
+			Return(decimal.Zero, entity.ErrInternal(""))
+
+		err := st.activity.InsertTransaction(testCtx, refund)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("refund exceeds the remaining amount", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		original, refund := createTestRefund(decimal.NewFromInt(4))
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.db.EXPECT().GetByIDForUpdate(testCtxTx, original.ID).Return(original, nil)
+		st.db.EXPECT().GetRefundedAmount(testCtxTx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).
+			Return(decimal.NewFromInt(7), nil)
+
+		err := st.activity.InsertTransaction(testCtx, refund)
+
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, err, &appErr)
+		assert.True(t, appErr.NonRetryable())
+		assert.Equal(t, workflow.ErrNonRetryableAlreadyRefunded, appErr.Type())
+	})
+
+	t.Run("success insert refund", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		original, refund := createTestRefund(decimal.NewFromInt(4))
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.db.EXPECT().GetByIDForUpdate(testCtxTx, original.ID).Return(original, nil)
+		st.db.EXPECT().GetRefundedAmount(testCtxTx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).
+			Return(decimal.NewFromInt(6), nil)
+		st.db.EXPECT().Insert(testCtxTx, refund).Return(nil)
+
+		err := st.activity.InsertTransaction(testCtx, refund)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateTransactionActivity_TransferBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("transfer is rejected", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.wallet.EXPECT().TransferBalance(testCtx, trx).Return(entity.ErrTransferRejected("insufficient balance"))
+
+		err := st.activity.TransferBalance(testCtx, trx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("wallet returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.wallet.EXPECT().TransferBalance(testCtx, trx).Return(assert.AnError)
+
+		err := st.activity.TransferBalance(testCtx, trx)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success transfer balance", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.wallet.EXPECT().TransferBalance(testCtx, trx).Return(nil)
+
+		err := st.activity.TransferBalance(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("capture is rejected", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		trx.HoldID = uuid.Must(uuid.NewV7())
+		st.wallet.EXPECT().CaptureHold(testCtx, trx).Return(entity.ErrTransferRejected("hold has expired"))
+
+		err := st.activity.TransferBalance(testCtx, trx)
+
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, err, &appErr)
+		assert.True(t, appErr.NonRetryable())
+	})
+
+	t.Run("success capture hold", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		trx.HoldID = uuid.Must(uuid.NewV7())
+		st.wallet.EXPECT().CaptureHold(testCtx, trx).Return(nil)
+
+		err := st.activity.TransferBalance(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateTransactionActivity_ResolveTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("wallet returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.wallet.EXPECT().ResolveTransfer(testCtx, trx.ID).Return(false, assert.AnError)
+
+		applied, err := st.activity.ResolveTransfer(testCtx, trx.ID)
+
+		assert.Error(t, err)
+		assert.False(t, applied)
+	})
+
+	t.Run("success resolve transfer", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		st.wallet.EXPECT().ResolveTransfer(testCtx, trx.ID).Return(true, nil)
+
+		applied, err := st.activity.ResolveTransfer(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.True(t, applied)
+	})
+}
+
+func TestCreateTransactionActivity_ReverseTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("get transaction returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestCompletedTransaction()
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(nil, entity.ErrInternal(""))
+
+		err := st.activity.ReverseTransaction(testCtx, trx.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("transaction is already reversed", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestCompletedTransaction()
+		trx.Status = entity.TransactionStatusReversed
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+
+		err := st.activity.ReverseTransaction(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get refunded amount returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestCompletedTransaction()
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+		st.db.EXPECT().GetRefundedAmount(testCtx, trx.ID, entity.TransactionStatusCompleted).Return(decimal.Zero, entity.ErrInternal(""))
+
+		err := st.activity.ReverseTransaction(testCtx, trx.ID)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("transaction is partially refunded and stays completed", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestCompletedTransaction()
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+		st.db.EXPECT().GetRefundedAmount(testCtx, trx.ID, entity.TransactionStatusCompleted).Return(decimal.NewFromInt(4), nil)
+
+		err := st.activity.ReverseTransaction(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusCompleted, trx.Status)
+	})
+
+	t.Run("success reverse fully refunded transaction", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestCompletedTransaction()
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil).Times(2)
+		st.db.EXPECT().GetRefundedAmount(testCtx, trx.ID, entity.TransactionStatusCompleted).Return(trx.Amount, nil)
+		st.db.EXPECT().UpdateStatus(testCtx, trx, entity.TransactionStatusCompleted).Return(nil)
+
+		err := st.activity.ReverseTransaction(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusReversed, trx.Status)
+		assert.NotNil(t, trx.ReversedAt)
+	})
+}
+
+func TestCreateTransactionActivity_TransitionTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("get transaction returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusProcessing}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(nil, entity.ErrInternal(""))
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("transaction is already in the requested status", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		trx.Status = entity.TransactionStatusProcessing
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusProcessing}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("transition is not allowed", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		trx.Status = entity.TransactionStatusFailed
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusCompleted}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, err, &appErr)
+		assert.True(t, appErr.NonRetryable())
+		assert.Equal(t, workflow.ErrNonRetryableInvalidStatusTransition, appErr.Type())
+	})
+
+	t.Run("transaction is moved concurrently", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusProcessing}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+		st.db.EXPECT().UpdateStatus(testCtx, trx, entity.TransactionStatusPending).
+			Return(entity.ErrInvalidStatusTransition(entity.TransactionStatusPending, entity.TransactionStatusProcessing))
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, err, &appErr)
+		assert.True(t, appErr.NonRetryable())
+	})
+
+	t.Run("update status returns error", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusProcessing}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+		st.db.EXPECT().UpdateStatus(testCtx, trx, entity.TransactionStatusPending).Return(entity.ErrInternal(""))
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("success move transaction to failed", func(t *testing.T) {
+		st := createCreateTransactionActivitySuite(ctrl)
+		trx := createTestTransaction()
+		trx.Status = entity.TransactionStatusProcessing
+		input := &entity.TransitionTransactionInput{ID: trx.ID, Status: entity.TransactionStatusFailed, FailureReason: "insufficient balance"}
+		st.db.EXPECT().GetByID(testCtx, trx.ID).Return(trx, nil)
+		st.db.EXPECT().UpdateStatus(testCtx, trx, entity.TransactionStatusProcessing).Return(nil)
+
+		err := st.activity.TransitionTransaction(testCtx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusFailed, trx.Status)
+		assert.Equal(t, "insufficient balance", trx.FailureReason)
+		assert.NotNil(t, trx.FailedAt)
+	})
+}
+
+func createTestTransaction() *entity.Transaction {
+	return &entity.Transaction{
+		ID:               uuid.Must(uuid.NewV7()),
+		SenderID:         uuid.Must(uuid.NewV7()),
+		SenderWalletID:   uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           decimal.NewFromInt(10),
+		Status:           entity.TransactionStatusPending,
+	}
+}
+
+func createTestCompletedTransaction() *entity.Transaction {
+	trx := createTestTransaction()
+	trx.Status = entity.TransactionStatusCompleted
+	return trx
+}
+
+func createTestRefund(amount decimal.Decimal) (*entity.Transaction, *entity.Transaction) {
+	original := createTestCompletedTransaction()
+	refund := createTestTransaction()
+	refund.Amount = amount
+	refund.OriginalTransactionID = &original.ID
+	return original, refund
+}
+
+func createCreateTransactionActivitySuite(ctrl *gomock.Controller) *CreateTransactionActivitySuite {
+	wc := mock_activity.NewMockCreateTransactionWalletConnection(ctrl)
+	db := mock_activity.NewMockCreateTransactionDatabase(ctrl)
+	tx := mock_uow.NewMockTxManager(ctrl)
+	a := activity.NewCreateTransactionActivity(wc, db, tx)
+	return &CreateTransactionActivitySuite{
+		activity:  a,
+		wallet:    wc,
+		db:        db,
+		txManager: tx,
+	}
+}
@@ -0,0 +1,220 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+const (
+	// TaskQueueCreateTransaction represents transaction creation.
+	TaskQueueCreateTransaction = "create-transaction"
+
+	// ActivityTimeoutDefault sets to 2 seconds.
+	ActivityTimeoutDefault = 2 * time.Second
+	// ActivityTransactionInsert is derived from struct name + method name. See activity registration in worker.
+	ActivityTransactionInsert = "CreateTransactionActivityInsertTransaction"
+	// ActivityTransactionTransition is derived from struct name + method name. See activity registration in worker.
+	ActivityTransactionTransition = "CreateTransactionActivityTransitionTransaction"
+	// ActivityTransactionReverse is derived from struct name + method name. See activity registration in worker.
+	ActivityTransactionReverse = "CreateTransactionActivityReverseTransaction"
+	// ActivityWalletTransfer is derived from struct name + method name. See activity registration in worker.
+	ActivityWalletTransfer = "CreateTransactionActivityTransferBalance"
+	// ActivityWalletResolve is derived from struct name + method name. See activity registration in worker.
+	ActivityWalletResolve = "CreateTransactionActivityResolveTransfer"
+	// ActivityRetryBackoffCoefficient sets to 2.
+	ActivityRetryBackoffCoefficient = 2
+	// ActivityRetryMaximumAttempts sets to 3.
+	// It is safe to retry the transfer since transaction's id is sent as idempotency key.
+	ActivityRetryMaximumAttempts = 3
+	// ActivityRetryInitialInterval sets to 1 second.
+	ActivityRetryInitialInterval = 1 * time.Second
+	// CompensationRetryMaximumAttempts sets to 5.
+	// Compensation and status updates after the balance is moved must try harder than the normal flow,
+	// otherwise transaction and wallet disagree.
+	CompensationRetryMaximumAttempts = 5
+
+	// WorkflowTimeoutDefault sets to 1 minute, enough to cover all retries including compensation.
+	WorkflowTimeoutDefault = 1 * time.Minute
+	// WorkflowNameCreateTransaction is derived from the process itself.
+	WorkflowNameCreateTransaction = "create-transaction"
+	// WorkflowRetryMaximumAttempts sets to 1.
+	WorkflowRetryMaximumAttempts = 1
+
+	// ErrNonRetryableTransactionExist occurs when transaction already exists in system.
+	ErrNonRetryableTransactionExist = "non-retryable-transaction-exist"
+	// ErrNonRetryableTransferRejected occurs when wallet rejects the transfer, e.g. insufficient balance.
+	ErrNonRetryableTransferRejected = "non-retryable-transfer-rejected"
+	// ErrNonRetryableInvalidStatusTransition occurs when transaction can't move to the requested status.
+	ErrNonRetryableInvalidStatusTransition = "non-retryable-invalid-status-transition"
+	// ErrNonRetryableAlreadyRefunded occurs when the refund exceeds the original transaction's remaining amount.
+	ErrNonRetryableAlreadyRefunded = "non-retryable-already-refunded"
+)
+
+// CreateTransactionWorkflow is responsible to execute create transaction workflow.
+type CreateTransactionWorkflow struct {
+	client client.Client
+}
+
+// NewCreateTransactionWorkflow creates an instance of CreateTransactionWorkflow.
+func NewCreateTransactionWorkflow(client client.Client) *CreateTransactionWorkflow {
+	return &CreateTransactionWorkflow{client: client}
+}
+
+// CreateTransaction runs the create transaction workflow and waits for its result.
+func (c *CreateTransactionWorkflow) CreateTransaction(ctx context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
+	opts := client.StartWorkflowOptions{
+		ID:                 fmt.Sprintf("%s-%s", WorkflowNameCreateTransaction, input.Transaction.ID),
+		TaskQueue:          TaskQueueCreateTransaction,
+		WorkflowRunTimeout: WorkflowTimeoutDefault,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: WorkflowRetryMaximumAttempts,
+			NonRetryableErrorTypes: []string{
+				ErrNonRetryableTransactionExist,
+				ErrNonRetryableTransferRejected,
+				ErrNonRetryableInvalidStatusTransition,
+				ErrNonRetryableAlreadyRefunded,
+			},
+		},
+	}
+	wr, err := c.client.ExecuteWorkflow(ctx, opts, CreateTransaction, input)
+	if err != nil {
+		slog.ErrorContext(ctx, "[CreateTransactionWorkflow-CreateTransaction] fail to start workflow", "error", err)
+		return nil, entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	slog.InfoContext(ctx, "[CreateTransactionWorkflow-CreateTransaction] started workflow", "workflow-id", wr.GetID(), "run-id", wr.GetRunID())
+
+	var output *entity.CreateTransactionOutput
+	err = wr.Get(ctx, &output)
+	if err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.Type() == ErrNonRetryableTransactionExist {
+			return nil, entity.ErrAlreadyExists()
+		}
+		if errors.As(err, &appErr) && appErr.Type() == ErrNonRetryableTransferRejected {
+			return nil, entity.ErrTransferRejected(appErr.Message())
+		}
+		if errors.As(err, &appErr) && appErr.Type() == ErrNonRetryableAlreadyRefunded {
+			return nil, entity.ErrAlreadyRefunded()
+		}
+		slog.ErrorContext(ctx, "[CreateTransactionWorkflow-CreateTransaction] error get workflow result", "error", err)
+		return nil, entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	return output, nil
+}
+
+// CreateTransaction runs the create transaction workflow.
+// It records the transaction as pending, marks it as processing, then moves the money in wallet.
+// If the money can't be moved, the transaction is marked as failed with the reason.
+// If it is unknown whether the money is moved, e.g. the transfer times out, the transfer is resolved in wallet;
+// wallet cancels a transfer that hasn't been applied, so both services agree on the outcome.
+// If the transfer can't be resolved, the transaction stays processing and is left for reconciliation.
+// Otherwise, it is marked as completed and, if it is a refund which completes the original transaction's refunds,
+// the original transaction is marked as reversed.
+// Once the money is moved the workflow succeeds even if the status can't be updated;
+// such transaction stays processing and is left for reconciliation.
+func CreateTransaction(ctx tempflow.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
+	if err := validateCreateTransactionInput(input); err != nil {
+		return nil, err
+	}
+
+	trx := input.Transaction
+	actx := createContextWithActivityOptions(ctx, ActivityTimeoutDefault, TaskQueueCreateTransaction, ActivityRetryMaximumAttempts)
+	err := tempflow.ExecuteActivity(actx, ActivityTransactionInsert, trx).Get(actx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tempflow.ExecuteActivity(actx, ActivityTransactionTransition, createTransitionTransactionInput(trx.ID, entity.TransactionStatusProcessing, nil)).Get(actx, nil)
+	cctx := createContextWithActivityOptions(ctx, ActivityTimeoutDefault, TaskQueueCreateTransaction, CompensationRetryMaximumAttempts)
+	if err == nil {
+		err = tempflow.ExecuteActivity(actx, ActivityWalletTransfer, trx).Get(actx, nil)
+		if err != nil && !isTransferRejected(err) {
+			var applied bool
+			if rerr := tempflow.ExecuteActivity(cctx, ActivityWalletResolve, trx.ID).Get(cctx, &applied); rerr != nil {
+				tempflow.GetLogger(ctx).Error("[CreateTransaction] fail resolve transfer", "transaction-id", trx.ID, "error", rerr)
+				return nil, err
+			}
+			if applied {
+				err = nil
+			}
+		}
+	}
+	if err != nil {
+		transitionTransaction(cctx, createTransitionTransactionInput(trx.ID, entity.TransactionStatusFailed, err))
+		return nil, err
+	}
+
+	transitionTransaction(cctx, createTransitionTransactionInput(trx.ID, entity.TransactionStatusCompleted, nil))
+	if trx.IsRefund() {
+		if err := tempflow.ExecuteActivity(cctx, ActivityTransactionReverse, *trx.OriginalTransactionID).Get(cctx, nil); err != nil {
+			tempflow.GetLogger(ctx).Error("[CreateTransaction] fail reverse transaction", "transaction-id", *trx.OriginalTransactionID, "error", err)
+		}
+	}
+	return &entity.CreateTransactionOutput{ID: trx.ID}, nil
+}
+
+// transitionTransaction runs the transition activity and only logs the error,
+// since it is called when the outcome of the transaction has been decided.
+func transitionTransaction(ctx tempflow.Context, input *entity.TransitionTransactionInput) {
+	if err := tempflow.ExecuteActivity(ctx, ActivityTransactionTransition, input).Get(ctx, nil); err != nil {
+		tempflow.GetLogger(ctx).Error("[CreateTransaction] fail transition transaction", "transaction-id", input.ID, "status", input.Status, "error", err)
+	}
+}
+
+// isTransferRejected tells whether wallet has rejected the transfer, hence the money is surely not moved.
+func isTransferRejected(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == ErrNonRetryableTransferRejected
+}
+
+func createTransitionTransactionInput(id uuid.UUID, status entity.TransactionStatus, cause error) *entity.TransitionTransactionInput {
+	input := &entity.TransitionTransactionInput{ID: id, Status: status}
+	var appErr *temporal.ApplicationError
+	switch {
+	case errors.As(cause, &appErr):
+		input.FailureReason = appErr.Message()
+	case cause != nil:
+		input.FailureReason = cause.Error()
+	}
+	return input
+}
+
+func createContextWithActivityOptions(tempoCtx tempflow.Context, timeout time.Duration, queue string, attempts int32) tempflow.Context {
+	opts := createActivityOptions(timeout, queue, attempts)
+	return tempflow.WithActivityOptions(tempoCtx, opts)
+}
+
+func createActivityOptions(timeout time.Duration, queue string, attempts int32) tempflow.ActivityOptions {
+	return tempflow.ActivityOptions{
+		StartToCloseTimeout: timeout,
+		TaskQueue:           queue,
+		RetryPolicy: &temporal.RetryPolicy{
+			BackoffCoefficient: ActivityRetryBackoffCoefficient,
+			MaximumAttempts:    attempts,
+			InitialInterval:    ActivityRetryInitialInterval,
+			NonRetryableErrorTypes: []string{
+				ErrNonRetryableTransactionExist,
+				ErrNonRetryableTransferRejected,
+				ErrNonRetryableInvalidStatusTransition,
+				ErrNonRetryableAlreadyRefunded,
+			},
+		},
+	}
+}
+
+func validateCreateTransactionInput(input *entity.CreateTransactionInput) error {
+	if input == nil || input.Transaction == nil {
+		return entity.ErrEmptyTransaction()
+	}
+	return nil
+}
@@ -0,0 +1,430 @@
+package workflow_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	tempomock "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/connection/wallet"
+	orcact "github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/activity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/orchestration/temporal/workflow"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/repository/postgres"
+)
+
+var (
+	testCtx = context.Background()
+)
+
+type CreateTransactionWorkflowSuite struct {
+	workflow *workflow.CreateTransactionWorkflow
+	client   *tempomock.Client
+}
+
+func TestNewCreateTransactionWorkflow(t *testing.T) {
+	t.Run("successfully create an instance of CreateTransactionWorkflow", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		assert.NotNil(t, st.workflow)
+	})
+}
+
+func TestCreateTransactionWorkflow_CreateTransaction(t *testing.T) {
+	workflowFuncType := mock.AnythingOfType("func(internal.Context, *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error)")
+
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(nil, assert.AnError)
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("workflow run returns transaction already exists error", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(temporal.NewNonRetryableApplicationError("", workflow.ErrNonRetryableTransactionExist, assert.AnError))
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("workflow run returns transfer rejected error", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(temporal.NewNonRetryableApplicationError("insufficient balance", workflow.ErrNonRetryableTransferRejected, assert.AnError))
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.Equal(t, entity.ErrTransferRejected("insufficient balance"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("workflow run returns already refunded error", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(temporal.NewNonRetryableApplicationError("", workflow.ErrNonRetryableAlreadyRefunded, assert.AnError))
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.Equal(t, entity.ErrAlreadyRefunded(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("workflow run returns internal error", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(assert.AnError)
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createCreateTransactionWorkflowSuite()
+		input := createCreateTransactionInput()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(nil)
+
+		res, err := st.workflow.CreateTransaction(testCtx, input)
+
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+type CreateTransactionSuite struct {
+	env *testsuite.TestWorkflowEnvironment
+	testsuite.WorkflowTestSuite
+}
+
+func TestCreateTransaction(t *testing.T) {
+	t.Run("input is invalid", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("input doesn't have transaction struct", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+
+		input := createCreateTransactionInput()
+		input.Transaction = nil
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("TransactionInsert activity returns error", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("TransactionTransition activity to processing returns error and transaction is failed", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(temporal.NewNonRetryableApplicationError("", workflow.ErrNonRetryableInvalidStatusTransition, assert.AnError))
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, mock.MatchedBy(func(in *entity.TransitionTransactionInput) bool {
+			return in.ID == id && in.Status == entity.TransactionStatusFailed
+		})).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+		st.env.AssertNotCalled(t, workflow.ActivityWalletTransfer, mock.Anything, mock.Anything)
+	})
+
+	t.Run("WalletTransfer activity returns error and transaction is failed with the reason", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(temporal.NewNonRetryableApplicationError("insufficient balance", workflow.ErrNonRetryableTransferRejected, assert.AnError))
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusFailed, FailureReason: "insufficient balance"}).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("WalletTransfer activity times out and transfer is resolved as not applied so transaction is failed", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityWalletResolve, mock.Anything, id).Return(false, nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, mock.MatchedBy(func(in *entity.TransitionTransactionInput) bool {
+			return in.ID == id && in.Status == entity.TransactionStatusFailed
+		})).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("WalletTransfer activity times out and transfer is resolved as applied so transaction is completed", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityWalletResolve, mock.Anything, id).Return(true, nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("transfer can't be resolved and transaction is left processing", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityWalletResolve, mock.Anything, id).Return(false, assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("transaction can't be failed and original error is returned", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(temporal.NewNonRetryableApplicationError("insufficient balance", workflow.ErrNonRetryableTransferRejected, assert.AnError))
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusFailed, FailureReason: "insufficient balance"}).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		var appErr *temporal.ApplicationError
+		assert.ErrorAs(t, st.env.GetWorkflowError(), &appErr)
+		assert.Equal(t, workflow.ErrNonRetryableTransferRejected, appErr.Type())
+	})
+
+	t.Run("transaction can't be completed but workflow still succeeds", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(nil)
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+
+		var res *entity.CreateTransactionOutput
+		_ = st.env.GetWorkflowResult(&res)
+		assert.Equal(t, input.Transaction.ID, res.ID)
+	})
+
+	t.Run("fx transaction is executed successfully and the quote is sent to wallet", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+		quoteID := uuid.Must(uuid.NewV7())
+		input.Transaction.QuoteID = quoteID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, mock.MatchedBy(func(trx *entity.Transaction) bool {
+			return trx.ID == id && trx.QuoteID == quoteID
+		})).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("refund is executed successfully and original transaction is reversed", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+		originalID := uuid.Must(uuid.NewV7())
+		input.Transaction.OriginalTransactionID = &originalID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionReverse, mock.Anything, originalID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("original transaction can't be reversed but refund still succeeds", func(t *testing.T) {
+		st := createCreateTransactionSuite()
+		input := createCreateTransactionInput()
+		id := input.Transaction.ID
+		originalID := uuid.Must(uuid.NewV7())
+		input.Transaction.OriginalTransactionID = &originalID
+
+		st.env.OnActivity(workflow.ActivityTransactionInsert, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusProcessing}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletTransfer, mock.Anything, input.Transaction).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionTransition, mock.Anything, &entity.TransitionTransactionInput{ID: id, Status: entity.TransactionStatusCompleted}).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityTransactionReverse, mock.Anything, originalID).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.CreateTransaction, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+	})
+}
+
+func createTestTransaction() *entity.Transaction {
+	return &entity.Transaction{
+		ID:               uuid.Must(uuid.NewV7()),
+		SenderID:         uuid.Must(uuid.NewV7()),
+		SenderWalletID:   uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           decimal.NewFromInt(10),
+		Status:           entity.TransactionStatusPending,
+	}
+}
+
+func createCreateTransactionInput() *entity.CreateTransactionInput {
+	return &entity.CreateTransactionInput{
+		Transaction: createTestTransaction(),
+	}
+}
+
+func createCreateTransactionWorkflowSuite() *CreateTransactionWorkflowSuite {
+	c := &tempomock.Client{}
+	w := workflow.NewCreateTransactionWorkflow(c)
+	return &CreateTransactionWorkflowSuite{
+		workflow: w,
+		client:   c,
+	}
+}
+
+func createCreateTransactionSuite() *CreateTransactionSuite {
+	s := &CreateTransactionSuite{}
+	s.env = s.NewTestWorkflowEnvironment()
+
+	wt := &wallet.Wallet{}
+	pg := &postgres.Transaction{}
+	uc := orcact.NewCreateTransactionActivity(wt, pg, nil)
+
+	s.env.RegisterActivityWithOptions(uc, activity.RegisterOptions{Name: "CreateTransactionActivity", SkipInvalidStructFunctions: true})
+
+	return s
+}
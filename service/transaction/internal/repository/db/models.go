@@ -5,21 +5,80 @@
 package db
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
+type TransactionStatus string
+
+const (
+	TransactionStatusPENDING    TransactionStatus = "PENDING"
+	TransactionStatusPROCESSING TransactionStatus = "PROCESSING"
+	TransactionStatusCOMPLETED  TransactionStatus = "COMPLETED"
+	TransactionStatusFAILED     TransactionStatus = "FAILED"
+	TransactionStatusREVERSED   TransactionStatus = "REVERSED"
+)
+
+func (e *TransactionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransactionStatus(s)
+	case string:
+		*e = TransactionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransactionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransactionStatus struct {
+	TransactionStatus TransactionStatus
+	Valid             bool // Valid is true if TransactionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransactionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransactionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransactionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransactionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransactionStatus), nil
+}
+
 type Transaction struct {
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  *time.Time
-	DeletedBy  *uuid.UUID
-	Amount     decimal.Decimal
-	ID         uuid.UUID
-	SenderID   uuid.UUID
-	ReceiverID uuid.UUID
-	CreatedBy  uuid.UUID
-	UpdatedBy  uuid.UUID
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	DeletedAt             *time.Time
+	DeletedBy             *uuid.UUID
+	OriginalTransactionID *uuid.UUID
+	QuoteID               *uuid.UUID
+	HoldID                *uuid.UUID
+	FailureReason         *string
+	ProcessingAt          *time.Time
+	CompletedAt           *time.Time
+	FailedAt              *time.Time
+	ReversedAt            *time.Time
+	Amount                decimal.Decimal
+	Currency              string
+	Status                TransactionStatus
+	ID                    uuid.UUID
+	SenderID              uuid.UUID
+	ReceiverID            uuid.UUID
+	CreatedBy             uuid.UUID
+	UpdatedBy             uuid.UUID
+	SenderWalletID        uuid.UUID
+	ReceiverWalletID      uuid.UUID
 }
@@ -14,19 +14,26 @@ import (
 )
 
 const createTransaction = `-- name: CreateTransaction :exec
-INSERT INTO transactions (id, sender_id, receiver_id, amount, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO transactions (id, sender_id, receiver_id, sender_wallet_id, receiver_wallet_id, amount, currency, original_transaction_id, quote_id, hold_id, status, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 `
 
 type CreateTransactionParams struct {
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Amount     decimal.Decimal
-	ID         uuid.UUID
-	SenderID   uuid.UUID
-	ReceiverID uuid.UUID
-	CreatedBy  uuid.UUID
-	UpdatedBy  uuid.UUID
+	UpdatedAt             time.Time
+	CreatedAt             time.Time
+	OriginalTransactionID *uuid.UUID
+	HoldID                *uuid.UUID
+	QuoteID               *uuid.UUID
+	Currency              string
+	Amount                decimal.Decimal
+	Status                TransactionStatus
+	ID                    uuid.UUID
+	ReceiverWalletID      uuid.UUID
+	SenderWalletID        uuid.UUID
+	ReceiverID            uuid.UUID
+	SenderID              uuid.UUID
+	CreatedBy             uuid.UUID
+	UpdatedBy             uuid.UUID
 }
 
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) error {
@@ -34,7 +41,14 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.ID,
 		arg.SenderID,
 		arg.ReceiverID,
+		arg.SenderWalletID,
+		arg.ReceiverWalletID,
 		arg.Amount,
+		arg.Currency,
+		arg.OriginalTransactionID,
+		arg.QuoteID,
+		arg.HoldID,
+		arg.Status,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.CreatedBy,
@@ -43,6 +57,228 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 	return err
 }
 
+const getRefundedAmount = `-- name: GetRefundedAmount :one
+
+SELECT COALESCE(SUM(amount), 0)::NUMERIC(24, 4) AS amount
+FROM transactions WHERE original_transaction_id = $1 AND status = ANY($2::transaction_status[])
+`
+
+type GetRefundedAmountParams struct {
+	OriginalTransactionID *uuid.UUID
+	Statuses              []TransactionStatus
+}
+
+// noqa
+func (q *Queries) GetRefundedAmount(ctx context.Context, arg GetRefundedAmountParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getRefundedAmount, arg.OriginalTransactionID, arg.Statuses)
+	var amount decimal.Decimal
+	err := row.Scan(&amount)
+	return amount, err
+}
+
+const getStaleTransactions = `-- name: GetStaleTransactions :many
+SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions
+WHERE status = $1 AND updated_at < $2
+ORDER BY updated_at ASC
+LIMIT $3
+`
+
+type GetStaleTransactionsParams struct {
+	OlderThan time.Time
+	Status    TransactionStatus
+	PageLimit int32
+}
+
+func (q *Queries) GetStaleTransactions(ctx context.Context, arg GetStaleTransactionsParams) ([]*Transaction, error) {
+	rows, err := q.db.Query(ctx, getStaleTransactions, arg.Status, arg.OlderThan, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.ReceiverID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.SenderWalletID,
+			&i.ReceiverWalletID,
+			&i.Currency,
+			&i.OriginalTransactionID,
+			&i.Status,
+			&i.FailureReason,
+			&i.ProcessingAt,
+			&i.CompletedAt,
+			&i.FailedAt,
+			&i.ReversedAt,
+			&i.QuoteID,
+			&i.HoldID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionByID = `-- name: GetTransactionByID :one
+SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE id = $1
+`
+
+func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (*Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransactionByID, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.ReceiverID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.SenderWalletID,
+		&i.ReceiverWalletID,
+		&i.Currency,
+		&i.OriginalTransactionID,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.QuoteID,
+		&i.HoldID,
+	)
+	return &i, err
+}
+
+const getTransactionByIDForUpdate = `-- name: GetTransactionByIDForUpdate :one
+SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransactionByIDForUpdate(ctx context.Context, id uuid.UUID) (*Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransactionByIDForUpdate, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.SenderID,
+		&i.ReceiverID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.SenderWalletID,
+		&i.ReceiverWalletID,
+		&i.Currency,
+		&i.OriginalTransactionID,
+		&i.Status,
+		&i.FailureReason,
+		&i.ProcessingAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.ReversedAt,
+		&i.QuoteID,
+		&i.HoldID,
+	)
+	return &i, err
+}
+
+const getUserTransactions = `-- name: GetUserTransactions :many
+SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions
+WHERE (sender_id = $1 OR receiver_id = $1)
+    AND ($2::UUID IS NULL OR id < $2::UUID)
+    AND ($3::UUID IS NULL OR sender_id = $3::UUID OR receiver_id = $3::UUID)
+    AND ($4::TIMESTAMP IS NULL OR created_at >= $4::TIMESTAMP)
+    AND ($5::TIMESTAMP IS NULL OR created_at < $5::TIMESTAMP)
+    AND ($6::NUMERIC IS NULL OR amount >= $6::NUMERIC)
+    AND ($7::NUMERIC IS NULL OR amount <= $7::NUMERIC)
+    AND ($8::CHAR(3) IS NULL OR currency = $8::CHAR(3))
+ORDER BY id DESC
+LIMIT $9
+`
+
+type GetUserTransactionsParams struct {
+	Cursor         *uuid.UUID
+	CounterpartyID *uuid.UUID
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	MinAmount      *decimal.Decimal
+	MaxAmount      *decimal.Decimal
+	Currency       *string
+	PageLimit      int32
+	UserID         uuid.UUID
+}
+
+func (q *Queries) GetUserTransactions(ctx context.Context, arg GetUserTransactionsParams) ([]*Transaction, error) {
+	rows, err := q.db.Query(ctx, getUserTransactions,
+		arg.UserID,
+		arg.Cursor,
+		arg.CounterpartyID,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Currency,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.SenderID,
+			&i.ReceiverID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.SenderWalletID,
+			&i.ReceiverWalletID,
+			&i.Currency,
+			&i.OriginalTransactionID,
+			&i.Status,
+			&i.FailureReason,
+			&i.ProcessingAt,
+			&i.CompletedAt,
+			&i.FailedAt,
+			&i.ReversedAt,
+			&i.QuoteID,
+			&i.HoldID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const hardDeleteAllTransactions = `-- name: HardDeleteAllTransactions :exec
 DELETE FROM transactions
 `
@@ -51,3 +287,39 @@ func (q *Queries) HardDeleteAllTransactions(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, hardDeleteAllTransactions)
 	return err
 }
+
+const updateTransactionStatus = `-- name: UpdateTransactionStatus :execrows
+UPDATE transactions
+SET status = $1, failure_reason = $2, processing_at = $3, completed_at = $4, failed_at = $5, reversed_at = $6, updated_at = $7
+WHERE id = $8 AND status = $9
+`
+
+type UpdateTransactionStatusParams struct {
+	UpdatedAt     time.Time
+	FailureReason *string
+	ProcessingAt  *time.Time
+	CompletedAt   *time.Time
+	FailedAt      *time.Time
+	ReversedAt    *time.Time
+	Status        TransactionStatus
+	CurrentStatus TransactionStatus
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTransactionStatus,
+		arg.Status,
+		arg.FailureReason,
+		arg.ProcessingAt,
+		arg.CompletedAt,
+		arg.FailedAt,
+		arg.ReversedAt,
+		arg.UpdatedAt,
+		arg.ID,
+		arg.CurrentStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
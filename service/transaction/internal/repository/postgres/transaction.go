@@ -4,6 +4,10 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
 	"github.com/indrasaputra/arjuna/service/transaction/entity"
 	"github.com/indrasaputra/arjuna/service/transaction/internal/repository/db"
@@ -26,14 +30,25 @@ func (t *Transaction) Insert(ctx context.Context, trx *entity.Transaction) error
 	}
 
 	param := db.CreateTransactionParams{
-		ID:         trx.ID,
-		SenderID:   trx.SenderID,
-		ReceiverID: trx.ReceiverID,
-		Amount:     trx.Amount,
-		CreatedAt:  trx.CreatedAt,
-		UpdatedAt:  trx.UpdatedAt,
-		CreatedBy:  trx.CreatedBy,
-		UpdatedBy:  trx.UpdatedBy,
+		ID:                    trx.ID,
+		SenderID:              trx.SenderID,
+		ReceiverID:            trx.ReceiverID,
+		SenderWalletID:        trx.SenderWalletID,
+		ReceiverWalletID:      trx.ReceiverWalletID,
+		Amount:                trx.Amount,
+		Currency:              trx.Currency,
+		OriginalTransactionID: trx.OriginalTransactionID,
+		Status:                db.TransactionStatus(trx.Status),
+		CreatedAt:             trx.CreatedAt,
+		UpdatedAt:             trx.UpdatedAt,
+		CreatedBy:             trx.CreatedBy,
+		UpdatedBy:             trx.UpdatedBy,
+	}
+	if trx.IsConverted() {
+		param.QuoteID = &trx.QuoteID
+	}
+	if trx.IsCapture() {
+		param.HoldID = &trx.HoldID
 	}
 	err := t.queries.CreateTransaction(ctx, param)
 	if sdkpostgres.IsUniqueViolationError(err) {
@@ -46,6 +61,83 @@ func (t *Transaction) Insert(ctx context.Context, trx *entity.Transaction) error
 	return nil
 }
 
+// GetByID gets a transaction by its id.
+func (t *Transaction) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	trx, err := t.queries.GetTransactionByID(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-GetByID] fail get transaction", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createTransactionFromModel(trx), nil
+}
+
+// GetByIDForUpdate gets a transaction by its id and locks it until the database transaction ends.
+func (t *Transaction) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	trx, err := t.queries.GetTransactionByIDForUpdate(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-GetByIDForUpdate] fail get transaction for update", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createTransactionFromModel(trx), nil
+}
+
+// GetRefundedAmount sums the amount of the transaction's refunds in any of the statuses.
+func (t *Transaction) GetRefundedAmount(ctx context.Context, id uuid.UUID, statuses ...entity.TransactionStatus) (decimal.Decimal, error) {
+	param := db.GetRefundedAmountParams{
+		OriginalTransactionID: &id,
+		Statuses:              make([]db.TransactionStatus, len(statuses)),
+	}
+	for i, st := range statuses {
+		param.Statuses[i] = db.TransactionStatus(st)
+	}
+
+	amount, err := t.queries.GetRefundedAmount(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-GetRefundedAmount] fail get refunded amount", "error", err)
+		return decimal.Zero, entity.ErrInternal(err.Error())
+	}
+	return amount, nil
+}
+
+// UpdateStatus saves the transaction's status, failure reason, and transition timestamps.
+// The update only applies if the transaction is still in the from status.
+// Otherwise, it returns entity.ErrInvalidStatusTransition.
+func (t *Transaction) UpdateStatus(ctx context.Context, trx *entity.Transaction, from entity.TransactionStatus) error {
+	if trx == nil {
+		return entity.ErrEmptyTransaction()
+	}
+
+	param := db.UpdateTransactionStatusParams{
+		ID:            trx.ID,
+		Status:        db.TransactionStatus(trx.Status),
+		CurrentStatus: db.TransactionStatus(from),
+		ProcessingAt:  trx.ProcessingAt,
+		CompletedAt:   trx.CompletedAt,
+		FailedAt:      trx.FailedAt,
+		ReversedAt:    trx.ReversedAt,
+		UpdatedAt:     trx.UpdatedAt,
+	}
+	if trx.FailureReason != "" {
+		param.FailureReason = &trx.FailureReason
+	}
+
+	n, err := t.queries.UpdateTransactionStatus(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-UpdateStatus] fail update transaction's status", "id", trx.ID, "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	if n == 0 {
+		return entity.ErrInvalidStatusTransition(from, trx.Status)
+	}
+	return nil
+}
+
 // DeleteAll deletes all transactions.
 func (t *Transaction) DeleteAll(ctx context.Context) error {
 	if err := t.queries.HardDeleteAllTransactions(ctx); err != nil {
@@ -54,3 +146,90 @@ func (t *Transaction) DeleteAll(ctx context.Context) error {
 	}
 	return nil
 }
+
+// GetAllByUser gets transactions sent or received by the user, newest first.
+// If there isn't any transaction matching the filter, it returns empty list of transaction and nil error.
+func (t *Transaction) GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error) {
+	if filter == nil {
+		return []*entity.Transaction{}, entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+
+	param := db.GetUserTransactionsParams{
+		UserID:         filter.UserID,
+		Cursor:         filter.Cursor,
+		CounterpartyID: filter.CounterpartyID,
+		CreatedAfter:   filter.CreatedAfter,
+		CreatedBefore:  filter.CreatedBefore,
+		MinAmount:      filter.MinAmount,
+		MaxAmount:      filter.MaxAmount,
+		Currency:       filter.Currency,
+		PageLimit:      int32(filter.Limit),
+	}
+	trxs, err := t.queries.GetUserTransactions(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-GetAllByUser] fail get user's transactions", "error", err)
+		return []*entity.Transaction{}, entity.ErrInternal(err.Error())
+	}
+
+	result := make([]*entity.Transaction, len(trxs))
+	for i, trx := range trxs {
+		result[i] = createTransactionFromModel(trx)
+	}
+	return result, nil
+}
+
+// GetStale gets transactions in the status which haven't been updated since the time, oldest first.
+func (t *Transaction) GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error) {
+	if filter == nil {
+		return []*entity.Transaction{}, entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+
+	param := db.GetStaleTransactionsParams{
+		Status:    db.TransactionStatus(filter.Status),
+		OlderThan: filter.OlderThan,
+		PageLimit: int32(filter.Limit),
+	}
+	trxs, err := t.queries.GetStaleTransactions(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransaction-GetStale] fail get stale transactions", "error", err)
+		return []*entity.Transaction{}, entity.ErrInternal(err.Error())
+	}
+
+	result := make([]*entity.Transaction, len(trxs))
+	for i, trx := range trxs {
+		result[i] = createTransactionFromModel(trx)
+	}
+	return result, nil
+}
+
+func createTransactionFromModel(trx *db.Transaction) *entity.Transaction {
+	res := &entity.Transaction{
+		ID:                    trx.ID,
+		SenderID:              trx.SenderID,
+		ReceiverID:            trx.ReceiverID,
+		SenderWalletID:        trx.SenderWalletID,
+		ReceiverWalletID:      trx.ReceiverWalletID,
+		Amount:                trx.Amount,
+		Currency:              trx.Currency,
+		OriginalTransactionID: trx.OriginalTransactionID,
+		Status:                entity.TransactionStatus(trx.Status),
+		ProcessingAt:          trx.ProcessingAt,
+		CompletedAt:           trx.CompletedAt,
+		FailedAt:              trx.FailedAt,
+		ReversedAt:            trx.ReversedAt,
+	}
+	if trx.FailureReason != nil {
+		res.FailureReason = *trx.FailureReason
+	}
+	if trx.QuoteID != nil {
+		res.QuoteID = *trx.QuoteID
+	}
+	if trx.HoldID != nil {
+		res.HoldID = *trx.HoldID
+	}
+	res.CreatedAt = trx.CreatedAt
+	res.UpdatedAt = trx.UpdatedAt
+	res.CreatedBy = trx.CreatedBy
+	res.UpdatedBy = trx.UpdatedBy
+	return res
+}
@@ -3,8 +3,10 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -40,8 +42,8 @@ func TestNewTransaction(t *testing.T) {
 func TestTransaction_Insert(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	query := `INSERT INTO transactions \(id, sender_id, receiver_id, amount, created_at, updated_at, created_by, updated_by\)
-				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`
+	query := `INSERT INTO transactions \(id, sender_id, receiver_id, sender_wallet_id, receiver_wallet_id, amount, currency, original_transaction_id, quote_id, hold_id, status, created_at, updated_at, created_by, updated_by\)
+				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13, \$14, \$15\)`
 
 	t.Run("nil transactions is prohibited", func(t *testing.T) {
 		st := createTransactionSuite(t, ctrl)
@@ -57,7 +59,7 @@ func TestTransaction_Insert(t *testing.T) {
 		st := createTransactionSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, trx.OriginalTransactionID, (*uuid.UUID)(nil), (*uuid.UUID)(nil), db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
 			WillReturnError(sdkpostgres.ErrUniqueViolation)
 
 		err := st.trx.Insert(testCtx, trx)
@@ -71,7 +73,7 @@ func TestTransaction_Insert(t *testing.T) {
 		st := createTransactionSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, trx.OriginalTransactionID, (*uuid.UUID)(nil), (*uuid.UUID)(nil), db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
 			WillReturnError(assert.AnError)
 
 		err := st.trx.Insert(testCtx, trx)
@@ -84,13 +86,361 @@ func TestTransaction_Insert(t *testing.T) {
 		st := createTransactionSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, trx.OriginalTransactionID, (*uuid.UUID)(nil), (*uuid.UUID)(nil), db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
 		err := st.trx.Insert(testCtx, trx)
 
 		assert.NoError(t, err)
 	})
+
+	t.Run("success insert refund transactions", func(t *testing.T) {
+		trx := createTestTransaction()
+		originalID := uuid.Must(uuid.NewV7())
+		trx.OriginalTransactionID = &originalID
+		st := createTransactionSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, &originalID, (*uuid.UUID)(nil), (*uuid.UUID)(nil), db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.trx.Insert(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success insert converted transactions", func(t *testing.T) {
+		trx := createTestTransaction()
+		trx.QuoteID = uuid.Must(uuid.NewV7())
+		st := createTransactionSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, trx.OriginalTransactionID, &trx.QuoteID, (*uuid.UUID)(nil), db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.trx.Insert(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success insert capture transactions", func(t *testing.T) {
+		trx := createTestTransaction()
+		trx.HoldID = uuid.Must(uuid.NewV7())
+		st := createTransactionSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(trx.ID, trx.SenderID, trx.ReceiverID, trx.SenderWalletID, trx.ReceiverWalletID, trx.Amount, trx.Currency, trx.OriginalTransactionID, (*uuid.UUID)(nil), &trx.HoldID, db.TransactionStatusPENDING, trx.CreatedAt, trx.UpdatedAt, trx.CreatedBy, trx.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.trx.Insert(testCtx, trx)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransaction_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE id = \$1`
+	columns := []string{"id", "sender_id", "receiver_id", "amount", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "sender_wallet_id", "receiver_wallet_id", "currency", "original_transaction_id", "status", "failure_reason", "processing_at", "completed_at", "failed_at", "reversed_at", "quote_id", "hold_id"}
+
+	t.Run("transaction is not found", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.trx.GetByID(testCtx, id)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		res, err := st.trx.GetByID(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get transaction", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestTransaction()
+		originalID := uuid.Must(uuid.NewV7())
+		trx.OriginalTransactionID = &originalID
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(trx.ID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, trx.OriginalTransactionID, db.TransactionStatusCOMPLETED, nil, &trx.CreatedAt, &trx.CreatedAt, nil, nil, nil, nil))
+
+		res, err := st.trx.GetByID(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID, res.ID)
+		assert.Equal(t, trx.ReceiverWalletID, res.ReceiverWalletID)
+		assert.Equal(t, originalID, *res.OriginalTransactionID)
+		assert.Equal(t, entity.TransactionStatusCompleted, res.Status)
+		assert.Equal(t, trx.CreatedAt, *res.CompletedAt)
+		assert.Nil(t, res.FailedAt)
+		assert.Empty(t, res.FailureReason)
+	})
+
+	t.Run("success get converted transaction", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestTransaction()
+		quoteID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(trx.ID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, trx.OriginalTransactionID, db.TransactionStatusCOMPLETED, nil, &trx.CreatedAt, &trx.CreatedAt, nil, nil, &quoteID, nil))
+
+		res, err := st.trx.GetByID(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, quoteID, res.QuoteID)
+		assert.True(t, res.IsConverted())
+	})
+
+	t.Run("success get capture transaction", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestTransaction()
+		holdID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(trx.ID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, trx.OriginalTransactionID, db.TransactionStatusCOMPLETED, nil, &trx.CreatedAt, &trx.CreatedAt, nil, nil, nil, &holdID))
+
+		res, err := st.trx.GetByID(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, holdID, res.HoldID)
+		assert.True(t, res.IsCapture())
+	})
+
+	t.Run("success get failed transaction", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestTransaction()
+		reason := "insufficient balance"
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(trx.ID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, trx.OriginalTransactionID, db.TransactionStatusFAILED, &reason, &trx.CreatedAt, nil, &trx.UpdatedAt, nil, nil, nil))
+
+		res, err := st.trx.GetByID(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransactionStatusFailed, res.Status)
+		assert.Equal(t, reason, res.FailureReason)
+		assert.Equal(t, trx.UpdatedAt, *res.FailedAt)
+	})
+}
+
+func TestTransaction_GetByIDForUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE id = \$1 LIMIT 1 FOR NO KEY UPDATE`
+	columns := []string{"id", "sender_id", "receiver_id", "amount", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "sender_wallet_id", "receiver_wallet_id", "currency", "original_transaction_id", "status", "failure_reason", "processing_at", "completed_at", "failed_at", "reversed_at", "quote_id", "hold_id"}
+
+	t.Run("transaction is not found", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.trx.GetByIDForUpdate(testCtx, id)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		res, err := st.trx.GetByIDForUpdate(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get transaction for update", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(trx.ID).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, nil, db.TransactionStatusCOMPLETED, nil, &trx.CreatedAt, &trx.CreatedAt, nil, nil, nil, nil))
+
+		res, err := st.trx.GetByIDForUpdate(testCtx, trx.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID, res.ID)
+		assert.Equal(t, entity.TransactionStatusCompleted, res.Status)
+	})
+}
+
+func TestTransaction_GetRefundedAmount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT COALESCE\(SUM\(amount\), 0\)::NUMERIC\(24, 4\) AS amount
+		FROM transactions WHERE original_transaction_id = \$1 AND status = ANY\(\$2::transaction_status\[\]\)`
+	statuses := []db.TransactionStatus{db.TransactionStatusPENDING, db.TransactionStatusCOMPLETED}
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(&id, statuses).WillReturnError(assert.AnError)
+
+		res, err := st.trx.GetRefundedAmount(testCtx, id, entity.TransactionStatusPending, entity.TransactionStatusCompleted)
+
+		assert.Error(t, err)
+		assert.True(t, res.IsZero())
+	})
+
+	t.Run("success get refunded amount", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		amount := decimal.RequireFromString("7.5")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(&id, statuses).WillReturnRows(pgxmock.NewRows([]string{"amount"}).AddRow(amount))
+
+		res, err := st.trx.GetRefundedAmount(testCtx, id, entity.TransactionStatusPending, entity.TransactionStatusCompleted)
+
+		assert.NoError(t, err)
+		assert.True(t, amount.Equal(res))
+	})
+}
+
+func TestTransaction_UpdateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE transactions SET status = \$1, failure_reason = \$2, processing_at = \$3, completed_at = \$4, failed_at = \$5, reversed_at = \$6, updated_at = \$7 WHERE id = \$8 AND status = \$9`
+	noReason := (*string)(nil)
+	noTime := (*time.Time)(nil)
+
+	t.Run("nil transaction is prohibited", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+
+		err := st.trx.UpdateStatus(testCtx, nil, entity.TransactionStatusPending)
+
+		assert.Equal(t, entity.ErrEmptyTransaction(), err)
+	})
+
+	t.Run("update returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestProcessingTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(db.TransactionStatusPROCESSING, noReason, trx.ProcessingAt, noTime, noTime, noTime, trx.UpdatedAt, trx.ID, db.TransactionStatusPENDING).
+			WillReturnError(assert.AnError)
+
+		err := st.trx.UpdateStatus(testCtx, trx, entity.TransactionStatusPending)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("transaction is no longer in the previous status", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestProcessingTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(db.TransactionStatusPROCESSING, noReason, trx.ProcessingAt, noTime, noTime, noTime, trx.UpdatedAt, trx.ID, db.TransactionStatusPENDING).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := st.trx.UpdateStatus(testCtx, trx, entity.TransactionStatusPending)
+
+		assert.Equal(t, entity.ErrInvalidStatusTransition(entity.TransactionStatusPending, entity.TransactionStatusProcessing), err)
+	})
+
+	t.Run("success update status", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestProcessingTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(db.TransactionStatusPROCESSING, noReason, trx.ProcessingAt, noTime, noTime, noTime, trx.UpdatedAt, trx.ID, db.TransactionStatusPENDING).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.trx.UpdateStatus(testCtx, trx, entity.TransactionStatusPending)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success update status to failed with the reason", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		trx := createTestProcessingTransaction()
+		_ = trx.Transition(entity.TransactionStatusFailed, time.Now(), "insufficient balance")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(db.TransactionStatusFAILED, &trx.FailureReason, trx.ProcessingAt, noTime, trx.FailedAt, noTime, trx.UpdatedAt, trx.ID, db.TransactionStatusPROCESSING).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.trx.UpdateStatus(testCtx, trx, entity.TransactionStatusProcessing)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransaction_GetAllByUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE \(sender_id = \$1 OR receiver_id = \$1\) .+ ORDER BY id DESC LIMIT \$9`
+	columns := []string{"id", "sender_id", "receiver_id", "amount", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "sender_wallet_id", "receiver_wallet_id", "currency", "original_transaction_id", "status", "failure_reason", "processing_at", "completed_at", "failed_at", "reversed_at", "quote_id", "hold_id"}
+
+	t.Run("nil filter is prohibited", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+
+		res, err := st.trx.GetAllByUser(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		filter := createTestTransactionFilter()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(filter.UserID, filter.Cursor, filter.CounterpartyID, filter.CreatedAfter, filter.CreatedBefore, filter.MinAmount, filter.MaxAmount, filter.Currency, int32(filter.Limit)).
+			WillReturnError(assert.AnError)
+
+		res, err := st.trx.GetAllByUser(testCtx, filter)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get user's transactions", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		filter := createTestTransactionFilter()
+		trx := createTestTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(filter.UserID, filter.Cursor, filter.CounterpartyID, filter.CreatedAfter, filter.CreatedBefore, filter.MinAmount, filter.MaxAmount, filter.Currency, int32(filter.Limit)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, trx.OriginalTransactionID, db.TransactionStatusCOMPLETED, nil, &trx.CreatedAt, &trx.CreatedAt, nil, nil, nil, nil))
+
+		res, err := st.trx.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, trx.ID, res[0].ID)
+		assert.Equal(t, trx.SenderWalletID, res[0].SenderWalletID)
+		assert.Equal(t, trx.Amount, res[0].Amount)
+		assert.Equal(t, trx.Currency, res[0].Currency)
+	})
 }
 
 func TestTransaction_DeleteAll(t *testing.T) {
@@ -119,13 +469,92 @@ func TestTransaction_DeleteAll(t *testing.T) {
 	})
 }
 
+func TestTransaction_GetStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, sender_id, receiver_id, amount, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, sender_wallet_id, receiver_wallet_id, currency, original_transaction_id, status, failure_reason, processing_at, completed_at, failed_at, reversed_at, quote_id, hold_id FROM transactions WHERE status = \$1 AND updated_at < \$2 ORDER BY updated_at ASC LIMIT \$3`
+	columns := []string{"id", "sender_id", "receiver_id", "amount", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "sender_wallet_id", "receiver_wallet_id", "currency", "original_transaction_id", "status", "failure_reason", "processing_at", "completed_at", "failed_at", "reversed_at", "quote_id", "hold_id"}
+
+	t.Run("nil filter is prohibited", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+
+		res, err := st.trx.GetStale(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		filter := createTestStaleTransactionFilter()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(db.TransactionStatusPROCESSING, filter.OlderThan, int32(filter.Limit)).
+			WillReturnError(assert.AnError)
+
+		res, err := st.trx.GetStale(testCtx, filter)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get stale transactions", func(t *testing.T) {
+		st := createTransactionSuite(t, ctrl)
+		filter := createTestStaleTransactionFilter()
+		trx := createTestProcessingTransaction()
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(db.TransactionStatusPROCESSING, filter.OlderThan, int32(filter.Limit)).
+			WillReturnRows(pgxmock.NewRows(columns).
+				AddRow(trx.ID, trx.SenderID, trx.ReceiverID, trx.Amount, trx.CreatedAt, trx.UpdatedAt, trx.DeletedAt, trx.CreatedBy, trx.UpdatedBy, trx.DeletedBy, trx.SenderWalletID, trx.ReceiverWalletID, trx.Currency, nil, db.TransactionStatusPROCESSING, nil, trx.ProcessingAt, nil, nil, nil, nil, nil))
+
+		res, err := st.trx.GetStale(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, trx.ID, res[0].ID)
+		assert.Equal(t, entity.TransactionStatusProcessing, res[0].Status)
+	})
+}
+
 func createTestTransaction() *entity.Transaction {
 	a, _ := decimal.NewFromString("10.23")
 	return &entity.Transaction{
-		ID:         uuid.Must(uuid.NewV7()),
-		SenderID:   uuid.Must(uuid.NewV7()),
-		ReceiverID: uuid.Must(uuid.NewV7()),
-		Amount:     a,
+		ID:               uuid.Must(uuid.NewV7()),
+		SenderID:         uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		SenderWalletID:   uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           a,
+		Currency:         "IDR",
+		Status:           entity.TransactionStatusPending,
+	}
+}
+
+func createTestProcessingTransaction() *entity.Transaction {
+	trx := createTestTransaction()
+	_ = trx.Transition(entity.TransactionStatusProcessing, time.Now(), "")
+	return trx
+}
+
+func createTestTransactionFilter() *entity.TransactionFilter {
+	cursor := uuid.Must(uuid.NewV7())
+	minAmount := decimal.NewFromInt(1)
+	currency := "IDR"
+	return &entity.TransactionFilter{
+		UserID:    uuid.Must(uuid.NewV7()),
+		Cursor:    &cursor,
+		MinAmount: &minAmount,
+		Currency:  &currency,
+		Limit:     10,
+	}
+}
+
+func createTestStaleTransactionFilter() *entity.StaleTransactionFilter {
+	return &entity.StaleTransactionFilter{
+		Status:    entity.TransactionStatusProcessing,
+		OlderThan: time.Now().Add(-time.Hour),
+		Limit:     10,
 	}
 }
 
@@ -6,9 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 
 	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	enwallet "github.com/indrasaputra/arjuna/service/wallet/entity"
 )
 
 // CreateTransaction defines interface to create transaction.
@@ -17,23 +17,24 @@ type CreateTransaction interface {
 	Create(ctx context.Context, transaction *entity.Transaction) (uuid.UUID, error)
 }
 
-// CreateTransactionRepository defines the interface to insert transaction to repository.
-type CreateTransactionRepository interface {
-	// Insert inserts a transaction.
-	Insert(ctx context.Context, transaction *entity.Transaction) error
+// CreateTransactionOrchestration defines the interface to orchestrate transaction creation.
+type CreateTransactionOrchestration interface {
+	// CreateTransaction records the transaction and moves the balance in wallet atomically.
+	// Either both happen or none of them.
+	CreateTransaction(ctx context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error)
 }
 
 // TransactionCreator is responsible for creating a new transaction.
 type TransactionCreator struct {
-	trxRepo CreateTransactionRepository
+	orchestrator CreateTransactionOrchestration
 }
 
 // NewTransactionCreator creates an instance of TransactionCreator.
-func NewTransactionCreator(t CreateTransactionRepository) *TransactionCreator {
-	return &TransactionCreator{trxRepo: t}
+func NewTransactionCreator(o CreateTransactionOrchestration) *TransactionCreator {
+	return &TransactionCreator{orchestrator: o}
 }
 
-// Create creates a new transaction.
+// Create creates a new transaction and moves the balance from sender to receiver.
 func (tc *TransactionCreator) Create(ctx context.Context, transaction *entity.Transaction) (uuid.UUID, error) {
 	sanitizeTransaction(transaction)
 	if err := validateTransaction(transaction); err != nil {
@@ -42,35 +43,46 @@ func (tc *TransactionCreator) Create(ctx context.Context, transaction *entity.Tr
 	}
 
 	setTransactionID(transaction)
+	setTransactionPending(transaction)
 	setTransactionAuditableProperties(transaction)
 
-	err := tc.trxRepo.Insert(ctx, transaction)
+	output, err := tc.orchestrator.CreateTransaction(ctx, &entity.CreateTransactionInput{Transaction: transaction})
 	if err != nil {
-		slog.ErrorContext(ctx, "[TransactionCreator-Create] fail save to repository", "error", err)
+		slog.ErrorContext(ctx, "[TransactionCreator-Create] fail orchestrate transaction", "error", err)
 		return uuid.Nil, err
 	}
-	return transaction.ID, nil
+	return output.ID, nil
 }
 
 func sanitizeTransaction(trx *entity.Transaction) {
 	if trx == nil {
 		return
 	}
+	trx.Currency = enwallet.NormalizeCurrencyCode(trx.Currency)
 }
 
 func validateTransaction(trx *entity.Transaction) error {
 	if trx == nil {
 		return entity.ErrEmptyTransaction()
 	}
-	if trx.SenderID == uuid.Nil {
+	if trx.SenderID == uuid.Nil || trx.SenderWalletID == uuid.Nil {
 		return entity.ErrInvalidSender()
 	}
-	if trx.ReceiverID == uuid.Nil {
+	if trx.ReceiverID == uuid.Nil || trx.ReceiverWalletID == uuid.Nil {
 		return entity.ErrInvalidReceiver()
 	}
-	if decimal.Zero.Equal(trx.Amount) {
+	if trx.SenderWalletID == trx.ReceiverWalletID {
+		return entity.ErrInvalidReceiver()
+	}
+	if !trx.Amount.IsPositive() {
 		return entity.ErrInvalidAmount()
 	}
+	if _, ok := enwallet.LookupCurrency(trx.Currency); !ok {
+		return entity.ErrInvalidCurrency()
+	}
+	if trx.IsCapture() && trx.IsConverted() {
+		return entity.ErrInvalidHold()
+	}
 	return nil
 }
 
@@ -78,6 +90,12 @@ func setTransactionID(trx *entity.Transaction) {
 	trx.ID = generateUniqueID()
 }
 
+// setTransactionPending starts the transaction's lifecycle.
+// The orchestrator moves it forward as the balance is moved.
+func setTransactionPending(trx *entity.Transaction) {
+	trx.Status = entity.TransactionStatusPending
+}
+
 func generateUniqueID() uuid.UUID {
 	return uuid.Must(uuid.NewV7())
 }
@@ -22,8 +22,8 @@ var (
 )
 
 type TransactionCreatorSuite struct {
-	trx     *service.TransactionCreator
-	trxRepo *mock_service.MockCreateTransactionRepository
+	trx          *service.TransactionCreator
+	orchestrator *mock_service.MockCreateTransactionOrchestration
 }
 
 func TestNewTransactionCreator(t *testing.T) {
@@ -72,6 +72,42 @@ func TestTransactionCreator_Create(t *testing.T) {
 		assert.Empty(t, id)
 	})
 
+	t.Run("sender wallet id is invalid", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.SenderWalletID = uuid.Nil
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidSender(), err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("receiver wallet id is invalid", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.ReceiverWalletID = uuid.Nil
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidReceiver(), err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("sender and receiver wallet are the same", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.ReceiverWalletID = trx.SenderWalletID
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidReceiver(), err)
+		assert.Empty(t, id)
+	})
+
 	t.Run("amount is invalid", func(t *testing.T) {
 		st := createTransactionCreatorSuite(ctrl)
 		trx := createTestTransaction()
@@ -83,11 +119,48 @@ func TestTransactionCreator_Create(t *testing.T) {
 		assert.Empty(t, id)
 	})
 
-	t.Run("trx repo insert returns error", func(t *testing.T) {
+	t.Run("amount is negative", func(t *testing.T) {
 		st := createTransactionCreatorSuite(ctrl)
 		trx := createTestTransaction()
+		trx.Amount = testAmount.Neg()
+
+		id, err := st.trx.Create(testCtx, trx)
 
-		st.trxRepo.EXPECT().Insert(testCtx, trx).Return(assert.AnError)
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("currency is not supported", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.Currency = "XYZ"
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("hold is used with fx quote", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.HoldID = uuid.Must(uuid.NewV7())
+		trx.QuoteID = uuid.Must(uuid.NewV7())
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidHold(), err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, &entity.CreateTransactionInput{Transaction: trx}).Return(nil, assert.AnError)
 
 		id, err := st.trx.Create(testCtx, trx)
 
@@ -99,29 +172,56 @@ func TestTransactionCreator_Create(t *testing.T) {
 		st := createTransactionCreatorSuite(ctrl)
 		trx := createTestTransaction()
 
-		st.trxRepo.EXPECT().Insert(testCtx, trx).Return(nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, &entity.CreateTransactionInput{Transaction: trx}).
+			DoAndReturn(func(_ context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
+				return &entity.CreateTransactionOutput{ID: input.Transaction.ID}, nil
+			})
 
 		id, err := st.trx.Create(testCtx, trx)
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, id)
+		assert.Equal(t, trx.ID, id)
+		assert.Equal(t, entity.TransactionStatusPending, trx.Status)
+		assert.Nil(t, trx.CompletedAt)
+	})
+
+	t.Run("empty currency is set to default currency", func(t *testing.T) {
+		st := createTransactionCreatorSuite(ctrl)
+		trx := createTestTransaction()
+		trx.Currency = ""
+
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
+				assert.Equal(t, "IDR", input.Transaction.Currency)
+				return &entity.CreateTransactionOutput{ID: input.Transaction.ID}, nil
+			})
+
+		id, err := st.trx.Create(testCtx, trx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID, id)
 	})
 }
 
 func createTransactionCreatorSuite(ctrl *gomock.Controller) *TransactionCreatorSuite {
-	r := mock_service.NewMockCreateTransactionRepository(ctrl)
-	t := service.NewTransactionCreator(r)
+	o := mock_service.NewMockCreateTransactionOrchestration(ctrl)
+	t := service.NewTransactionCreator(o)
 	return &TransactionCreatorSuite{
-		trx:     t,
-		trxRepo: r,
+		trx:          t,
+		orchestrator: o,
 	}
 }
 
 func createTestTransaction() *entity.Transaction {
 	return &entity.Transaction{
-		ID:         uuid.Must(uuid.NewV7()),
-		SenderID:   testSenderID,
-		ReceiverID: testReceiverID,
-		Amount:     testAmount,
+		ID:               uuid.Must(uuid.NewV7()),
+		SenderID:         testSenderID,
+		ReceiverID:       testReceiverID,
+		SenderWalletID:   uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           testAmount,
+		Currency:         "IDR",
+		Status:           entity.TransactionStatusCompleted,
 	}
 }
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	enwallet "github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 50
+	// DefaultListTransactionsLimit is used when the limit is not set.
+	DefaultListTransactionsLimit = uint(defaultLimit)
+	// MaxListTransactionsLimit is the biggest page size to keep the query cheap.
+	MaxListTransactionsLimit = uint(maxLimit)
+)
+
+// GetTransaction defines the interface to get transaction.
+type GetTransaction interface {
+	// GetAllByUser gets a page of transactions sent or received by the user.
+	GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) (*entity.TransactionPage, error)
+	// GetStale gets transactions which haven't moved from the status since the time, oldest first.
+	GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error)
+}
+
+// GetTransactionRepository defines the interface to get transaction from the repository.
+type GetTransactionRepository interface {
+	// GetAllByUser gets transactions sent or received by the user, newest first.
+	// If there isn't any transaction matching the filter, it returns empty list of transaction and nil error.
+	GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error)
+	// GetStale gets transactions in the status which haven't been updated since the time, oldest first.
+	// If there isn't any transaction matching the filter, it returns empty list of transaction and nil error.
+	GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error)
+}
+
+// TransactionGetter is responsible for getting transaction.
+type TransactionGetter struct {
+	repo GetTransactionRepository
+}
+
+// NewTransactionGetter creates an instance of TransactionGetter.
+func NewTransactionGetter(repo GetTransactionRepository) *TransactionGetter {
+	return &TransactionGetter{repo: repo}
+}
+
+// GetAllByUser gets a page of transactions sent or received by the user.
+// The page is ordered by id descending. Since the id is UUIDv7, it means newest first.
+func (tg *TransactionGetter) GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) (*entity.TransactionPage, error) {
+	sanitizeTransactionFilter(filter)
+	if err := validateTransactionFilter(filter); err != nil {
+		return nil, err
+	}
+
+	limit := normalizeListTransactionsLimit(filter.Limit)
+
+	// fetch one more row to know whether there is a next page.
+	query := *filter
+	query.Limit = limit + 1
+	trxs, err := tg.repo.GetAllByUser(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.TransactionPage{Transactions: trxs}
+	if uint(len(trxs)) > limit {
+		page.Transactions = trxs[:limit]
+		cursor := page.Transactions[limit-1].ID
+		page.NextCursor = &cursor
+	}
+	return page, nil
+}
+
+// GetStale gets transactions which haven't moved from the status since the time, oldest first.
+// It is meant for reconciling transactions left pending or processing.
+func (tg *TransactionGetter) GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error) {
+	if err := validateStaleTransactionFilter(filter); err != nil {
+		return nil, err
+	}
+
+	query := *filter
+	query.Limit = normalizeListTransactionsLimit(filter.Limit)
+	return tg.repo.GetStale(ctx, &query)
+}
+
+func normalizeListTransactionsLimit(limit uint) uint {
+	if limit == 0 {
+		return DefaultListTransactionsLimit
+	}
+	if limit > MaxListTransactionsLimit {
+		return MaxListTransactionsLimit
+	}
+	return limit
+}
+
+func validateStaleTransactionFilter(filter *entity.StaleTransactionFilter) error {
+	if filter == nil {
+		return entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+	if filter.Status == "" {
+		return entity.ErrInvalidFilter("status", "must be set")
+	}
+	if filter.OlderThan.IsZero() {
+		return entity.ErrInvalidFilter("older_than", "must be set")
+	}
+	return nil
+}
+
+func sanitizeTransactionFilter(filter *entity.TransactionFilter) {
+	if filter == nil || filter.Currency == nil {
+		return
+	}
+	currency := enwallet.NormalizeCurrencyCode(*filter.Currency)
+	filter.Currency = &currency
+}
+
+func validateTransactionFilter(filter *entity.TransactionFilter) error {
+	if filter == nil {
+		return entity.ErrInvalidFilter("filter", "empty or nil")
+	}
+	if filter.UserID == uuid.Nil {
+		return entity.ErrInvalidSender()
+	}
+	if filter.MinAmount != nil && filter.MinAmount.IsNegative() {
+		return entity.ErrInvalidFilter("min_amount", "must be greater than or equal to zero")
+	}
+	if filter.MaxAmount != nil && filter.MaxAmount.IsNegative() {
+		return entity.ErrInvalidFilter("max_amount", "must be greater than or equal to zero")
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.GreaterThan(*filter.MaxAmount) {
+		return entity.ErrInvalidFilter("min_amount", "must be less than or equal to max_amount")
+	}
+	// amounts in different currencies aren't comparable.
+	if (filter.MinAmount != nil || filter.MaxAmount != nil) && filter.Currency == nil {
+		return entity.ErrInvalidFilter("currency", "must be set to filter by amount")
+	}
+	if filter.Currency != nil {
+		if _, ok := enwallet.LookupCurrency(*filter.Currency); !ok {
+			return entity.ErrInvalidFilter("currency", "must be a supported currency")
+		}
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return entity.ErrInvalidFilter("created_after", "must be before created_before")
+	}
+	return nil
+}
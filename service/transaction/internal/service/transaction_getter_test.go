@@ -0,0 +1,226 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/transaction/test/mock/service"
+)
+
+type TransactionGetterSuite struct {
+	getter *service.TransactionGetter
+	repo   *mock_service.MockGetTransactionRepository
+}
+
+func TestNewTransactionGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of TransactionGetter", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		assert.NotNil(t, st.getter)
+	})
+}
+
+func TestTransactionGetter_GetAllByUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("invalid filter is prohibited", func(t *testing.T) {
+		negative := decimal.NewFromInt(-1)
+		small := decimal.NewFromInt(1)
+		big := decimal.NewFromInt(10)
+		now := time.Now()
+		later := now.Add(time.Hour)
+		currency := "IDR"
+		unknown := "XYZ"
+
+		filters := []*entity.TransactionFilter{
+			nil,
+			{},
+			{UserID: testSenderID, MinAmount: &negative, Currency: &currency},
+			{UserID: testSenderID, MaxAmount: &negative, Currency: &currency},
+			{UserID: testSenderID, MinAmount: &big, MaxAmount: &small, Currency: &currency},
+			{UserID: testSenderID, MinAmount: &small},
+			{UserID: testSenderID, MaxAmount: &big},
+			{UserID: testSenderID, Currency: &unknown},
+			{UserID: testSenderID, CreatedAfter: &later, CreatedBefore: &now},
+			{UserID: testSenderID, CreatedAfter: &now, CreatedBefore: &now},
+		}
+
+		for _, filter := range filters {
+			st := createTransactionGetterSuite(ctrl)
+
+			res, err := st.getter.GetAllByUser(testCtx, filter)
+
+			assert.Error(t, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.TransactionFilter{UserID: testSenderID, Limit: 5}
+		st.repo.EXPECT().GetAllByUser(testCtx, &entity.TransactionFilter{UserID: testSenderID, Limit: 6}).Return([]*entity.Transaction{}, entity.ErrInternal(""))
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("currency is normalized", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		minAmount := decimal.NewFromInt(1)
+		currency := " idr "
+		normalized := "IDR"
+		filter := &entity.TransactionFilter{UserID: testSenderID, MinAmount: &minAmount, Currency: &currency, Limit: 5}
+		expected := &entity.TransactionFilter{UserID: testSenderID, MinAmount: &minAmount, Currency: &normalized, Limit: 6}
+		st.repo.EXPECT().GetAllByUser(testCtx, expected).Return([]*entity.Transaction{}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+	})
+
+	t.Run("limit is set to default when it is zero", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.TransactionFilter{UserID: testSenderID}
+		expected := &entity.TransactionFilter{UserID: testSenderID, Limit: service.DefaultListTransactionsLimit + 1}
+		st.repo.EXPECT().GetAllByUser(testCtx, expected).Return([]*entity.Transaction{}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+		assert.Nil(t, res.NextCursor)
+	})
+
+	t.Run("limit is capped to max", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.TransactionFilter{UserID: testSenderID, Limit: 1000}
+		expected := &entity.TransactionFilter{UserID: testSenderID, Limit: service.MaxListTransactionsLimit + 1}
+		st.repo.EXPECT().GetAllByUser(testCtx, expected).Return([]*entity.Transaction{}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+	})
+
+	t.Run("last page doesn't have next cursor", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.TransactionFilter{UserID: testSenderID, Limit: 2}
+		trxs := []*entity.Transaction{createTestTransaction(), createTestTransaction()}
+		st.repo.EXPECT().GetAllByUser(testCtx, gomock.Any()).Return(trxs, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Transactions, 2)
+		assert.Nil(t, res.NextCursor)
+	})
+
+	t.Run("page has next cursor when there are more transactions", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		cursor := uuid.Must(uuid.NewV7())
+		filter := &entity.TransactionFilter{UserID: testSenderID, Cursor: &cursor, Limit: 2}
+		trxs := []*entity.Transaction{createTestTransaction(), createTestTransaction(), createTestTransaction()}
+		st.repo.EXPECT().GetAllByUser(testCtx, &entity.TransactionFilter{UserID: testSenderID, Cursor: &cursor, Limit: 3}).Return(trxs, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Transactions, 2)
+		assert.Equal(t, trxs[1].ID, *res.NextCursor)
+	})
+}
+
+func TestTransactionGetter_GetStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	olderThan := time.Now().Add(-time.Hour)
+
+	t.Run("invalid filter is prohibited", func(t *testing.T) {
+		filters := []*entity.StaleTransactionFilter{
+			nil,
+			{},
+			{OlderThan: olderThan},
+			{Status: entity.TransactionStatusProcessing},
+		}
+
+		for _, filter := range filters {
+			st := createTransactionGetterSuite(ctrl)
+
+			res, err := st.getter.GetStale(testCtx, filter)
+
+			assert.Error(t, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.StaleTransactionFilter{Status: entity.TransactionStatusProcessing, OlderThan: olderThan, Limit: 5}
+		st.repo.EXPECT().GetStale(testCtx, filter).Return([]*entity.Transaction{}, entity.ErrInternal(""))
+
+		res, err := st.getter.GetStale(testCtx, filter)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("limit is set to default when it is zero", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.StaleTransactionFilter{Status: entity.TransactionStatusPending, OlderThan: olderThan}
+		expected := &entity.StaleTransactionFilter{Status: entity.TransactionStatusPending, OlderThan: olderThan, Limit: service.DefaultListTransactionsLimit}
+		st.repo.EXPECT().GetStale(testCtx, expected).Return([]*entity.Transaction{}, nil)
+
+		res, err := st.getter.GetStale(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("limit is capped to max", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.StaleTransactionFilter{Status: entity.TransactionStatusPending, OlderThan: olderThan, Limit: 1000}
+		expected := &entity.StaleTransactionFilter{Status: entity.TransactionStatusPending, OlderThan: olderThan, Limit: service.MaxListTransactionsLimit}
+		st.repo.EXPECT().GetStale(testCtx, expected).Return([]*entity.Transaction{}, nil)
+
+		res, err := st.getter.GetStale(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get stale transactions", func(t *testing.T) {
+		st := createTransactionGetterSuite(ctrl)
+		filter := &entity.StaleTransactionFilter{Status: entity.TransactionStatusProcessing, OlderThan: olderThan, Limit: 5}
+		trxs := []*entity.Transaction{{ID: uuid.Must(uuid.NewV7()), Status: entity.TransactionStatusProcessing}}
+		st.repo.EXPECT().GetStale(testCtx, filter).Return(trxs, nil)
+
+		res, err := st.getter.GetStale(testCtx, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trxs, res)
+	})
+}
+
+func createTransactionGetterSuite(ctrl *gomock.Controller) *TransactionGetterSuite {
+	r := mock_service.NewMockGetTransactionRepository(ctrl)
+	g := service.NewTransactionGetter(r)
+	return &TransactionGetterSuite{
+		getter: g,
+		repo:   r,
+	}
+}
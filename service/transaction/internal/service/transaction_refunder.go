@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+// RefundTransaction defines interface to refund transaction.
+type RefundTransaction interface {
+	// Refund refunds the transaction on behalf of the user.
+	// Zero amount means the whole remaining amount is refunded.
+	Refund(ctx context.Context, userID, id uuid.UUID, amount decimal.Decimal) (*entity.Transaction, error)
+}
+
+// RefundTransactionRepository defines interface to get transaction from repository.
+type RefundTransactionRepository interface {
+	// GetByID gets a transaction by its id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
+	// GetRefundedAmount sums the amount of the transaction's refunds in any of the statuses.
+	GetRefundedAmount(ctx context.Context, id uuid.UUID, statuses ...entity.TransactionStatus) (decimal.Decimal, error)
+}
+
+// TransactionRefunder is responsible for refunding a transaction.
+type TransactionRefunder struct {
+	repo         RefundTransactionRepository
+	orchestrator CreateTransactionOrchestration
+}
+
+// NewTransactionRefunder creates an instance of TransactionRefunder.
+func NewTransactionRefunder(r RefundTransactionRepository, o CreateTransactionOrchestration) *TransactionRefunder {
+	return &TransactionRefunder{repo: r, orchestrator: o}
+}
+
+// Refund creates a transaction which moves the amount back from the receiver to the sender of the original transaction.
+// The refund is linked to the original transaction. A transaction can be refunded partially many times
+// as long as its refunds which haven't failed don't exceed its amount.
+// Only completed transaction can be refunded. Transaction converted to another currency can't be refunded.
+// Once its refunds complete its amount, the original transaction is reversed.
+// The balance is moved by the same flow as a new transaction, hence both wallets are adjusted atomically.
+// The remaining amount is checked again when the refund is recorded, so concurrent refunds can't exceed it.
+func (tr *TransactionRefunder) Refund(ctx context.Context, userID, id uuid.UUID, amount decimal.Decimal) (*entity.Transaction, error) {
+	original, err := tr.repo.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionRefunder-Refund] fail get transaction", "error", err)
+		return nil, err
+	}
+	if err := validateRefund(original, userID); err != nil {
+		slog.ErrorContext(ctx, "[TransactionRefunder-Refund] refund is invalid", "error", err)
+		return nil, err
+	}
+	refunded, err := tr.repo.GetRefundedAmount(ctx, id, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted)
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionRefunder-Refund] fail get refunded amount", "error", err)
+		return nil, err
+	}
+	remaining := original.Amount.Sub(refunded)
+	if err := validateRefundAmount(amount, remaining); err != nil {
+		slog.ErrorContext(ctx, "[TransactionRefunder-Refund] refund amount is invalid", "error", err)
+		return nil, err
+	}
+	if amount.IsZero() {
+		amount = remaining
+	}
+
+	refund := createRefundTransaction(original, amount)
+	setTransactionID(refund)
+	setTransactionPending(refund)
+	setTransactionAuditableProperties(refund)
+
+	_, err = tr.orchestrator.CreateTransaction(ctx, &entity.CreateTransactionInput{Transaction: refund})
+	if err != nil {
+		slog.ErrorContext(ctx, "[TransactionRefunder-Refund] fail orchestrate refund", "error", err)
+		return nil, err
+	}
+	return refund, nil
+}
+
+func validateRefund(original *entity.Transaction, userID uuid.UUID) error {
+	if userID != original.SenderID && userID != original.ReceiverID {
+		return entity.ErrNotFound()
+	}
+	if userID != original.ReceiverID {
+		return entity.ErrNotRefundable("only the receiver can refund the transaction")
+	}
+	if original.IsRefund() {
+		return entity.ErrNotRefundable("refund can't be refunded")
+	}
+	if original.IsConverted() {
+		return entity.ErrNotRefundable("transaction converted to another currency can't be refunded")
+	}
+	if original.Status == entity.TransactionStatusReversed {
+		return entity.ErrAlreadyRefunded()
+	}
+	if original.Status != entity.TransactionStatusCompleted {
+		return entity.ErrNotRefundable("only completed transaction can be refunded")
+	}
+	if original.SenderWalletID == uuid.Nil || original.ReceiverWalletID == uuid.Nil {
+		return entity.ErrNotRefundable("transaction doesn't have wallet to refund")
+	}
+	return nil
+}
+
+// validateRefundAmount checks the amount against the amount which hasn't been refunded.
+func validateRefundAmount(amount, remaining decimal.Decimal) error {
+	if !remaining.IsPositive() {
+		return entity.ErrNotRefundable("fully refunded")
+	}
+	if amount.IsNegative() || amount.GreaterThan(remaining) {
+		return entity.ErrInvalidAmount()
+	}
+	return nil
+}
+
+// createRefundTransaction swaps the sender and the receiver of the original transaction.
+func createRefundTransaction(original *entity.Transaction, amount decimal.Decimal) *entity.Transaction {
+	return &entity.Transaction{
+		SenderID:              original.ReceiverID,
+		SenderWalletID:        original.ReceiverWalletID,
+		ReceiverID:            original.SenderID,
+		ReceiverWalletID:      original.SenderWalletID,
+		Amount:                amount,
+		Currency:              original.Currency,
+		OriginalTransactionID: &original.ID,
+	}
+}
@@ -0,0 +1,285 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+	"github.com/indrasaputra/arjuna/service/transaction/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/transaction/test/mock/service"
+)
+
+type TransactionRefunderSuite struct {
+	refunder     *service.TransactionRefunder
+	repo         *mock_service.MockRefundTransactionRepository
+	orchestrator *mock_service.MockCreateTransactionOrchestration
+}
+
+func TestNewTransactionRefunder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of TransactionRefunder", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		assert.NotNil(t, st.refunder)
+	})
+}
+
+func TestTransactionRefunder_Refund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("get transaction returns error", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.repo.EXPECT().GetByID(testCtx, id).Return(nil, entity.ErrNotFound())
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, id, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user is not part of the transaction", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, uuid.Must(uuid.NewV7()), original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("sender can't refund the transaction", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, testSenderID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotRefundable("only the receiver can refund the transaction"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("refund can't be refunded", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		originalID := uuid.Must(uuid.NewV7())
+		original.OriginalTransactionID = &originalID
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotRefundable("refund can't be refunded"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction is converted to another currency", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		original.QuoteID = uuid.Must(uuid.NewV7())
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotRefundable("transaction converted to another currency can't be refunded"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction has been reversed", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		original.Status = entity.TransactionStatusReversed
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrAlreadyRefunded(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction is not completed", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		statuses := []entity.TransactionStatus{
+			entity.TransactionStatusPending,
+			entity.TransactionStatusProcessing,
+			entity.TransactionStatusFailed,
+		}
+
+		for _, status := range statuses {
+			original.Status = status
+			st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+			res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+			assert.Equal(t, entity.ErrNotRefundable("only completed transaction can be refunded"), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("transaction doesn't have wallet", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		original.SenderWalletID = uuid.Nil
+		original.ReceiverWalletID = uuid.Nil
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotRefundable("transaction doesn't have wallet to refund"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount is invalid", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		amounts := []decimal.Decimal{
+			decimal.NewFromInt(-1),
+			original.Amount.Add(decimal.RequireFromString("0.01")),
+		}
+
+		for _, amount := range amounts {
+			st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+			st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, nil)
+
+			res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, amount)
+
+			assert.Equal(t, entity.ErrInvalidAmount(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("get refunded amount returns error", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, entity.ErrInternal(""))
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction has been refunded", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(original.Amount, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrNotRefundable("fully refunded"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount exceeds the remaining amount", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.RequireFromString("4"), nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, original.Amount.Sub(decimal.RequireFromString("3.99")))
+
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("refund is exceeded concurrently", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).Return(nil, entity.ErrAlreadyRefunded())
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrAlreadyRefunded(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).Return(nil, entity.ErrTransferRejected("insufficient balance"))
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.Equal(t, entity.ErrTransferRejected("insufficient balance"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success refund the whole amount", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
+				return &entity.CreateTransactionOutput{ID: input.Transaction.ID}, nil
+			})
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.NotEqual(t, original.ID, res.ID)
+		assert.Equal(t, original.ReceiverID, res.SenderID)
+		assert.Equal(t, original.ReceiverWalletID, res.SenderWalletID)
+		assert.Equal(t, original.SenderID, res.ReceiverID)
+		assert.Equal(t, original.SenderWalletID, res.ReceiverWalletID)
+		assert.Equal(t, original.Amount, res.Amount)
+		assert.Equal(t, original.Currency, res.Currency)
+		assert.Equal(t, original.ID, *res.OriginalTransactionID)
+		assert.Equal(t, entity.TransactionStatusPending, res.Status)
+		assert.Equal(t, testReceiverID, res.CreatedBy)
+	})
+
+	t.Run("success refund part of the amount", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		amount := decimal.RequireFromString("5.1")
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(decimal.Zero, nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).Return(&entity.CreateTransactionOutput{}, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, amount)
+
+		assert.NoError(t, err)
+		assert.Equal(t, amount, res.Amount)
+		assert.Equal(t, original.ID, *res.OriginalTransactionID)
+	})
+
+	t.Run("success refund the remaining amount", func(t *testing.T) {
+		st := createTransactionRefunderSuite(ctrl)
+		original := createTestTransaction()
+		refunded := decimal.RequireFromString("4")
+		st.repo.EXPECT().GetByID(testCtx, original.ID).Return(original, nil)
+		st.repo.EXPECT().GetRefundedAmount(testCtx, original.ID, entity.TransactionStatusPending, entity.TransactionStatusProcessing, entity.TransactionStatusCompleted).Return(refunded, nil)
+		st.orchestrator.EXPECT().CreateTransaction(testCtx, gomock.Any()).Return(&entity.CreateTransactionOutput{}, nil)
+
+		res, err := st.refunder.Refund(testCtx, testReceiverID, original.ID, decimal.Zero)
+
+		assert.NoError(t, err)
+		assert.True(t, original.Amount.Sub(refunded).Equal(res.Amount))
+	})
+}
+
+func createTransactionRefunderSuite(ctrl *gomock.Controller) *TransactionRefunderSuite {
+	r := mock_service.NewMockRefundTransactionRepository(ctrl)
+	o := mock_service.NewMockCreateTransactionOrchestration(ctrl)
+	return &TransactionRefunderSuite{
+		refunder:     service.NewTransactionRefunder(r, o),
+		repo:         r,
+		orchestrator: o,
+	}
+}
@@ -0,0 +1,2 @@
+// Package transaction provides client SDK to access all transaction's use cases.
+package transaction
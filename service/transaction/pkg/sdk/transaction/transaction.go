@@ -0,0 +1,108 @@
+package transaction
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	"github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+const (
+	headerAuthorization = "authorization"
+)
+
+// Config defines configuration to work with Client.
+type Config struct {
+	Host     string
+	Username string
+	Password string
+	Options  []grpc.DialOption
+}
+
+// Client is responsible to connect to transaction use cases.
+type Client struct {
+	queryHandler apiv1.TransactionQueryServiceClient
+	config       *Config
+}
+
+// NewClient creates an instance of Client.
+func NewClient(cfg *Config) (*Client, error) {
+	conn, err := grpc.NewClient(cfg.Host, cfg.Options...)
+	if err != nil {
+		return nil, status.New(codes.Unavailable, "").Err()
+	}
+
+	return &Client{
+		queryHandler: apiv1.NewTransactionQueryServiceClient(conn),
+		config:       cfg,
+	}, nil
+}
+
+// ListUserTransactions lists a page of transactions sent or received by the user.
+// Set cursor to nil to get the first page.
+func (c *Client) ListUserTransactions(ctx context.Context, userID uuid.UUID, cursor *uuid.UUID, limit uint32) (*entity.TransactionPage, error) {
+	req := &apiv1.ListUserTransactionsRequest{UserId: userID.String(), Limit: limit}
+	if cursor != nil {
+		req.Cursor = cursor.String()
+	}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+
+	resp, err := c.queryHandler.ListUserTransactions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.TransactionPage{Transactions: make([]*entity.Transaction, len(resp.GetData()))}
+	for i, data := range resp.GetData() {
+		trx := &entity.Transaction{}
+		trx.ID, _ = uuid.Parse(data.GetId())
+		trx.SenderID, _ = uuid.Parse(data.GetSenderId())
+		trx.ReceiverID, _ = uuid.Parse(data.GetReceiverId())
+		trx.SenderWalletID, _ = uuid.Parse(data.GetSenderWalletId())
+		trx.ReceiverWalletID, _ = uuid.Parse(data.GetReceiverWalletId())
+		trx.Amount, _ = decimal.NewFromString(data.GetAmount())
+		trx.Currency = data.GetCurrency()
+		trx.Status = createTransactionStatus(data.GetStatus())
+		trx.FailureReason = data.GetFailureReason()
+		trx.CreatedAt = data.GetCreatedAt().AsTime()
+		if originalID, err := uuid.Parse(data.GetOriginalTransactionId()); err == nil {
+			trx.OriginalTransactionID = &originalID
+		}
+		page.Transactions[i] = trx
+	}
+	if resp.GetNextCursor() != "" {
+		next, err := uuid.Parse(resp.GetNextCursor())
+		if err == nil {
+			page.NextCursor = &next
+		}
+	}
+	return page, nil
+}
+
+func createTransactionStatus(status apiv1.TransactionStatus) entity.TransactionStatus {
+	switch status {
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		return entity.TransactionStatusPending
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_PROCESSING:
+		return entity.TransactionStatusProcessing
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_COMPLETED:
+		return entity.TransactionStatusCompleted
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_FAILED:
+		return entity.TransactionStatusFailed
+	case apiv1.TransactionStatus_TRANSACTION_STATUS_REVERSED:
+		return entity.TransactionStatusReversed
+	default:
+		return ""
+	}
+}
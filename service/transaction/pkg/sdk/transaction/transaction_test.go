@@ -0,0 +1 @@
+package transaction_test
@@ -1,8 +1,22 @@
+CREATE TYPE transaction_status AS ENUM ('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED', 'REVERSED');
+
 CREATE TABLE IF NOT EXISTS transactions (
     id UUID PRIMARY KEY,
     sender_id UUID NOT NULL,
     receiver_id UUID NOT NULL,
-    amount NUMERIC(20, 2) NOT NULL,
+    sender_wallet_id UUID NOT NULL,
+    receiver_wallet_id UUID NOT NULL,
+    amount NUMERIC(24, 4) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    original_transaction_id UUID,
+    quote_id UUID,
+    hold_id UUID,
+    status transaction_status NOT NULL DEFAULT 'COMPLETED',
+    failure_reason TEXT,
+    processing_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    reversed_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP,
@@ -10,3 +24,19 @@ CREATE TABLE IF NOT EXISTS transactions (
     updated_by UUID NOT NULL,
     deleted_by UUID
 );
+
+CREATE INDEX IF NOT EXISTS index_on_transactions_on_sender_id_and_id ON transactions USING btree (
+    sender_id, id
+);
+
+CREATE INDEX IF NOT EXISTS index_on_transactions_on_receiver_id_and_id ON transactions USING btree (
+    receiver_id, id
+);
+
+CREATE INDEX IF NOT EXISTS index_on_transactions_on_original_transaction_id ON transactions USING btree (
+    original_transaction_id
+);
+
+CREATE INDEX IF NOT EXISTS index_on_transactions_on_status_and_updated_at ON transactions USING btree (
+    status, updated_at
+);
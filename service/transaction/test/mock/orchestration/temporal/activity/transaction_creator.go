@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/transaction/internal/orchestration/temporal/activity/transaction_creator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/transaction/internal/orchestration/temporal/activity/transaction_creator.go -destination=./service/transaction/test/mock//orchestration/temporal/activity/transaction_creator.go
+//
+
+// Package mock_activity is a generated GoMock package.
+package mock_activity
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+// MockCreateTransactionWalletConnection is a mock of CreateTransactionWalletConnection interface.
+type MockCreateTransactionWalletConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockCreateTransactionWalletConnectionMockRecorder
+}
+
+// MockCreateTransactionWalletConnectionMockRecorder is the mock recorder for MockCreateTransactionWalletConnection.
+type MockCreateTransactionWalletConnectionMockRecorder struct {
+	mock *MockCreateTransactionWalletConnection
+}
+
+// NewMockCreateTransactionWalletConnection creates a new mock instance.
+func NewMockCreateTransactionWalletConnection(ctrl *gomock.Controller) *MockCreateTransactionWalletConnection {
+	mock := &MockCreateTransactionWalletConnection{ctrl: ctrl}
+	mock.recorder = &MockCreateTransactionWalletConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateTransactionWalletConnection) EXPECT() *MockCreateTransactionWalletConnectionMockRecorder {
+	return m.recorder
+}
+
+// CaptureHold mocks base method.
+func (m *MockCreateTransactionWalletConnection) CaptureHold(ctx context.Context, trx *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, trx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockCreateTransactionWalletConnectionMockRecorder) CaptureHold(ctx, trx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockCreateTransactionWalletConnection)(nil).CaptureHold), ctx, trx)
+}
+
+// ResolveTransfer mocks base method.
+func (m *MockCreateTransactionWalletConnection) ResolveTransfer(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTransfer", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTransfer indicates an expected call of ResolveTransfer.
+func (mr *MockCreateTransactionWalletConnectionMockRecorder) ResolveTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTransfer", reflect.TypeOf((*MockCreateTransactionWalletConnection)(nil).ResolveTransfer), ctx, id)
+}
+
+// TransferBalance mocks base method.
+func (m *MockCreateTransactionWalletConnection) TransferBalance(ctx context.Context, trx *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferBalance", ctx, trx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferBalance indicates an expected call of TransferBalance.
+func (mr *MockCreateTransactionWalletConnectionMockRecorder) TransferBalance(ctx, trx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBalance", reflect.TypeOf((*MockCreateTransactionWalletConnection)(nil).TransferBalance), ctx, trx)
+}
+
+// MockCreateTransactionDatabase is a mock of CreateTransactionDatabase interface.
+type MockCreateTransactionDatabase struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockCreateTransactionDatabaseMockRecorder
+}
+
+// MockCreateTransactionDatabaseMockRecorder is the mock recorder for MockCreateTransactionDatabase.
+type MockCreateTransactionDatabaseMockRecorder struct {
+	mock *MockCreateTransactionDatabase
+}
+
+// NewMockCreateTransactionDatabase creates a new mock instance.
+func NewMockCreateTransactionDatabase(ctrl *gomock.Controller) *MockCreateTransactionDatabase {
+	mock := &MockCreateTransactionDatabase{ctrl: ctrl}
+	mock.recorder = &MockCreateTransactionDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateTransactionDatabase) EXPECT() *MockCreateTransactionDatabaseMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockCreateTransactionDatabase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCreateTransactionDatabaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCreateTransactionDatabase)(nil).GetByID), ctx, id)
+}
+
+// GetByIDForUpdate mocks base method.
+func (m *MockCreateTransactionDatabase) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockCreateTransactionDatabaseMockRecorder) GetByIDForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockCreateTransactionDatabase)(nil).GetByIDForUpdate), ctx, id)
+}
+
+// GetRefundedAmount mocks base method.
+func (m *MockCreateTransactionDatabase) GetRefundedAmount(ctx context.Context, id uuid.UUID, statuses ...entity.TransactionStatus) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRefundedAmount", varargs...)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundedAmount indicates an expected call of GetRefundedAmount.
+func (mr *MockCreateTransactionDatabaseMockRecorder) GetRefundedAmount(ctx, id any, statuses ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundedAmount", reflect.TypeOf((*MockCreateTransactionDatabase)(nil).GetRefundedAmount), varargs...)
+}
+
+// Insert mocks base method.
+func (m *MockCreateTransactionDatabase) Insert(ctx context.Context, trx *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, trx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateTransactionDatabaseMockRecorder) Insert(ctx, trx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateTransactionDatabase)(nil).Insert), ctx, trx)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockCreateTransactionDatabase) UpdateStatus(ctx context.Context, trx *entity.Transaction, from entity.TransactionStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, trx, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockCreateTransactionDatabaseMockRecorder) UpdateStatus(ctx, trx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockCreateTransactionDatabase)(nil).UpdateStatus), ctx, trx, from)
+}
@@ -58,40 +58,41 @@ func (mr *MockCreateTransactionMockRecorder) Create(ctx, transaction any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateTransaction)(nil).Create), ctx, transaction)
 }
 
-// MockCreateTransactionRepository is a mock of CreateTransactionRepository interface.
-type MockCreateTransactionRepository struct {
+// MockCreateTransactionOrchestration is a mock of CreateTransactionOrchestration interface.
+type MockCreateTransactionOrchestration struct {
 	isgomock struct{}
 	ctrl     *gomock.Controller
-	recorder *MockCreateTransactionRepositoryMockRecorder
+	recorder *MockCreateTransactionOrchestrationMockRecorder
 }
 
-// MockCreateTransactionRepositoryMockRecorder is the mock recorder for MockCreateTransactionRepository.
-type MockCreateTransactionRepositoryMockRecorder struct {
-	mock *MockCreateTransactionRepository
+// MockCreateTransactionOrchestrationMockRecorder is the mock recorder for MockCreateTransactionOrchestration.
+type MockCreateTransactionOrchestrationMockRecorder struct {
+	mock *MockCreateTransactionOrchestration
 }
 
-// NewMockCreateTransactionRepository creates a new mock instance.
-func NewMockCreateTransactionRepository(ctrl *gomock.Controller) *MockCreateTransactionRepository {
-	mock := &MockCreateTransactionRepository{ctrl: ctrl}
-	mock.recorder = &MockCreateTransactionRepositoryMockRecorder{mock}
+// NewMockCreateTransactionOrchestration creates a new mock instance.
+func NewMockCreateTransactionOrchestration(ctrl *gomock.Controller) *MockCreateTransactionOrchestration {
+	mock := &MockCreateTransactionOrchestration{ctrl: ctrl}
+	mock.recorder = &MockCreateTransactionOrchestrationMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCreateTransactionRepository) EXPECT() *MockCreateTransactionRepositoryMockRecorder {
+func (m *MockCreateTransactionOrchestration) EXPECT() *MockCreateTransactionOrchestrationMockRecorder {
 	return m.recorder
 }
 
-// Insert mocks base method.
-func (m *MockCreateTransactionRepository) Insert(ctx context.Context, transaction *entity.Transaction) error {
+// CreateTransaction mocks base method.
+func (m *MockCreateTransactionOrchestration) CreateTransaction(ctx context.Context, input *entity.CreateTransactionInput) (*entity.CreateTransactionOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, transaction)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, input)
+	ret0, _ := ret[0].(*entity.CreateTransactionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Insert indicates an expected call of Insert.
-func (mr *MockCreateTransactionRepositoryMockRecorder) Insert(ctx, transaction any) *gomock.Call {
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockCreateTransactionOrchestrationMockRecorder) CreateTransaction(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateTransactionRepository)(nil).Insert), ctx, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockCreateTransactionOrchestration)(nil).CreateTransaction), ctx, input)
 }
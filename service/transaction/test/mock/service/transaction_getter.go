@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/transaction/internal/service/transaction_getter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/transaction/internal/service/transaction_getter.go -destination=./service/transaction/test/mock//service/transaction_getter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+// MockGetTransaction is a mock of GetTransaction interface.
+type MockGetTransaction struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetTransactionMockRecorder
+}
+
+// MockGetTransactionMockRecorder is the mock recorder for MockGetTransaction.
+type MockGetTransactionMockRecorder struct {
+	mock *MockGetTransaction
+}
+
+// NewMockGetTransaction creates a new mock instance.
+func NewMockGetTransaction(ctrl *gomock.Controller) *MockGetTransaction {
+	mock := &MockGetTransaction{ctrl: ctrl}
+	mock.recorder = &MockGetTransactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTransaction) EXPECT() *MockGetTransactionMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method.
+func (m *MockGetTransaction) GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) (*entity.TransactionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx, filter)
+	ret0, _ := ret[0].(*entity.TransactionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockGetTransactionMockRecorder) GetAllByUser(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockGetTransaction)(nil).GetAllByUser), ctx, filter)
+}
+
+// GetStale mocks base method.
+func (m *MockGetTransaction) GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStale", ctx, filter)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStale indicates an expected call of GetStale.
+func (mr *MockGetTransactionMockRecorder) GetStale(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStale", reflect.TypeOf((*MockGetTransaction)(nil).GetStale), ctx, filter)
+}
+
+// MockGetTransactionRepository is a mock of GetTransactionRepository interface.
+type MockGetTransactionRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetTransactionRepositoryMockRecorder
+}
+
+// MockGetTransactionRepositoryMockRecorder is the mock recorder for MockGetTransactionRepository.
+type MockGetTransactionRepositoryMockRecorder struct {
+	mock *MockGetTransactionRepository
+}
+
+// NewMockGetTransactionRepository creates a new mock instance.
+func NewMockGetTransactionRepository(ctrl *gomock.Controller) *MockGetTransactionRepository {
+	mock := &MockGetTransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockGetTransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTransactionRepository) EXPECT() *MockGetTransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method.
+func (m *MockGetTransactionRepository) GetAllByUser(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx, filter)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockGetTransactionRepositoryMockRecorder) GetAllByUser(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockGetTransactionRepository)(nil).GetAllByUser), ctx, filter)
+}
+
+// GetStale mocks base method.
+func (m *MockGetTransactionRepository) GetStale(ctx context.Context, filter *entity.StaleTransactionFilter) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStale", ctx, filter)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStale indicates an expected call of GetStale.
+func (mr *MockGetTransactionRepositoryMockRecorder) GetStale(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStale", reflect.TypeOf((*MockGetTransactionRepository)(nil).GetStale), ctx, filter)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/transaction/internal/service/transaction_refunder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/transaction/internal/service/transaction_refunder.go -destination=./service/transaction/test/mock//service/transaction_refunder.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/transaction/entity"
+)
+
+// MockRefundTransaction is a mock of RefundTransaction interface.
+type MockRefundTransaction struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockRefundTransactionMockRecorder
+}
+
+// MockRefundTransactionMockRecorder is the mock recorder for MockRefundTransaction.
+type MockRefundTransactionMockRecorder struct {
+	mock *MockRefundTransaction
+}
+
+// NewMockRefundTransaction creates a new mock instance.
+func NewMockRefundTransaction(ctrl *gomock.Controller) *MockRefundTransaction {
+	mock := &MockRefundTransaction{ctrl: ctrl}
+	mock.recorder = &MockRefundTransactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundTransaction) EXPECT() *MockRefundTransactionMockRecorder {
+	return m.recorder
+}
+
+// Refund mocks base method.
+func (m *MockRefundTransaction) Refund(ctx context.Context, userID, id uuid.UUID, amount decimal.Decimal) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, userID, id, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockRefundTransactionMockRecorder) Refund(ctx, userID, id, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockRefundTransaction)(nil).Refund), ctx, userID, id, amount)
+}
+
+// MockRefundTransactionRepository is a mock of RefundTransactionRepository interface.
+type MockRefundTransactionRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockRefundTransactionRepositoryMockRecorder
+}
+
+// MockRefundTransactionRepositoryMockRecorder is the mock recorder for MockRefundTransactionRepository.
+type MockRefundTransactionRepositoryMockRecorder struct {
+	mock *MockRefundTransactionRepository
+}
+
+// NewMockRefundTransactionRepository creates a new mock instance.
+func NewMockRefundTransactionRepository(ctrl *gomock.Controller) *MockRefundTransactionRepository {
+	mock := &MockRefundTransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockRefundTransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundTransactionRepository) EXPECT() *MockRefundTransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockRefundTransactionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRefundTransactionRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRefundTransactionRepository)(nil).GetByID), ctx, id)
+}
+
+// GetRefundedAmount mocks base method.
+func (m *MockRefundTransactionRepository) GetRefundedAmount(ctx context.Context, id uuid.UUID, statuses ...entity.TransactionStatus) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRefundedAmount", varargs...)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundedAmount indicates an expected call of GetRefundedAmount.
+func (mr *MockRefundTransactionRepositoryMockRecorder) GetRefundedAmount(ctx, id any, statuses ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundedAmount", reflect.TypeOf((*MockRefundTransactionRepository)(nil).GetRefundedAmount), varargs...)
+}
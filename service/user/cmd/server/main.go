@@ -12,23 +12,29 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/server"
 	sdklog "github.com/indrasaputra/arjuna/pkg/sdk/log"
+	"github.com/indrasaputra/arjuna/pkg/sdk/outbox"
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/builder"
 	"github.com/indrasaputra/arjuna/service/user/internal/config"
 	connauth "github.com/indrasaputra/arjuna/service/user/internal/connection/auth"
+	conntransaction "github.com/indrasaputra/arjuna/service/user/internal/connection/transaction"
 	connwallet "github.com/indrasaputra/arjuna/service/user/internal/connection/wallet"
 	"github.com/indrasaputra/arjuna/service/user/internal/grpc/handler"
 	orcact "github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/activity"
@@ -37,6 +43,10 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/internal/service"
 )
 
+const (
+	defaultDeadLetterListLimit = uint(100)
+)
+
 func main() {
 	command := &cobra.Command{Use: "user", Short: "Start the service."}
 
@@ -55,6 +65,7 @@ func main() {
 		Short: "Run the relayer.",
 		Run:   Relayer,
 	})
+	command.AddCommand(outboxCommand())
 	command.AddCommand(&cobra.Command{
 		Use:   "seed",
 		Short: "Run the seeder.",
@@ -98,18 +109,21 @@ func API(_ *cobra.Command, _ []string) {
 		Config:         cfg,
 		TxManager:      txm,
 		Queries:        queries,
+		Outbox:         outbox.NewStore(pool, uow.NewTxGetter()),
 	}
 
 	c := &server.Config{
 		Name:                      cfg.ServiceName,
 		Port:                      cfg.Port,
-		Secret:                    []byte(cfg.SecretKey),
+		KeySet:                    sdkauth.NewRemoteKeySet(cfg.JWKSURL, sdkauth.DefaultKeySetTTL),
 		Username:                  cfg.Username,
 		Password:                  cfg.Password,
 		AppliedBearerAuthMethods:  strings.Split(cfg.AppliedAuthBearer, ","),
 		AppliedBasicAuthMethods:   strings.Split(cfg.AppliedAuthBasic, ","),
 		AppliedIdempotencyMethods: strings.Split(cfg.AppliedIdempotency, ","),
 		IdempotencyStore:          idempotencyStore,
+		IdempotencyLockTTL:        time.Duration(cfg.IdempotencyLockTTLMillisecond) * time.Millisecond,
+		TokenRevocationStore:      interceptor.NewRevocationCache(redis.NewTokenRevocation(redisClient), interceptor.DefaultRevocationCacheTTL),
 	}
 	srv := server.NewServer(c)
 	registerGrpcService(srv, dep)
@@ -139,6 +153,8 @@ func Worker(_ *cobra.Command, _ []string) {
 	checkError(err)
 	walletClient, err := builder.BuildWalletClient(cfg.WalletServiceHost, cfg.WalletServiceUsername, cfg.WalletServicePassword)
 	checkError(err)
+	transactionClient, err := builder.BuildTransactionClient(cfg.TransactionServiceHost, cfg.TransactionServiceUsername, cfg.TransactionServicePassword)
+	checkError(err)
 	pool, err := sdkpostgres.NewPgxPool(cfg.Postgres)
 	checkError(err)
 	defer pool.Close()
@@ -146,22 +162,81 @@ func Worker(_ *cobra.Command, _ []string) {
 
 	ac := connauth.NewAuth(authClient)
 	wc := connwallet.NewWallet(walletClient)
+	tc := conntransaction.NewTransaction(transactionClient)
 	db := postgres.NewUser(queries)
 
 	act := orcact.NewRegisterUserActivity(ac, wc, db)
+	policy := builder.BuildRegisterUserPolicy(cfg.Temporal)
+	def := orcwork.NewRegisterUserDefinition(policy)
 
-	w := worker.New(temporalClient, orcwork.TaskQueueRegisterUser, worker.Options{
+	w := worker.New(temporalClient, policy.TaskQueue, worker.Options{
 		DisableRegistrationAliasing: true,
 	})
-	w.RegisterWorkflow(orcwork.RegisterUser)
+	w.RegisterWorkflowWithOptions(def.RegisterUser, workflow.RegisterOptions{Name: orcwork.WorkflowTypeRegisterUser})
 	w.RegisterActivityWithOptions(act, activity.RegisterOptions{Name: "RegisterUserActivity", SkipInvalidStructFunctions: true})
 
+	delAct := orcact.NewDeleteUserActivity(ac, wc, db)
+	delPolicy := builder.BuildDeleteUserPolicy(cfg.Temporal)
+	delDef := orcwork.NewDeleteUserDefinition(delPolicy)
+
+	dw := worker.New(temporalClient, delPolicy.TaskQueue, worker.Options{
+		DisableRegistrationAliasing: true,
+	})
+	dw.RegisterWorkflowWithOptions(delDef.DeleteUser, workflow.RegisterOptions{Name: orcwork.WorkflowTypeDeleteUser})
+	dw.RegisterWorkflowWithOptions(delDef.RestoreUser, workflow.RegisterOptions{Name: orcwork.WorkflowTypeRestoreUser})
+	dw.RegisterActivityWithOptions(delAct, activity.RegisterOptions{Name: "DeleteUserActivity", SkipInvalidStructFunctions: true})
+
+	err = dw.Start()
+	if err != nil {
+		log.Panic("Unable to start delete user worker", err)
+	}
+	defer dw.Stop()
+
+	dataAct := orcact.NewUserDataActivity(ac, wc, tc, db, time.Duration(cfg.DataExportTTLMillisecond)*time.Millisecond)
+	dataPolicy := builder.BuildUserDataPolicy(cfg.Temporal)
+	dataDef := orcwork.NewUserDataDefinition(dataPolicy)
+
+	uw := worker.New(temporalClient, dataPolicy.TaskQueue, worker.Options{
+		DisableRegistrationAliasing: true,
+	})
+	uw.RegisterWorkflowWithOptions(dataDef.ExportUserData, workflow.RegisterOptions{Name: orcwork.WorkflowTypeExportUserData})
+	uw.RegisterWorkflowWithOptions(dataDef.EraseUserData, workflow.RegisterOptions{Name: orcwork.WorkflowTypeEraseUserData})
+	uw.RegisterActivityWithOptions(dataAct, activity.RegisterOptions{Name: "UserDataActivity", SkipInvalidStructFunctions: true})
+
+	err = uw.Start()
+	if err != nil {
+		log.Panic("Unable to start user data worker", err)
+	}
+	defer uw.Stop()
+
+	go purgeDataExports(ctx, db, time.Duration(cfg.DataExportPurgeIntervalMillisecond)*time.Millisecond)
+
 	err = w.Run(worker.InterruptCh())
 	if err != nil {
 		log.Panic("Unable to start worker", err)
 	}
 }
 
+func purgeDataExports(ctx context.Context, db *postgres.User, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := db.DeleteExpiredDataExports(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "error during data export purge", "error", err)
+		} else {
+			slog.InfoContext(ctx, "deleted expired data exports", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // Relayer is the entry point for running the Relayer server.
 func Relayer(_ *cobra.Command, _ []string) {
 	ctx := context.Background()
@@ -182,24 +257,94 @@ func Relayer(_ *cobra.Command, _ []string) {
 	pool, err := sdkpostgres.NewPgxPool(cfg.Postgres)
 	checkError(err)
 	defer pool.Close()
-	txm, err := uow.NewTxManager(pool)
+
+	w := orcwork.NewRegisterUserWorkflow(temporalClient, builder.BuildRegisterUserPolicy(cfg.Temporal))
+	svc := service.NewUserRelayRegistrar(w)
+
+	rc := outbox.Config{
+		BatchSize:   cfg.RelayerBatchSize,
+		Concurrency: cfg.RelayerConcurrency,
+		MaxAttempts: cfg.RelayerMaxAttempts,
+		BackoffBase: time.Duration(cfg.RelayerBackoffBaseMillisecond) * time.Millisecond,
+		BackoffMax:  time.Duration(cfg.RelayerBackoffMaxMillisecond) * time.Millisecond,
+		Interval:    time.Duration(cfg.RelayerSleepTimeMillisecond) * time.Millisecond,
+		ClaimLease:  time.Duration(cfg.RelayerClaimLeaseMillisecond) * time.Millisecond,
+	}
+	relayer := outbox.NewRelayer(outbox.NewStore(pool, uow.NewTxGetter()), rc)
+	// the password hash is only needed to create the account, so it isn't kept once the user is relayed
+	relayer.Handle(entity.UserRegisteredTopic, svc, "password_hash")
+
+	slog.InfoContext(ctx, "running user registration relayer", "time", time.Now())
+	if err := relayer.Run(ctx); err != nil {
+		log.Panic("Unable to run relayer", err)
+	}
+}
+
+func outboxCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "outbox", Short: "Manage outbox messages that can't be relayed."}
+
+	list := &cobra.Command{
+		Use:   "dead-letter",
+		Short: "List dead-lettered messages.",
+		Run:   ListDeadLetterOutbox,
+	}
+	list.Flags().Uint("limit", defaultDeadLetterListLimit, "maximum number of messages to list")
+	cmd.AddCommand(list)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "requeue [outbox-id...]",
+		Short: "Requeue dead-lettered messages to be relayed again.",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   RequeueOutbox,
+	})
+	return cmd
+}
+
+// ListDeadLetterOutbox is the entry point for listing dead-lettered outbox messages.
+func ListDeadLetterOutbox(cmd *cobra.Command, _ []string) {
+	ctx := context.Background()
+
+	limit, err := cmd.Flags().GetUint("limit")
 	checkError(err)
-	queries := builder.BuildQueries(pool, uow.NewTxGetter())
+	store, closeFn := buildOutboxStore()
+	defer closeFn()
+
+	messages, err := store.ListDeadLetter(ctx, limit)
+	checkError(err)
+	for _, msg := range messages {
+		log.Printf("outbox %s (%s): attempts %d, dead-lettered at %s, last error: %s\n", msg.ID, msg.Topic, msg.Attempts, msg.UpdatedAt.Format(time.RFC3339), msg.LastError)
+	}
+	log.Printf("%d dead-lettered messages\n", len(messages))
+}
 
-	p := postgres.NewUserOutbox(queries)
-	w := orcwork.NewRegisterUserWorkflow(temporalClient)
+// RequeueOutbox is the entry point for requeueing dead-lettered outbox messages.
+func RequeueOutbox(_ *cobra.Command, args []string) {
+	ctx := context.Background()
 
-	svc := service.NewUserRelayRegistrar(p, w, txm)
+	ids := make([]uuid.UUID, len(args))
+	for i, arg := range args {
+		id, err := uuid.Parse(arg)
+		checkError(err)
+		ids[i] = id
+	}
+	store, closeFn := buildOutboxStore()
+	defer closeFn()
 
-	for {
-		slog.InfoContext(ctx, "running user registration relayer", "time", time.Now())
-		if err := svc.Register(ctx); err != nil {
-			slog.ErrorContext(ctx, "error during relay register", "error", err)
-		}
-		time.Sleep(time.Duration(cfg.RelayerSleepTimeMillisecond) * time.Millisecond)
+	for _, id := range ids {
+		checkError(store.Requeue(ctx, id))
+		log.Printf("outbox %s is requeued\n", id)
 	}
 }
 
+func buildOutboxStore() (*outbox.Store, func()) {
+	cfg, err := config.NewConfig(".env")
+	checkError(err)
+	pool, err := sdkpostgres.NewPgxPool(cfg.Postgres)
+	checkError(err)
+
+	return outbox.NewStore(pool, uow.NewTxGetter()), pool.Close
+}
+
 // Seed is the entry point for running the seeder.
 func Seed(_ *cobra.Command, _ []string) {
 	ctx := context.Background()
@@ -0,0 +1,8 @@
+-- Add value to enum type: "user_outbox_status"
+ALTER TYPE public.user_outbox_status ADD VALUE 'DEAD_LETTER';
+-- Modify "users_outbox" table
+ALTER TABLE public.users_outbox ADD COLUMN attempts integer NOT NULL DEFAULT 0, ADD COLUMN next_attempt_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, ADD COLUMN last_error text NULL;
+-- Drop index "index_on_users_outbox_on_status_and_created_at" from table: "users_outbox"
+DROP INDEX public.index_on_users_outbox_on_status_and_created_at;
+-- Create index "index_on_users_outbox_on_status_and_next_attempt_at" to table: "users_outbox"
+CREATE INDEX index_on_users_outbox_on_status_and_next_attempt_at ON public.users_outbox (status, next_attempt_at);
@@ -0,0 +1,6 @@
+-- Enable "pgcrypto" extension to hash the existing plaintext passwords
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+-- Replace plaintext password with its bcrypt hash in "users_outbox" records that haven't been delivered
+UPDATE public.users_outbox SET payload = (payload - 'password') || jsonb_build_object('password_hash', crypt(payload->>'password', gen_salt('bf', 10))) WHERE status <> 'DELIVERED' AND payload ? 'password';
+-- Redact password from "users_outbox" records that have been delivered
+UPDATE public.users_outbox SET payload = payload - 'password' - 'password_hash' WHERE status = 'DELIVERED';
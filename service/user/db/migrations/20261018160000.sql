@@ -0,0 +1,2 @@
+-- Create index "index_on_users_outbox_on_payload_id" to table: "users_outbox"
+CREATE INDEX index_on_users_outbox_on_payload_id ON public.users_outbox (((payload ->> 'id'::text)));
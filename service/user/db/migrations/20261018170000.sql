@@ -0,0 +1,2 @@
+-- Create "users_data_exports" table
+CREATE TABLE public.users_data_exports (id uuid NOT NULL, user_id uuid NOT NULL, archive jsonb NOT NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY (id));
@@ -0,0 +1,14 @@
+-- Create enum type "outbox_status"
+CREATE TYPE public.outbox_status AS ENUM ('READY', 'PROCESSED', 'DELIVERED', 'FAILED', 'DEAD_LETTER');
+-- Create "outbox" table
+CREATE TABLE public.outbox (id uuid NOT NULL, topic text NOT NULL, payload jsonb NOT NULL, status public.outbox_status NOT NULL DEFAULT 'READY', attempts integer NOT NULL DEFAULT 0, next_attempt_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, last_error text NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY (id));
+-- Create index "index_on_outbox_on_status_and_next_attempt_at" to table: "outbox"
+CREATE INDEX index_on_outbox_on_status_and_next_attempt_at ON public.outbox (status, next_attempt_at);
+-- Create index "index_on_outbox_on_topic_and_payload_id" to table: "outbox"
+CREATE INDEX index_on_outbox_on_topic_and_payload_id ON public.outbox (topic, ((payload ->> 'id'::text)));
+-- Move "users_outbox" records to "outbox" table
+INSERT INTO public.outbox (id, topic, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at) SELECT id, 'user.registered', payload, status::text::public.outbox_status, attempts, next_attempt_at, last_error, created_at, updated_at FROM public.users_outbox;
+-- Drop "users_outbox" table
+DROP TABLE public.users_outbox;
+-- Drop enum type "user_outbox_status"
+DROP TYPE public.user_outbox_status;
@@ -0,0 +1,7 @@
+-- Modify "users_data_exports" table
+-- Existing archives are expired right away, so they are purged. The default is dropped so new archives must set the expiry.
+ALTER TABLE public.users_data_exports ADD COLUMN expires_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- Modify "users_data_exports" table
+ALTER TABLE public.users_data_exports ALTER COLUMN expires_at DROP DEFAULT;
+-- Create index "index_on_users_data_exports_on_expires_at" to table: "users_data_exports"
+CREATE INDEX index_on_users_data_exports_on_expires_at ON public.users_data_exports (expires_at);
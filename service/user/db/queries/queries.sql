@@ -5,25 +5,63 @@ VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetUserByID :one
 SELECT * FROM users
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetUserByIDIncludingDeleted :one
+SELECT * FROM users
 WHERE id = $1 LIMIT 1;
 
+-- name: GetDeletedUserByID :one
+SELECT * FROM users
+WHERE id = $1 AND deleted_at IS NOT NULL LIMIT 1;
+
 -- name: GetAllUsers :many
-SELECT * FROM users LIMIT $1;
+SELECT * FROM users
+WHERE deleted_at IS NULL LIMIT $1;
 
 -- name: HardDeleteUserByID :exec
 DELETE FROM users
 WHERE id = $1;
 
--- name: CreateUserOutbox :exec
+-- name: SoftDeleteUserByID :exec
+UPDATE users SET deleted_at = @deleted_at::TIMESTAMP, deleted_by = id, updated_at = @deleted_at::TIMESTAMP, updated_by = id
+WHERE id = @id AND deleted_at IS NULL;
+
+-- name: RestoreUserByID :exec
+UPDATE users SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW(), updated_by = id
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: AnonymizeUserByID :exec
+WITH anonymized_outboxes AS (
+    UPDATE outbox SET payload = (payload - 'password' - 'password_hash') || jsonb_build_object('name', @name::TEXT, 'email', @email::TEXT), updated_at = NOW()
+    WHERE topic = @topic::TEXT AND payload->>'id' = CAST(@id::UUID AS TEXT)
+), deleted_exports AS (
+    DELETE FROM users_data_exports WHERE user_id = @id::UUID
+)
+UPDATE users SET name = @name::TEXT, deleted_at = COALESCE(deleted_at, NOW()), deleted_by = COALESCE(deleted_by, id), updated_at = NOW(), updated_by = id
+WHERE id = @id::UUID;
+
+-- name: UpdateUserProfile :one
+UPDATE users SET name = @name, updated_at = NOW(), updated_by = id
+WHERE id = @id AND updated_at = @updated_at AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetUserOutboxByUserID :one
+SELECT * FROM outbox
+WHERE topic = @topic::TEXT AND payload->>'id' = @user_id::TEXT
+ORDER BY created_at DESC LIMIT 1;
+
+-- name: CreateUserDataExport :exec
 INSERT INTO
-users_outbox (id, status, payload, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7);
+users_data_exports (id, user_id, archive, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING;
 
--- name: GetAllUserOutboxesForUpdateByStatus :many
-SELECT * FROM users_outbox
-WHERE status = $1
-ORDER BY created_at ASC LIMIT $2;
+-- name: DeleteUserDataExportByID :one
+DELETE FROM users_data_exports
+WHERE id = $1 AND user_id = $2 AND expires_at > NOW()
+RETURNING *;
 
--- name: UpdateUserOutboxID :exec
-UPDATE users_outbox SET status = $1, updated_at = NOW()
-WHERE id = $2;
+-- name: DeleteExpiredUserDataExports :execrows
+DELETE FROM users_data_exports
+WHERE expires_at <= NOW();
@@ -57,7 +57,7 @@ func ErrInvalidName() error {
 	st := status.New(codes.InvalidArgument, "")
 	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
 		Field:       "name",
-		Description: "contain character outside of alphabet",
+		Description: "must only contain alphabet and be at most 100 characters",
 	})
 
 	te := &apiv1.UserError{
@@ -87,6 +87,24 @@ func ErrInvalidEmail() error {
 	return res.Err()
 }
 
+// ErrInvalidPassword returns codes.InvalidArgument explained that the user's password is invalid.
+func ErrInvalidPassword() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "password",
+		Description: "must not be empty and must be at most 72 bytes",
+	})
+
+	te := &apiv1.UserError{
+		ErrorCode: apiv1.UserErrorCode_USER_ERROR_CODE_INVALID_PASSWORD,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
 // ErrNotFound returns codes.NotFound explained that the user is not found.
 func ErrNotFound() error {
 	st := status.New(codes.NotFound, "")
@@ -100,6 +118,75 @@ func ErrNotFound() error {
 	return res.Err()
 }
 
+// ErrRegistrationRolledBack returns codes.Aborted explained that the registration failed
+// and every completed step has been compensated.
+func ErrRegistrationRolledBack(message string) error {
+	st := status.New(codes.Aborted, message)
+	te := &apiv1.UserError{
+		ErrorCode: apiv1.UserErrorCode_USER_ERROR_CODE_REGISTRATION_ROLLED_BACK,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrRegistrationPartiallyFailed returns codes.Internal explained that the registration failed
+// and some completed steps couldn't be compensated.
+func ErrRegistrationPartiallyFailed(message string) error {
+	st := status.New(codes.Internal, message)
+	te := &apiv1.UserError{
+		ErrorCode: apiv1.UserErrorCode_USER_ERROR_CODE_REGISTRATION_PARTIALLY_FAILED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrRestorePeriodExpired returns codes.FailedPrecondition explained that the soft-deleted user
+// can't be restored since the grace period has passed.
+func ErrRestorePeriodExpired() error {
+	st := status.New(codes.FailedPrecondition, "user can't be restored since the grace period has passed")
+	te := &apiv1.UserError{
+		ErrorCode: apiv1.UserErrorCode_USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrUpdateConflict returns codes.Aborted explained that the user has been changed since it was read.
+func ErrUpdateConflict() error {
+	st := status.New(codes.Aborted, "user has been changed since it was read, please read it again")
+	te := &apiv1.UserError{
+		ErrorCode: apiv1.UserErrorCode_USER_ERROR_CODE_UPDATE_CONFLICT,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// HasErrorCode reports whether err carries UserError with the given code.
+func HasErrorCode(err error, code apiv1.UserErrorCode) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if ue, ok := detail.(*apiv1.UserError); ok && ue.GetErrorCode() == code {
+			return true
+		}
+	}
+	return false
+}
+
 func createBadRequest(details ...*errdetails.BadRequest_FieldViolation) *errdetails.BadRequest {
 	return &errdetails.BadRequest{
 		FieldViolations: details,
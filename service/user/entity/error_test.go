@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
@@ -48,6 +49,14 @@ func TestErrInvalidEmail(t *testing.T) {
 	})
 }
 
+func TestErrInvalidPassword(t *testing.T) {
+	t.Run("success get invalid password error", func(t *testing.T) {
+		err := entity.ErrInvalidPassword()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
 func TestErrNotFound(t *testing.T) {
 	t.Run("success get user not found error", func(t *testing.T) {
 		err := entity.ErrNotFound()
@@ -55,3 +64,55 @@ func TestErrNotFound(t *testing.T) {
 		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
 	})
 }
+
+func TestErrRegistrationRolledBack(t *testing.T) {
+	t.Run("success get registration rolled back error", func(t *testing.T) {
+		err := entity.ErrRegistrationRolledBack("wallet unavailable")
+
+		assert.Contains(t, err.Error(), "rpc error: code = Aborted")
+		assert.Contains(t, err.Error(), "wallet unavailable")
+	})
+}
+
+func TestErrRegistrationPartiallyFailed(t *testing.T) {
+	t.Run("success get registration partially failed error", func(t *testing.T) {
+		err := entity.ErrRegistrationPartiallyFailed("auth delete failed")
+
+		assert.Contains(t, err.Error(), "rpc error: code = Internal")
+		assert.Contains(t, err.Error(), "auth delete failed")
+	})
+}
+
+func TestErrRestorePeriodExpired(t *testing.T) {
+	t.Run("success get restore period expired error", func(t *testing.T) {
+		err := entity.ErrRestorePeriodExpired()
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+		assert.True(t, entity.HasErrorCode(err, apiv1.UserErrorCode_USER_ERROR_CODE_RESTORE_PERIOD_EXPIRED))
+	})
+}
+
+func TestErrUpdateConflict(t *testing.T) {
+	t.Run("success get update conflict error", func(t *testing.T) {
+		err := entity.ErrUpdateConflict()
+
+		assert.Contains(t, err.Error(), "rpc error: code = Aborted")
+		assert.True(t, entity.HasErrorCode(err, apiv1.UserErrorCode_USER_ERROR_CODE_UPDATE_CONFLICT))
+	})
+}
+
+func TestHasErrorCode(t *testing.T) {
+	t.Run("error is not a status error", func(t *testing.T) {
+		assert.False(t, entity.HasErrorCode(assert.AnError, apiv1.UserErrorCode_USER_ERROR_CODE_INTERNAL))
+	})
+
+	t.Run("error carries different code", func(t *testing.T) {
+		assert.False(t, entity.HasErrorCode(entity.ErrNotFound(), apiv1.UserErrorCode_USER_ERROR_CODE_INTERNAL))
+	})
+
+	t.Run("error carries the code", func(t *testing.T) {
+		err := entity.ErrRegistrationRolledBack("")
+
+		assert.True(t, entity.HasErrorCode(err, apiv1.UserErrorCode_USER_ERROR_CODE_REGISTRATION_ROLLED_BACK))
+	})
+}
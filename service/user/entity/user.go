@@ -8,33 +8,47 @@ import (
 
 // User defines the logical data of a user.
 type User struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Password is the plaintext password given at registration.
+	// It is never serialized, so it doesn't leak to the outbox payload or workflow history.
+	Password string `json:"-"`
+	// PasswordHash is the bcrypt hash of Password.
+	PasswordHash string `json:"password_hash,omitempty"`
 	Auditable
 	ID uuid.UUID `json:"id"`
 }
 
+// UserRegisteredTopic is the outbox topic of the registered users waiting to be relayed to the orchestrator.
+const UserRegisteredTopic = "user.registered"
+
 // UserOutboxStatus enumerates user outbox status.
 type UserOutboxStatus string
 
 var (
 	// UserOutboxStatusReady means ready to be picked up.
 	UserOutboxStatusReady UserOutboxStatus = "READY"
-	// UserOutboxStatusProcessed means being processed.
+	// UserOutboxStatusProcessed means being processed by a relayer.
+	// The relayer holds it until next attempt time, after that it can be claimed again.
 	UserOutboxStatusProcessed UserOutboxStatus = "PROCESSED"
 	// UserOutboxStatusDelivered means successfully sent to server.
 	UserOutboxStatusDelivered UserOutboxStatus = "DELIVERED"
-	// UserOutboxStatusFailed means failure.
+	// UserOutboxStatusFailed means failure and will be retried after next attempt time.
 	UserOutboxStatusFailed UserOutboxStatus = "FAILED"
+	// UserOutboxStatusDeadLetter means it keeps failing until the maximum attempts and won't be retried.
+	// It needs to be requeued manually.
+	UserOutboxStatusDeadLetter UserOutboxStatus = "DEAD_LETTER"
 )
 
 // UserOutbox defines logical data of user outbox.
 type UserOutbox struct {
-	Payload *User
-	Status  UserOutboxStatus
+	NextAttemptAt time.Time
+	Payload       *User
+	Status        UserOutboxStatus
+	LastError     string
 	Auditable
-	ID uuid.UUID
+	ID       uuid.UUID
+	Attempts int32
 }
 
 // RegisterUserInput holds input data for register user workflow.
@@ -46,6 +60,77 @@ type RegisterUserInput struct {
 type RegisterUserOutput struct {
 }
 
+// RegistrationState enumerates user registration state.
+type RegistrationState string
+
+var (
+	// RegistrationStatePending means the registration is still in progress.
+	RegistrationStatePending RegistrationState = "PENDING"
+	// RegistrationStateCompleted means the registration is completed.
+	RegistrationStateCompleted RegistrationState = "COMPLETED"
+	// RegistrationStateFailed means the registration is failed and won't be continued.
+	RegistrationStateFailed RegistrationState = "FAILED"
+)
+
+// RegistrationStatus defines logical data of user registration status.
+type RegistrationStatus struct {
+	State  RegistrationState `json:"state"`
+	Reason string            `json:"reason"`
+}
+
+// UserDataArchive defines all data owned by a user, collected for export.
+type UserDataArchive struct {
+	ExportedAt   time.Time          `json:"exported_at"`
+	Profile      *UserProfileData   `json:"profile"`
+	Account      *AccountData       `json:"account"`
+	Wallets      []*WalletData      `json:"wallets"`
+	Transactions []*TransactionData `json:"transactions"`
+	ID           uuid.UUID          `json:"id"`
+	UserID       uuid.UUID          `json:"user_id"`
+}
+
+// UserDataExportInput defines the input of the export user data workflow's activity.
+// It only carries ids, so the personal data doesn't go through the workflow history.
+type UserDataExportInput struct {
+	ExportedAt time.Time `json:"exported_at"`
+	ID         uuid.UUID `json:"id"`
+	UserID     uuid.UUID `json:"user_id"`
+}
+
+// UserProfileData defines the profile part of the user data archive.
+type UserProfileData struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Name      string    `json:"name"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// AccountData defines the account part of the user data archive.
+type AccountData struct {
+	CreatedAt time.Time `json:"created_at"`
+	Email     string    `json:"email"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// WalletData defines a wallet in the user data archive.
+type WalletData struct {
+	Balance  string    `json:"balance"`
+	Currency string    `json:"currency"`
+	ID       uuid.UUID `json:"id"`
+}
+
+// TransactionData defines a transaction in the user data archive.
+type TransactionData struct {
+	CreatedAt        time.Time `json:"created_at"`
+	Amount           string    `json:"amount"`
+	Currency         string    `json:"currency"`
+	ID               uuid.UUID `json:"id"`
+	SenderID         uuid.UUID `json:"sender_id"`
+	ReceiverID       uuid.UUID `json:"receiver_id"`
+	SenderWalletID   uuid.UUID `json:"sender_wallet_id"`
+	ReceiverWalletID uuid.UUID `json:"receiver_wallet_id"`
+}
+
 // Auditable defines logical data related to audit.
 type Auditable struct {
 	CreatedAt time.Time
@@ -8,6 +8,7 @@ replace (
 	github.com/indrasaputra/arjuna/pkg/sdk => ../../pkg/sdk
 	github.com/indrasaputra/arjuna/proto => ../../proto
 	github.com/indrasaputra/arjuna/service/auth => ../../service/auth
+	github.com/indrasaputra/arjuna/service/transaction => ../../service/transaction
 	github.com/indrasaputra/arjuna/service/wallet => ../../service/wallet
 )
 
@@ -18,6 +19,7 @@ require (
 	github.com/indrasaputra/arjuna/pkg/sdk v0.0.0-00010101000000-000000000000
 	github.com/indrasaputra/arjuna/proto v0.0.0-00010101000000-000000000000
 	github.com/indrasaputra/arjuna/service/auth v0.0.0-00010101000000-000000000000
+	github.com/indrasaputra/arjuna/service/transaction v0.0.0-00010101000000-000000000000
 	github.com/indrasaputra/arjuna/service/wallet v0.0.0-00010101000000-000000000000
 	github.com/jackc/pgx/v5 v5.7.6
 	github.com/joeshaw/envdecode v0.0.0-20200121155833-099f1fc765bd
@@ -28,8 +30,11 @@ require (
 	github.com/spf13/cobra v1.10.1
 	github.com/stretchr/testify v1.11.1
 	github.com/tidwall/gjson v1.18.0
+	go.temporal.io/api v1.53.0
 	go.temporal.io/sdk v1.37.0
 	go.uber.org/mock v0.6.0
+	golang.org/x/crypto v0.43.0
+	golang.org/x/sync v0.17.0
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
@@ -88,13 +93,10 @@ require (
 	go.opentelemetry.io/otel/sdk v1.38.0 // indirect
 	go.opentelemetry.io/otel/trace v1.38.0 // indirect
 	go.opentelemetry.io/proto/otlp v1.7.1 // indirect
-	go.temporal.io/api v1.53.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.9.0 // indirect
 	go.yaml.in/yaml/v2 v2.4.2 // indirect
-	golang.org/x/crypto v0.43.0 // indirect
 	golang.org/x/net v0.45.0 // indirect
-	golang.org/x/sync v0.17.0 // indirect
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
 	golang.org/x/time v0.3.0 // indirect
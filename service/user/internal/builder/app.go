@@ -1,15 +1,20 @@
 package builder
 
 import (
+	"time"
+
 	"go.temporal.io/sdk/client"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/pkg/sdk/outbox"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
+	sdktransaction "github.com/indrasaputra/arjuna/service/transaction/pkg/sdk/transaction"
 	"github.com/indrasaputra/arjuna/service/user/internal/config"
 	"github.com/indrasaputra/arjuna/service/user/internal/grpc/handler"
+	orcwork "github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/workflow"
 	"github.com/indrasaputra/arjuna/service/user/internal/repository/db"
 	"github.com/indrasaputra/arjuna/service/user/internal/repository/postgres"
 	"github.com/indrasaputra/arjuna/service/user/internal/service"
@@ -22,21 +27,27 @@ type Dependency struct {
 	TemporalClient client.Client
 	TxManager      uow.TxManager
 	Queries        *db.Queries
+	Outbox         *outbox.Store
 }
 
 // BuildUserCommandHandler builds user command handler including all of its dependencies.
 func BuildUserCommandHandler(dep *Dependency) *handler.UserCommand {
 	pu := postgres.NewUser(dep.Queries)
-	puo := postgres.NewUserOutbox(dep.Queries)
 
-	rg := service.NewUserRegistrar(dep.TxManager, pu, puo)
-	return handler.NewUserCommand(rg)
+	rg := service.NewUserRegistrar(dep.TxManager, pu, dep.Outbox)
+	up := service.NewUserUpdater(pu)
+
+	wf := orcwork.NewUserDataWorkflow(dep.TemporalClient, BuildUserDataPolicy(dep.Config.Temporal))
+	er := service.NewUserEraser(pu, wf)
+	return handler.NewUserCommand(rg, up, er)
 }
 
 // BuildUserCommandInternalHandler builds user command handler including all of its dependencies.
 func BuildUserCommandInternalHandler(dep *Dependency) *handler.UserCommandInternal {
 	pg := postgres.NewUser(dep.Queries)
-	d := service.NewUserDeleter(pg)
+	wf := orcwork.NewDeleteUserWorkflow(dep.TemporalClient, BuildDeleteUserPolicy(dep.Config.Temporal))
+	gracePeriod := time.Duration(dep.Config.RestoreGracePeriodHour) * time.Hour
+	d := service.NewUserDeleter(pg, wf, gracePeriod)
 	return handler.NewUserCommandInternal(d)
 }
 
@@ -44,7 +55,57 @@ func BuildUserCommandInternalHandler(dep *Dependency) *handler.UserCommandIntern
 func BuildUserQueryHandler(dep *Dependency) *handler.UserQuery {
 	pg := postgres.NewUser(dep.Queries)
 	g := service.NewUserGetter(pg)
-	return handler.NewUserQuery(g)
+
+	puo := postgres.NewUserOutbox(dep.Queries)
+	wf := orcwork.NewRegisterUserWorkflow(dep.TemporalClient, BuildRegisterUserPolicy(dep.Config.Temporal))
+	sg := service.NewRegistrationStatusGetter(puo, wf)
+
+	dwf := orcwork.NewUserDataWorkflow(dep.TemporalClient, BuildUserDataPolicy(dep.Config.Temporal))
+	ex := service.NewUserDataExporter(pg, dwf)
+	return handler.NewUserQuery(g, sg, ex)
+}
+
+// BuildRegisterUserPolicy builds the policy of the register user workflow from the config.
+func BuildRegisterUserPolicy(cfg config.Temporal) *orcwork.Policy {
+	return buildPolicy(cfg, cfg.TaskQueueRegisterUser)
+}
+
+// BuildDeleteUserPolicy builds the policy of the delete user and restore user workflows from the config.
+func BuildDeleteUserPolicy(cfg config.Temporal) *orcwork.Policy {
+	return buildPolicy(cfg, cfg.TaskQueueDeleteUser)
+}
+
+// BuildUserDataPolicy builds the policy of the export user data and erase user data workflows from the config.
+func BuildUserDataPolicy(cfg config.Temporal) *orcwork.Policy {
+	return buildPolicy(cfg, cfg.TaskQueueUserData)
+}
+
+func buildPolicy(cfg config.Temporal, taskQueue string) *orcwork.Policy {
+	overrides := make(map[string]orcwork.ActivityPolicy, len(cfg.ActivityOverrides))
+	for name, act := range cfg.ActivityOverrides {
+		overrides[name] = orcwork.ActivityPolicy{
+			Timeout:            time.Duration(act.TimeoutMillisecond) * time.Millisecond,
+			InitialInterval:    time.Duration(act.RetryInitialIntervalMillisecond) * time.Millisecond,
+			MaximumInterval:    time.Duration(act.RetryMaximumIntervalMillisecond) * time.Millisecond,
+			BackoffCoefficient: act.RetryBackoffCoefficient,
+			MaximumAttempts:    act.RetryMaximumAttempts,
+		}
+	}
+
+	return &orcwork.Policy{
+		ActivityOverrides: overrides,
+		TaskQueue:         taskQueue,
+		Activity: orcwork.ActivityPolicy{
+			Timeout:            time.Duration(cfg.ActivityTimeoutMillisecond) * time.Millisecond,
+			InitialInterval:    time.Duration(cfg.ActivityRetryInitialIntervalMillisecond) * time.Millisecond,
+			MaximumInterval:    time.Duration(cfg.ActivityRetryMaximumIntervalMillisecond) * time.Millisecond,
+			BackoffCoefficient: cfg.ActivityRetryBackoffCoefficient,
+			MaximumAttempts:    cfg.ActivityRetryMaximumAttempts,
+		},
+		WorkflowTimeout:             time.Duration(cfg.WorkflowTimeoutMillisecond) * time.Millisecond,
+		CompensationMaximumAttempts: cfg.CompensationRetryMaximumAttempts,
+		WorkflowMaximumAttempts:     cfg.WorkflowRetryMaximumAttempts,
+	}
 }
 
 // BuildTemporalClient builds temporal client.
@@ -74,6 +135,17 @@ func BuildWalletClient(host, username, password string) (*sdkwallet.Client, erro
 	return sdkwallet.NewClient(dc)
 }
 
+// BuildTransactionClient builds transaction service client.
+func BuildTransactionClient(host, username, password string) (*sdktransaction.Client, error) {
+	dc := &sdktransaction.Config{
+		Host:     host,
+		Options:  []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		Username: username,
+		Password: password,
+	}
+	return sdktransaction.NewClient(dc)
+}
+
 // BuildQueries builds sqlc queries.
 func BuildQueries(tr uow.Tr, getter uow.TxGetter) *db.Queries {
 	tx := sdkpostgres.NewTxDB(tr, getter)
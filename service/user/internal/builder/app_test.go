@@ -2,6 +2,7 @@ package builder_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/user/internal/builder"
 	"github.com/indrasaputra/arjuna/service/user/internal/config"
+	orcwork "github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/workflow"
 )
 
 func TestBuildUserCommandHandler(t *testing.T) {
@@ -38,7 +40,9 @@ func TestBuildUserCommandInternalHandler(t *testing.T) {
 
 func TestBuildUserQueryHandler(t *testing.T) {
 	t.Run("success create user query handler", func(t *testing.T) {
-		dep := &builder.Dependency{}
+		dep := &builder.Dependency{
+			Config: &config.Config{},
+		}
 
 		handler := builder.BuildUserQueryHandler(dep)
 
@@ -46,6 +50,89 @@ func TestBuildUserQueryHandler(t *testing.T) {
 	})
 }
 
+func TestBuildRegisterUserPolicy(t *testing.T) {
+	t.Run("success build register user policy from config", func(t *testing.T) {
+		cfg := config.Temporal{
+			ActivityOverrides: config.ActivityOverrides{
+				orcwork.ActivityWalletCreate: {TimeoutMillisecond: 5000, RetryMaximumAttempts: 5},
+			},
+			TaskQueueRegisterUser:                   "register-user",
+			ActivityRetryBackoffCoefficient:         2,
+			ActivityTimeoutMillisecond:              2000,
+			ActivityRetryInitialIntervalMillisecond: 1000,
+			ActivityRetryMaximumIntervalMillisecond: 30000,
+			WorkflowTimeoutMillisecond:              60000,
+			ActivityRetryMaximumAttempts:            3,
+			CompensationRetryMaximumAttempts:        4,
+			WorkflowRetryMaximumAttempts:            1,
+		}
+		expected := &orcwork.Policy{
+			ActivityOverrides: map[string]orcwork.ActivityPolicy{
+				orcwork.ActivityWalletCreate: {Timeout: 5 * time.Second, MaximumAttempts: 5},
+			},
+			TaskQueue: "register-user",
+			Activity: orcwork.ActivityPolicy{
+				Timeout:            2 * time.Second,
+				InitialInterval:    time.Second,
+				MaximumInterval:    30 * time.Second,
+				BackoffCoefficient: 2,
+				MaximumAttempts:    3,
+			},
+			WorkflowTimeout:             time.Minute,
+			CompensationMaximumAttempts: 4,
+			WorkflowMaximumAttempts:     1,
+		}
+
+		policy := builder.BuildRegisterUserPolicy(cfg)
+
+		assert.Equal(t, expected, policy)
+	})
+}
+
+func TestBuildDeleteUserPolicy(t *testing.T) {
+	t.Run("success build delete user policy from config", func(t *testing.T) {
+		cfg := config.Temporal{
+			TaskQueueRegisterUser:            "register-user",
+			TaskQueueDeleteUser:              "delete-user",
+			ActivityTimeoutMillisecond:       2000,
+			WorkflowTimeoutMillisecond:       60000,
+			ActivityRetryMaximumAttempts:     3,
+			CompensationRetryMaximumAttempts: 4,
+			WorkflowRetryMaximumAttempts:     1,
+		}
+
+		policy := builder.BuildDeleteUserPolicy(cfg)
+
+		assert.Equal(t, "delete-user", policy.TaskQueue)
+		assert.Equal(t, 2*time.Second, policy.Activity.Timeout)
+		assert.Equal(t, int32(3), policy.Activity.MaximumAttempts)
+		assert.Equal(t, time.Minute, policy.WorkflowTimeout)
+		assert.Equal(t, int32(4), policy.CompensationMaximumAttempts)
+		assert.Equal(t, int32(1), policy.WorkflowMaximumAttempts)
+	})
+}
+
+func TestBuildUserDataPolicy(t *testing.T) {
+	t.Run("success build user data policy from config", func(t *testing.T) {
+		cfg := config.Temporal{
+			TaskQueueDeleteUser:          "delete-user",
+			TaskQueueUserData:            "user-data",
+			ActivityTimeoutMillisecond:   2000,
+			WorkflowTimeoutMillisecond:   60000,
+			ActivityRetryMaximumAttempts: 3,
+			WorkflowRetryMaximumAttempts: 1,
+		}
+
+		policy := builder.BuildUserDataPolicy(cfg)
+
+		assert.Equal(t, "user-data", policy.TaskQueue)
+		assert.Equal(t, 2*time.Second, policy.Activity.Timeout)
+		assert.Equal(t, int32(3), policy.Activity.MaximumAttempts)
+		assert.Equal(t, time.Minute, policy.WorkflowTimeout)
+		assert.Equal(t, int32(1), policy.WorkflowMaximumAttempts)
+	})
+}
+
 func TestBuildTemporalClient(t *testing.T) {
 	t.Run("fail build a temporal client", func(t *testing.T) {
 		client, err := builder.BuildTemporalClient("localhost:7233")
@@ -73,6 +160,15 @@ func TestBuildWalletClient(t *testing.T) {
 	})
 }
 
+func TestBuildTransactionClient(t *testing.T) {
+	t.Run("success build a transaction client", func(t *testing.T) {
+		client, err := builder.BuildTransactionClient("localhost:8003", "user", "pass")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+}
+
 func TestBuildQueries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
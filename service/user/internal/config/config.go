@@ -1,6 +1,9 @@
 package config
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/joeshaw/envdecode"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
@@ -12,32 +15,129 @@ import (
 
 // Config holds configuration for the project.
 type Config struct {
-	Tracer                      trace.Config
-	Temporal                    Temporal
-	AppliedAuthBasic            string `env:"APPLIED_AUTH_BASIC"`
-	WalletServiceHost           string `env:"WALLET_SERVICE_HOST,required"`
-	ServiceName                 string `env:"SERVICE_NAME,default=user-server"`
-	AppEnv                      string `env:"APP_ENV,default=development"`
-	Port                        string `env:"PORT,default=8001"`
-	PrometheusPort              string `env:"PROMETHEUS_PORT,default=7001"`
-	AuthServiceHost             string `env:"AUTH_SERVICE_HOST,required"`
-	Username                    string `env:"USERNAME,default=user-user"`
-	WalletServiceUsername       string `env:"WALLET_SERVICE_USERNAME"`
-	Password                    string `env:"PASSWORD,default=user-password"`
-	AppliedAuthBearer           string `env:"APPLIED_AUTH_BEARER"`
-	SecretKey                   string `env:"TOKEN_SECRET_KEY,required"`
-	WalletServicePassword       string `env:"WALLET_SERVICE_PASSWORD"`
-	AuthServiceUsername         string `env:"AUTH_SERVICE_USERNAME"`
-	AuthServicePassword         string `env:"AUTH_SERVICE_PASSWORD"`
-	AppliedIdempotency          string `env:"APPLIED_IDEMPOTENCY"`
-	Redis                       sdkrds.Config
-	Postgres                    sdkpg.Config
-	RelayerSleepTimeMillisecond int `env:"RELAYER_SLEEP_TIME_MILLISECONDS,default=1000"`
+	Tracer                             trace.Config
+	AppliedAuthBasic                   string `env:"APPLIED_AUTH_BASIC"`
+	WalletServiceHost                  string `env:"WALLET_SERVICE_HOST,required"`
+	ServiceName                        string `env:"SERVICE_NAME,default=user-server"`
+	AppEnv                             string `env:"APP_ENV,default=development"`
+	Port                               string `env:"PORT,default=8001"`
+	PrometheusPort                     string `env:"PROMETHEUS_PORT,default=7001"`
+	AuthServiceHost                    string `env:"AUTH_SERVICE_HOST,required"`
+	Username                           string `env:"USERNAME,default=user-user"`
+	WalletServiceUsername              string `env:"WALLET_SERVICE_USERNAME"`
+	Password                           string `env:"PASSWORD,default=user-password"`
+	AppliedAuthBearer                  string `env:"APPLIED_AUTH_BEARER"`
+	JWKSURL                            string `env:"TOKEN_JWKS_URL,required"`
+	WalletServicePassword              string `env:"WALLET_SERVICE_PASSWORD"`
+	AuthServiceUsername                string `env:"AUTH_SERVICE_USERNAME"`
+	AuthServicePassword                string `env:"AUTH_SERVICE_PASSWORD"`
+	TransactionServiceHost             string `env:"TRANSACTION_SERVICE_HOST,required"`
+	TransactionServiceUsername         string `env:"TRANSACTION_SERVICE_USERNAME"`
+	TransactionServicePassword         string `env:"TRANSACTION_SERVICE_PASSWORD"`
+	AppliedIdempotency                 string `env:"APPLIED_IDEMPOTENCY"`
+	Redis                              sdkrds.Config
+	Postgres                           sdkpg.Config
+	Temporal                           Temporal
+	RelayerSleepTimeMillisecond        int   `env:"RELAYER_SLEEP_TIME_MILLISECONDS,default=1000"`
+	RelayerBatchSize                   uint  `env:"RELAYER_BATCH_SIZE,default=10"`
+	RelayerConcurrency                 int   `env:"RELAYER_CONCURRENCY,default=5"`
+	RelayerMaxAttempts                 int32 `env:"RELAYER_MAX_ATTEMPTS,default=10"`
+	RelayerBackoffBaseMillisecond      int   `env:"RELAYER_BACKOFF_BASE_MILLISECONDS,default=1000"`
+	RelayerBackoffMaxMillisecond       int   `env:"RELAYER_BACKOFF_MAX_MILLISECONDS,default=300000"`
+	RelayerClaimLeaseMillisecond       int   `env:"RELAYER_CLAIM_LEASE_MILLISECONDS,default=300000"`
+	RestoreGracePeriodHour             int   `env:"RESTORE_GRACE_PERIOD_HOURS,default=720"`
+	IdempotencyLockTTLMillisecond      int   `env:"IDEMPOTENCY_LOCK_TTL_MILLISECONDS,default=300000"`
+	DataExportTTLMillisecond           int   `env:"DATA_EXPORT_TTL_MILLISECONDS,default=3600000"`
+	DataExportPurgeIntervalMillisecond int   `env:"DATA_EXPORT_PURGE_INTERVAL_MILLISECONDS,default=600000"`
 }
 
 // Temporal holds configuration for Temporal.
 type Temporal struct {
-	Address string `env:"TEMPORAL_ADDRESS,default=localhost:7233"`
+	ActivityOverrides                       ActivityOverrides `env:"TEMPORAL_ACTIVITY_OVERRIDES"`
+	Address                                 string            `env:"TEMPORAL_ADDRESS,default=localhost:7233"`
+	TaskQueueRegisterUser                   string            `env:"TEMPORAL_TASK_QUEUE_REGISTER_USER,default=register-user"`
+	TaskQueueDeleteUser                     string            `env:"TEMPORAL_TASK_QUEUE_DELETE_USER,default=delete-user"`
+	TaskQueueUserData                       string            `env:"TEMPORAL_TASK_QUEUE_USER_DATA,default=user-data"`
+	ActivityRetryBackoffCoefficient         float64           `env:"TEMPORAL_ACTIVITY_RETRY_BACKOFF_COEFFICIENT,default=2"`
+	ActivityTimeoutMillisecond              int               `env:"TEMPORAL_ACTIVITY_TIMEOUT_MILLISECONDS,default=2000"`
+	ActivityRetryInitialIntervalMillisecond int               `env:"TEMPORAL_ACTIVITY_RETRY_INITIAL_INTERVAL_MILLISECONDS,default=1000"`
+	ActivityRetryMaximumIntervalMillisecond int               `env:"TEMPORAL_ACTIVITY_RETRY_MAXIMUM_INTERVAL_MILLISECONDS"`
+	WorkflowTimeoutMillisecond              int               `env:"TEMPORAL_WORKFLOW_TIMEOUT_MILLISECONDS,default=60000"`
+	ActivityRetryMaximumAttempts            int32             `env:"TEMPORAL_ACTIVITY_RETRY_MAXIMUM_ATTEMPTS,default=3"`
+	CompensationRetryMaximumAttempts        int32             `env:"TEMPORAL_COMPENSATION_RETRY_MAXIMUM_ATTEMPTS,default=3"`
+	WorkflowRetryMaximumAttempts            int32             `env:"TEMPORAL_WORKFLOW_RETRY_MAXIMUM_ATTEMPTS,default=1"`
+}
+
+// Activity holds the timeout and retry configuration of an activity.
+// Zero fields mean the activity uses the default configuration.
+type Activity struct {
+	TimeoutMillisecond              int
+	RetryInitialIntervalMillisecond int
+	RetryMaximumIntervalMillisecond int
+	RetryBackoffCoefficient         float64
+	RetryMaximumAttempts            int32
+}
+
+// ActivityOverrides holds the configuration of activities keyed by the activity name.
+// It is decoded from the format "<activity>:<key>=<value>,<key>=<value>;<activity>:<key>=<value>".
+// The keys are timeout_milliseconds, retry_initial_interval_milliseconds, retry_maximum_interval_milliseconds,
+// retry_backoff_coefficient and retry_maximum_attempts.
+type ActivityOverrides map[string]Activity
+
+// Decode decodes the activity overrides from env value.
+func (o *ActivityOverrides) Decode(value string) error {
+	overrides := ActivityOverrides{}
+	for _, part := range strings.Split(value, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, settings, ok := strings.Cut(part, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return errors.Errorf("[ActivityOverrides-Decode] invalid activity override %q", part)
+		}
+		act, err := decodeActivity(settings)
+		if err != nil {
+			return errors.Wrapf(err, "[ActivityOverrides-Decode] invalid activity override for %s", name)
+		}
+		overrides[name] = act
+	}
+	*o = overrides
+	return nil
+}
+
+func decodeActivity(settings string) (Activity, error) {
+	var act Activity
+	for _, setting := range strings.Split(settings, ",") {
+		key, value, ok := strings.Cut(setting, "=")
+		if !ok {
+			return act, errors.Errorf("invalid setting %q", setting)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		var err error
+		switch key {
+		case "timeout_milliseconds":
+			act.TimeoutMillisecond, err = strconv.Atoi(value)
+		case "retry_initial_interval_milliseconds":
+			act.RetryInitialIntervalMillisecond, err = strconv.Atoi(value)
+		case "retry_maximum_interval_milliseconds":
+			act.RetryMaximumIntervalMillisecond, err = strconv.Atoi(value)
+		case "retry_backoff_coefficient":
+			act.RetryBackoffCoefficient, err = strconv.ParseFloat(value, 64)
+		case "retry_maximum_attempts":
+			var n int64
+			n, err = strconv.ParseInt(value, 10, 32)
+			act.RetryMaximumAttempts = int32(n)
+		default:
+			return act, errors.Errorf("unknown setting %q", key)
+		}
+		if err != nil {
+			return act, errors.Wrapf(err, "invalid value of %s", key)
+		}
+	}
+	return act, nil
 }
 
 // NewConfig creates an instance of Config.
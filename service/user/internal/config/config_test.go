@@ -27,3 +27,42 @@ func TestNewConfig(t *testing.T) {
 		assert.NotNil(t, cfg)
 	})
 }
+
+func TestActivityOverrides_Decode(t *testing.T) {
+	t.Run("invalid format is rejected", func(t *testing.T) {
+		values := []string{
+			"RegisterUserActivityCreateWallet",
+			":retry_maximum_attempts=5",
+			"RegisterUserActivityCreateWallet:retry_maximum_attempts",
+			"RegisterUserActivityCreateWallet:unknown=5",
+			"RegisterUserActivityCreateWallet:timeout_milliseconds=abc",
+			"RegisterUserActivityCreateWallet:retry_backoff_coefficient=abc",
+			"RegisterUserActivityCreateWallet:retry_maximum_attempts=99999999999",
+		}
+
+		for _, value := range values {
+			var overrides config.ActivityOverrides
+			err := overrides.Decode(value)
+			assert.Error(t, err, value)
+		}
+	})
+
+	t.Run("successfully decode activity overrides", func(t *testing.T) {
+		value := "RegisterUserActivityCreateWallet:timeout_milliseconds=5000, retry_maximum_attempts=5;" +
+			" RegisterUserActivityDeleteAccount:retry_initial_interval_milliseconds=500,retry_maximum_interval_milliseconds=10000,retry_backoff_coefficient=1.5;"
+		expected := config.ActivityOverrides{
+			"RegisterUserActivityCreateWallet": {TimeoutMillisecond: 5000, RetryMaximumAttempts: 5},
+			"RegisterUserActivityDeleteAccount": {
+				RetryInitialIntervalMillisecond: 500,
+				RetryMaximumIntervalMillisecond: 10000,
+				RetryBackoffCoefficient:         1.5,
+			},
+		}
+
+		var overrides config.ActivityOverrides
+		err := overrides.Decode(value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, overrides)
+	})
+}
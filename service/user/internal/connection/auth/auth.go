@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 
 	enauth "github.com/indrasaputra/arjuna/service/auth/entity"
@@ -24,7 +25,7 @@ func NewAuth(c *sdkauth.Client) *Auth {
 
 // CreateAccount creates an account.
 func (a *Auth) CreateAccount(ctx context.Context, user *entity.User) error {
-	req := &enauth.Account{UserID: user.ID, Email: user.Email, Password: user.Password}
+	req := &enauth.Account{UserID: user.ID, Email: user.Email, PasswordHash: user.PasswordHash}
 	err := a.client.Register(ctx, req)
 	if err != nil {
 		slog.ErrorContext(ctx, "[Auth-CreateAccount] fail call register", "error", err)
@@ -34,3 +35,49 @@ func (a *Auth) CreateAccount(ctx context.Context, user *entity.User) error {
 	}
 	return err
 }
+
+// DeleteAccount deletes the account owned by the user.
+func (a *Auth) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.DeleteAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-DeleteAccount] fail call delete account", "error", err)
+	}
+	return err
+}
+
+// SoftDeleteAccount soft-deletes the account owned by the user, so the user can't login.
+func (a *Auth) SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.SoftDeleteAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-SoftDeleteAccount] fail call soft delete account", "error", err)
+	}
+	return err
+}
+
+// RestoreAccount restores the soft-deleted account owned by the user.
+func (a *Auth) RestoreAccount(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.RestoreAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-RestoreAccount] fail call restore account", "error", err)
+	}
+	return err
+}
+
+// GetAccount gets the account owned by the user.
+func (a *Auth) GetAccount(ctx context.Context, userID uuid.UUID) (*entity.AccountData, error) {
+	account, err := a.client.GetAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-GetAccount] fail call get account", "error", err)
+		return nil, err
+	}
+	return &entity.AccountData{ID: account.ID, Email: account.Email, CreatedAt: account.CreatedAt}, nil
+}
+
+// EraseAccount anonymizes the account owned by the user.
+func (a *Auth) EraseAccount(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.EraseAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Auth-EraseAccount] fail call erase account", "error", err)
+	}
+	return err
+}
@@ -0,0 +1,2 @@
+// Package transaction provides real connection to transaction service.
+package transaction
@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	sdktransaction "github.com/indrasaputra/arjuna/service/transaction/pkg/sdk/transaction"
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+const pageSize = 100
+
+// Transaction is responsible to connect to transaction service.
+type Transaction struct {
+	client *sdktransaction.Client
+}
+
+// NewTransaction creates an instance of Transaction.
+func NewTransaction(c *sdktransaction.Client) *Transaction {
+	return &Transaction{client: c}
+}
+
+// ListTransactions lists all transactions sent or received by the user.
+// It keeps fetching the next page until there is no more transaction.
+func (t *Transaction) ListTransactions(ctx context.Context, userID uuid.UUID) ([]*entity.TransactionData, error) {
+	var (
+		res    []*entity.TransactionData
+		cursor *uuid.UUID
+	)
+	for {
+		page, err := t.client.ListUserTransactions(ctx, userID, cursor, pageSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Transaction-ListTransactions] fail call list user transactions", "error", err)
+			return nil, err
+		}
+		for _, trx := range page.Transactions {
+			res = append(res, &entity.TransactionData{
+				ID:               trx.ID,
+				SenderID:         trx.SenderID,
+				ReceiverID:       trx.ReceiverID,
+				SenderWalletID:   trx.SenderWalletID,
+				ReceiverWalletID: trx.ReceiverWalletID,
+				Amount:           trx.Amount.String(),
+				Currency:         trx.Currency,
+				CreatedAt:        trx.CreatedAt,
+			})
+		}
+		if page.NextCursor == nil {
+			return res, nil
+		}
+		cursor = page.NextCursor
+	}
+}
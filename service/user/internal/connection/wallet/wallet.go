@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 
@@ -35,3 +36,36 @@ func (a *Wallet) CreateWallet(ctx context.Context, user *entity.User) error {
 	}
 	return err
 }
+
+// FreezeWallets freezes all wallets owned by the user.
+func (a *Wallet) FreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.FreezeUserWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-FreezeWallets] fail call freeze user wallets", "error", err)
+	}
+	return err
+}
+
+// UnfreezeWallets unfreezes all wallets owned by the user.
+func (a *Wallet) UnfreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	err := a.client.UnfreezeUserWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-UnfreezeWallets] fail call unfreeze user wallets", "error", err)
+	}
+	return err
+}
+
+// ListWallets lists all wallets owned by the user.
+func (a *Wallet) ListWallets(ctx context.Context, userID uuid.UUID) ([]*entity.WalletData, error) {
+	wallets, err := a.client.ListUserWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Wallet-ListWallets] fail call list user wallets", "error", err)
+		return nil, err
+	}
+
+	res := make([]*entity.WalletData, len(wallets))
+	for i, w := range wallets {
+		res[i] = &entity.WalletData{ID: w.ID, Balance: w.Balance.String(), Currency: w.Currency}
+	}
+	return res, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/service"
@@ -13,11 +16,13 @@ import (
 type UserCommand struct {
 	apiv1.UnimplementedUserCommandServiceServer
 	registrar service.RegisterUser
+	updater   service.UpdateUser
+	eraser    service.EraseUser
 }
 
 // NewUserCommand creates an instance of UserCommand.
-func NewUserCommand(registrar service.RegisterUser) *UserCommand {
-	return &UserCommand{registrar: registrar}
+func NewUserCommand(registrar service.RegisterUser, updater service.UpdateUser, eraser service.EraseUser) *UserCommand {
+	return &UserCommand{registrar: registrar, updater: updater, eraser: eraser}
 }
 
 // RegisterUser handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
@@ -35,6 +40,34 @@ func (uc *UserCommand) RegisterUser(ctx context.Context, request *apiv1.Register
 	return &apiv1.RegisterUserResponse{Data: &apiv1.User{Id: id.String()}}, nil
 }
 
+// UpdateProfile handles HTTP/2 gRPC request similar to PATCH in HTTP/1.1.
+// The user is taken from the bearer token, so users can only update their own profile.
+func (uc *UserCommand) UpdateProfile(ctx context.Context, request *apiv1.UpdateProfileRequest) (*apiv1.UpdateProfileResponse, error) {
+	if request == nil || request.GetUpdatedAt() == nil {
+		slog.ErrorContext(ctx, "[UserCommand-UpdateProfile] empty or nil user")
+		return nil, entity.ErrEmptyUser()
+	}
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	user, err := uc.updater.UpdateProfile(ctx, createUserFromUpdateProfileRequest(request, userID))
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-UpdateProfile] fail update profile", "error", err)
+		return nil, err
+	}
+	return &apiv1.UpdateProfileResponse{Data: createProtoUser(user)}, nil
+}
+
+// EraseMyData handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (uc *UserCommand) EraseMyData(ctx context.Context, _ *apiv1.EraseMyDataRequest) (*apiv1.EraseMyDataResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	if err := uc.eraser.Erase(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-EraseMyData] fail erase user data", "error", err)
+		return nil, err
+	}
+	return &apiv1.EraseMyDataResponse{}, nil
+}
+
 func createUserFromRegisterUserRequest(request *apiv1.RegisterUserRequest) *entity.User {
 	return &entity.User{
 		Name:     request.GetUser().GetName(),
@@ -42,3 +75,11 @@ func createUserFromRegisterUserRequest(request *apiv1.RegisterUserRequest) *enti
 		Password: request.GetUser().GetPassword(),
 	}
 }
+
+func createUserFromUpdateProfileRequest(request *apiv1.UpdateProfileRequest, userID uuid.UUID) *entity.User {
+	return &entity.User{
+		ID:        userID,
+		Name:      request.GetName(),
+		Auditable: entity.Auditable{UpdatedAt: request.GetUpdatedAt().AsTime()},
+	}
+}
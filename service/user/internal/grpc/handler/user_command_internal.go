@@ -36,3 +36,35 @@ func (uci *UserCommandInternal) DeleteUser(ctx context.Context, request *apiv1.D
 	}
 	return &apiv1.DeleteUserResponse{}, nil
 }
+
+// SoftDeleteUser handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (uci *UserCommandInternal) SoftDeleteUser(ctx context.Context, request *apiv1.SoftDeleteUserRequest) (*apiv1.SoftDeleteUserResponse, error) {
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-SoftDeleteUser] empty or invalid user", "error", err)
+		return nil, entity.ErrEmptyUser()
+	}
+
+	err = uci.deleter.SoftDelete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-SoftDeleteUser] fail soft delete user", "error", err)
+		return nil, err
+	}
+	return &apiv1.SoftDeleteUserResponse{}, nil
+}
+
+// RestoreUser handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (uci *UserCommandInternal) RestoreUser(ctx context.Context, request *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error) {
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-RestoreUser] empty or invalid user", "error", err)
+		return nil, entity.ErrEmptyUser()
+	}
+
+	err = uci.deleter.Restore(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserCommand-RestoreUser] fail restore user", "error", err)
+		return nil, err
+	}
+	return &apiv1.RestoreUserResponse{}, nil
+}
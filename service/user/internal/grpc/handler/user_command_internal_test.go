@@ -88,6 +88,100 @@ func TestUserCommandInternal_DeleteUser(t *testing.T) {
 	})
 }
 
+func TestUserCommandInternal_SoftDeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+
+		res, err := st.handler.SoftDeleteUser(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid id is prohibited", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+
+		res, err := st.handler.SoftDeleteUser(testCtx, &apiv1.SoftDeleteUserRequest{Id: "invalid"})
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user deleter service returns error", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		request := &apiv1.SoftDeleteUserRequest{Id: id.String()}
+		st.deleter.EXPECT().SoftDelete(testCtx, id).Return(entity.ErrNotFound())
+
+		res, err := st.handler.SoftDeleteUser(testCtx, request)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success soft delete user", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		request := &apiv1.SoftDeleteUserRequest{Id: id.String()}
+		st.deleter.EXPECT().SoftDelete(testCtx, id).Return(nil)
+
+		res, err := st.handler.SoftDeleteUser(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestUserCommandInternal_RestoreUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+
+		res, err := st.handler.RestoreUser(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid id is prohibited", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+
+		res, err := st.handler.RestoreUser(testCtx, &apiv1.RestoreUserRequest{Id: "invalid"})
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user deleter service returns error", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		request := &apiv1.RestoreUserRequest{Id: id.String()}
+		st.deleter.EXPECT().Restore(testCtx, id).Return(entity.ErrNotFound())
+
+		res, err := st.handler.RestoreUser(testCtx, request)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success restore user", func(t *testing.T) {
+		st := createUserCommandInternalSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		request := &apiv1.RestoreUserRequest{Id: id.String()}
+		st.deleter.EXPECT().Restore(testCtx, id).Return(nil)
+
+		res, err := st.handler.RestoreUser(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
 func createUserCommandInternalSuite(ctrl *gomock.Controller) *UserCommandInternalSuite {
 	d := mock_service.NewMockDeleteUser(ctrl)
 	h := handler.NewUserCommandInternal(d)
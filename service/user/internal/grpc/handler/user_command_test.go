@@ -1,21 +1,32 @@
 package handler_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/grpc/handler"
 	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
 )
 
+var (
+	testUserID      = uuid.Must(uuid.NewV7())
+	testCtxWithAuth = context.WithValue(testCtx, interceptor.HeaderKeyUserID, testUserID)
+)
+
 type UserCommandSuite struct {
 	handler   *handler.UserCommand
 	registrar *mock_service.MockRegisterUser
+	updater   *mock_service.MockUpdateUser
+	eraser    *mock_service.MockEraseUser
 }
 
 func TestNewUserCommand(t *testing.T) {
@@ -100,11 +111,86 @@ func TestUserCommand_RegisterUser(t *testing.T) {
 	})
 }
 
+func TestUserCommand_UpdateProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request or empty updated at is prohibited", func(t *testing.T) {
+		requests := []*apiv1.UpdateProfileRequest{nil, {Name: "Second User"}}
+
+		for _, request := range requests {
+			st := createUserCommandSuite(ctrl)
+
+			res, err := st.handler.UpdateProfile(testCtxWithAuth, request)
+
+			assert.Equal(t, entity.ErrEmptyUser(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		st := createUserCommandSuite(ctrl)
+		updatedAt := time.Now().UTC()
+		expected := &entity.User{ID: testUserID, Name: "Second User", Auditable: entity.Auditable{UpdatedAt: updatedAt}}
+		st.updater.EXPECT().UpdateProfile(testCtxWithAuth, expected).Return(nil, entity.ErrUpdateConflict())
+
+		res, err := st.handler.UpdateProfile(testCtxWithAuth, &apiv1.UpdateProfileRequest{Name: "Second User", UpdatedAt: timestamppb.New(updatedAt)})
+
+		assert.Equal(t, entity.ErrUpdateConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update profile of the authenticated user", func(t *testing.T) {
+		st := createUserCommandSuite(ctrl)
+		updatedAt := time.Now().UTC()
+		expected := &entity.User{ID: testUserID, Name: "Second User", Auditable: entity.Auditable{UpdatedAt: updatedAt}}
+		updated := &entity.User{ID: testUserID, Name: "Second User", Auditable: entity.Auditable{UpdatedAt: updatedAt.Add(time.Second)}}
+		st.updater.EXPECT().UpdateProfile(testCtxWithAuth, expected).Return(updated, nil)
+
+		res, err := st.handler.UpdateProfile(testCtxWithAuth, &apiv1.UpdateProfileRequest{Name: "Second User", UpdatedAt: timestamppb.New(updatedAt)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID.String(), res.GetData().GetId())
+		assert.Equal(t, "Second User", res.GetData().GetName())
+		assert.Equal(t, updated.UpdatedAt, res.GetData().GetUpdatedAt().AsTime())
+	})
+}
+
+func TestUserCommand_EraseMyData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("eraser service returns error", func(t *testing.T) {
+		st := createUserCommandSuite(ctrl)
+		errReturn := entity.ErrInternal("")
+		st.eraser.EXPECT().Erase(testCtxWithAuth, testUserID).Return(errReturn)
+
+		res, err := st.handler.EraseMyData(testCtxWithAuth, &apiv1.EraseMyDataRequest{})
+
+		assert.Equal(t, errReturn, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success erase my data", func(t *testing.T) {
+		st := createUserCommandSuite(ctrl)
+		st.eraser.EXPECT().Erase(testCtxWithAuth, testUserID).Return(nil)
+
+		res, err := st.handler.EraseMyData(testCtxWithAuth, &apiv1.EraseMyDataRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
 func createUserCommandSuite(ctrl *gomock.Controller) *UserCommandSuite {
 	r := mock_service.NewMockRegisterUser(ctrl)
-	h := handler.NewUserCommand(r)
+	u := mock_service.NewMockUpdateUser(ctrl)
+	e := mock_service.NewMockEraseUser(ctrl)
+	h := handler.NewUserCommand(r, u, e)
 	return &UserCommandSuite{
 		handler:   h,
 		registrar: r,
+		updater:   u,
+		eraser:    e,
 	}
 }
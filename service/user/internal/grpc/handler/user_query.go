@@ -2,10 +2,14 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/service"
@@ -14,12 +18,14 @@ import (
 // UserQuery handles HTTP/2 gRPC request for retrieving user.
 type UserQuery struct {
 	apiv1.UnimplementedUserQueryServiceServer
-	getter service.GetUser
+	getter       service.GetUser
+	statusGetter service.GetRegistrationStatus
+	exporter     service.ExportUserData
 }
 
 // NewUserQuery creates an instance of UserQuery.
-func NewUserQuery(getter service.GetUser) *UserQuery {
-	return &UserQuery{getter: getter}
+func NewUserQuery(getter service.GetUser, statusGetter service.GetRegistrationStatus, exporter service.ExportUserData) *UserQuery {
+	return &UserQuery{getter: getter, statusGetter: statusGetter, exporter: exporter}
 }
 
 // GetAllUsers handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
@@ -36,6 +42,71 @@ func (uc *UserQuery) GetAllUsers(ctx context.Context, request *apiv1.GetAllUsers
 	return createGetAllUsersResponse(users), nil
 }
 
+// GetUserByID handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (uc *UserQuery) GetUserByID(ctx context.Context, request *apiv1.GetUserByIDRequest) (*apiv1.GetUserByIDResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyUser()
+	}
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		return nil, entity.ErrEmptyUser()
+	}
+
+	user, err := uc.getter.GetByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserQuery-GetUserByID] fail get user", "error", err)
+		return nil, err
+	}
+	return &apiv1.GetUserByIDResponse{Data: createProtoUser(user)}, nil
+}
+
+// GetMe handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (uc *UserQuery) GetMe(ctx context.Context, _ *apiv1.GetMeRequest) (*apiv1.GetMeResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	user, err := uc.getter.GetByID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserQuery-GetMe] fail get user", "error", err)
+		return nil, err
+	}
+	return &apiv1.GetMeResponse{Data: createProtoUser(user)}, nil
+}
+
+// GetRegistrationStatus handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (uc *UserQuery) GetRegistrationStatus(ctx context.Context, request *apiv1.GetRegistrationStatusRequest) (*apiv1.GetRegistrationStatusResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyUser()
+	}
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		return nil, entity.ErrEmptyUser()
+	}
+
+	status, err := uc.statusGetter.GetRegistrationStatus(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserQuery-GetRegistrationStatus] fail get registration status", "error", err)
+		return nil, err
+	}
+	return &apiv1.GetRegistrationStatusResponse{Data: createProtoRegistrationStatus(status)}, nil
+}
+
+// ExportMyData handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (uc *UserQuery) ExportMyData(ctx context.Context, _ *apiv1.ExportMyDataRequest) (*apiv1.ExportMyDataResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	archive, err := uc.exporter.Export(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserQuery-ExportMyData] fail export user data", "error", err)
+		return nil, err
+	}
+	data, err := createProtoUserDataArchive(archive)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserQuery-ExportMyData] fail convert user data archive", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return &apiv1.ExportMyDataResponse{Data: data}, nil
+}
+
 func createGetAllUsersResponse(users []*entity.User) *apiv1.GetAllUsersResponse {
 	resp := &apiv1.GetAllUsersResponse{}
 	for _, user := range users {
@@ -53,3 +124,37 @@ func createProtoUser(user *entity.User) *apiv1.User {
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
 	}
 }
+
+// createProtoUserDataArchive converts the archive using its json representation,
+// so the response has the same shape as the downloaded archive.
+func createProtoUserDataArchive(archive *entity.UserDataArchive) (*structpb.Struct, error) {
+	raw, err := json.Marshal(archive)
+	if err != nil {
+		return nil, err
+	}
+	data := &structpb.Struct{}
+	if err := data.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func createProtoRegistrationStatus(status *entity.RegistrationStatus) *apiv1.RegistrationStatus {
+	return &apiv1.RegistrationStatus{
+		State:  createProtoRegistrationState(status.State),
+		Reason: status.Reason,
+	}
+}
+
+func createProtoRegistrationState(state entity.RegistrationState) apiv1.RegistrationState {
+	switch state {
+	case entity.RegistrationStatePending:
+		return apiv1.RegistrationState_REGISTRATION_STATE_PENDING
+	case entity.RegistrationStateCompleted:
+		return apiv1.RegistrationState_REGISTRATION_STATE_COMPLETED
+	case entity.RegistrationStateFailed:
+		return apiv1.RegistrationState_REGISTRATION_STATE_FAILED
+	default:
+		return apiv1.RegistrationState_REGISTRATION_STATE_UNSPECIFIED
+	}
+}
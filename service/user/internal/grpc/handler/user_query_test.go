@@ -3,6 +3,7 @@ package handler_test
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -21,8 +22,10 @@ var (
 )
 
 type UserQuerySuite struct {
-	handler *handler.UserQuery
-	getter  *mock_service.MockGetUser
+	handler      *handler.UserQuery
+	getter       *mock_service.MockGetUser
+	statusGetter *mock_service.MockGetRegistrationStatus
+	exporter     *mock_service.MockExportUserData
 }
 
 func TestNewUserQuery(t *testing.T) {
@@ -71,11 +74,177 @@ func TestUserQuery_GetAllUsers(t *testing.T) {
 	})
 }
 
+func TestUserQuery_GetRegistrationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+
+		res, err := st.handler.GetRegistrationStatus(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("id is invalid", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+
+		res, err := st.handler.GetRegistrationStatus(testCtx, &apiv1.GetRegistrationStatusRequest{Id: "invalid"})
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("registration status service returns error", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		st.statusGetter.EXPECT().GetRegistrationStatus(testCtx, id).Return(nil, entity.ErrNotFound())
+
+		res, err := st.handler.GetRegistrationStatus(testCtx, &apiv1.GetRegistrationStatusRequest{Id: id.String()})
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get registration status", func(t *testing.T) {
+		tables := []struct {
+			state    entity.RegistrationState
+			expected apiv1.RegistrationState
+		}{
+			{state: entity.RegistrationStatePending, expected: apiv1.RegistrationState_REGISTRATION_STATE_PENDING},
+			{state: entity.RegistrationStateCompleted, expected: apiv1.RegistrationState_REGISTRATION_STATE_COMPLETED},
+			{state: entity.RegistrationStateFailed, expected: apiv1.RegistrationState_REGISTRATION_STATE_FAILED},
+			{state: entity.RegistrationState("UNKNOWN"), expected: apiv1.RegistrationState_REGISTRATION_STATE_UNSPECIFIED},
+		}
+
+		for _, table := range tables {
+			st := createUserQuerySuite(ctrl)
+			status := &entity.RegistrationStatus{State: table.state, Reason: "reason"}
+			st.statusGetter.EXPECT().GetRegistrationStatus(testCtx, id).Return(status, nil)
+
+			res, err := st.handler.GetRegistrationStatus(testCtx, &apiv1.GetRegistrationStatusRequest{Id: id.String()})
+
+			assert.NoError(t, err)
+			assert.Equal(t, table.expected, res.GetData().GetState())
+			assert.Equal(t, "reason", res.GetData().GetReason())
+		}
+	})
+}
+
+func TestUserQuery_GetUserByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request or invalid id is prohibited", func(t *testing.T) {
+		requests := []*apiv1.GetUserByIDRequest{nil, {Id: "invalid"}}
+
+		for _, request := range requests {
+			st := createUserQuerySuite(ctrl)
+
+			res, err := st.handler.GetUserByID(testCtxWithAuth, request)
+
+			assert.Equal(t, entity.ErrEmptyUser(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().GetByID(testCtxWithAuth, id).Return(nil, entity.ErrNotFound())
+
+		res, err := st.handler.GetUserByID(testCtxWithAuth, &apiv1.GetUserByIDRequest{Id: id.String()})
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get user by id", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		user := &entity.User{ID: uuid.Must(uuid.NewV7()), Name: "First User"}
+		st.getter.EXPECT().GetByID(testCtxWithAuth, user.ID).Return(user, nil)
+
+		res, err := st.handler.GetUserByID(testCtxWithAuth, &apiv1.GetUserByIDRequest{Id: user.ID.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID.String(), res.GetData().GetId())
+		assert.Equal(t, user.Name, res.GetData().GetName())
+	})
+}
+
+func TestUserQuery_GetMe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		st.getter.EXPECT().GetByID(testCtxWithAuth, testUserID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.handler.GetMe(testCtxWithAuth, &apiv1.GetMeRequest{})
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get the authenticated user", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		user := &entity.User{ID: testUserID, Name: "First User"}
+		st.getter.EXPECT().GetByID(testCtxWithAuth, testUserID).Return(user, nil)
+
+		res, err := st.handler.GetMe(testCtxWithAuth, &apiv1.GetMeRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID.String(), res.GetData().GetId())
+		assert.Equal(t, user.Name, res.GetData().GetName())
+	})
+}
+
+func TestUserQuery_ExportMyData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("exporter service returns error", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		errReturn := entity.ErrInternal("")
+		st.exporter.EXPECT().Export(testCtxWithAuth, testUserID).Return(nil, errReturn)
+
+		res, err := st.handler.ExportMyData(testCtxWithAuth, &apiv1.ExportMyDataRequest{})
+
+		assert.Equal(t, errReturn, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success export my data", func(t *testing.T) {
+		st := createUserQuerySuite(ctrl)
+		archive := &entity.UserDataArchive{
+			Profile: &entity.UserProfileData{ID: testUserID, Name: "First User"},
+			Account: &entity.AccountData{Email: "first@user.com"},
+			Wallets: []*entity.WalletData{{Balance: "10"}},
+		}
+		st.exporter.EXPECT().Export(testCtxWithAuth, testUserID).Return(archive, nil)
+
+		res, err := st.handler.ExportMyData(testCtxWithAuth, &apiv1.ExportMyDataRequest{})
+
+		assert.NoError(t, err)
+		data := res.GetData().AsMap()
+		assert.Equal(t, "First User", data["profile"].(map[string]any)["name"])
+		assert.Equal(t, testUserID.String(), data["profile"].(map[string]any)["id"])
+		assert.Equal(t, "first@user.com", data["account"].(map[string]any)["email"])
+		assert.Len(t, data["wallets"], 1)
+		assert.Nil(t, data["transactions"])
+	})
+}
+
 func createUserQuerySuite(ctrl *gomock.Controller) *UserQuerySuite {
 	g := mock_service.NewMockGetUser(ctrl)
-	h := handler.NewUserQuery(g)
+	sg := mock_service.NewMockGetRegistrationStatus(ctrl)
+	ex := mock_service.NewMockExportUserData(ctrl)
+	h := handler.NewUserQuery(g, sg, ex)
 	return &UserQuerySuite{
-		handler: h,
-		getter:  g,
+		handler:      h,
+		getter:       g,
+		statusGetter: sg,
+		exporter:     ex,
 	}
 }
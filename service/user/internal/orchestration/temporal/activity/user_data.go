@@ -0,0 +1,126 @@
+package activity
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// UserDataAuthConnection defines interface to get and erase user's account in 3rd party.
+type UserDataAuthConnection interface {
+	// GetAccount gets the account owned by the user in 3rd party.
+	GetAccount(ctx context.Context, userID uuid.UUID) (*entity.AccountData, error)
+	// EraseAccount anonymizes the account owned by the user in 3rd party.
+	EraseAccount(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserDataWalletConnection defines interface to list and freeze user's wallets in 3rd party.
+type UserDataWalletConnection interface {
+	// ListWallets lists all wallets owned by the user in 3rd party.
+	ListWallets(ctx context.Context, userID uuid.UUID) ([]*entity.WalletData, error)
+	// FreezeWallets freezes all wallets owned by the user in 3rd party.
+	FreezeWallets(ctx context.Context, userID uuid.UUID) error
+}
+
+// UserDataTransactionConnection defines interface to list user's transactions in 3rd party.
+type UserDataTransactionConnection interface {
+	// ListTransactions lists all transactions sent or received by the user in 3rd party.
+	ListTransactions(ctx context.Context, userID uuid.UUID) ([]*entity.TransactionData, error)
+}
+
+// UserDataDatabase defines interface to get and anonymize user and store user's data archive in database.
+type UserDataDatabase interface {
+	// GetByIDIncludingDeleted gets a user by its id, including the soft-deleted one.
+	GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// Anonymize replaces the user's personal data with anonymized value.
+	Anonymize(ctx context.Context, id uuid.UUID) error
+	// InsertDataExport stores the user's data archive until expiresAt.
+	InsertDataExport(ctx context.Context, archive *entity.UserDataArchive, expiresAt time.Time) error
+}
+
+// UserDataActivity is responsible to execute export user data and erase user data workflows.
+type UserDataActivity struct {
+	authConn        UserDataAuthConnection
+	walletConn      UserDataWalletConnection
+	transactionConn UserDataTransactionConnection
+	database        UserDataDatabase
+	exportTTL       time.Duration
+}
+
+// NewUserDataActivity creates an instance of UserDataActivity.
+// exportTTL is how long a stored archive can be taken before it expires.
+func NewUserDataActivity(ac UserDataAuthConnection, wc UserDataWalletConnection, tc UserDataTransactionConnection, db UserDataDatabase, exportTTL time.Duration) *UserDataActivity {
+	return &UserDataActivity{authConn: ac, walletConn: wc, transactionConn: tc, database: db, exportTTL: exportTTL}
+}
+
+// StoreArchive collects user's profile, account, wallets and transactions and stores them as an archive in database.
+// The data is collected here, so the personal data doesn't go through the workflow history.
+// The archive expires after the export ttl counted from input.ExportedAt, so a retry stores the same archive.
+func (u *UserDataActivity) StoreArchive(ctx context.Context, input *entity.UserDataExportInput) error {
+	user, err := u.database.GetByIDIncludingDeleted(ctx, input.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-StoreArchive] fail get user", "error", err)
+		return err
+	}
+	account, err := u.authConn.GetAccount(ctx, input.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-StoreArchive] fail get account", "error", err)
+		return err
+	}
+	wallets, err := u.walletConn.ListWallets(ctx, input.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-StoreArchive] fail list wallets", "error", err)
+		return err
+	}
+	trxs, err := u.transactionConn.ListTransactions(ctx, input.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-StoreArchive] fail list transactions", "error", err)
+		return err
+	}
+
+	archive := &entity.UserDataArchive{
+		ID:           input.ID,
+		UserID:       input.UserID,
+		ExportedAt:   input.ExportedAt,
+		Profile:      &entity.UserProfileData{ID: user.ID, Name: user.Name, CreatedAt: user.CreatedAt, UpdatedAt: user.UpdatedAt},
+		Account:      account,
+		Wallets:      wallets,
+		Transactions: trxs,
+	}
+	if err := u.database.InsertDataExport(ctx, archive, input.ExportedAt.Add(u.exportTTL)); err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-StoreArchive] fail insert data export", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FreezeWallets freezes user's wallets in wallet service.
+func (u *UserDataActivity) FreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	err := u.walletConn.FreezeWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-FreezeWallets] fail freeze wallets", "error", err)
+	}
+	return err
+}
+
+// EraseAccount anonymizes user's account in auth service.
+func (u *UserDataActivity) EraseAccount(ctx context.Context, userID uuid.UUID) error {
+	err := u.authConn.EraseAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-EraseAccount] fail erase account", "error", err)
+	}
+	return err
+}
+
+// AnonymizeInUser anonymizes user in database.
+func (u *UserDataActivity) AnonymizeInUser(ctx context.Context, id uuid.UUID) error {
+	err := u.database.Anonymize(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataActivity-AnonymizeInUser] fail anonymize in user", "error", err)
+	}
+	return err
+}
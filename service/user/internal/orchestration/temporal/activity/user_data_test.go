@@ -0,0 +1,229 @@
+package activity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/activity"
+	mock_activity "github.com/indrasaputra/arjuna/service/user/test/mock/orchestration/temporal/activity"
+)
+
+const testExportTTL = time.Hour
+
+type UserDataActivitySuite struct {
+	activity *activity.UserDataActivity
+
+	auth        *mock_activity.MockUserDataAuthConnection
+	wallet      *mock_activity.MockUserDataWalletConnection
+	transaction *mock_activity.MockUserDataTransactionConnection
+	db          *mock_activity.MockUserDataDatabase
+}
+
+func TestNewUserDataActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of UserDataActivity", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		assert.NotNil(t, st.activity)
+	})
+}
+
+func TestUserDataActivity_StoreArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when get user", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(nil, assert.AnError)
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error when get account", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(createTestUser(), nil)
+		st.auth.EXPECT().GetAccount(testCtx, input.UserID).Return(nil, assert.AnError)
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error when list wallets", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(createTestUser(), nil)
+		st.auth.EXPECT().GetAccount(testCtx, input.UserID).Return(&entity.AccountData{}, nil)
+		st.wallet.EXPECT().ListWallets(testCtx, input.UserID).Return(nil, assert.AnError)
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error when list transactions", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(createTestUser(), nil)
+		st.auth.EXPECT().GetAccount(testCtx, input.UserID).Return(&entity.AccountData{}, nil)
+		st.wallet.EXPECT().ListWallets(testCtx, input.UserID).Return([]*entity.WalletData{}, nil)
+		st.transaction.EXPECT().ListTransactions(testCtx, input.UserID).Return(nil, assert.AnError)
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error when insert data export", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(createTestUser(), nil)
+		st.auth.EXPECT().GetAccount(testCtx, input.UserID).Return(&entity.AccountData{}, nil)
+		st.wallet.EXPECT().ListWallets(testCtx, input.UserID).Return([]*entity.WalletData{}, nil)
+		st.transaction.EXPECT().ListTransactions(testCtx, input.UserID).Return([]*entity.TransactionData{}, nil)
+		st.db.EXPECT().InsertDataExport(testCtx, gomock.Any(), input.ExportedAt.Add(testExportTTL)).Return(assert.AnError)
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success store archive", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		input := createTestUserDataExportInput()
+		user := createTestUser()
+		account := &entity.AccountData{Email: user.Email}
+		wallets := []*entity.WalletData{{Balance: "10"}}
+		trxs := []*entity.TransactionData{{Amount: "10"}}
+		var stored *entity.UserDataArchive
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, input.UserID).Return(user, nil)
+		st.auth.EXPECT().GetAccount(testCtx, input.UserID).Return(account, nil)
+		st.wallet.EXPECT().ListWallets(testCtx, input.UserID).Return(wallets, nil)
+		st.transaction.EXPECT().ListTransactions(testCtx, input.UserID).Return(trxs, nil)
+		st.db.EXPECT().InsertDataExport(testCtx, gomock.Any(), input.ExportedAt.Add(testExportTTL)).
+			DoAndReturn(func(_ context.Context, archive *entity.UserDataArchive, _ time.Time) error {
+				stored = archive
+				return nil
+			})
+
+		err := st.activity.StoreArchive(testCtx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, input.ID, stored.ID)
+		assert.Equal(t, input.UserID, stored.UserID)
+		assert.Equal(t, input.ExportedAt, stored.ExportedAt)
+		assert.Equal(t, user.Name, stored.Profile.Name)
+		assert.Equal(t, account, stored.Account)
+		assert.Equal(t, wallets, stored.Wallets)
+		assert.Equal(t, trxs, stored.Transactions)
+	})
+}
+
+func TestUserDataActivity_FreezeWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when freeze wallets", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().FreezeWallets(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.FreezeWallets(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success freeze wallets", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().FreezeWallets(testCtx, user.ID).Return(nil)
+
+		err := st.activity.FreezeWallets(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUserDataActivity_EraseAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when erase account", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().EraseAccount(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.EraseAccount(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success erase account", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().EraseAccount(testCtx, user.ID).Return(nil)
+
+		err := st.activity.EraseAccount(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUserDataActivity_AnonymizeInUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when anonymize in user", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().Anonymize(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.AnonymizeInUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success anonymize in user", func(t *testing.T) {
+		st := createUserDataActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().Anonymize(testCtx, user.ID).Return(nil)
+
+		err := st.activity.AnonymizeInUser(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createUserDataActivitySuite(ctrl *gomock.Controller) *UserDataActivitySuite {
+	ac := mock_activity.NewMockUserDataAuthConnection(ctrl)
+	wc := mock_activity.NewMockUserDataWalletConnection(ctrl)
+	tc := mock_activity.NewMockUserDataTransactionConnection(ctrl)
+	db := mock_activity.NewMockUserDataDatabase(ctrl)
+	a := activity.NewUserDataActivity(ac, wc, tc, db, testExportTTL)
+	return &UserDataActivitySuite{
+		activity:    a,
+		auth:        ac,
+		wallet:      wc,
+		transaction: tc,
+		db:          db,
+	}
+}
+
+func createTestUserDataExportInput() *entity.UserDataExportInput {
+	return &entity.UserDataExportInput{
+		ID:         uuid.Must(uuid.NewV7()),
+		UserID:     uuid.Must(uuid.NewV7()),
+		ExportedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,98 @@
+package activity
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// DeleteUserAuthConnection defines interface to soft-delete user's account in 3rd party.
+type DeleteUserAuthConnection interface {
+	// SoftDeleteAccount soft-deletes the account owned by the user in 3rd party.
+	SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error
+	// RestoreAccount restores the soft-deleted account owned by the user in 3rd party.
+	RestoreAccount(ctx context.Context, userID uuid.UUID) error
+}
+
+// DeleteUserWalletConnection defines interface to freeze user's wallets in 3rd party.
+type DeleteUserWalletConnection interface {
+	// FreezeWallets freezes all wallets owned by the user in 3rd party.
+	FreezeWallets(ctx context.Context, userID uuid.UUID) error
+	// UnfreezeWallets unfreezes all wallets owned by the user in 3rd party.
+	UnfreezeWallets(ctx context.Context, userID uuid.UUID) error
+}
+
+// DeleteUserDatabase defines interface to soft-delete user in database.
+type DeleteUserDatabase interface {
+	// SoftDelete soft-deletes the user.
+	SoftDelete(ctx context.Context, id uuid.UUID) error
+	// Restore restores the soft-deleted user.
+	Restore(ctx context.Context, id uuid.UUID) error
+}
+
+// DeleteUserActivity is responsible to execute delete user and restore user workflows.
+type DeleteUserActivity struct {
+	authConn   DeleteUserAuthConnection
+	walletConn DeleteUserWalletConnection
+	database   DeleteUserDatabase
+}
+
+// NewDeleteUserActivity creates an instance of DeleteUserActivity.
+func NewDeleteUserActivity(ac DeleteUserAuthConnection, wc DeleteUserWalletConnection, db DeleteUserDatabase) *DeleteUserActivity {
+	return &DeleteUserActivity{authConn: ac, walletConn: wc, database: db}
+}
+
+// SoftDeleteAccount soft-deletes user's account in auth service.
+func (d *DeleteUserActivity) SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	err := d.authConn.SoftDeleteAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-SoftDeleteAccount] fail soft delete account", "error", err)
+	}
+	return err
+}
+
+// RestoreAccount restores user's account in auth service.
+func (d *DeleteUserActivity) RestoreAccount(ctx context.Context, userID uuid.UUID) error {
+	err := d.authConn.RestoreAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-RestoreAccount] fail restore account", "error", err)
+	}
+	return err
+}
+
+// FreezeWallets freezes user's wallets in wallet service.
+func (d *DeleteUserActivity) FreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	err := d.walletConn.FreezeWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-FreezeWallets] fail freeze wallets", "error", err)
+	}
+	return err
+}
+
+// UnfreezeWallets unfreezes user's wallets in wallet service.
+func (d *DeleteUserActivity) UnfreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	err := d.walletConn.UnfreezeWallets(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-UnfreezeWallets] fail unfreeze wallets", "error", err)
+	}
+	return err
+}
+
+// SoftDeleteInUser soft-deletes user in database.
+func (d *DeleteUserActivity) SoftDeleteInUser(ctx context.Context, id uuid.UUID) error {
+	err := d.database.SoftDelete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-SoftDeleteInUser] fail soft delete in user", "error", err)
+	}
+	return err
+}
+
+// RestoreInUser restores user in database.
+func (d *DeleteUserActivity) RestoreInUser(ctx context.Context, id uuid.UUID) error {
+	err := d.database.Restore(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserActivity-RestoreInUser] fail restore in user", "error", err)
+	}
+	return err
+}
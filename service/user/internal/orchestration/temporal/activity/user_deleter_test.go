@@ -0,0 +1,192 @@
+package activity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/activity"
+	mock_activity "github.com/indrasaputra/arjuna/service/user/test/mock/orchestration/temporal/activity"
+)
+
+type DeleteUserActivitySuite struct {
+	activity *activity.DeleteUserActivity
+
+	auth   *mock_activity.MockDeleteUserAuthConnection
+	wallet *mock_activity.MockDeleteUserWalletConnection
+	db     *mock_activity.MockDeleteUserDatabase
+}
+
+func TestNewDeleteUserActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of DeleteUserActivity", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		assert.NotNil(t, st.activity)
+	})
+}
+
+func TestDeleteUserActivity_SoftDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when soft delete account", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().SoftDeleteAccount(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.SoftDeleteAccount(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success soft delete account", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().SoftDeleteAccount(testCtx, user.ID).Return(nil)
+
+		err := st.activity.SoftDeleteAccount(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserActivity_RestoreAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when restore account", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().RestoreAccount(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.RestoreAccount(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success restore account", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().RestoreAccount(testCtx, user.ID).Return(nil)
+
+		err := st.activity.RestoreAccount(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserActivity_FreezeWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when freeze wallets", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().FreezeWallets(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.FreezeWallets(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success freeze wallets", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().FreezeWallets(testCtx, user.ID).Return(nil)
+
+		err := st.activity.FreezeWallets(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserActivity_UnfreezeWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when unfreeze wallets", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().UnfreezeWallets(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.UnfreezeWallets(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success unfreeze wallets", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.wallet.EXPECT().UnfreezeWallets(testCtx, user.ID).Return(nil)
+
+		err := st.activity.UnfreezeWallets(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserActivity_SoftDeleteInUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when soft delete user in database", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().SoftDelete(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.SoftDeleteInUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success soft delete user in database", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().SoftDelete(testCtx, user.ID).Return(nil)
+
+		err := st.activity.SoftDeleteInUser(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserActivity_RestoreInUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when restore user in database", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().Restore(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.RestoreInUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success restore user in database", func(t *testing.T) {
+		st := createDeleteUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.db.EXPECT().Restore(testCtx, user.ID).Return(nil)
+
+		err := st.activity.RestoreInUser(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createDeleteUserActivitySuite(ctrl *gomock.Controller) *DeleteUserActivitySuite {
+	ac := mock_activity.NewMockDeleteUserAuthConnection(ctrl)
+	wc := mock_activity.NewMockDeleteUserWalletConnection(ctrl)
+	db := mock_activity.NewMockDeleteUserDatabase(ctrl)
+	a := activity.NewDeleteUserActivity(ac, wc, db)
+	return &DeleteUserActivitySuite{
+		activity: a,
+		auth:     ac,
+		wallet:   wc,
+		db:       db,
+	}
+}
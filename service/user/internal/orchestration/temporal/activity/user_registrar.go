@@ -16,6 +16,9 @@ import (
 type RegisterUserAuthConnection interface {
 	// CreateAccount creates an account in 3rd party.
 	CreateAccount(ctx context.Context, user *entity.User) error
+	// DeleteAccount deletes the account owned by the user in 3rd party.
+	// It must be called when a creation is failing and need a clean up or rollback.
+	DeleteAccount(ctx context.Context, userID uuid.UUID) error
 }
 
 // RegisterUserWalletConnection defines interface to register user to 3rd party.
@@ -51,6 +54,15 @@ func (r *RegisterUserActivity) CreateAccount(ctx context.Context, user *entity.U
 	return err
 }
 
+// DeleteAccount deletes user's account in auth service.
+func (r *RegisterUserActivity) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	err := r.authConn.DeleteAccount(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[RegisterUserActivity-DeleteAccount] fail delete account", "error", err)
+	}
+	return err
+}
+
 // CreateWallet creates user's wallet in wallet service.
 func (r *RegisterUserActivity) CreateWallet(ctx context.Context, user *entity.User) error {
 	err := r.walletConn.CreateWallet(ctx, user)
@@ -60,6 +60,31 @@ func TestRegisterUserActivity_CreateAccount(t *testing.T) {
 	})
 }
 
+func TestRegisterUserActivity_DeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("error when delete account from auth", func(t *testing.T) {
+		st := createRegisterUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().DeleteAccount(testCtx, user.ID).Return(assert.AnError)
+
+		err := st.activity.DeleteAccount(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success delete account from auth", func(t *testing.T) {
+		st := createRegisterUserActivitySuite(ctrl)
+		user := createTestUser()
+		st.auth.EXPECT().DeleteAccount(testCtx, user.ID).Return(nil)
+
+		err := st.activity.DeleteAccount(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
 func TestRegisterUserActivity_CreateWallet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
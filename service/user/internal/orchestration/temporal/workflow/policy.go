@@ -0,0 +1,104 @@
+package workflow
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	tempflow "go.temporal.io/sdk/workflow"
+)
+
+// ActivityPolicy holds the timeout and retry policy of an activity.
+// When it is used as an override, zero fields are taken from the default policy.
+type ActivityPolicy struct {
+	Timeout            time.Duration
+	InitialInterval    time.Duration
+	MaximumInterval    time.Duration
+	BackoffCoefficient float64
+	MaximumAttempts    int32
+}
+
+// Policy holds the task queue, timeouts and retry policies of a workflow.
+type Policy struct {
+	// ActivityOverrides overrides the policy of the activity with the same name.
+	ActivityOverrides map[string]ActivityPolicy
+	TaskQueue         string
+	// Activity is the default policy of every activity.
+	Activity        ActivityPolicy
+	WorkflowTimeout time.Duration
+	// CompensationMaximumAttempts replaces the default maximum attempts of the compensation activities.
+	CompensationMaximumAttempts int32
+	WorkflowMaximumAttempts     int32
+}
+
+// DefaultRegisterUserPolicy creates the policy of the register user workflow using the default values.
+func DefaultRegisterUserPolicy() *Policy {
+	return defaultPolicy(TaskQueueRegisterUser)
+}
+
+// DefaultDeleteUserPolicy creates the policy of the delete user workflow using the default values.
+func DefaultDeleteUserPolicy() *Policy {
+	return defaultPolicy(TaskQueueDeleteUser)
+}
+
+// DefaultUserDataPolicy creates the policy of the export user data and erase user data workflows using the default values.
+func DefaultUserDataPolicy() *Policy {
+	return defaultPolicy(TaskQueueUserData)
+}
+
+func defaultPolicy(taskQueue string) *Policy {
+	return &Policy{
+		TaskQueue: taskQueue,
+		Activity: ActivityPolicy{
+			Timeout:            ActivityTimeoutDefault,
+			InitialInterval:    ActivityRetryInitialInterval,
+			BackoffCoefficient: ActivityRetryBackoffCoefficient,
+			MaximumAttempts:    ActivityRetryMaximumAttempts,
+		},
+		WorkflowTimeout:             WorkflowTimeoutDefault,
+		CompensationMaximumAttempts: CompensationRetryMaximumAttempts,
+		WorkflowMaximumAttempts:     WorkflowRetryMaximumAttempts,
+	}
+}
+
+// activityOptions creates the options of the activity.
+// The override of the activity is applied on top of the default policy.
+func (p *Policy) activityOptions(activity string, compensation bool) tempflow.ActivityOptions {
+	policy := p.Activity
+	if compensation {
+		policy.MaximumAttempts = p.CompensationMaximumAttempts
+	}
+	policy = mergeActivityPolicy(policy, p.ActivityOverrides[activity])
+
+	return tempflow.ActivityOptions{
+		StartToCloseTimeout: policy.Timeout,
+		TaskQueue:           p.TaskQueue,
+		RetryPolicy: &temporal.RetryPolicy{
+			BackoffCoefficient: policy.BackoffCoefficient,
+			MaximumAttempts:    policy.MaximumAttempts,
+			InitialInterval:    policy.InitialInterval,
+			MaximumInterval:    policy.MaximumInterval,
+			NonRetryableErrorTypes: []string{
+				ErrNonRetryableUserExist,
+			},
+		},
+	}
+}
+
+func mergeActivityPolicy(policy, override ActivityPolicy) ActivityPolicy {
+	if override.Timeout > 0 {
+		policy.Timeout = override.Timeout
+	}
+	if override.InitialInterval > 0 {
+		policy.InitialInterval = override.InitialInterval
+	}
+	if override.MaximumInterval > 0 {
+		policy.MaximumInterval = override.MaximumInterval
+	}
+	if override.BackoffCoefficient > 0 {
+		policy.BackoffCoefficient = override.BackoffCoefficient
+	}
+	if override.MaximumAttempts > 0 {
+		policy.MaximumAttempts = override.MaximumAttempts
+	}
+	return policy
+}
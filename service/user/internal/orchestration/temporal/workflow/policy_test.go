@@ -0,0 +1,49 @@
+package workflow_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/workflow"
+)
+
+func TestDefaultRegisterUserPolicy(t *testing.T) {
+	t.Run("default policy uses the default values", func(t *testing.T) {
+		policy := workflow.DefaultRegisterUserPolicy()
+
+		assert.Equal(t, workflow.TaskQueueRegisterUser, policy.TaskQueue)
+		assert.Equal(t, workflow.ActivityTimeoutDefault, policy.Activity.Timeout)
+		assert.Equal(t, workflow.ActivityRetryInitialInterval, policy.Activity.InitialInterval)
+		assert.InDelta(t, workflow.ActivityRetryBackoffCoefficient, policy.Activity.BackoffCoefficient, 0)
+		assert.Equal(t, int32(workflow.ActivityRetryMaximumAttempts), policy.Activity.MaximumAttempts)
+		assert.Equal(t, workflow.WorkflowTimeoutDefault, policy.WorkflowTimeout)
+		assert.Equal(t, int32(workflow.CompensationRetryMaximumAttempts), policy.CompensationMaximumAttempts)
+		assert.Equal(t, int32(workflow.WorkflowRetryMaximumAttempts), policy.WorkflowMaximumAttempts)
+		assert.Empty(t, policy.ActivityOverrides)
+	})
+}
+
+func TestDefaultDeleteUserPolicy(t *testing.T) {
+	t.Run("default policy uses the delete user task queue", func(t *testing.T) {
+		policy := workflow.DefaultDeleteUserPolicy()
+
+		assert.Equal(t, workflow.TaskQueueDeleteUser, policy.TaskQueue)
+		assert.Equal(t, workflow.ActivityTimeoutDefault, policy.Activity.Timeout)
+		assert.Equal(t, int32(workflow.ActivityRetryMaximumAttempts), policy.Activity.MaximumAttempts)
+		assert.Equal(t, workflow.WorkflowTimeoutDefault, policy.WorkflowTimeout)
+		assert.Equal(t, int32(workflow.CompensationRetryMaximumAttempts), policy.CompensationMaximumAttempts)
+		assert.Equal(t, int32(workflow.WorkflowRetryMaximumAttempts), policy.WorkflowMaximumAttempts)
+	})
+}
+
+func TestDefaultUserDataPolicy(t *testing.T) {
+	t.Run("default policy uses the user data task queue", func(t *testing.T) {
+		policy := workflow.DefaultUserDataPolicy()
+
+		assert.Equal(t, workflow.TaskQueueUserData, policy.TaskQueue)
+		assert.Equal(t, workflow.ActivityTimeoutDefault, policy.Activity.Timeout)
+		assert.Equal(t, int32(workflow.ActivityRetryMaximumAttempts), policy.Activity.MaximumAttempts)
+		assert.Equal(t, workflow.WorkflowTimeoutDefault, policy.WorkflowTimeout)
+	})
+}
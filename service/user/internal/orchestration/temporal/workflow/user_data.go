@@ -0,0 +1,151 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+const (
+	// TaskQueueUserData represents user data export and erasure.
+	// It is the default task queue of the export user data and erase user data workflows.
+	TaskQueueUserData = "user-data"
+
+	// ActivityUserStoreArchive is derived from struct name + method name. See activity registration in worker.
+	ActivityUserStoreArchive = "UserDataActivityStoreArchive"
+	// ActivityEraseWalletFreeze is derived from struct name + method name. See activity registration in worker.
+	ActivityEraseWalletFreeze = "UserDataActivityFreezeWallets"
+	// ActivityAuthErase is derived from struct name + method name. See activity registration in worker.
+	ActivityAuthErase = "UserDataActivityEraseAccount"
+	// ActivityUserAnonymize is derived from struct name + method name. See activity registration in worker.
+	ActivityUserAnonymize = "UserDataActivityAnonymizeInUser"
+
+	// WorkflowTypeExportUserData is the name the export user data workflow is registered with in worker.
+	WorkflowTypeExportUserData = "ExportUserData"
+	// WorkflowTypeEraseUserData is the name the erase user data workflow is registered with in worker.
+	WorkflowTypeEraseUserData = "EraseUserData"
+	// WorkflowNameExportUserData is derived from the process itself.
+	WorkflowNameExportUserData = "export-user-data"
+	// WorkflowNameEraseUserData is derived from the process itself.
+	WorkflowNameEraseUserData = "erase-user-data"
+)
+
+// UserDataWorkflow is responsible to execute export user data and erase user data workflows.
+type UserDataWorkflow struct {
+	client client.Client
+	policy *Policy
+}
+
+// NewUserDataWorkflow creates an instance of UserDataWorkflow.
+func NewUserDataWorkflow(client client.Client, policy *Policy) *UserDataWorkflow {
+	return &UserDataWorkflow{client: client, policy: policy}
+}
+
+// ExportUserData runs the export user data workflow and waits until the archive is stored.
+// It returns the id of the stored archive.
+func (u *UserDataWorkflow) ExportUserData(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	var archiveID uuid.UUID
+	if err := u.execute(ctx, fmt.Sprintf("%s-%s", WorkflowNameExportUserData, id), WorkflowTypeExportUserData, id, &archiveID); err != nil {
+		return uuid.Nil, err
+	}
+	return archiveID, nil
+}
+
+// EraseUserData runs the erase user data workflow and waits until it is done.
+func (u *UserDataWorkflow) EraseUserData(ctx context.Context, id uuid.UUID) error {
+	return u.execute(ctx, fmt.Sprintf("%s-%s", WorkflowNameEraseUserData, id), WorkflowTypeEraseUserData, id, nil)
+}
+
+func (u *UserDataWorkflow) execute(ctx context.Context, workflowID, workflowType string, id uuid.UUID, result any) error {
+	opts := client.StartWorkflowOptions{
+		ID:                 workflowID,
+		TaskQueue:          u.policy.TaskQueue,
+		WorkflowRunTimeout: u.policy.WorkflowTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: u.policy.WorkflowMaximumAttempts,
+		},
+	}
+	wr, err := u.client.ExecuteWorkflow(ctx, opts, workflowType, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataWorkflow-execute] fail to start workflow", "workflow-type", workflowType, "error", err)
+		return entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	slog.InfoContext(ctx, "[UserDataWorkflow-execute] started workflow", "workflow-id", wr.GetID(), "run-id", wr.GetRunID())
+
+	if err = wr.Get(ctx, result); err != nil {
+		slog.ErrorContext(ctx, "[UserDataWorkflow-execute] error get workflow result", "workflow-type", workflowType, "error", err)
+		return entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	return nil
+}
+
+// UserDataDefinition defines the export user data and erase user data workflows run by the worker.
+type UserDataDefinition struct {
+	policy *Policy
+}
+
+// NewUserDataDefinition creates an instance of UserDataDefinition.
+func NewUserDataDefinition(policy *Policy) *UserDataDefinition {
+	return &UserDataDefinition{policy: policy}
+}
+
+// ExportUserData runs the export user data workflow.
+// Only the ids are passed to the activity, which collects the user's data and stores it as an archive,
+// so neither the personal data nor a long transaction history goes through the workflow history.
+// It returns the id of the stored archive.
+// It must be registered in worker using WorkflowTypeExportUserData as its name.
+func (u *UserDataDefinition) ExportUserData(ctx tempflow.Context, id uuid.UUID) (uuid.UUID, error) {
+	if id == uuid.Nil {
+		return uuid.Nil, entity.ErrEmptyUser()
+	}
+
+	archiveID, err := generateArchiveID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	input := &entity.UserDataExportInput{ID: archiveID, UserID: id, ExportedAt: tempflow.Now(ctx).UTC()}
+	if err := u.executeActivity(ctx, ActivityUserStoreArchive, input).Get(ctx, nil); err != nil {
+		return uuid.Nil, err
+	}
+	return input.ID, nil
+}
+
+// EraseUserData runs the erase user data workflow.
+// It freezes the wallets, so no money moves in or out, before anonymizing the account and the user.
+// The wallets and the transactions are kept as they are needed by the ledger.
+// Every step is idempotent, so a failed erasure completes when the workflow is run again.
+// It must be registered in worker using WorkflowTypeEraseUserData as its name.
+func (u *UserDataDefinition) EraseUserData(ctx tempflow.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return entity.ErrEmptyUser()
+	}
+
+	for _, activity := range []string{ActivityEraseWalletFreeze, ActivityAuthErase, ActivityUserAnonymize} {
+		if err := u.executeActivity(ctx, activity, id).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UserDataDefinition) executeActivity(ctx tempflow.Context, activity string, input any) tempflow.Future {
+	actCtx := tempflow.WithActivityOptions(ctx, u.policy.activityOptions(activity, false))
+	return tempflow.ExecuteActivity(actCtx, activity, input)
+}
+
+// generateArchiveID generates the archive id as a side effect, so the same id is used when the workflow is replayed.
+func generateArchiveID(ctx tempflow.Context) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := tempflow.SideEffect(ctx, func(_ tempflow.Context) any {
+		return uuid.Must(uuid.NewV7())
+	}).Get(&id)
+	return id, err
+}
@@ -0,0 +1,266 @@
+package workflow_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	tempomock "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/connection/auth"
+	"github.com/indrasaputra/arjuna/service/user/internal/connection/transaction"
+	"github.com/indrasaputra/arjuna/service/user/internal/connection/wallet"
+	orcact "github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/activity"
+	"github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/workflow"
+	"github.com/indrasaputra/arjuna/service/user/internal/repository/postgres"
+)
+
+type UserDataWorkflowSuite struct {
+	workflow *workflow.UserDataWorkflow
+	client   *tempomock.Client
+}
+
+func TestNewUserDataWorkflow(t *testing.T) {
+	t.Run("successfully create an instance of UserDataWorkflow", func(t *testing.T) {
+		st := createUserDataWorkflowSuite()
+		assert.NotNil(t, st.workflow)
+	})
+}
+
+func TestUserDataWorkflow_ExportUserData(t *testing.T) {
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createUserDataWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeExportUserData, user.ID).
+			Return(nil, assert.AnError)
+
+		res, err := st.workflow.ExportUserData(testCtx, user.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, uuid.Nil, res)
+	})
+
+	t.Run("workflow run returns error", func(t *testing.T) {
+		st := createUserDataWorkflowSuite()
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeExportUserData, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(assert.AnError)
+
+		res, err := st.workflow.ExportUserData(testCtx, user.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, uuid.Nil, res)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		policy := workflow.DefaultUserDataPolicy()
+		policy.WorkflowTimeout = 5 * time.Minute
+		st := createUserDataWorkflowSuiteWithPolicy(policy)
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		isOptionsFromPolicy := func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == "export-user-data-"+user.ID.String() &&
+				opts.TaskQueue == workflow.TaskQueueUserData &&
+				opts.WorkflowRunTimeout == 5*time.Minute
+		}
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.MatchedBy(isOptionsFromPolicy), workflow.WorkflowTypeExportUserData, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		archiveID := uuid.Must(uuid.NewV7())
+		wr.On("Get", testCtx, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*uuid.UUID) = archiveID
+			}).
+			Return(nil)
+
+		res, err := st.workflow.ExportUserData(testCtx, user.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, archiveID, res)
+	})
+}
+
+func TestUserDataWorkflow_EraseUserData(t *testing.T) {
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createUserDataWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeEraseUserData, user.ID).
+			Return(nil, assert.AnError)
+
+		err := st.workflow.EraseUserData(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createUserDataWorkflowSuite()
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		isEraseWorkflow := func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == "erase-user-data-"+user.ID.String()
+		}
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.MatchedBy(isEraseWorkflow), workflow.WorkflowTypeEraseUserData, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(nil)
+
+		err := st.workflow.EraseUserData(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+type UserDataSuite struct {
+	env *testsuite.TestWorkflowEnvironment
+	testsuite.WorkflowTestSuite
+}
+
+func TestExportUserData(t *testing.T) {
+	t.Run("id is empty", func(t *testing.T) {
+		st := createUserDataSuite()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeExportUserData, uuid.Nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("StoreArchive activity returns error", func(t *testing.T) {
+		st := createUserDataSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityUserStoreArchive, mock.Anything, mock.Anything).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeExportUserData, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createUserDataSuite()
+		user := createTestUser()
+		var stored *entity.UserDataExportInput
+
+		st.env.OnActivity(workflow.ActivityUserStoreArchive, mock.Anything, mock.Anything).
+			Return(func(_ context.Context, input *entity.UserDataExportInput) error {
+				stored = input
+				return nil
+			}).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeExportUserData, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		var archiveID uuid.UUID
+		assert.NoError(t, st.env.GetWorkflowResult(&archiveID))
+		assert.NotEqual(t, uuid.Nil, archiveID)
+		assert.Equal(t, archiveID, stored.ID)
+		assert.Equal(t, user.ID, stored.UserID)
+		assert.False(t, stored.ExportedAt.IsZero())
+		st.env.AssertExpectations(t)
+	})
+}
+
+func TestEraseUserData(t *testing.T) {
+	t.Run("id is empty", func(t *testing.T) {
+		st := createUserDataSuite()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeEraseUserData, uuid.Nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("AuthErase activity returns error and the user is not anonymized", func(t *testing.T) {
+		st := createUserDataSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityEraseWalletFreeze, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityAuthErase, mock.Anything, user.ID).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeEraseUserData, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+		st.env.AssertNotCalled(t, workflow.ActivityUserAnonymize, mock.Anything, user.ID)
+	})
+
+	t.Run("workflow is executed successfully in order", func(t *testing.T) {
+		st := createUserDataSuite()
+		user := createTestUser()
+		var order []string
+		record := func(name string) func(context.Context, uuid.UUID) error {
+			return func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, name)
+				return nil
+			}
+		}
+
+		st.env.OnActivity(workflow.ActivityEraseWalletFreeze, mock.Anything, user.ID).Return(record(workflow.ActivityEraseWalletFreeze)).Once()
+		st.env.OnActivity(workflow.ActivityAuthErase, mock.Anything, user.ID).Return(record(workflow.ActivityAuthErase)).Once()
+		st.env.OnActivity(workflow.ActivityUserAnonymize, mock.Anything, user.ID).Return(record(workflow.ActivityUserAnonymize)).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeEraseUserData, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		assert.Equal(t, []string{workflow.ActivityEraseWalletFreeze, workflow.ActivityAuthErase, workflow.ActivityUserAnonymize}, order)
+		st.env.AssertExpectations(t)
+	})
+}
+
+func createUserDataWorkflowSuite() *UserDataWorkflowSuite {
+	return createUserDataWorkflowSuiteWithPolicy(workflow.DefaultUserDataPolicy())
+}
+
+func createUserDataWorkflowSuiteWithPolicy(policy *workflow.Policy) *UserDataWorkflowSuite {
+	c := &tempomock.Client{}
+	w := workflow.NewUserDataWorkflow(c, policy)
+	return &UserDataWorkflowSuite{
+		workflow: w,
+		client:   c,
+	}
+}
+
+func createUserDataSuite() *UserDataSuite {
+	s := &UserDataSuite{}
+	s.env = s.NewTestWorkflowEnvironment()
+
+	at := &auth.Auth{}
+	wt := &wallet.Wallet{}
+	tt := &transaction.Transaction{}
+	pg := &postgres.User{}
+	uc := orcact.NewUserDataActivity(at, wt, tt, pg, time.Hour)
+	def := workflow.NewUserDataDefinition(workflow.DefaultUserDataPolicy())
+
+	s.env.RegisterActivityWithOptions(uc, activity.RegisterOptions{Name: "UserDataActivity", SkipInvalidStructFunctions: true})
+	s.env.RegisterWorkflowWithOptions(def.ExportUserData, tempflow.RegisterOptions{Name: workflow.WorkflowTypeExportUserData})
+	s.env.RegisterWorkflowWithOptions(def.EraseUserData, tempflow.RegisterOptions{Name: workflow.WorkflowTypeEraseUserData})
+
+	return s
+}
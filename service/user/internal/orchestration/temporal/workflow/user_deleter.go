@@ -0,0 +1,175 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+const (
+	// TaskQueueDeleteUser represents user deletion and restoration.
+	// It is the default task queue of the delete user and restore user workflows.
+	TaskQueueDeleteUser = "delete-user"
+
+	// ActivityAuthSoftDelete is derived from struct name + method name. See activity registration in worker.
+	ActivityAuthSoftDelete = "DeleteUserActivitySoftDeleteAccount"
+	// ActivityAuthRestore is derived from struct name + method name. See activity registration in worker.
+	ActivityAuthRestore = "DeleteUserActivityRestoreAccount"
+	// ActivityWalletFreeze is derived from struct name + method name. See activity registration in worker.
+	ActivityWalletFreeze = "DeleteUserActivityFreezeWallets"
+	// ActivityWalletUnfreeze is derived from struct name + method name. See activity registration in worker.
+	ActivityWalletUnfreeze = "DeleteUserActivityUnfreezeWallets"
+	// ActivityUserSoftDelete is derived from struct name + method name. See activity registration in worker.
+	ActivityUserSoftDelete = "DeleteUserActivitySoftDeleteInUser"
+	// ActivityUserRestore is derived from struct name + method name. See activity registration in worker.
+	ActivityUserRestore = "DeleteUserActivityRestoreInUser"
+
+	// WorkflowTypeDeleteUser is the name the delete user workflow is registered with in worker.
+	WorkflowTypeDeleteUser = "DeleteUser"
+	// WorkflowTypeRestoreUser is the name the restore user workflow is registered with in worker.
+	WorkflowTypeRestoreUser = "RestoreUser"
+	// WorkflowNameDeleteUser is derived from the process itself.
+	WorkflowNameDeleteUser = "delete-user"
+	// WorkflowNameRestoreUser is derived from the process itself.
+	WorkflowNameRestoreUser = "restore-user"
+
+	// ErrNonRetryableDeletionRolledBack occurs when a step of deletion or restoration fails and every completed step is compensated.
+	ErrNonRetryableDeletionRolledBack = "non-retryable-deletion-rolled-back"
+	// ErrNonRetryableDeletionPartiallyFailed occurs when a step of deletion or restoration fails and some completed steps can't be compensated.
+	ErrNonRetryableDeletionPartiallyFailed = "non-retryable-deletion-partially-failed"
+)
+
+// DeleteUserWorkflow is responsible to execute delete user and restore user workflows.
+type DeleteUserWorkflow struct {
+	client client.Client
+	policy *Policy
+}
+
+// NewDeleteUserWorkflow creates an instance of DeleteUserWorkflow.
+func NewDeleteUserWorkflow(client client.Client, policy *Policy) *DeleteUserWorkflow {
+	return &DeleteUserWorkflow{client: client, policy: policy}
+}
+
+// DeleteUser runs the delete user workflow and waits until it is done.
+func (d *DeleteUserWorkflow) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	return d.execute(ctx, fmt.Sprintf("%s-%s", WorkflowNameDeleteUser, id), WorkflowTypeDeleteUser, id)
+}
+
+// RestoreUser runs the restore user workflow and waits until it is done.
+func (d *DeleteUserWorkflow) RestoreUser(ctx context.Context, id uuid.UUID) error {
+	return d.execute(ctx, fmt.Sprintf("%s-%s", WorkflowNameRestoreUser, id), WorkflowTypeRestoreUser, id)
+}
+
+func (d *DeleteUserWorkflow) execute(ctx context.Context, workflowID, workflowType string, id uuid.UUID) error {
+	opts := client.StartWorkflowOptions{
+		ID:                 workflowID,
+		TaskQueue:          d.policy.TaskQueue,
+		WorkflowRunTimeout: d.policy.WorkflowTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: d.policy.WorkflowMaximumAttempts,
+		},
+	}
+	wr, err := d.client.ExecuteWorkflow(ctx, opts, workflowType, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserWorkflow-execute] fail to start workflow", "workflow-type", workflowType, "error", err)
+		return entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	slog.InfoContext(ctx, "[DeleteUserWorkflow-execute] started workflow", "workflow-id", wr.GetID(), "run-id", wr.GetRunID())
+
+	if err = wr.Get(ctx, nil); err != nil {
+		slog.ErrorContext(ctx, "[DeleteUserWorkflow-execute] error get workflow result", "workflow-type", workflowType, "error", err)
+		return entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	return nil
+}
+
+// DeleteUserDefinition defines the delete user and restore user workflows run by the worker.
+type DeleteUserDefinition struct {
+	policy *Policy
+}
+
+// NewDeleteUserDefinition creates an instance of DeleteUserDefinition.
+func NewDeleteUserDefinition(policy *Policy) *DeleteUserDefinition {
+	return &DeleteUserDefinition{policy: policy}
+}
+
+// deletionStep is a step of the workflow and the activity that undoes it.
+// The last step doesn't need to be undone, so its compensation is empty.
+type deletionStep struct {
+	activity     string
+	compensation string
+}
+
+// DeleteUser runs the user deletion workflow.
+// It soft-deletes the account, so the user can't login, and freezes the wallets before soft-deleting the user.
+// When a step fails, the completed steps are compensated in reverse order.
+// It must be registered in worker using WorkflowTypeDeleteUser as its name.
+func (d *DeleteUserDefinition) DeleteUser(ctx tempflow.Context, id uuid.UUID) error {
+	return d.run(ctx, id, []deletionStep{
+		{activity: ActivityAuthSoftDelete, compensation: ActivityAuthRestore},
+		{activity: ActivityWalletFreeze, compensation: ActivityWalletUnfreeze},
+		{activity: ActivityUserSoftDelete},
+	})
+}
+
+// RestoreUser runs the user restoration workflow.
+// The user is restored last, so the user stays deleted until the account and the wallets are restored.
+// When a step fails, the completed steps are compensated in reverse order.
+// It must be registered in worker using WorkflowTypeRestoreUser as its name.
+func (d *DeleteUserDefinition) RestoreUser(ctx tempflow.Context, id uuid.UUID) error {
+	return d.run(ctx, id, []deletionStep{
+		{activity: ActivityAuthRestore, compensation: ActivityAuthSoftDelete},
+		{activity: ActivityWalletUnfreeze, compensation: ActivityWalletFreeze},
+		{activity: ActivityUserRestore},
+	})
+}
+
+func (d *DeleteUserDefinition) run(ctx tempflow.Context, id uuid.UUID, steps []deletionStep) error {
+	if id == uuid.Nil {
+		return entity.ErrEmptyUser()
+	}
+
+	var compensations []compensation
+	for _, step := range steps {
+		actCtx := tempflow.WithActivityOptions(ctx, d.policy.activityOptions(step.activity, false))
+		if err := tempflow.ExecuteActivity(actCtx, step.activity, id).Get(actCtx, nil); err != nil {
+			return d.compensate(ctx, compensations, err)
+		}
+		if step.compensation != "" {
+			compensations = append(compensations, compensation{activity: step.compensation, arg: id})
+		}
+	}
+	return nil
+}
+
+// compensate executes the compensations in reverse order.
+// Every compensation is executed even if the previous one fails, so as much as possible is undone.
+func (d *DeleteUserDefinition) compensate(ctx tempflow.Context, compensations []compensation, cause error) error {
+	ctx, _ = tempflow.NewDisconnectedContext(ctx)
+
+	var failed []string
+	for i := len(compensations) - 1; i >= 0; i-- {
+		c := compensations[i]
+		actCtx := tempflow.WithActivityOptions(ctx, d.policy.activityOptions(c.activity, true))
+		if err := tempflow.ExecuteActivity(actCtx, c.activity, c.arg).Get(actCtx, nil); err != nil {
+			tempflow.GetLogger(ctx).Error("[DeleteUser-compensate] fail compensate", "activity", c.activity, "error", err)
+			failed = append(failed, c.activity)
+		}
+	}
+
+	name := tempflow.GetInfo(ctx).WorkflowType.Name
+	if len(failed) > 0 {
+		msg := fmt.Sprintf("%s failed: %v; fail to compensate: %s", name, cause, strings.Join(failed, ", "))
+		return temporal.NewNonRetryableApplicationError(msg, ErrNonRetryableDeletionPartiallyFailed, cause, failed)
+	}
+	msg := fmt.Sprintf("%s is rolled back: %v", name, cause)
+	return temporal.NewNonRetryableApplicationError(msg, ErrNonRetryableDeletionRolledBack, cause)
+}
@@ -0,0 +1,293 @@
+package workflow_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	tempomock "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/user/internal/connection/auth"
+	"github.com/indrasaputra/arjuna/service/user/internal/connection/wallet"
+	orcact "github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/activity"
+	"github.com/indrasaputra/arjuna/service/user/internal/orchestration/temporal/workflow"
+	"github.com/indrasaputra/arjuna/service/user/internal/repository/postgres"
+)
+
+type DeleteUserWorkflowSuite struct {
+	workflow *workflow.DeleteUserWorkflow
+	client   *tempomock.Client
+}
+
+func TestNewDeleteUserWorkflow(t *testing.T) {
+	t.Run("successfully create an instance of DeleteUserWorkflow", func(t *testing.T) {
+		st := createDeleteUserWorkflowSuite()
+		assert.NotNil(t, st.workflow)
+	})
+}
+
+func TestDeleteUserWorkflow_DeleteUser(t *testing.T) {
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createDeleteUserWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeDeleteUser, user.ID).
+			Return(nil, assert.AnError)
+
+		err := st.workflow.DeleteUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("workflow run returns error", func(t *testing.T) {
+		st := createDeleteUserWorkflowSuite()
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeDeleteUser, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(assert.AnError)
+
+		err := st.workflow.DeleteUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		policy := workflow.DefaultDeleteUserPolicy()
+		policy.WorkflowTimeout = 5 * time.Minute
+		st := createDeleteUserWorkflowSuiteWithPolicy(policy)
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		isOptionsFromPolicy := func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == "delete-user-"+user.ID.String() &&
+				opts.TaskQueue == workflow.TaskQueueDeleteUser &&
+				opts.WorkflowRunTimeout == 5*time.Minute
+		}
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.MatchedBy(isOptionsFromPolicy), workflow.WorkflowTypeDeleteUser, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(nil)
+
+		err := st.workflow.DeleteUser(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteUserWorkflow_RestoreUser(t *testing.T) {
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createDeleteUserWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeRestoreUser, user.ID).
+			Return(nil, assert.AnError)
+
+		err := st.workflow.RestoreUser(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createDeleteUserWorkflowSuite()
+		user := createTestUser()
+		wr := &tempomock.WorkflowRun{}
+
+		isRestoreWorkflow := func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == "restore-user-"+user.ID.String()
+		}
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.MatchedBy(isRestoreWorkflow), workflow.WorkflowTypeRestoreUser, user.ID).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+		wr.On("Get", testCtx, mock.Anything).Return(nil)
+
+		err := st.workflow.RestoreUser(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+type DeleteUserSuite struct {
+	env *testsuite.TestWorkflowEnvironment
+	testsuite.WorkflowTestSuite
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("id is empty", func(t *testing.T) {
+		st := createDeleteUserSuite()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeDeleteUser, uuid.Nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("AuthSoftDelete activity returns error", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityAuthSoftDelete, mock.Anything, user.ID).Return(assert.AnError)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeDeleteUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableDeletionRolledBack)
+	})
+
+	t.Run("UserSoftDelete activity returns error and compensations run in reverse order", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+		var order []string
+
+		st.env.OnActivity(workflow.ActivityAuthSoftDelete, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletFreeze, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityUserSoftDelete, mock.Anything, user.ID).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityWalletUnfreeze, mock.Anything, user.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityWalletUnfreeze)
+				return nil
+			})
+		st.env.OnActivity(workflow.ActivityAuthRestore, mock.Anything, user.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityAuthRestore)
+				return nil
+			})
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeDeleteUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableDeletionRolledBack)
+		assert.Equal(t, []string{workflow.ActivityWalletUnfreeze, workflow.ActivityAuthRestore}, order)
+	})
+
+	t.Run("compensation fails and the rest is still compensated", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityAuthSoftDelete, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletFreeze, mock.Anything, user.ID).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityAuthRestore, mock.Anything, user.ID).Return(assert.AnError).Times(workflow.CompensationRetryMaximumAttempts)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeDeleteUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		err := st.env.GetWorkflowError()
+		assertWorkflowErrorType(t, err, workflow.ErrNonRetryableDeletionPartiallyFailed)
+		assert.Contains(t, err.Error(), workflow.ActivityAuthRestore)
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityAuthSoftDelete, mock.Anything, user.ID).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletFreeze, mock.Anything, user.ID).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityUserSoftDelete, mock.Anything, user.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeDeleteUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+}
+
+func TestRestoreUser(t *testing.T) {
+	t.Run("id is empty", func(t *testing.T) {
+		st := createDeleteUserSuite()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRestoreUser, uuid.Nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("UserRestore activity returns error and compensations run in reverse order", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+		var order []string
+
+		st.env.OnActivity(workflow.ActivityAuthRestore, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletUnfreeze, mock.Anything, user.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityUserRestore, mock.Anything, user.ID).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityWalletFreeze, mock.Anything, user.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityWalletFreeze)
+				return nil
+			})
+		st.env.OnActivity(workflow.ActivityAuthSoftDelete, mock.Anything, user.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityAuthSoftDelete)
+				return nil
+			})
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRestoreUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableDeletionRolledBack)
+		assert.Equal(t, []string{workflow.ActivityWalletFreeze, workflow.ActivityAuthSoftDelete}, order)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		st := createDeleteUserSuite()
+		user := createTestUser()
+
+		st.env.OnActivity(workflow.ActivityAuthRestore, mock.Anything, user.ID).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletUnfreeze, mock.Anything, user.ID).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityUserRestore, mock.Anything, user.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRestoreUser, user.ID)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+}
+
+func createDeleteUserWorkflowSuite() *DeleteUserWorkflowSuite {
+	return createDeleteUserWorkflowSuiteWithPolicy(workflow.DefaultDeleteUserPolicy())
+}
+
+func createDeleteUserWorkflowSuiteWithPolicy(policy *workflow.Policy) *DeleteUserWorkflowSuite {
+	c := &tempomock.Client{}
+	w := workflow.NewDeleteUserWorkflow(c, policy)
+	return &DeleteUserWorkflowSuite{
+		workflow: w,
+		client:   c,
+	}
+}
+
+func createDeleteUserSuite() *DeleteUserSuite {
+	s := &DeleteUserSuite{}
+	s.env = s.NewTestWorkflowEnvironment()
+
+	at := &auth.Auth{}
+	wt := &wallet.Wallet{}
+	pg := &postgres.User{}
+	uc := orcact.NewDeleteUserActivity(at, wt, pg)
+	def := workflow.NewDeleteUserDefinition(workflow.DefaultDeleteUserPolicy())
+
+	s.env.RegisterActivityWithOptions(uc, activity.RegisterOptions{Name: "DeleteUserActivity", SkipInvalidStructFunctions: true})
+	s.env.RegisterWorkflowWithOptions(def.DeleteUser, tempflow.RegisterOptions{Name: workflow.WorkflowTypeDeleteUser})
+	s.env.RegisterWorkflowWithOptions(def.RestoreUser, tempflow.RegisterOptions{Name: workflow.WorkflowTypeRestoreUser})
+
+	return s
+}
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	tempflow "go.temporal.io/sdk/workflow"
@@ -16,12 +20,15 @@ import (
 
 const (
 	// TaskQueueRegisterUser represents user registration.
+	// It is the default task queue of the register user workflow.
 	TaskQueueRegisterUser = "register-user"
 
 	// ActivityTimeoutDefault sets to 2 seconds.
 	ActivityTimeoutDefault = 2 * time.Second
 	// ActivityAuthCreate is derived from struct name + method name. See activity registration in worker.
 	ActivityAuthCreate = "RegisterUserActivityCreateAccount"
+	// ActivityAuthDelete is derived from struct name + method name. See activity registration in worker.
+	ActivityAuthDelete = "RegisterUserActivityDeleteAccount"
 	// ActivityUserHardDelete is derived from struct name + method name. See activity registration in worker.
 	ActivityUserHardDelete = "RegisterUserActivityHardDeleteInUser"
 	// ActivityWalletCreate is derived from struct name + method name. See activity registration in worker.
@@ -29,104 +36,189 @@ const (
 	// ActivityRetryBackoffCoefficient sets to 2.
 	ActivityRetryBackoffCoefficient = 2
 	// ActivityRetryMaximumAttempts sets to 3.
-	ActivityRetryMaximumAttempts = 1
+	ActivityRetryMaximumAttempts = 3
 	// ActivityRetryInitialInterval sets to 1 second.
 	ActivityRetryInitialInterval = 1 * time.Second
-
-	// WorkflowTimeoutDefault sets ActivityRetryMaximumAttempts * ActivityTimeoutDefault.
-	WorkflowTimeoutDefault = ActivityRetryMaximumAttempts * ActivityTimeoutDefault
+	// CompensationRetryMaximumAttempts sets to 3.
+	// Compensation is retried more than the step since a failed compensation leaves the user partially registered.
+	CompensationRetryMaximumAttempts = 3
+
+	// WorkflowTimeoutDefault sets to 1 minute to cover the steps and their compensations, including the retries.
+	WorkflowTimeoutDefault = 1 * time.Minute
+	// WorkflowTypeRegisterUser is the name the register user workflow is registered with in worker.
+	// It keeps the name derived from the former workflow function, so the running workflows can still be replayed.
+	WorkflowTypeRegisterUser = "RegisterUser"
 	// WorkflowNameRegisterUser is derived from the process itself.
 	WorkflowNameRegisterUser = "register-user"
 	// WorkflowRetryMaximumAttempts sets to 1.
 	WorkflowRetryMaximumAttempts = 1
+	// QueryRegistrationStatus is the query type to get the registration status from the workflow.
+	QueryRegistrationStatus = "registration-status"
 
 	// ErrNonRetryableUserExist occurs when user already exists in system.
 	ErrNonRetryableUserExist = "non-retryable-user-exist"
+	// ErrNonRetryableRegistrationRolledBack occurs when a step fails and every completed step is compensated.
+	ErrNonRetryableRegistrationRolledBack = "non-retryable-registration-rolled-back"
+	// ErrNonRetryableRegistrationPartiallyFailed occurs when a step fails and some completed steps can't be compensated.
+	ErrNonRetryableRegistrationPartiallyFailed = "non-retryable-registration-partially-failed"
 )
 
 // RegisterUserWorkflow is responsible to execute register user workflow.
 type RegisterUserWorkflow struct {
 	client client.Client
+	policy *Policy
 }
 
 // NewRegisterUserWorkflow creates an instance of RegisterUserWorkflow.
-func NewRegisterUserWorkflow(client client.Client) *RegisterUserWorkflow {
-	return &RegisterUserWorkflow{client: client}
+func NewRegisterUserWorkflow(client client.Client, policy *Policy) *RegisterUserWorkflow {
+	return &RegisterUserWorkflow{client: client, policy: policy}
 }
 
-// RegisterUser runs the register users workflow.
+// RegisterUser starts the register user workflow and returns once it has been started.
+// It doesn't wait for the registration to be done, its progress is reported by GetRegistrationStatus.
+// The workflow is started at most once for the user, so starting it again, either running or closed, doesn't return error.
 func (r *RegisterUserWorkflow) RegisterUser(ctx context.Context, input *entity.RegisterUserInput) (*entity.RegisterUserOutput, error) {
 	opts := client.StartWorkflowOptions{
-		ID:                 fmt.Sprintf("%s-%s", WorkflowNameRegisterUser, input.User.ID),
-		TaskQueue:          TaskQueueRegisterUser,
-		WorkflowRunTimeout: WorkflowTimeoutDefault,
+		ID:                    createRegisterUserWorkflowID(input.User.ID),
+		TaskQueue:             r.policy.TaskQueue,
+		WorkflowRunTimeout:    r.policy.WorkflowTimeout,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
 		RetryPolicy: &temporal.RetryPolicy{
-			MaximumAttempts: WorkflowRetryMaximumAttempts,
+			MaximumAttempts: r.policy.WorkflowMaximumAttempts,
 			NonRetryableErrorTypes: []string{
 				ErrNonRetryableUserExist,
 			},
 		},
 	}
-	wr, err := r.client.ExecuteWorkflow(ctx, opts, RegisterUser, input)
+	wr, err := r.client.ExecuteWorkflow(ctx, opts, WorkflowTypeRegisterUser, input)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		slog.InfoContext(ctx, "[RegisterUserWorkflow-RegisterUser] workflow has been started", "workflow-id", opts.ID, "run-id", alreadyStarted.RunId)
+		return &entity.RegisterUserOutput{}, nil
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "[RegisterUserWorkflow-RegisterUser] fail to start workflow", "error", err)
 		return nil, entity.ErrInternal("Something went wrong within our server. Please, try again")
 	}
 	slog.InfoContext(ctx, "[RegisterUserWorkflow-RegisterUser] started workflow", "workflow-id", wr.GetID(), "run-id", wr.GetRunID())
+	return &entity.RegisterUserOutput{}, nil
+}
 
-	var output *entity.RegisterUserOutput
-	err = wr.Get(ctx, &output)
+// GetRegistrationStatus queries the registration status from the user's register user workflow.
+// It returns entity.ErrNotFound if the workflow doesn't exist.
+func (r *RegisterUserWorkflow) GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error) {
+	val, err := r.client.QueryWorkflow(ctx, createRegisterUserWorkflowID(userID), "", QueryRegistrationStatus)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil, entity.ErrNotFound()
+	}
 	if err != nil {
-		var appErr *temporal.ApplicationError
-		if errors.As(err, &appErr) && appErr.Type() == ErrNonRetryableUserExist {
-			return nil, entity.ErrAlreadyExists()
-		}
-		slog.ErrorContext(ctx, "[RegisterUserWorkflow-RegisterUser] error get workflow result", "error", err)
+		slog.ErrorContext(ctx, "[RegisterUserWorkflow-GetRegistrationStatus] fail query workflow", "error", err)
+		return nil, entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+
+	var status *entity.RegistrationStatus
+	if err := val.Get(&status); err != nil {
+		slog.ErrorContext(ctx, "[RegisterUserWorkflow-GetRegistrationStatus] fail decode query result", "error", err)
 		return nil, entity.ErrInternal("Something went wrong within our server. Please, try again")
 	}
-	return output, nil
+	return status, nil
+}
+
+// RegisterUserDefinition defines the register user workflow run by the worker.
+type RegisterUserDefinition struct {
+	policy *Policy
+}
+
+// NewRegisterUserDefinition creates an instance of RegisterUserDefinition.
+func NewRegisterUserDefinition(policy *Policy) *RegisterUserDefinition {
+	return &RegisterUserDefinition{policy: policy}
 }
 
 // RegisterUser runs the user registration workflow.
-func RegisterUser(ctx tempflow.Context, input *entity.RegisterUserInput) (*entity.RegisterUserOutput, error) {
+// When a step fails, the completed steps are compensated in reverse order.
+// The user is stored before the workflow starts, so deleting it is always the last compensation.
+// It must be registered in worker using WorkflowTypeRegisterUser as its name.
+func (d *RegisterUserDefinition) RegisterUser(ctx tempflow.Context, input *entity.RegisterUserInput) (*entity.RegisterUserOutput, error) {
 	if err := validateRegisterUserInput(input); err != nil {
 		return nil, err
 	}
 
-	ctx = createContextWithActivityOptions(ctx, ActivityTimeoutDefault, TaskQueueRegisterUser)
-	err := tempflow.ExecuteActivity(ctx, ActivityAuthCreate, input.User).Get(ctx, nil)
+	status := &entity.RegistrationStatus{State: entity.RegistrationStatePending}
+	err := tempflow.SetQueryHandler(ctx, QueryRegistrationStatus, func() (*entity.RegistrationStatus, error) {
+		return status, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	ctx = createContextWithActivityOptions(ctx, ActivityTimeoutDefault, TaskQueueRegisterUser)
-	err = tempflow.ExecuteActivity(ctx, ActivityWalletCreate, input.User).Get(ctx, nil)
+	compensations := []compensation{{activity: ActivityUserHardDelete, arg: input.User.ID}}
+
+	err = d.executeActivity(ctx, ActivityAuthCreate, input.User)
+	if err != nil {
+		return nil, failRegistration(status, d.compensate(ctx, compensations, err))
+	}
+	compensations = append(compensations, compensation{activity: ActivityAuthDelete, arg: input.User.ID})
+
+	err = d.executeActivity(ctx, ActivityWalletCreate, input.User)
 	if err != nil {
-		ctx = createContextWithActivityOptions(ctx, ActivityTimeoutDefault, TaskQueueRegisterUser)
-		_ = tempflow.ExecuteActivity(ctx, ActivityUserHardDelete, input.User.ID).Get(ctx, nil)
-		return nil, entity.ErrInternal("Something went wrong within our server. Please try again")
+		return nil, failRegistration(status, d.compensate(ctx, compensations, err))
 	}
+
+	status.State = entity.RegistrationStateCompleted
 	return &entity.RegisterUserOutput{}, nil
 }
 
-func createContextWithActivityOptions(tempoCtx tempflow.Context, timeout time.Duration, queue string) tempflow.Context {
-	opts := createActivityOptions(timeout, queue)
-	return tempflow.WithActivityOptions(tempoCtx, opts)
+// failRegistration marks the status as failed with the reason shown to the user.
+// The reason is kept general since the error may contain internal details,
+// and the status can be queried without authentication, so it must not tell whether the email has been registered.
+func failRegistration(status *entity.RegistrationStatus, err error) error {
+	status.State = entity.RegistrationStateFailed
+	status.Reason = "registration can't be completed, please try again"
+	return err
 }
 
-func createActivityOptions(timeout time.Duration, queue string) tempflow.ActivityOptions {
-	return tempflow.ActivityOptions{
-		StartToCloseTimeout: timeout,
-		TaskQueue:           queue,
-		RetryPolicy: &temporal.RetryPolicy{
-			BackoffCoefficient: ActivityRetryBackoffCoefficient,
-			MaximumAttempts:    ActivityRetryMaximumAttempts,
-			InitialInterval:    ActivityRetryInitialInterval,
-			NonRetryableErrorTypes: []string{
-				ErrNonRetryableUserExist,
-			},
-		},
+func createRegisterUserWorkflowID(userID uuid.UUID) string {
+	return fmt.Sprintf("%s-%s", WorkflowNameRegisterUser, userID)
+}
+
+// compensation undoes a completed step by executing the activity with the argument.
+type compensation struct {
+	arg      any
+	activity string
+}
+
+// compensate executes the compensations in reverse order.
+// Every compensation is executed even if the previous one fails, so as much as possible is undone.
+// It returns the cause as is when the user already exists, so the caller can tell it apart.
+func (d *RegisterUserDefinition) compensate(ctx tempflow.Context, compensations []compensation, cause error) error {
+	ctx, _ = tempflow.NewDisconnectedContext(ctx)
+
+	var failed []string
+	for i := len(compensations) - 1; i >= 0; i-- {
+		c := compensations[i]
+		actCtx := tempflow.WithActivityOptions(ctx, d.policy.activityOptions(c.activity, true))
+		if err := tempflow.ExecuteActivity(actCtx, c.activity, c.arg).Get(actCtx, nil); err != nil {
+			tempflow.GetLogger(ctx).Error("[RegisterUser-compensate] fail compensate", "activity", c.activity, "error", err)
+			failed = append(failed, c.activity)
+		}
+	}
+
+	if len(failed) > 0 {
+		msg := fmt.Sprintf("registration failed: %v; fail to compensate: %s", cause, strings.Join(failed, ", "))
+		return temporal.NewNonRetryableApplicationError(msg, ErrNonRetryableRegistrationPartiallyFailed, cause, failed)
+	}
+	var appErr *temporal.ApplicationError
+	if errors.As(cause, &appErr) && appErr.Type() == ErrNonRetryableUserExist {
+		return cause
 	}
+	msg := fmt.Sprintf("registration is rolled back: %v", cause)
+	return temporal.NewNonRetryableApplicationError(msg, ErrNonRetryableRegistrationRolledBack, cause)
+}
+
+func (d *RegisterUserDefinition) executeActivity(ctx tempflow.Context, activity string, arg any) error {
+	ctx = tempflow.WithActivityOptions(ctx, d.policy.activityOptions(activity, false))
+	return tempflow.ExecuteActivity(ctx, activity, arg).Get(ctx, nil)
 }
 
 func validateRegisterUserInput(input *entity.RegisterUserInput) error {
@@ -3,14 +3,20 @@ package workflow_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
 	tempomock "go.temporal.io/sdk/mocks"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
+	tempflow "go.temporal.io/sdk/workflow"
 
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/connection/auth"
@@ -43,7 +49,7 @@ func TestRegisterUserWorkflow_RegisterUser(t *testing.T) {
 		input := &entity.RegisterUserInput{User: user}
 
 		st.client.
-			On("ExecuteWorkflow", testCtx, mock.Anything, mock.AnythingOfType("func(internal.Context, *entity.RegisterUserInput) (*entity.RegisterUserOutput, error)"), input).
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeRegisterUser, input).
 			Return(nil, assert.AnError)
 
 		res, err := st.workflow.RegisterUser(testCtx, input)
@@ -52,61 +58,116 @@ func TestRegisterUserWorkflow_RegisterUser(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
-	t.Run("workflow run returns user already exists error", func(t *testing.T) {
+	t.Run("workflow has been started", func(t *testing.T) {
 		st := createRegisterUserWorkflowSuite()
 		user := createTestUser()
 		input := &entity.RegisterUserInput{User: user}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflow.WorkflowTypeRegisterUser, input).
+			Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("workflow has been started", "", "run-id"))
+
+		res, err := st.workflow.RegisterUser(testCtx, input)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("workflow is executed successfully", func(t *testing.T) {
+		policy := workflow.DefaultRegisterUserPolicy()
+		policy.TaskQueue = "custom-register-user"
+		policy.WorkflowTimeout = 5 * time.Minute
+		policy.WorkflowMaximumAttempts = 2
+		st := createRegisterUserWorkflowSuiteWithPolicy(policy)
+		user := createTestUser()
+		input := &entity.RegisterUserInput{User: user}
 		wr := &tempomock.WorkflowRun{}
 
+		isOptionsFromPolicy := func(opts client.StartWorkflowOptions) bool {
+			return opts.ID == "register-user-"+user.ID.String() &&
+				opts.TaskQueue == "custom-register-user" &&
+				opts.WorkflowIDReusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE &&
+				opts.WorkflowRunTimeout == 5*time.Minute &&
+				opts.RetryPolicy.MaximumAttempts == 2
+		}
 		st.client.
-			On("ExecuteWorkflow", testCtx, mock.Anything, mock.AnythingOfType("func(internal.Context, *entity.RegisterUserInput) (*entity.RegisterUserOutput, error)"), input).
+			On("ExecuteWorkflow", testCtx, mock.MatchedBy(isOptionsFromPolicy), workflow.WorkflowTypeRegisterUser, input).
 			Return(wr, nil)
 		wr.On("GetID").Return("")
 		wr.On("GetRunID").Return("")
-		wr.On("Get", testCtx, mock.Anything).Return(temporal.NewNonRetryableApplicationError("", workflow.ErrNonRetryableUserExist, assert.AnError))
 
 		res, err := st.workflow.RegisterUser(testCtx, input)
 
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		wr.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRegisterUserWorkflow_GetRegistrationStatus(t *testing.T) {
+	t.Run("workflow doesn't exist", func(t *testing.T) {
+		st := createRegisterUserWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("QueryWorkflow", testCtx, "register-user-"+user.ID.String(), "", workflow.QueryRegistrationStatus).
+			Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+		res, err := st.workflow.GetRegistrationStatus(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("query workflow returns error", func(t *testing.T) {
+		st := createRegisterUserWorkflowSuite()
+		user := createTestUser()
+
+		st.client.
+			On("QueryWorkflow", testCtx, "register-user-"+user.ID.String(), "", workflow.QueryRegistrationStatus).
+			Return(nil, assert.AnError)
+
+		res, err := st.workflow.GetRegistrationStatus(testCtx, user.ID)
+
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
 
-	t.Run("workflow run returns internal error", func(t *testing.T) {
+	t.Run("query result can't be decoded", func(t *testing.T) {
 		st := createRegisterUserWorkflowSuite()
 		user := createTestUser()
-		input := &entity.RegisterUserInput{User: user}
-		wr := &tempomock.WorkflowRun{}
+		val := &tempomock.Value{}
 
 		st.client.
-			On("ExecuteWorkflow", testCtx, mock.Anything, mock.AnythingOfType("func(internal.Context, *entity.RegisterUserInput) (*entity.RegisterUserOutput, error)"), input).
-			Return(wr, nil)
-		wr.On("GetID").Return("")
-		wr.On("GetRunID").Return("")
-		wr.On("Get", testCtx, mock.Anything).Return(assert.AnError)
+			On("QueryWorkflow", testCtx, "register-user-"+user.ID.String(), "", workflow.QueryRegistrationStatus).
+			Return(val, nil)
+		val.On("Get", mock.Anything).Return(assert.AnError)
 
-		res, err := st.workflow.RegisterUser(testCtx, input)
+		res, err := st.workflow.GetRegistrationStatus(testCtx, user.ID)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
 
-	t.Run("workflow is executed successfully", func(t *testing.T) {
+	t.Run("success get registration status", func(t *testing.T) {
 		st := createRegisterUserWorkflowSuite()
 		user := createTestUser()
-		input := &entity.RegisterUserInput{User: user}
-		wr := &tempomock.WorkflowRun{}
+		val := &tempomock.Value{}
+		expected := &entity.RegistrationStatus{State: entity.RegistrationStateCompleted}
 
 		st.client.
-			On("ExecuteWorkflow", testCtx, mock.Anything, mock.AnythingOfType("func(internal.Context, *entity.RegisterUserInput) (*entity.RegisterUserOutput, error)"), input).
-			Return(wr, nil)
-		wr.On("GetID").Return("")
-		wr.On("GetRunID").Return("")
-		wr.On("Get", testCtx, mock.Anything).Return(nil)
+			On("QueryWorkflow", testCtx, "register-user-"+user.ID.String(), "", workflow.QueryRegistrationStatus).
+			Return(val, nil)
+		val.On("Get", mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(**entity.RegistrationStatus) = expected
+			}).
+			Return(nil)
 
-		res, err := st.workflow.RegisterUser(testCtx, input)
+		res, err := st.workflow.GetRegistrationStatus(testCtx, user.ID)
 
 		assert.NoError(t, err)
-		assert.Nil(t, res)
+		assert.Equal(t, expected, res)
 	})
 }
 
@@ -119,7 +180,7 @@ func TestRegisterUser(t *testing.T) {
 	t.Run("input is invalid", func(t *testing.T) {
 		st := createRegisterUserSuite()
 
-		st.env.ExecuteWorkflow(workflow.RegisterUser, nil)
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, nil)
 
 		assert.True(t, st.env.IsWorkflowCompleted())
 		assert.Error(t, st.env.GetWorkflowError())
@@ -130,7 +191,7 @@ func TestRegisterUser(t *testing.T) {
 
 		input := createRegisterUserInput()
 		input.User = nil
-		st.env.ExecuteWorkflow(workflow.RegisterUser, input)
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
 
 		assert.True(t, st.env.IsWorkflowCompleted())
 		assert.Error(t, st.env.GetWorkflowError())
@@ -141,25 +202,131 @@ func TestRegisterUser(t *testing.T) {
 		input := createRegisterUserInput()
 
 		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil).Once()
 
-		st.env.ExecuteWorkflow(workflow.RegisterUser, input)
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
 
 		assert.True(t, st.env.IsWorkflowCompleted())
-		assert.Error(t, st.env.GetWorkflowError())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableRegistrationRolledBack)
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("AuthCreate activity returns user already exists", func(t *testing.T) {
+		st := createRegisterUserSuite()
+		input := createRegisterUserInput()
+		errExist := temporal.NewNonRetryableApplicationError("", workflow.ErrNonRetryableUserExist, assert.AnError)
+
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(errExist)
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableUserExist)
+		assertRegistrationStatus(t, st.env, entity.RegistrationStateFailed, "registration can't be completed, please try again")
 	})
 
-	t.Run("WalletCreate activity returns error", func(t *testing.T) {
+	t.Run("WalletCreate activity returns error and compensations run in reverse order", func(t *testing.T) {
 		st := createRegisterUserSuite()
 		input := createRegisterUserInput()
+		var order []string
 
 		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil)
 		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(assert.AnError)
-		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil)
+		st.env.OnActivity(workflow.ActivityAuthDelete, mock.Anything, input.User.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityAuthDelete)
+				return nil
+			})
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				order = append(order, workflow.ActivityUserHardDelete)
+				return nil
+			})
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableRegistrationRolledBack)
+		assert.Equal(t, []string{workflow.ActivityAuthDelete, workflow.ActivityUserHardDelete}, order)
+		assertRegistrationStatus(t, st.env, entity.RegistrationStateFailed, "registration can't be completed, please try again")
+	})
+
+	t.Run("step is retried using the default policy", func(t *testing.T) {
+		st := createRegisterUserSuite()
+		input := createRegisterUserInput()
 
-		st.env.ExecuteWorkflow(workflow.RegisterUser, input)
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(assert.AnError).Times(workflow.ActivityRetryMaximumAttempts)
+		st.env.OnActivity(workflow.ActivityAuthDelete, mock.Anything, input.User.ID).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
 
 		assert.True(t, st.env.IsWorkflowCompleted())
-		assert.Error(t, st.env.GetWorkflowError())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableRegistrationRolledBack)
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("activity override replaces the default policy", func(t *testing.T) {
+		policy := workflow.DefaultRegisterUserPolicy()
+		policy.ActivityOverrides = map[string]workflow.ActivityPolicy{
+			workflow.ActivityWalletCreate: {MaximumAttempts: 5},
+			workflow.ActivityAuthDelete:   {MaximumAttempts: 1},
+		}
+		st := createRegisterUserSuiteWithPolicy(policy)
+		input := createRegisterUserInput()
+
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil).Once()
+		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(assert.AnError).Times(5)
+		st.env.OnActivity(workflow.ActivityAuthDelete, mock.Anything, input.User.ID).Return(assert.AnError).Once()
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assertWorkflowErrorType(t, st.env.GetWorkflowError(), workflow.ErrNonRetryableRegistrationPartiallyFailed)
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("activity timeout is taken from the policy", func(t *testing.T) {
+		policy := workflow.DefaultRegisterUserPolicy()
+		policy.ActivityOverrides = map[string]workflow.ActivityPolicy{
+			workflow.ActivityWalletCreate: {Timeout: 7 * time.Second},
+		}
+		st := createRegisterUserSuiteWithPolicy(policy)
+		input := createRegisterUserInput()
+		timeouts := map[string]time.Duration{}
+
+		st.env.SetOnActivityStartedListener(func(info *activity.Info, _ context.Context, _ converter.EncodedValues) {
+			timeouts[info.ActivityType.Name] = info.StartToCloseTimeout
+		})
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(nil)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.NoError(t, st.env.GetWorkflowError())
+		assert.Equal(t, workflow.ActivityTimeoutDefault, timeouts[workflow.ActivityAuthCreate])
+		assert.Equal(t, 7*time.Second, timeouts[workflow.ActivityWalletCreate])
+	})
+
+	t.Run("compensation fails and the rest is still compensated", func(t *testing.T) {
+		st := createRegisterUserSuite()
+		input := createRegisterUserInput()
+
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil)
+		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(assert.AnError)
+		st.env.OnActivity(workflow.ActivityAuthDelete, mock.Anything, input.User.ID).Return(assert.AnError).Times(workflow.CompensationRetryMaximumAttempts)
+		st.env.OnActivity(workflow.ActivityUserHardDelete, mock.Anything, input.User.ID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		err := st.env.GetWorkflowError()
+		assertWorkflowErrorType(t, err, workflow.ErrNonRetryableRegistrationPartiallyFailed)
+		assert.Contains(t, err.Error(), workflow.ActivityAuthDelete)
+		st.env.AssertExpectations(t)
 	})
 
 	t.Run("workflow is executed successfully", func(t *testing.T) {
@@ -169,7 +336,7 @@ func TestRegisterUser(t *testing.T) {
 		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).Return(nil)
 		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(nil)
 
-		st.env.ExecuteWorkflow(workflow.RegisterUser, input)
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
 
 		assert.True(t, st.env.IsWorkflowCompleted())
 		assert.NoError(t, st.env.GetWorkflowError())
@@ -177,9 +344,45 @@ func TestRegisterUser(t *testing.T) {
 		var res *entity.RegisterUserOutput
 		_ = st.env.GetWorkflowResult(&res)
 		assert.NotNil(t, res)
+		assertRegistrationStatus(t, st.env, entity.RegistrationStateCompleted, "")
+	})
+
+	t.Run("registration status is pending while a step is running", func(t *testing.T) {
+		st := createRegisterUserSuite()
+		input := createRegisterUserInput()
+
+		st.env.OnActivity(workflow.ActivityAuthCreate, mock.Anything, input.User).
+			Return(func(_ context.Context, _ *entity.User) error {
+				assertRegistrationStatus(t, st.env, entity.RegistrationStatePending, "")
+				return nil
+			})
+		st.env.OnActivity(workflow.ActivityWalletCreate, mock.Anything, input.User).Return(nil)
+
+		st.env.ExecuteWorkflow(workflow.WorkflowTypeRegisterUser, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
 	})
 }
 
+func assertRegistrationStatus(t *testing.T, env *testsuite.TestWorkflowEnvironment, state entity.RegistrationState, reason string) {
+	val, err := env.QueryWorkflow(workflow.QueryRegistrationStatus)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var status *entity.RegistrationStatus
+	assert.NoError(t, val.Get(&status))
+	assert.Equal(t, state, status.State)
+	assert.Equal(t, reason, status.Reason)
+}
+
+func assertWorkflowErrorType(t *testing.T, err error, errType string) {
+	var appErr *temporal.ApplicationError
+	if assert.ErrorAs(t, err, &appErr) {
+		assert.Equal(t, errType, appErr.Type())
+	}
+}
+
 func createTestUser() *entity.User {
 	return &entity.User{
 		ID:    uuid.Must(uuid.NewV7()),
@@ -195,8 +398,12 @@ func createRegisterUserInput() *entity.RegisterUserInput {
 }
 
 func createRegisterUserWorkflowSuite() *RegisterUserWorkflowSuite {
+	return createRegisterUserWorkflowSuiteWithPolicy(workflow.DefaultRegisterUserPolicy())
+}
+
+func createRegisterUserWorkflowSuiteWithPolicy(policy *workflow.Policy) *RegisterUserWorkflowSuite {
 	c := &tempomock.Client{}
-	w := workflow.NewRegisterUserWorkflow(c)
+	w := workflow.NewRegisterUserWorkflow(c, policy)
 	return &RegisterUserWorkflowSuite{
 		workflow: w,
 		client:   c,
@@ -204,6 +411,10 @@ func createRegisterUserWorkflowSuite() *RegisterUserWorkflowSuite {
 }
 
 func createRegisterUserSuite() *RegisterUserSuite {
+	return createRegisterUserSuiteWithPolicy(workflow.DefaultRegisterUserPolicy())
+}
+
+func createRegisterUserSuiteWithPolicy(policy *workflow.Policy) *RegisterUserSuite {
 	s := &RegisterUserSuite{}
 	s.env = s.NewTestWorkflowEnvironment()
 
@@ -213,6 +424,7 @@ func createRegisterUserSuite() *RegisterUserSuite {
 	uc := orcact.NewRegisterUserActivity(at, wt, pg)
 
 	s.env.RegisterActivityWithOptions(uc, activity.RegisterOptions{Name: "RegisterUserActivity", SkipInvalidStructFunctions: true})
+	s.env.RegisterWorkflowWithOptions(workflow.NewRegisterUserDefinition(policy).RegisterUser, tempflow.RegisterOptions{Name: workflow.WorkflowTypeRegisterUser})
 
 	return s
 }
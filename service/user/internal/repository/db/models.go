@@ -14,48 +14,61 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
-type UserOutboxStatus string
+type OutboxStatus string
 
 const (
-	UserOutboxStatusREADY     UserOutboxStatus = "READY"
-	UserOutboxStatusPROCESSED UserOutboxStatus = "PROCESSED"
-	UserOutboxStatusDELIVERED UserOutboxStatus = "DELIVERED"
-	UserOutboxStatusFAILED    UserOutboxStatus = "FAILED"
+	OutboxStatusREADY      OutboxStatus = "READY"
+	OutboxStatusPROCESSED  OutboxStatus = "PROCESSED"
+	OutboxStatusDELIVERED  OutboxStatus = "DELIVERED"
+	OutboxStatusFAILED     OutboxStatus = "FAILED"
+	OutboxStatusDEADLETTER OutboxStatus = "DEAD_LETTER"
 )
 
-func (e *UserOutboxStatus) Scan(src interface{}) error {
+func (e *OutboxStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = UserOutboxStatus(s)
+		*e = OutboxStatus(s)
 	case string:
-		*e = UserOutboxStatus(s)
+		*e = OutboxStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for UserOutboxStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OutboxStatus: %T", src)
 	}
 	return nil
 }
 
-type NullUserOutboxStatus struct {
-	UserOutboxStatus UserOutboxStatus
-	Valid            bool // Valid is true if UserOutboxStatus is not NULL
+type NullOutboxStatus struct {
+	OutboxStatus OutboxStatus
+	Valid        bool // Valid is true if OutboxStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullUserOutboxStatus) Scan(value interface{}) error {
+func (ns *NullOutboxStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.UserOutboxStatus, ns.Valid = "", false
+		ns.OutboxStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.UserOutboxStatus.Scan(value)
+	return ns.OutboxStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullUserOutboxStatus) Value() (driver.Value, error) {
+func (ns NullOutboxStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.UserOutboxStatus), nil
+	return string(ns.OutboxStatus), nil
+}
+
+type Outbox struct {
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	LastError     *string
+	Topic         string
+	Status        OutboxStatus
+	Payload       []byte
+	Attempts      int32
+	ID            uuid.UUID
 }
 
 type User struct {
@@ -69,14 +82,10 @@ type User struct {
 	UpdatedBy uuid.UUID
 }
 
-type UsersOutbox struct {
+type UsersDataExport struct {
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	Payload   *entity.User
-	DeletedAt *time.Time
-	DeletedBy *uuid.UUID
-	Status    UserOutboxStatus
+	ExpiresAt time.Time
+	Archive   *entity.UserDataArchive
 	ID        uuid.UUID
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
+	UserID    uuid.UUID
 }
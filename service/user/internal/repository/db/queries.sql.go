@@ -14,6 +14,34 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
+const anonymizeUserByID = `-- name: AnonymizeUserByID :exec
+WITH anonymized_outboxes AS (
+    UPDATE outbox SET payload = (payload - 'password' - 'password_hash') || jsonb_build_object('name', $1::TEXT, 'email', $3::TEXT), updated_at = NOW()
+    WHERE topic = $4::TEXT AND payload->>'id' = CAST($2::UUID AS TEXT)
+), deleted_exports AS (
+    DELETE FROM users_data_exports WHERE user_id = $2::UUID
+)
+UPDATE users SET name = $1::TEXT, deleted_at = COALESCE(deleted_at, NOW()), deleted_by = COALESCE(deleted_by, id), updated_at = NOW(), updated_by = id
+WHERE id = $2::UUID
+`
+
+type AnonymizeUserByIDParams struct {
+	Name  string
+	Email string
+	Topic string
+	ID    uuid.UUID
+}
+
+func (q *Queries) AnonymizeUserByID(ctx context.Context, arg AnonymizeUserByIDParams) error {
+	_, err := q.db.Exec(ctx, anonymizeUserByID,
+		arg.Name,
+		arg.ID,
+		arg.Email,
+		arg.Topic,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT INTO
 users (id, name, created_at, updated_at, created_by, updated_by)
@@ -41,78 +69,72 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	return err
 }
 
-const createUserOutbox = `-- name: CreateUserOutbox :exec
+const createUserDataExport = `-- name: CreateUserDataExport :exec
 INSERT INTO
-users_outbox (id, status, payload, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+users_data_exports (id, user_id, archive, created_at, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO NOTHING
 `
 
-type CreateUserOutboxParams struct {
+type CreateUserDataExportParams struct {
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	Payload   *entity.User
-	Status    UserOutboxStatus
+	ExpiresAt time.Time
+	Archive   *entity.UserDataArchive
 	ID        uuid.UUID
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
+	UserID    uuid.UUID
 }
 
-func (q *Queries) CreateUserOutbox(ctx context.Context, arg CreateUserOutboxParams) error {
-	_, err := q.db.Exec(ctx, createUserOutbox,
+func (q *Queries) CreateUserDataExport(ctx context.Context, arg CreateUserDataExportParams) error {
+	_, err := q.db.Exec(ctx, createUserDataExport,
 		arg.ID,
-		arg.Status,
-		arg.Payload,
+		arg.UserID,
+		arg.Archive,
 		arg.CreatedAt,
-		arg.UpdatedAt,
-		arg.CreatedBy,
-		arg.UpdatedBy,
+		arg.ExpiresAt,
 	)
 	return err
 }
 
-const getAllUserOutboxesForUpdateByStatus = `-- name: GetAllUserOutboxesForUpdateByStatus :many
-SELECT id, payload, status, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users_outbox
-WHERE status = $1
-ORDER BY created_at ASC LIMIT $2
+const deleteExpiredUserDataExports = `-- name: DeleteExpiredUserDataExports :execrows
+DELETE FROM users_data_exports
+WHERE expires_at <= NOW()
 `
 
-type GetAllUserOutboxesForUpdateByStatusParams struct {
-	Status UserOutboxStatus
-	Limit  int32
-}
-
-func (q *Queries) GetAllUserOutboxesForUpdateByStatus(ctx context.Context, arg GetAllUserOutboxesForUpdateByStatusParams) ([]*UsersOutbox, error) {
-	rows, err := q.db.Query(ctx, getAllUserOutboxesForUpdateByStatus, arg.Status, arg.Limit)
+func (q *Queries) DeleteExpiredUserDataExports(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredUserDataExports)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*UsersOutbox
-	for rows.Next() {
-		var i UsersOutbox
-		if err := rows.Scan(
-			&i.ID,
-			&i.Payload,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.DeletedAt,
-			&i.CreatedBy,
-			&i.UpdatedBy,
-			&i.DeletedBy,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
+}
+
+const deleteUserDataExportByID = `-- name: DeleteUserDataExportByID :one
+DELETE FROM users_data_exports
+WHERE id = $1 AND user_id = $2 AND expires_at > NOW()
+RETURNING id, user_id, archive, created_at, expires_at
+`
+
+type DeleteUserDataExportByIDParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteUserDataExportByID(ctx context.Context, arg DeleteUserDataExportByIDParams) (*UsersDataExport, error) {
+	row := q.db.QueryRow(ctx, deleteUserDataExportByID, arg.ID, arg.UserID)
+	var i UsersDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Archive,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return &i, err
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users LIMIT $1
+SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users
+WHERE deleted_at IS NULL LIMIT $1
 `
 
 func (q *Queries) GetAllUsers(ctx context.Context, limit int32) ([]*User, error) {
@@ -144,9 +166,30 @@ func (q *Queries) GetAllUsers(ctx context.Context, limit int32) ([]*User, error)
 	return items, nil
 }
 
+const getDeletedUserByID = `-- name: GetDeletedUserByID :one
+SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users
+WHERE id = $1 AND deleted_at IS NOT NULL LIMIT 1
+`
+
+func (q *Queries) GetDeletedUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	row := q.db.QueryRow(ctx, getDeletedUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+	)
+	return &i, err
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users
-WHERE id = $1 LIMIT 1
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
@@ -165,6 +208,55 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 	return &i, err
 }
 
+const getUserByIDIncludingDeleted = `-- name: GetUserByIDIncludingDeleted :one
+SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*User, error) {
+	row := q.db.QueryRow(ctx, getUserByIDIncludingDeleted, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+	)
+	return &i, err
+}
+
+const getUserOutboxByUserID = `-- name: GetUserOutboxByUserID :one
+SELECT id, topic, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at FROM outbox
+WHERE topic = $1::TEXT AND payload->>'id' = $2::TEXT
+ORDER BY created_at DESC LIMIT 1
+`
+
+type GetUserOutboxByUserIDParams struct {
+	Topic  string
+	UserID string
+}
+
+func (q *Queries) GetUserOutboxByUserID(ctx context.Context, arg GetUserOutboxByUserIDParams) (*Outbox, error) {
+	row := q.db.QueryRow(ctx, getUserOutboxByUserID, arg.Topic, arg.UserID)
+	var i Outbox
+	err := row.Scan(
+		&i.ID,
+		&i.Topic,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const hardDeleteUserByID = `-- name: HardDeleteUserByID :exec
 DELETE FROM users
 WHERE id = $1
@@ -175,17 +267,55 @@ func (q *Queries) HardDeleteUserByID(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const updateUserOutboxID = `-- name: UpdateUserOutboxID :exec
-UPDATE users_outbox SET status = $1, updated_at = NOW()
-WHERE id = $2
+const restoreUserByID = `-- name: RestoreUserByID :exec
+UPDATE users SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW(), updated_by = id
+WHERE id = $1 AND deleted_at IS NOT NULL
 `
 
-type UpdateUserOutboxIDParams struct {
-	Status UserOutboxStatus
-	ID     uuid.UUID
+func (q *Queries) RestoreUserByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, restoreUserByID, id)
+	return err
+}
+
+const softDeleteUserByID = `-- name: SoftDeleteUserByID :exec
+UPDATE users SET deleted_at = $1::TIMESTAMP, deleted_by = id, updated_at = $1::TIMESTAMP, updated_by = id
+WHERE id = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteUserByIDParams struct {
+	DeletedAt time.Time
+	ID        uuid.UUID
 }
 
-func (q *Queries) UpdateUserOutboxID(ctx context.Context, arg UpdateUserOutboxIDParams) error {
-	_, err := q.db.Exec(ctx, updateUserOutboxID, arg.Status, arg.ID)
+func (q *Queries) SoftDeleteUserByID(ctx context.Context, arg SoftDeleteUserByIDParams) error {
+	_, err := q.db.Exec(ctx, softDeleteUserByID, arg.DeletedAt, arg.ID)
 	return err
 }
+
+const updateUserProfile = `-- name: UpdateUserProfile :one
+UPDATE users SET name = $1, updated_at = NOW(), updated_by = id
+WHERE id = $2 AND updated_at = $3 AND deleted_at IS NULL
+RETURNING id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by
+`
+
+type UpdateUserProfileParams struct {
+	UpdatedAt time.Time
+	Name      string
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (*User, error) {
+	row := q.db.QueryRow(ctx, updateUserProfile, arg.Name, arg.ID, arg.UpdatedAt)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+	)
+	return &i, err
+}
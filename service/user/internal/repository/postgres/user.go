@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +14,11 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/internal/repository/db"
 )
 
+const (
+	erasedName        = "Erased User"
+	erasedEmailDomain = "erased.invalid"
+)
+
 // User is responsible to connect user entity with users table in PostgreSQL.
 type User struct {
 	queries *db.Queries
@@ -49,7 +56,7 @@ func (u *User) Insert(ctx context.Context, user *entity.User) error {
 }
 
 // GetByID gets a user from database.
-// It returns entity.ErrNotFound if user can't be found.
+// It returns entity.ErrNotFound if user can't be found or has been soft-deleted.
 func (u *User) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	user, err := u.queries.GetUserByID(ctx, id)
 	if errors.Is(err, sdkpostgres.ErrNotFound) {
@@ -72,7 +79,60 @@ func (u *User) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	}, nil
 }
 
+// GetByIDIncludingDeleted gets a user from database, including the soft-deleted one.
+// It returns entity.ErrNotFound if user can't be found.
+func (u *User) GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	user, err := u.queries.GetUserByIDIncludingDeleted(ctx, id)
+	if errors.Is(err, sdkpostgres.ErrNotFound) {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-GetByIDIncludingDeleted] fail get user", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	return &entity.User{
+		ID:   user.ID,
+		Name: user.Name,
+		Auditable: entity.Auditable{
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+			DeletedAt: user.DeletedAt,
+			CreatedBy: user.CreatedBy,
+			UpdatedBy: user.UpdatedBy,
+			DeletedBy: user.DeletedBy,
+		},
+	}, nil
+}
+
+// GetDeletedByID gets a soft-deleted user from database.
+// It returns entity.ErrNotFound if user can't be found or isn't soft-deleted.
+func (u *User) GetDeletedByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	user, err := u.queries.GetDeletedUserByID(ctx, id)
+	if errors.Is(err, sdkpostgres.ErrNotFound) {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-GetDeletedByID] fail get deleted user", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	return &entity.User{
+		ID:   user.ID,
+		Name: user.Name,
+		Auditable: entity.Auditable{
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+			DeletedAt: user.DeletedAt,
+			CreatedBy: user.CreatedBy,
+			UpdatedBy: user.UpdatedBy,
+			DeletedBy: user.DeletedBy,
+		},
+	}, nil
+}
+
 // GetAll gets all users in users table.
+// Soft-deleted users are excluded.
 func (u *User) GetAll(ctx context.Context, limit uint) ([]*entity.User, error) {
 	users, err := u.queries.GetAllUsers(ctx, int32(limit))
 	if err != nil {
@@ -94,6 +154,40 @@ func (u *User) GetAll(ctx context.Context, limit uint) ([]*entity.User, error) {
 	return result, err
 }
 
+// UpdateProfile updates the user's profile in database.
+// The user's UpdatedAt must be the one that was read, so a concurrent change isn't overwritten.
+// It returns entity.ErrUpdateConflict if the user has been changed, soft-deleted or can't be found.
+func (u *User) UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if user == nil {
+		return nil, entity.ErrEmptyUser()
+	}
+
+	param := db.UpdateUserProfileParams{
+		ID:        user.ID,
+		Name:      user.Name,
+		UpdatedAt: user.UpdatedAt,
+	}
+	res, err := u.queries.UpdateUserProfile(ctx, param)
+	if errors.Is(err, sdkpostgres.ErrNotFound) {
+		return nil, entity.ErrUpdateConflict()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-UpdateProfile] fail update user profile", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	return &entity.User{
+		ID:   res.ID,
+		Name: res.Name,
+		Auditable: entity.Auditable{
+			CreatedAt: res.CreatedAt,
+			UpdatedAt: res.UpdatedAt,
+			CreatedBy: res.CreatedBy,
+			UpdatedBy: res.UpdatedBy,
+		},
+	}, nil
+}
+
 // HardDelete deletes a user from database.
 // If the user doesn't exist, it doesn't returns error.
 func (u *User) HardDelete(ctx context.Context, id uuid.UUID) error {
@@ -103,3 +197,90 @@ func (u *User) HardDelete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// SoftDelete soft-deletes a user in database.
+// If the user doesn't exist or has been soft-deleted, it doesn't return error.
+func (u *User) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	param := db.SoftDeleteUserByIDParams{ID: id, DeletedAt: time.Now().UTC()}
+	if err := u.queries.SoftDeleteUserByID(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-SoftDelete] fail soft delete user", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Restore restores a soft-deleted user in database.
+// If the user doesn't exist or isn't soft-deleted, it doesn't return error.
+func (u *User) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := u.queries.RestoreUserByID(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-Restore] fail restore user", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Anonymize replaces the user's personal data with anonymized value and soft-deletes the user.
+// The personal data kept in the user's outboxes is anonymized too and the user's data exports are deleted.
+// It is idempotent, so anonymizing an anonymized user doesn't return error.
+func (u *User) Anonymize(ctx context.Context, id uuid.UUID) error {
+	param := db.AnonymizeUserByIDParams{
+		ID:    id,
+		Name:  erasedName,
+		Email: fmt.Sprintf("erased-%s@%s", id, erasedEmailDomain),
+		Topic: entity.UserRegisteredTopic,
+	}
+	if err := u.queries.AnonymizeUserByID(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-Anonymize] fail anonymize user", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// InsertDataExport inserts the user's data archive into users_data_exports table.
+// The archive can't be taken after expiresAt and is deleted by DeleteExpiredDataExports.
+// Inserting the same archive twice doesn't return error, so it is safe to retry.
+func (u *User) InsertDataExport(ctx context.Context, archive *entity.UserDataArchive, expiresAt time.Time) error {
+	if archive == nil {
+		return entity.ErrEmptyUser()
+	}
+
+	param := db.CreateUserDataExportParams{
+		ID:        archive.ID,
+		UserID:    archive.UserID,
+		Archive:   archive,
+		CreatedAt: archive.ExportedAt,
+		ExpiresAt: expiresAt,
+	}
+	if err := u.queries.CreateUserDataExport(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-InsertDataExport] fail insert user data export", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// TakeDataExport gets the user's data archive and deletes it from users_data_exports table.
+// The archive can only be taken once, so the personal data doesn't stay in the table.
+// It returns entity.ErrNotFound if the archive can't be found, is owned by other user, or has expired.
+func (u *User) TakeDataExport(ctx context.Context, id, userID uuid.UUID) (*entity.UserDataArchive, error) {
+	param := db.DeleteUserDataExportByIDParams{ID: id, UserID: userID}
+	export, err := u.queries.DeleteUserDataExportByID(ctx, param)
+	if errors.Is(err, sdkpostgres.ErrNotFound) {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-TakeDataExport] fail take user data export", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return export.Archive, nil
+}
+
+// DeleteExpiredDataExports deletes the expired user's data archives from users_data_exports table.
+// It returns the number of deleted archives.
+func (u *User) DeleteExpiredDataExports(ctx context.Context) (int64, error) {
+	n, err := u.queries.DeleteExpiredUserDataExports(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresUser-DeleteExpiredDataExports] fail delete expired user data exports", "error", err)
+		return 0, entity.ErrInternal(err.Error())
+	}
+	return n, nil
+}
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 
 	"github.com/google/uuid"
@@ -11,7 +13,8 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/internal/repository/db"
 )
 
-// UserOutbox is responsible to connect user_outbox entity with users_outbox table in PostgreSQL.
+// UserOutbox is responsible to read user registration's messages from outbox table in PostgreSQL.
+// The messages are enqueued and relayed by outbox package.
 type UserOutbox struct {
 	queries *db.Queries
 }
@@ -21,83 +24,41 @@ func NewUserOutbox(q *db.Queries) *UserOutbox {
 	return &UserOutbox{queries: q}
 }
 
-// Insert inserts the payload into users_outbox table.
-func (uo *UserOutbox) Insert(ctx context.Context, payload *entity.UserOutbox) error {
-	if payload == nil || payload.Payload == nil {
-		return entity.ErrEmptyUser()
+// GetByUserID gets the latest user registration's message of the user in outbox table.
+func (uo *UserOutbox) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserOutbox, error) {
+	param := db.GetUserOutboxByUserIDParams{
+		Topic:  entity.UserRegisteredTopic,
+		UserID: userID.String(),
 	}
-
-	param := db.CreateUserOutboxParams{
-		ID:        payload.ID,
-		Status:    db.UserOutboxStatus(payload.Status),
-		Payload:   payload.Payload,
-		CreatedAt: payload.CreatedAt,
-		UpdatedAt: payload.UpdatedAt,
-		CreatedBy: payload.CreatedBy,
-		UpdatedBy: payload.UpdatedBy,
-	}
-	err := uo.queries.CreateUserOutbox(ctx, param)
-	if sdkpostgres.IsUniqueViolationError(err) {
-		return entity.ErrAlreadyExists()
+	outbox, err := uo.queries.GetUserOutboxByUserID(ctx, param)
+	if errors.Is(err, sdkpostgres.ErrNotFound) {
+		return nil, entity.ErrNotFound()
 	}
 	if err != nil {
-		slog.ErrorContext(ctx, "[PostgresUserOutbox-Insert] fail insert user", "error", err)
-		return entity.ErrInternal(err.Error())
+		slog.ErrorContext(ctx, "[PostgresUserOutbox-GetByUserID] fail get user's outbox", "error", err)
+		return nil, entity.ErrInternal(err.Error())
 	}
-	return nil
+	return toUserOutbox(ctx, outbox)
 }
 
-// GetAllReady gets all ready records by status in users_outbox table.
-// This process uses SELECT FOR UPDATE so be mindful to update the record after using this method.
-func (uo *UserOutbox) GetAllReady(ctx context.Context, limit uint) ([]*entity.UserOutbox, error) {
-	param := db.GetAllUserOutboxesForUpdateByStatusParams{
-		Status: db.UserOutboxStatus(entity.UserOutboxStatusReady),
-		Limit:  int32(limit),
-	}
-	outboxes, err := uo.queries.GetAllUserOutboxesForUpdateByStatus(ctx, param)
-	if err != nil {
-		slog.ErrorContext(ctx, "[PostgresUserOutbox-GetAllReady] fail get all user's outbox", "error", err)
-		return []*entity.UserOutbox{}, entity.ErrInternal(err.Error())
+func toUserOutbox(ctx context.Context, outbox *db.Outbox) (*entity.UserOutbox, error) {
+	var user entity.User
+	if err := json.Unmarshal(outbox.Payload, &user); err != nil {
+		slog.ErrorContext(ctx, "[PostgresUserOutbox-toUserOutbox] fail decode user's outbox payload", "id", outbox.ID, "error", err)
+		return nil, entity.ErrInternal(err.Error())
 	}
 
-	result := make([]*entity.UserOutbox, len(outboxes))
-	for i, outbox := range outboxes {
-		res := &entity.UserOutbox{
-			ID:      outbox.ID,
-			Status:  entity.UserOutboxStatus(outbox.Status),
-			Payload: outbox.Payload,
-		}
-		result[i] = res
+	res := &entity.UserOutbox{
+		ID:            outbox.ID,
+		Status:        entity.UserOutboxStatus(outbox.Status),
+		Payload:       &user,
+		Attempts:      outbox.Attempts,
+		NextAttemptAt: outbox.NextAttemptAt,
 	}
-	return result, nil
-}
-
-// SetProcessed sets record's status to processed in users_outbox table.
-func (uo *UserOutbox) SetProcessed(ctx context.Context, id uuid.UUID) error {
-	return uo.SetRecordStatus(ctx, id, entity.UserOutboxStatusProcessed)
-}
-
-// SetDelivered sets record's status to delivered in users_outbox table.
-func (uo *UserOutbox) SetDelivered(ctx context.Context, id uuid.UUID) error {
-	return uo.SetRecordStatus(ctx, id, entity.UserOutboxStatusDelivered)
-}
-
-// SetFailed sets record's status to failed in users_outbox table.
-func (uo *UserOutbox) SetFailed(ctx context.Context, id uuid.UUID) error {
-	return uo.SetRecordStatus(ctx, id, entity.UserOutboxStatusFailed)
-}
-
-// SetRecordStatus sets record's status in users_outbox table.
-func (uo *UserOutbox) SetRecordStatus(ctx context.Context, id uuid.UUID, status entity.UserOutboxStatus) error {
-	param := db.UpdateUserOutboxIDParams{
-		ID:     id,
-		Status: db.UserOutboxStatus(status),
-	}
-
-	err := uo.queries.UpdateUserOutboxID(ctx, param)
-	if err != nil {
-		slog.ErrorContext(ctx, "[PostgresUserOutbox-SetRecordStatus] fail set record's status", "dest-status", status, "error", err)
-		return entity.ErrInternal(err.Error())
+	if outbox.LastError != nil {
+		res.LastError = *outbox.LastError
 	}
-	return nil
+	res.CreatedAt = outbox.CreatedAt
+	res.UpdatedAt = outbox.UpdatedAt
+	return res, nil
 }
@@ -1,10 +1,10 @@
 package postgres_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -16,8 +16,8 @@ import (
 	"github.com/indrasaputra/arjuna/service/user/internal/repository/postgres"
 )
 
-const (
-	queryUpdateRecordStatus = `UPDATE users_outbox SET status = \$1, updated_at = NOW\(\) WHERE id = \$2`
+var (
+	outboxColumns = []string{"id", "topic", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "updated_at"}
 )
 
 type UserOutboxSuite struct {
@@ -36,220 +36,68 @@ func TestNewUserOutbox(t *testing.T) {
 	})
 }
 
-func TestUserOutbox_Insert(t *testing.T) {
+func TestUserOutbox_GetByUserID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	query := `SELECT id, topic, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at FROM outbox WHERE topic = \$1::TEXT AND payload->>'id' = \$2::TEXT ORDER BY created_at DESC LIMIT 1`
 
-	query := `INSERT INTO
-		users_outbox \(id, status, payload, created_at, updated_at, created_by, updated_by\)
-		VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`
-
-	t.Run("nil outbox is prohibited", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-
-		err := st.outbox.Insert(testCtx, nil)
-
-		assert.Error(t, err)
-		assert.Equal(t, entity.ErrEmptyUser(), err)
-	})
-
-	t.Run("nil payload is prohibited", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-		out := createTestUserOutbox()
-		out.Payload = nil
-
-		err := st.outbox.Insert(testCtx, out)
-
-		assert.Error(t, err)
-		assert.Equal(t, entity.ErrEmptyUser(), err)
-	})
-
-	t.Run("insert duplicate outbox", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-		out := createTestUserOutbox()
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(query).WithArgs(out.ID, db.UserOutboxStatus(out.Status), out.Payload, out.CreatedAt, out.UpdatedAt, out.CreatedBy, out.UpdatedBy).WillReturnError(&pgconn.PgError{Code: "23505"})
-
-		err := st.outbox.Insert(testCtx, out)
-
-		assert.Error(t, err)
-		assert.Equal(t, entity.ErrAlreadyExists(), err)
-	})
-
-	t.Run("insert returns error", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-		out := createTestUserOutbox()
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(query).WithArgs(out.ID, db.UserOutboxStatus(out.Status), out.Payload, out.CreatedAt, out.UpdatedAt, out.CreatedBy, out.UpdatedBy).WillReturnError(assert.AnError)
-
-		err := st.outbox.Insert(testCtx, out)
-
-		assert.Error(t, err)
-	})
-
-	t.Run("success insert", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-		out := createTestUserOutbox()
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(query).WithArgs(out.ID, db.UserOutboxStatus(out.Status), out.Payload, out.CreatedAt, out.UpdatedAt, out.CreatedBy, out.UpdatedBy).WillReturnResult(pgxmock.NewResult("INSERT", 1))
-
-		err := st.outbox.Insert(testCtx, out)
-
-		assert.NoError(t, err)
-	})
-}
-
-func TestUserOutbox_GetAllReady(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	query := `SELECT id, payload, status, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users_outbox WHERE status = \$1 ORDER BY created_at ASC LIMIT \$2`
-	limit := uint(10)
-
-	t.Run("get all returns error", func(t *testing.T) {
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectQuery(query).WithArgs(db.UserOutboxStatusREADY, int32(limit)).WillReturnError(assert.AnError)
-
-		res, err := st.outbox.GetAllReady(testCtx, limit)
-
-		assert.Error(t, err)
-		assert.Empty(t, res)
-	})
-
-	t.Run("success get all", func(t *testing.T) {
+	t.Run("user outbox is not found", func(t *testing.T) {
 		user := createTestUser()
 		st := createUserOutboxSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectQuery(query).WithArgs(db.UserOutboxStatusREADY, int32(limit)).WillReturnRows(pgxmock.
-			NewRows([]string{"id", "payload", "status", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
-			AddRow(uuid.Must(uuid.NewV7()), user, db.UserOutboxStatusREADY, user.CreatedAt, user.UpdatedAt, user.DeletedAt, user.CreatedBy, user.UpdatedBy, user.DeletedBy))
+		st.db.ExpectQuery(query).WithArgs(entity.UserRegisteredTopic, user.ID.String()).WillReturnError(sdkpostgres.ErrNotFound)
 
-		res, err := st.outbox.GetAllReady(testCtx, limit)
+		res, err := st.outbox.GetByUserID(testCtx, user.ID)
 
-		assert.NoError(t, err)
-		assert.Len(t, res, 1)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
 	})
-}
-
-func TestUserOutbox_SetProcessed(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
 
-	t.Run("set processed returns error", func(t *testing.T) {
-		out := createTestUserOutbox()
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusPROCESSED, out.ID).WillReturnError(assert.AnError)
-
-		err := st.outbox.SetProcessed(testCtx, out.ID)
-
-		assert.Error(t, err)
-	})
-
-	t.Run("set processed success", func(t *testing.T) {
-		out := createTestUser()
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusPROCESSED, out.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
-
-		err := st.outbox.SetProcessed(testCtx, out.ID)
-
-		assert.NoError(t, err)
-	})
-}
-
-func TestUserOutbox_SetDelivered(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	t.Run("set delivered returns error", func(t *testing.T) {
-		out := createTestUserOutbox()
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusDELIVERED, out.ID).WillReturnError(assert.AnError)
-
-		err := st.outbox.SetDelivered(testCtx, out.ID)
-
-		assert.Error(t, err)
-	})
-
-	t.Run("set delivered success", func(t *testing.T) {
-		out := createTestUser()
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusDELIVERED, out.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
-
-		err := st.outbox.SetDelivered(testCtx, out.ID)
-
-		assert.NoError(t, err)
-	})
-}
-
-func TestUserOutbox_SetFailed(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	t.Run("set failed returns error", func(t *testing.T) {
-		out := createTestUserOutbox()
+	t.Run("get by user id returns error", func(t *testing.T) {
+		user := createTestUser()
 		st := createUserOutboxSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusFAILED, out.ID).WillReturnError(assert.AnError)
+		st.db.ExpectQuery(query).WithArgs(entity.UserRegisteredTopic, user.ID.String()).WillReturnError(assert.AnError)
 
-		err := st.outbox.SetFailed(testCtx, out.ID)
+		res, err := st.outbox.GetByUserID(testCtx, user.ID)
 
 		assert.Error(t, err)
+		assert.Nil(t, res)
 	})
 
-	t.Run("set failed success", func(t *testing.T) {
-		out := createTestUser()
-		st := createUserOutboxSuite(t, ctrl)
-		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatusFAILED, out.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
-
-		err := st.outbox.SetFailed(testCtx, out.ID)
-
-		assert.NoError(t, err)
-	})
-}
-
-func TestUserOutbox_SetRecordStatus(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	t.Run("set status returns error", func(t *testing.T) {
-		out := createTestUserOutbox()
-		status := entity.UserOutboxStatusProcessed
+	t.Run("payload can't be decoded", func(t *testing.T) {
+		user := createTestUser()
 		st := createUserOutboxSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatus(status), out.ID).WillReturnError(assert.AnError)
+		st.db.ExpectQuery(query).WithArgs(entity.UserRegisteredTopic, user.ID.String()).WillReturnRows(pgxmock.
+			NewRows(outboxColumns).
+			AddRow(uuid.Must(uuid.NewV7()), entity.UserRegisteredTopic, []byte(`[]`), db.OutboxStatusDELIVERED, int32(1), user.CreatedAt, nil, user.CreatedAt, user.UpdatedAt))
 
-		err := st.outbox.SetRecordStatus(testCtx, out.ID, status)
+		res, err := st.outbox.GetByUserID(testCtx, user.ID)
 
 		assert.Error(t, err)
+		assert.Nil(t, res)
 	})
 
-	t.Run("set status success", func(t *testing.T) {
-		out := createTestUser()
-		status := entity.UserOutboxStatusProcessed
+	t.Run("success get by user id", func(t *testing.T) {
+		user := createTestUser()
+		payload, _ := json.Marshal(user)
+		reason := "unavailable"
 		st := createUserOutboxSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
-		st.db.ExpectExec(queryUpdateRecordStatus).WithArgs(db.UserOutboxStatus(status), out.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		st.db.ExpectQuery(query).WithArgs(entity.UserRegisteredTopic, user.ID.String()).WillReturnRows(pgxmock.
+			NewRows(outboxColumns).
+			AddRow(uuid.Must(uuid.NewV7()), entity.UserRegisteredTopic, payload, db.OutboxStatusFAILED, int32(1), user.CreatedAt, &reason, user.CreatedAt, user.UpdatedAt))
 
-		err := st.outbox.SetRecordStatus(testCtx, out.ID, status)
+		res, err := st.outbox.GetByUserID(testCtx, user.ID)
 
 		assert.NoError(t, err)
+		assert.Equal(t, entity.UserOutboxStatusFailed, res.Status)
+		assert.Equal(t, user.ID, res.Payload.ID)
+		assert.Equal(t, reason, res.LastError)
 	})
 }
 
-func createTestUserOutbox() *entity.UserOutbox {
-	user := createTestUser()
-	return &entity.UserOutbox{
-		ID:      uuid.Must(uuid.NewV7()),
-		Status:  entity.UserOutboxStatusReady,
-		Payload: user,
-	}
-}
-
 func createUserOutboxSuite(t *testing.T, ctrl *gomock.Controller) *UserOutboxSuite {
 	pool, err := pgxmock.NewPool()
 	if err != nil {
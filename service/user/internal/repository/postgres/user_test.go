@@ -3,6 +3,7 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -98,7 +99,7 @@ func TestUser_GetByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users WHERE id = \$1 LIMIT 1`
+	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users WHERE id = \$1 AND deleted_at IS NULL LIMIT 1`
 
 	t.Run("select by id returns empty row", func(t *testing.T) {
 		user := createTestUser()
@@ -140,11 +141,165 @@ func TestUser_GetByID(t *testing.T) {
 	})
 }
 
+func TestUser_UpdateProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `UPDATE users SET name = \$1, updated_at = NOW\(\), updated_by = id WHERE id = \$2 AND updated_at = \$3 AND deleted_at IS NULL RETURNING id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by`
+
+	t.Run("nil user is prohibited", func(t *testing.T) {
+		st := createUserSuite(t, ctrl)
+
+		res, err := st.user.UpdateProfile(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user has been changed", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.Name, user.ID, user.UpdatedAt).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.user.UpdateProfile(testCtx, user)
+
+		assert.Equal(t, entity.ErrUpdateConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("update returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.Name, user.ID, user.UpdatedAt).WillReturnError(assert.AnError)
+
+		res, err := st.user.UpdateProfile(testCtx, user)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update profile", func(t *testing.T) {
+		user := createTestUser()
+		updatedAt := time.Now().UTC()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.Name, user.ID, user.UpdatedAt).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "name", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
+			AddRow(user.ID, user.Name, user.CreatedAt, updatedAt, user.DeletedAt, user.CreatedBy, user.ID, user.DeletedBy))
+
+		res, err := st.user.UpdateProfile(testCtx, user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.Name, res.Name)
+		assert.Equal(t, updatedAt, res.UpdatedAt)
+	})
+}
+
+func TestUser_GetByIDIncludingDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users WHERE id = \$1 LIMIT 1`
+
+	t.Run("user doesn't exist", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.user.GetByIDIncludingDeleted(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select user returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnError(assert.AnError)
+
+		res, err := st.user.GetByIDIncludingDeleted(testCtx, user.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get soft-deleted user", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().UTC()
+		user.DeletedAt = &deletedAt
+		user.DeletedBy = &user.ID
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "name", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
+			AddRow(user.ID, user.Name, user.CreatedAt, user.UpdatedAt, user.DeletedAt, user.CreatedBy, user.UpdatedBy, user.DeletedBy))
+
+		res, err := st.user.GetByIDIncludingDeleted(testCtx, user.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID, res.ID)
+		assert.Equal(t, user.DeletedAt, res.DeletedAt)
+	})
+}
+
+func TestUser_GetDeletedByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users WHERE id = \$1 AND deleted_at IS NOT NULL LIMIT 1`
+
+	t.Run("deleted user doesn't exist", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.user.GetDeletedByID(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select deleted user returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnError(assert.AnError)
+
+		res, err := st.user.GetDeletedByID(testCtx, user.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get deleted user", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().UTC()
+		user.DeletedAt = &deletedAt
+		user.DeletedBy = &user.ID
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(user.ID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "name", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
+			AddRow(user.ID, user.Name, user.CreatedAt, user.UpdatedAt, user.DeletedAt, user.CreatedBy, user.UpdatedBy, user.DeletedBy))
+
+		res, err := st.user.GetDeletedByID(testCtx, user.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.DeletedAt, res.DeletedAt)
+		assert.Equal(t, user.DeletedBy, res.DeletedBy)
+	})
+}
+
 func TestUser_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users LIMIT \$1`
+	query := `SELECT id, name, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM users WHERE deleted_at IS NULL LIMIT \$1`
 	limit := uint(10)
 
 	t.Run("get all returns error", func(t *testing.T) {
@@ -202,6 +357,220 @@ func TestUser_HardDelete(t *testing.T) {
 	})
 }
 
+func TestUser_SoftDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `UPDATE users SET deleted_at = \$1::TIMESTAMP, deleted_by = id, updated_at = \$1::TIMESTAMP, updated_by = id WHERE id = \$2 AND deleted_at IS NULL`
+
+	t.Run("soft delete returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(pgxmock.AnyArg(), user.ID).WillReturnError(assert.AnError)
+
+		err := st.user.SoftDelete(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success soft delete", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(pgxmock.AnyArg(), user.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.user.SoftDelete(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUser_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `UPDATE users SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW\(\), updated_by = id WHERE id = \$1 AND deleted_at IS NOT NULL`
+
+	t.Run("restore returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(user.ID).WillReturnError(assert.AnError)
+
+		err := st.user.Restore(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success restore", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(user.ID).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.user.Restore(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUser_Anonymize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `WITH anonymized_outboxes AS \(.+\), deleted_exports AS \( DELETE FROM users_data_exports WHERE user_id = \$2::UUID \) UPDATE users SET name = \$1::TEXT, deleted_at = COALESCE\(deleted_at, NOW\(\)\), deleted_by = COALESCE\(deleted_by, id\), updated_at = NOW\(\), updated_by = id WHERE id = \$2::UUID`
+
+	t.Run("anonymize returns error", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs("Erased User", user.ID, "erased-"+user.ID.String()+"@erased.invalid", entity.UserRegisteredTopic).WillReturnError(assert.AnError)
+
+		err := st.user.Anonymize(testCtx, user.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success anonymize", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs("Erased User", user.ID, "erased-"+user.ID.String()+"@erased.invalid", entity.UserRegisteredTopic).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.user.Anonymize(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUser_InsertDataExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `INSERT INTO users_data_exports \(id, user_id, archive, created_at, expires_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\) ON CONFLICT \(id\) DO NOTHING`
+
+	t.Run("nil archive is prohibited", func(t *testing.T) {
+		st := createUserSuite(t, ctrl)
+
+		err := st.user.InsertDataExport(testCtx, nil, time.Now())
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+	})
+
+	t.Run("insert returns error", func(t *testing.T) {
+		archive := createTestUserDataArchive()
+		expiresAt := archive.ExportedAt.Add(time.Hour)
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(archive.ID, archive.UserID, archive, archive.ExportedAt, expiresAt).
+			WillReturnError(assert.AnError)
+
+		err := st.user.InsertDataExport(testCtx, archive, expiresAt)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success insert data export", func(t *testing.T) {
+		archive := createTestUserDataArchive()
+		expiresAt := archive.ExportedAt.Add(time.Hour)
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(archive.ID, archive.UserID, archive, archive.ExportedAt, expiresAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.user.InsertDataExport(testCtx, archive, expiresAt)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUser_TakeDataExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `DELETE FROM users_data_exports WHERE id = \$1 AND user_id = \$2 AND expires_at > NOW\(\) RETURNING id, user_id, archive, created_at, expires_at`
+
+	t.Run("data export doesn't exist", func(t *testing.T) {
+		archive := createTestUserDataArchive()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(archive.ID, archive.UserID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.user.TakeDataExport(testCtx, archive.ID, archive.UserID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("delete returns error", func(t *testing.T) {
+		archive := createTestUserDataArchive()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(archive.ID, archive.UserID).WillReturnError(assert.AnError)
+
+		res, err := st.user.TakeDataExport(testCtx, archive.ID, archive.UserID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success take data export", func(t *testing.T) {
+		archive := createTestUserDataArchive()
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(archive.ID, archive.UserID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "archive", "created_at", "expires_at"}).
+			AddRow(archive.ID, archive.UserID, archive, archive.ExportedAt, archive.ExportedAt.Add(time.Hour)))
+
+		res, err := st.user.TakeDataExport(testCtx, archive.ID, archive.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, archive, res)
+	})
+}
+
+func TestUser_DeleteExpiredDataExports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `DELETE FROM users_data_exports WHERE expires_at <= NOW\(\)`
+
+	t.Run("delete returns error", func(t *testing.T) {
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WillReturnError(assert.AnError)
+
+		n, err := st.user.DeleteExpiredDataExports(testCtx)
+
+		assert.Error(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("success delete expired data exports", func(t *testing.T) {
+		st := createUserSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+
+		n, err := st.user.DeleteExpiredDataExports(testCtx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+	})
+}
+
+func createTestUserDataArchive() *entity.UserDataArchive {
+	userID := uuid.Must(uuid.NewV7())
+	return &entity.UserDataArchive{
+		ID:         uuid.Must(uuid.NewV7()),
+		UserID:     userID,
+		ExportedAt: time.Now().UTC(),
+		Profile:    &entity.UserProfileData{ID: userID, Name: "First User"},
+	}
+}
+
 func createTestUser() *entity.User {
 	return &entity.User{
 		ID:    uuid.Must(uuid.NewV7()),
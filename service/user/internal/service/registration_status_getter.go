@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+const (
+	reasonRegistrationRetried = "registration is being retried"
+	reasonRegistrationFailed  = "registration can't be completed, please try again"
+)
+
+// GetRegistrationStatus defines the interface to get user registration status.
+type GetRegistrationStatus interface {
+	// GetRegistrationStatus gets the registration status of the user.
+	GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error)
+}
+
+// GetRegistrationStatusRepository defines the interface to get user outbox from the repository.
+type GetRegistrationStatusRepository interface {
+	// GetByUserID gets the latest user outbox of the user.
+	// It returns entity.ErrNotFound if the user outbox can't be found.
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserOutbox, error)
+}
+
+// GetRegistrationStatusOrchestration defines the interface to get registration status from the orchestrator.
+type GetRegistrationStatusOrchestration interface {
+	// GetRegistrationStatus gets the registration status from the user's registration process.
+	GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error)
+}
+
+// RegistrationStatusGetter is responsible for getting user registration status.
+type RegistrationStatusGetter struct {
+	repo         GetRegistrationStatusRepository
+	orchestrator GetRegistrationStatusOrchestration
+}
+
+// NewRegistrationStatusGetter creates an instance of RegistrationStatusGetter.
+func NewRegistrationStatusGetter(r GetRegistrationStatusRepository, o GetRegistrationStatusOrchestration) *RegistrationStatusGetter {
+	return &RegistrationStatusGetter{repo: r, orchestrator: o}
+}
+
+// GetRegistrationStatus gets the registration status of the user.
+// The user outbox tells whether the registration has been handed to the orchestrator.
+// Once it has, the orchestrator is asked for the current state of the registration,
+// since the registration is handed over as soon as it is started and goes on in the background.
+func (rg *RegistrationStatusGetter) GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrEmptyUser()
+	}
+
+	outbox, err := rg.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[RegistrationStatusGetter-GetRegistrationStatus] fail get user outbox", "error", err)
+		return nil, err
+	}
+
+	switch outbox.Status {
+	case entity.UserOutboxStatusReady:
+		return &entity.RegistrationStatus{State: entity.RegistrationStatePending}, nil
+	case entity.UserOutboxStatusFailed:
+		return &entity.RegistrationStatus{State: entity.RegistrationStatePending, Reason: reasonRegistrationRetried}, nil
+	case entity.UserOutboxStatusDelivered:
+		return rg.getStatusFromOrchestrator(ctx, userID, &entity.RegistrationStatus{State: entity.RegistrationStateCompleted}), nil
+	case entity.UserOutboxStatusDeadLetter:
+		return rg.getStatusFromOrchestrator(ctx, userID, &entity.RegistrationStatus{State: entity.RegistrationStateFailed, Reason: reasonRegistrationFailed}), nil
+	default:
+		return rg.getStatusFromOrchestrator(ctx, userID, &entity.RegistrationStatus{State: entity.RegistrationStatePending}), nil
+	}
+}
+
+// getStatusFromOrchestrator returns the fallback status if the orchestrator can't tell the registration status,
+// for example when the registration hasn't been started or has been cleaned up.
+func (rg *RegistrationStatusGetter) getStatusFromOrchestrator(ctx context.Context, userID uuid.UUID, fallback *entity.RegistrationStatus) *entity.RegistrationStatus {
+	status, err := rg.orchestrator.GetRegistrationStatus(ctx, userID)
+	if err != nil || status == nil {
+		slog.WarnContext(ctx, "[RegistrationStatusGetter-GetRegistrationStatus] fail get status from orchestrator", "error", err)
+		return fallback
+	}
+	return status
+}
@@ -0,0 +1,122 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
+)
+
+type RegistrationStatusGetterSuite struct {
+	getter       *service.RegistrationStatusGetter
+	repo         *mock_service.MockGetRegistrationStatusRepository
+	orchestrator *mock_service.MockGetRegistrationStatusOrchestration
+}
+
+func TestNewRegistrationStatusGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of RegistrationStatusGetter", func(t *testing.T) {
+		st := createRegistrationStatusGetterSuite(ctrl)
+		assert.NotNil(t, st.getter)
+	})
+}
+
+func TestRegistrationStatusGetter_GetRegistrationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("empty user id is prohibited", func(t *testing.T) {
+		st := createRegistrationStatusGetterSuite(ctrl)
+
+		res, err := st.getter.GetRegistrationStatus(testCtx, uuid.Nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user outbox repo returns error", func(t *testing.T) {
+		st := createRegistrationStatusGetterSuite(ctrl)
+		st.repo.EXPECT().GetByUserID(testCtx, id).Return(nil, entity.ErrNotFound())
+
+		res, err := st.getter.GetRegistrationStatus(testCtx, id)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("status is derived from user outbox", func(t *testing.T) {
+		tables := []struct {
+			status   entity.UserOutboxStatus
+			expected entity.RegistrationState
+		}{
+			{status: entity.UserOutboxStatusReady, expected: entity.RegistrationStatePending},
+			{status: entity.UserOutboxStatusFailed, expected: entity.RegistrationStatePending},
+		}
+
+		for _, table := range tables {
+			st := createRegistrationStatusGetterSuite(ctrl)
+			st.repo.EXPECT().GetByUserID(testCtx, id).Return(&entity.UserOutbox{Status: table.status}, nil)
+
+			res, err := st.getter.GetRegistrationStatus(testCtx, id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, table.expected, res.State)
+		}
+	})
+
+	t.Run("status is taken from orchestrator", func(t *testing.T) {
+		statuses := []entity.UserOutboxStatus{entity.UserOutboxStatusProcessed, entity.UserOutboxStatusDelivered, entity.UserOutboxStatusDeadLetter}
+
+		for _, status := range statuses {
+			st := createRegistrationStatusGetterSuite(ctrl)
+			expected := &entity.RegistrationStatus{State: entity.RegistrationStateFailed, Reason: "user already exists"}
+			st.repo.EXPECT().GetByUserID(testCtx, id).Return(&entity.UserOutbox{Status: status}, nil)
+			st.orchestrator.EXPECT().GetRegistrationStatus(testCtx, id).Return(expected, nil)
+
+			res, err := st.getter.GetRegistrationStatus(testCtx, id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, res)
+		}
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		tables := []struct {
+			status   entity.UserOutboxStatus
+			expected entity.RegistrationState
+		}{
+			{status: entity.UserOutboxStatusProcessed, expected: entity.RegistrationStatePending},
+			{status: entity.UserOutboxStatusDelivered, expected: entity.RegistrationStateCompleted},
+			{status: entity.UserOutboxStatusDeadLetter, expected: entity.RegistrationStateFailed},
+		}
+
+		for _, table := range tables {
+			st := createRegistrationStatusGetterSuite(ctrl)
+			st.repo.EXPECT().GetByUserID(testCtx, id).Return(&entity.UserOutbox{Status: table.status}, nil)
+			st.orchestrator.EXPECT().GetRegistrationStatus(testCtx, id).Return(nil, entity.ErrNotFound())
+
+			res, err := st.getter.GetRegistrationStatus(testCtx, id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, table.expected, res.State)
+		}
+	})
+}
+
+func createRegistrationStatusGetterSuite(ctrl *gomock.Controller) *RegistrationStatusGetterSuite {
+	r := mock_service.NewMockGetRegistrationStatusRepository(ctrl)
+	o := mock_service.NewMockGetRegistrationStatusOrchestration(ctrl)
+	return &RegistrationStatusGetterSuite{
+		getter:       service.NewRegistrationStatusGetter(r, o),
+		repo:         r,
+		orchestrator: o,
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// ExportUserData defines the interface to export all data owned by a user.
+type ExportUserData interface {
+	// Export collects the profile, the account, the wallets and the transactions of the user.
+	Export(ctx context.Context, id uuid.UUID) (*entity.UserDataArchive, error)
+}
+
+// ExportUserDataRepository defines interface to get user and user's data archive from repository.
+type ExportUserDataRepository interface {
+	// GetByIDIncludingDeleted gets a user, including the soft-deleted one.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// TakeDataExport gets the user's data archive and deletes it, so it can only be taken once.
+	// It returns entity.ErrNotFound if the archive can't be found.
+	TakeDataExport(ctx context.Context, id, userID uuid.UUID) (*entity.UserDataArchive, error)
+}
+
+// ExportUserDataOrchestration defines interface to orchestrate user data export across services.
+type ExportUserDataOrchestration interface {
+	// ExportUserData collects all data owned by the user and stores it as an archive.
+	// It returns the id of the stored archive.
+	ExportUserData(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+}
+
+// UserDataExporter is responsible for exporting all data owned by a user.
+type UserDataExporter struct {
+	repo         ExportUserDataRepository
+	orchestrator ExportUserDataOrchestration
+}
+
+// NewUserDataExporter creates an instance of UserDataExporter.
+func NewUserDataExporter(repo ExportUserDataRepository, orchestrator ExportUserDataOrchestration) *UserDataExporter {
+	return &UserDataExporter{repo: repo, orchestrator: orchestrator}
+}
+
+// Export exports all data owned by a user.
+// The archive is stored by the orchestrator and only its id is passed around,
+// so a long transaction history doesn't go through the orchestrator's history.
+// Data of a soft-deleted user can be exported too.
+// It returns entity.ErrNotFound if the user can't be found.
+func (ue *UserDataExporter) Export(ctx context.Context, id uuid.UUID) (*entity.UserDataArchive, error) {
+	if _, err := ue.repo.GetByIDIncludingDeleted(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "[UserDataExporter-Export] fail get user", "error", err)
+		return nil, err
+	}
+
+	archiveID, err := ue.orchestrator.ExportUserData(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataExporter-Export] fail export user data", "error", err)
+		return nil, err
+	}
+
+	archive, err := ue.repo.TakeDataExport(ctx, archiveID, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDataExporter-Export] fail take user data export", "error", err)
+		return nil, err
+	}
+	return archive, nil
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
+)
+
+type UserDataExporterSuite struct {
+	exporter     *service.UserDataExporter
+	db           *mock_service.MockExportUserDataRepository
+	orchestrator *mock_service.MockExportUserDataOrchestration
+}
+
+func TestNewUserDataExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of UserDataExporter", func(t *testing.T) {
+		st := createUserDataExporterSuite(ctrl)
+		assert.NotNil(t, st.exporter)
+	})
+}
+
+func TestUserDataExporter_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user can't be found", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserDataExporterSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.exporter.Export(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		user := createTestUser()
+		errReturn := entity.ErrInternal("")
+
+		st := createUserDataExporterSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().ExportUserData(testCtx, user.ID).Return(uuid.Nil, errReturn)
+
+		res, err := st.exporter.Export(testCtx, user.ID)
+
+		assert.Equal(t, errReturn, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("take data export returns error", func(t *testing.T) {
+		user := createTestUser()
+		archiveID := uuid.Must(uuid.NewV7())
+		errReturn := entity.ErrInternal("")
+
+		st := createUserDataExporterSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().ExportUserData(testCtx, user.ID).Return(archiveID, nil)
+		st.db.EXPECT().TakeDataExport(testCtx, archiveID, user.ID).Return(nil, errReturn)
+
+		res, err := st.exporter.Export(testCtx, user.ID)
+
+		assert.Equal(t, errReturn, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success export user data", func(t *testing.T) {
+		user := createTestUser()
+		archive := &entity.UserDataArchive{ID: uuid.Must(uuid.NewV7()), UserID: user.ID, Profile: &entity.UserProfileData{ID: user.ID, Name: user.Name}}
+
+		st := createUserDataExporterSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().ExportUserData(testCtx, user.ID).Return(archive.ID, nil)
+		st.db.EXPECT().TakeDataExport(testCtx, archive.ID, user.ID).Return(archive, nil)
+
+		res, err := st.exporter.Export(testCtx, user.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, archive, res)
+	})
+}
+
+func createUserDataExporterSuite(ctrl *gomock.Controller) *UserDataExporterSuite {
+	r := mock_service.NewMockExportUserDataRepository(ctrl)
+	o := mock_service.NewMockExportUserDataOrchestration(ctrl)
+	e := service.NewUserDataExporter(r, o)
+	return &UserDataExporterSuite{
+		exporter:     e,
+		db:           r,
+		orchestrator: o,
+	}
+}
@@ -3,14 +3,21 @@ package service
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
 // DeleteUser defines the interface to delete a user.
 type DeleteUser interface {
 	// HardDelete hard-deletes all users.
 	HardDelete(ctx context.Context, id uuid.UUID) error
+	// SoftDelete soft-deletes the user along with the account and the wallets.
+	SoftDelete(ctx context.Context, id uuid.UUID) error
+	// Restore restores the soft-deleted user along with the account and the wallets.
+	Restore(ctx context.Context, id uuid.UUID) error
 }
 
 // DeleteUserRepository defines interface to delete user from repository.
@@ -18,20 +25,36 @@ type DeleteUserRepository interface {
 	// HardDelete hard-deletes a single user from the repository using transaction.
 	// If the user can't be found, it doesn't return error.
 	HardDelete(ctx context.Context, id uuid.UUID) error
+	// GetByID gets a user that isn't soft-deleted.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// GetDeletedByID gets a soft-deleted user.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+}
+
+// DeleteUserOrchestration defines interface to orchestrate user deletion across services.
+type DeleteUserOrchestration interface {
+	// DeleteUser soft-deletes the user, the account and the wallets.
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// RestoreUser restores the user, the account and the wallets.
+	RestoreUser(ctx context.Context, id uuid.UUID) error
 }
 
 // UserDeleter is responsible for deleting a user.
 type UserDeleter struct {
-	repo DeleteUserRepository
+	repo         DeleteUserRepository
+	orchestrator DeleteUserOrchestration
+	gracePeriod  time.Duration
 }
 
 // NewUserDeleter creates an instance of UserDeleter.
-func NewUserDeleter(repo DeleteUserRepository) *UserDeleter {
-	return &UserDeleter{repo: repo}
+// The soft-deleted user can be restored until the grace period passes.
+func NewUserDeleter(repo DeleteUserRepository, orchestrator DeleteUserOrchestration, gracePeriod time.Duration) *UserDeleter {
+	return &UserDeleter{repo: repo, orchestrator: orchestrator, gracePeriod: gracePeriod}
 }
 
 // HardDelete hard-deletes a user in system.
-// TODO: Use temporal to call auth service.
 func (td *UserDeleter) HardDelete(ctx context.Context, id uuid.UUID) error {
 	err := td.repo.HardDelete(ctx, id)
 	if err != nil {
@@ -39,3 +62,40 @@ func (td *UserDeleter) HardDelete(ctx context.Context, id uuid.UUID) error {
 	}
 	return err
 }
+
+// SoftDelete soft-deletes a user in system.
+// The account is soft-deleted, so the user can't login, and the wallets are frozen.
+// It returns entity.ErrNotFound if the user can't be found or has been soft-deleted.
+func (td *UserDeleter) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	if _, err := td.repo.GetByID(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "[UserDeleter-SoftDelete] fail get user", "error", err)
+		return err
+	}
+
+	err := td.orchestrator.DeleteUser(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDeleter-SoftDelete] fail soft delete user", "error", err)
+	}
+	return err
+}
+
+// Restore restores a soft-deleted user in system.
+// It returns entity.ErrNotFound if the user can't be found or isn't soft-deleted
+// and entity.ErrRestorePeriodExpired if the grace period has passed.
+func (td *UserDeleter) Restore(ctx context.Context, id uuid.UUID) error {
+	user, err := td.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDeleter-Restore] fail get deleted user", "error", err)
+		return err
+	}
+	if user.DeletedAt == nil || time.Since(*user.DeletedAt) > td.gracePeriod {
+		slog.ErrorContext(ctx, "[UserDeleter-Restore] restore period has expired", "deleted_at", user.DeletedAt)
+		return entity.ErrRestorePeriodExpired()
+	}
+
+	err = td.orchestrator.RestoreUser(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserDeleter-Restore] fail restore user", "error", err)
+	}
+	return err
+}
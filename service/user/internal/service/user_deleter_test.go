@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -12,10 +13,13 @@ import (
 )
 
 type UserDeleterSuite struct {
-	deleter *service.UserDeleter
-	db      *mock_service.MockDeleteUserRepository
+	deleter      *service.UserDeleter
+	db           *mock_service.MockDeleteUserRepository
+	orchestrator *mock_service.MockDeleteUserOrchestration
 }
 
+const testRestoreGracePeriod = 24 * time.Hour
+
 func TestNewUserDeleter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -55,11 +59,112 @@ func TestUserDeleter_HardDelete(t *testing.T) {
 	})
 }
 
+func TestUserDeleter_SoftDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user can't be found", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetByID(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		err := st.deleter.SoftDelete(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		user := createTestUser()
+		errReturn := entity.ErrInternal("")
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetByID(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().DeleteUser(testCtx, user.ID).Return(errReturn)
+
+		err := st.deleter.SoftDelete(testCtx, user.ID)
+
+		assert.Equal(t, errReturn, err)
+	})
+
+	t.Run("success soft delete user", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetByID(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().DeleteUser(testCtx, user.ID).Return(nil)
+
+		err := st.deleter.SoftDelete(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestUserDeleter_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("deleted user can't be found", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetDeletedByID(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		err := st.deleter.Restore(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("grace period has passed", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().Add(-testRestoreGracePeriod - time.Minute)
+		user.DeletedAt = &deletedAt
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetDeletedByID(testCtx, user.ID).Return(user, nil)
+
+		err := st.deleter.Restore(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrRestorePeriodExpired(), err)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().Add(-time.Hour)
+		user.DeletedAt = &deletedAt
+		errReturn := entity.ErrInternal("")
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetDeletedByID(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().RestoreUser(testCtx, user.ID).Return(errReturn)
+
+		err := st.deleter.Restore(testCtx, user.ID)
+
+		assert.Equal(t, errReturn, err)
+	})
+
+	t.Run("success restore user", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().Add(-time.Hour)
+		user.DeletedAt = &deletedAt
+
+		st := createUserDeleterSuite(ctrl)
+		st.db.EXPECT().GetDeletedByID(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().RestoreUser(testCtx, user.ID).Return(nil)
+
+		err := st.deleter.Restore(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
 func createUserDeleterSuite(ctrl *gomock.Controller) *UserDeleterSuite {
 	db := mock_service.NewMockDeleteUserRepository(ctrl)
-	d := service.NewUserDeleter(db)
+	o := mock_service.NewMockDeleteUserOrchestration(ctrl)
+	d := service.NewUserDeleter(db, o, testRestoreGracePeriod)
 	return &UserDeleterSuite{
-		deleter: d,
-		db:      db,
+		deleter:      d,
+		db:           db,
+		orchestrator: o,
 	}
 }
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// EraseUser defines the interface to erase personal data of a user.
+type EraseUser interface {
+	// Erase anonymizes personal data of the user and keeps the financial records.
+	Erase(ctx context.Context, id uuid.UUID) error
+}
+
+// EraseUserRepository defines interface to get user from repository.
+type EraseUserRepository interface {
+	// GetByIDIncludingDeleted gets a user, including the soft-deleted one.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error)
+}
+
+// EraseUserOrchestration defines interface to orchestrate user data erasure across services.
+type EraseUserOrchestration interface {
+	// EraseUserData anonymizes the user and the account and freezes the wallets.
+	EraseUserData(ctx context.Context, id uuid.UUID) error
+}
+
+// UserEraser is responsible for erasing personal data of a user.
+type UserEraser struct {
+	repo         EraseUserRepository
+	orchestrator EraseUserOrchestration
+}
+
+// NewUserEraser creates an instance of UserEraser.
+func NewUserEraser(repo EraseUserRepository, orchestrator EraseUserOrchestration) *UserEraser {
+	return &UserEraser{repo: repo, orchestrator: orchestrator}
+}
+
+// Erase erases personal data of a user.
+// The wallets and the transactions are kept, since they are needed by the ledger.
+// A soft-deleted user can be erased too, and so can an erased user, which completes a failed erasure.
+// It returns entity.ErrNotFound if the user can't be found.
+func (ue *UserEraser) Erase(ctx context.Context, id uuid.UUID) error {
+	if _, err := ue.repo.GetByIDIncludingDeleted(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "[UserEraser-Erase] fail get user", "error", err)
+		return err
+	}
+
+	err := ue.orchestrator.EraseUserData(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserEraser-Erase] fail erase user data", "error", err)
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
+)
+
+type UserEraserSuite struct {
+	eraser       *service.UserEraser
+	db           *mock_service.MockEraseUserRepository
+	orchestrator *mock_service.MockEraseUserOrchestration
+}
+
+func TestNewUserEraser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of UserEraser", func(t *testing.T) {
+		st := createUserEraserSuite(ctrl)
+		assert.NotNil(t, st.eraser)
+	})
+}
+
+func TestUserEraser_Erase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user can't be found", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserEraserSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		err := st.eraser.Erase(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("orchestrator returns error", func(t *testing.T) {
+		user := createTestUser()
+		errReturn := entity.ErrInternal("")
+
+		st := createUserEraserSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().EraseUserData(testCtx, user.ID).Return(errReturn)
+
+		err := st.eraser.Erase(testCtx, user.ID)
+
+		assert.Equal(t, errReturn, err)
+	})
+
+	t.Run("success erase user", func(t *testing.T) {
+		user := createTestUser()
+
+		st := createUserEraserSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().EraseUserData(testCtx, user.ID).Return(nil)
+
+		err := st.eraser.Erase(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success erase soft-deleted user", func(t *testing.T) {
+		user := createTestUser()
+		deletedAt := time.Now().UTC()
+		user.DeletedAt = &deletedAt
+
+		st := createUserEraserSuite(ctrl)
+		st.db.EXPECT().GetByIDIncludingDeleted(testCtx, user.ID).Return(user, nil)
+		st.orchestrator.EXPECT().EraseUserData(testCtx, user.ID).Return(nil)
+
+		err := st.eraser.Erase(testCtx, user.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createUserEraserSuite(ctrl *gomock.Controller) *UserEraserSuite {
+	r := mock_service.NewMockEraseUserRepository(ctrl)
+	o := mock_service.NewMockEraseUserOrchestration(ctrl)
+	e := service.NewUserEraser(r, o)
+	return &UserEraserSuite{
+		eraser:       e,
+		db:           r,
+		orchestrator: o,
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
@@ -16,6 +18,8 @@ const (
 type GetUser interface {
 	// GetAll gets all users.
 	GetAll(ctx context.Context, limit uint) ([]*entity.User, error)
+	// GetByID gets a user by its id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 }
 
 // GetUserRepository defines the interface to get user from the repository.
@@ -23,6 +27,9 @@ type GetUserRepository interface {
 	// GetAll gets all users available in repository.
 	// If there isn't any user in repository, it returns empty list of user and nil error.
 	GetAll(ctx context.Context, limit uint) ([]*entity.User, error)
+	// GetByID gets a user that isn't soft-deleted.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 }
 
 // UserGetter is responsible for getting user.
@@ -43,3 +50,9 @@ func (ug *UserGetter) GetAll(ctx context.Context, limit uint) ([]*entity.User, e
 	}
 	return ug.repo.GetAll(ctx, limit)
 }
+
+// GetByID gets a user by its id.
+// It returns entity.ErrNotFound if the user can't be found or has been soft-deleted.
+func (ug *UserGetter) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	return ug.repo.GetByID(ctx, id)
+}
@@ -82,6 +82,33 @@ func TestUserGetter_GetAll(t *testing.T) {
 	})
 }
 
+func TestUserGetter_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user can't be found", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserGetterSuite(ctrl)
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.getter.GetByID(testCtx, user.ID)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully get user by id", func(t *testing.T) {
+		user := createTestUser()
+		st := createUserGetterSuite(ctrl)
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(user, nil)
+
+		res, err := st.getter.GetByID(testCtx, user.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, user, res)
+	})
+}
+
 func createUserGetterSuite(ctrl *gomock.Controller) *UserGetterSuite {
 	r := mock_service.NewMockGetUserRepository(ctrl)
 	g := service.NewUserGetter(r)
@@ -9,11 +9,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 )
 
+const (
+	// maxPasswordLength is the maximum length of password bcrypt can hash.
+	maxPasswordLength = 72
+	// maxNameLength follows the name length constraint of users table.
+	maxNameLength = 100
+)
+
 var (
 	regexNameCompiler *regexp.Regexp
 )
@@ -37,26 +45,26 @@ type RegisterUserRepository interface {
 	Insert(ctx context.Context, user *entity.User) error
 }
 
-// RegisterUserOutboxRepository defines interface to register user outbox to repository.
-type RegisterUserOutboxRepository interface {
-	// InsertWithTx inserts user outbox to repository using transaction.
-	// InsertWithTx(ctx context.Context, tx uow.Tx, payload *entity.UserOutbox) error
-	Insert(ctx context.Context, payload *entity.UserOutbox) error
+// RegisterUserOutbox defines interface to enqueue the registered user to the outbox.
+type RegisterUserOutbox interface {
+	// Enqueue stores payload as a message of the topic.
+	// It joins the transaction found in the context, if any.
+	Enqueue(ctx context.Context, topic string, payload any) error
 }
 
 // UserRegistrar is responsible for registering a new user.
 type UserRegistrar struct {
-	txManager      uow.TxManager
-	userRepo       RegisterUserRepository
-	userOutboxRepo RegisterUserOutboxRepository
+	txManager  uow.TxManager
+	userRepo   RegisterUserRepository
+	userOutbox RegisterUserOutbox
 }
 
 // NewUserRegistrar creates an instance of UserRegistrar.
-func NewUserRegistrar(txm uow.TxManager, ur RegisterUserRepository, uor RegisterUserOutboxRepository) *UserRegistrar {
+func NewUserRegistrar(txm uow.TxManager, ur RegisterUserRepository, uo RegisterUserOutbox) *UserRegistrar {
 	return &UserRegistrar{
-		txManager:      txm,
-		userRepo:       ur,
-		userOutboxRepo: uor,
+		txManager:  txm,
+		userRepo:   ur,
+		userOutbox: uo,
 	}
 }
 
@@ -70,6 +78,9 @@ func (ur *UserRegistrar) Register(ctx context.Context, user *entity.User) (uuid.
 		return uuid.Nil, err
 	}
 
+	if err := hashUserPassword(ctx, user); err != nil {
+		return uuid.Nil, err
+	}
 	setUserID(user)
 	setUserAuditableProperties(user)
 
@@ -87,12 +98,11 @@ func (ur *UserRegistrar) saveUserToRepository(ctx context.Context, user *entity.
 			slog.ErrorContext(ctx, "[UserRegistrar-saveUserToRepository] fail insert user to repo", "error", err)
 			return err
 		}
-		payload := createUserOutbox(user)
-		err := ur.userOutboxRepo.Insert(ctx, payload)
-		if err != nil {
-			slog.ErrorContext(ctx, "[UserRegistrar-saveUserToRepository] fail insert user outbox to repo", "error", err)
+		if err := ur.userOutbox.Enqueue(ctx, entity.UserRegisteredTopic, user); err != nil {
+			slog.ErrorContext(ctx, "[UserRegistrar-saveUserToRepository] fail enqueue user to outbox", "error", err)
+			return entity.ErrInternal(err.Error())
 		}
-		return err
+		return nil
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "[UserRegistrar-saveUserToRepository] transaction fail", "error", err)
@@ -104,12 +114,32 @@ func validateUser(user *entity.User) error {
 	if user == nil {
 		return entity.ErrEmptyUser()
 	}
-	if !regexNameCompiler.MatchString(user.Name) {
+	if !isValidName(user.Name) {
 		return entity.ErrInvalidName()
 	}
 	if _, err := mail.ParseAddress(user.Email); err != nil {
 		return entity.ErrInvalidEmail()
 	}
+	if user.Password == "" || len(user.Password) > maxPasswordLength {
+		return entity.ErrInvalidPassword()
+	}
+	return nil
+}
+
+func isValidName(name string) bool {
+	return len(name) <= maxNameLength && regexNameCompiler.MatchString(name)
+}
+
+// hashUserPassword replaces the plaintext password with its bcrypt hash,
+// so only the hash is kept in the outbox and passed to the workflow.
+func hashUserPassword(ctx context.Context, user *entity.User) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err != nil {
+		slog.ErrorContext(ctx, "[hashUserPassword] fail generate from password", "error", err)
+		return entity.ErrInternal("fail to hash the password")
+	}
+	user.PasswordHash = string(hash)
+	user.Password = ""
 	return nil
 }
 
@@ -136,17 +166,3 @@ func setUserAuditableProperties(user *entity.User) {
 	user.CreatedBy = user.ID
 	user.UpdatedBy = user.ID
 }
-
-func createUserOutbox(user *entity.User) *entity.UserOutbox {
-	return &entity.UserOutbox{
-		ID:      generateUniqueID(),
-		Status:  entity.UserOutboxStatusReady,
-		Payload: user,
-		Auditable: entity.Auditable{
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
-			CreatedBy: user.CreatedBy,
-			UpdatedBy: user.UpdatedBy,
-		},
-	}
-}
@@ -2,11 +2,13 @@ package service_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
 
 	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/user/entity"
@@ -22,10 +24,10 @@ var (
 )
 
 type UserRegistrarSuite struct {
-	registrar      *service.UserRegistrar
-	txManager      *mock_uow.MockTxManager
-	userRepo       *mock_service.MockRegisterUserRepository
-	userOutboxRepo *mock_service.MockRegisterUserOutboxRepository
+	registrar  *service.UserRegistrar
+	txManager  *mock_uow.MockTxManager
+	userRepo   *mock_service.MockRegisterUserRepository
+	userOutbox *mock_service.MockRegisterUserOutbox
 }
 
 func TestNewUserRegistrar(t *testing.T) {
@@ -52,13 +54,14 @@ func TestUserRegistrar_Register(t *testing.T) {
 		assert.Empty(t, id)
 	})
 
-	t.Run("name contains character other than alphabet", func(t *testing.T) {
+	t.Run("name contains character other than alphabet or is too long", func(t *testing.T) {
 		st := createUserRegistrarSuite(ctrl)
 		names := []string{
 			"123",
 			"First Us3r",
 			"First User !!!",
 			"!@#$%^&*()",
+			strings.Repeat("a", 101),
 		}
 
 		for _, name := range names {
@@ -92,6 +95,26 @@ func TestUserRegistrar_Register(t *testing.T) {
 		}
 	})
 
+	t.Run("password is invalid", func(t *testing.T) {
+		st := createUserRegistrarSuite(ctrl)
+		passwords := []string{
+			"",
+			"   ",
+			strings.Repeat("a", 73),
+		}
+
+		for _, password := range passwords {
+			user := createTestUser()
+			user.Password = password
+
+			id, err := st.registrar.Register(testCtx, user)
+
+			assert.Error(t, err)
+			assert.Equal(t, entity.ErrInvalidPassword(), err)
+			assert.Empty(t, id)
+		}
+	})
+
 	t.Run("user repo insert returns error", func(t *testing.T) {
 		st := createUserRegistrarSuite(ctrl)
 		user := createTestUser()
@@ -110,16 +133,16 @@ func TestUserRegistrar_Register(t *testing.T) {
 		assert.Empty(t, id)
 	})
 
-	t.Run("user outbox repo insert with tx returns error", func(t *testing.T) {
+	t.Run("user outbox enqueue with tx returns error", func(t *testing.T) {
 		st := createUserRegistrarSuite(ctrl)
 		user := createTestUser()
 		errReturn := entity.ErrInternal("")
 
 		st.userRepo.EXPECT().Insert(testCtxTx, user).Return(nil)
-		st.userOutboxRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(errReturn)
+		st.userOutbox.EXPECT().Enqueue(testCtxTx, entity.UserRegisteredTopic, user).Return(assert.AnError)
 		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.Error(t, fn(testCtxTx))
+				assert.Equal(t, entity.ErrInternal(assert.AnError.Error()), fn(testCtxTx))
 				return errReturn
 			})
 
@@ -135,7 +158,7 @@ func TestUserRegistrar_Register(t *testing.T) {
 		errReturn := entity.ErrInternal("")
 
 		st.userRepo.EXPECT().Insert(testCtxTx, user).Return(nil)
-		st.userOutboxRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(nil)
+		st.userOutbox.EXPECT().Enqueue(testCtxTx, entity.UserRegisteredTopic, user).Return(nil)
 		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 				assert.NoError(t, fn(testCtxTx))
@@ -151,9 +174,17 @@ func TestUserRegistrar_Register(t *testing.T) {
 	t.Run("success register user", func(t *testing.T) {
 		st := createUserRegistrarSuite(ctrl)
 		user := createTestUser()
+		password := user.Password
 
 		st.userRepo.EXPECT().Insert(testCtxTx, user).Return(nil)
-		st.userOutboxRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(nil)
+		st.userOutbox.EXPECT().Enqueue(testCtxTx, entity.UserRegisteredTopic, user).
+			DoAndReturn(func(_ context.Context, _ string, payload any) error {
+				u, ok := payload.(*entity.User)
+				assert.True(t, ok)
+				assert.Empty(t, u.Password)
+				assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)))
+				return nil
+			})
 		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 				assert.NoError(t, fn(testCtxTx))
@@ -170,20 +201,21 @@ func TestUserRegistrar_Register(t *testing.T) {
 func createUserRegistrarSuite(ctrl *gomock.Controller) *UserRegistrarSuite {
 	m := mock_uow.NewMockTxManager(ctrl)
 	ur := mock_service.NewMockRegisterUserRepository(ctrl)
-	uor := mock_service.NewMockRegisterUserOutboxRepository(ctrl)
-	r := service.NewUserRegistrar(m, ur, uor)
+	uo := mock_service.NewMockRegisterUserOutbox(ctrl)
+	r := service.NewUserRegistrar(m, ur, uo)
 	return &UserRegistrarSuite{
-		registrar:      r,
-		txManager:      m,
-		userRepo:       ur,
-		userOutboxRepo: uor,
+		registrar:  r,
+		txManager:  m,
+		userRepo:   ur,
+		userOutbox: uo,
 	}
 }
 
 func createTestUser() *entity.User {
 	return &entity.User{
-		ID:    uuid.Must(uuid.NewV7()),
-		Name:  "First User",
-		Email: "first@user.com",
+		ID:       uuid.Must(uuid.NewV7()),
+		Name:     "First User",
+		Email:    "first@user.com",
+		Password: "WeakPassword123",
 	}
 }
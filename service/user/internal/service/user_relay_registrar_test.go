@@ -2,27 +2,22 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
-	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/pkg/sdk/outbox"
 	"github.com/indrasaputra/arjuna/service/user/entity"
 	"github.com/indrasaputra/arjuna/service/user/internal/service"
 	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
 )
 
-const (
-	limitGetAllReady = uint(1)
-)
-
 type UserRelayRegistrarSuite struct {
-	relayer        *service.UserRelayRegistrar
-	userOutboxRepo *mock_service.MockRelayRegisterUserOutboxRepository
-	orchestration  *mock_service.MockRelayRegisterUserOrchestration
-	txManager      *mock_uow.MockTxManager
+	relayer       *service.UserRelayRegistrar
+	orchestration *mock_service.MockRelayRegisterUserOrchestration
 }
 
 func TestNewUserRelayRegistrar(t *testing.T) {
@@ -35,147 +30,72 @@ func TestNewUserRelayRegistrar(t *testing.T) {
 	})
 }
 
-func TestUserRelayRegistrar_Register(t *testing.T) {
+func TestUserRelayRegistrar_Handle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	t.Run("user outbox get all ready record returns error", func(t *testing.T) {
+	t.Run("payload can't be decoded", func(t *testing.T) {
 		st := createUserRelayRegistrarSuite(ctrl)
-		errReturn := entity.ErrInternal("")
+		msg := &outbox.Message{ID: uuid.Must(uuid.NewV7()), Topic: entity.UserRegisteredTopic, Payload: []byte(`[]`)}
 
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(nil, errReturn)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.Error(t, fn(testCtxTx))
-				return errReturn
-			})
-
-		err := st.relayer.Register(testCtx)
+		err := st.relayer.Handle(testCtx, msg)
 
 		assert.Error(t, err)
+		assert.True(t, outbox.IsPermanent(err))
 	})
 
-	t.Run("set record processed returns error", func(t *testing.T) {
+	t.Run("orchestrator returns error", func(t *testing.T) {
 		st := createUserRelayRegistrarSuite(ctrl)
-		rc := createTestUserOutbox()
-		records := []*entity.UserOutbox{rc}
+		msg := createTestUserRegisteredMessage(t, createTestUser())
 		errReturn := entity.ErrInternal("")
 
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(records, nil)
-		st.userOutboxRepo.EXPECT().SetProcessed(testCtxTx, rc.ID).Return(errReturn)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.NoError(t, fn(testCtxTx))
-				return nil
-			})
+		st.orchestration.EXPECT().RegisterUser(testCtx, gomock.Any()).Return(nil, errReturn)
 
-		err := st.relayer.Register(testCtx)
+		err := st.relayer.Handle(testCtx, msg)
 
-		assert.NoError(t, err)
+		assert.Equal(t, errReturn, err)
+		assert.False(t, outbox.IsPermanent(err))
 	})
 
-	t.Run("enqueue to orchestrator returns error", func(t *testing.T) {
+	t.Run("success relay registered user", func(t *testing.T) {
 		st := createUserRelayRegistrarSuite(ctrl)
-		rc := createTestUserOutbox()
-		records := []*entity.UserOutbox{rc}
-		errReturn := entity.ErrInternal("")
-
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(records, nil)
-		st.userOutboxRepo.EXPECT().SetProcessed(testCtxTx, rc.ID).Return(nil)
-		st.orchestration.EXPECT().RegisterUser(testCtxTx, gomock.Any()).Return(nil, errReturn)
-		st.userOutboxRepo.EXPECT().SetFailed(testCtxTx, rc.ID).Return(nil)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.NoError(t, fn(testCtxTx))
-				return nil
+		user := createTestUser()
+		user.Password = ""
+		user.PasswordHash = "hash"
+		msg := createTestUserRegisteredMessage(t, user)
+
+		st.orchestration.EXPECT().RegisterUser(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *entity.RegisterUserInput) (*entity.RegisterUserOutput, error) {
+				assert.Equal(t, user.ID, input.User.ID)
+				assert.Equal(t, user.Email, input.User.Email)
+				assert.Equal(t, user.PasswordHash, input.User.PasswordHash)
+				return &entity.RegisterUserOutput{}, nil
 			})
 
-		err := st.relayer.Register(testCtx)
+		err := st.relayer.Handle(testCtx, msg)
 
 		assert.NoError(t, err)
 	})
+}
 
-	t.Run("set failed returns error", func(t *testing.T) {
-		st := createUserRelayRegistrarSuite(ctrl)
-		rc := createTestUserOutbox()
-		records := []*entity.UserOutbox{rc}
-		errReturn := entity.ErrInternal("")
-
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(records, nil)
-		st.userOutboxRepo.EXPECT().SetProcessed(testCtxTx, rc.ID).Return(nil)
-		st.orchestration.EXPECT().RegisterUser(testCtxTx, gomock.Any()).Return(nil, errReturn)
-		st.userOutboxRepo.EXPECT().SetFailed(testCtxTx, rc.ID).Return(errReturn)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.NoError(t, fn(testCtxTx))
-				return nil
-			})
-
-		err := st.relayer.Register(testCtx)
-
-		assert.NoError(t, err)
-	})
-
-	t.Run("set delivered returns error", func(t *testing.T) {
-		st := createUserRelayRegistrarSuite(ctrl)
-		rc := createTestUserOutbox()
-		records := []*entity.UserOutbox{rc}
-		errReturn := entity.ErrInternal("")
-
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(records, nil)
-		st.userOutboxRepo.EXPECT().SetProcessed(testCtxTx, rc.ID).Return(nil)
-		st.orchestration.EXPECT().RegisterUser(testCtxTx, gomock.Any()).Return(nil, nil)
-		st.userOutboxRepo.EXPECT().SetDelivered(testCtxTx, rc.ID).Return(errReturn)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.NoError(t, fn(testCtxTx))
-				return nil
-			})
-
-		err := st.relayer.Register(testCtx)
-
-		assert.NoError(t, err)
-	})
-
-	t.Run("set delivered success", func(t *testing.T) {
-		st := createUserRelayRegistrarSuite(ctrl)
-		rc := createTestUserOutbox()
-		records := []*entity.UserOutbox{rc}
-
-		st.userOutboxRepo.EXPECT().GetAllReady(testCtxTx, limitGetAllReady).Return(records, nil)
-		st.userOutboxRepo.EXPECT().SetProcessed(testCtxTx, rc.ID).Return(nil)
-		st.orchestration.EXPECT().RegisterUser(testCtxTx, gomock.Any()).Return(nil, nil)
-		st.userOutboxRepo.EXPECT().SetDelivered(testCtxTx, rc.ID).Return(nil)
-		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
-				assert.NoError(t, fn(testCtxTx))
-				return nil
-			})
-
-		err := st.relayer.Register(testCtx)
-
-		assert.NoError(t, err)
-	})
+func createTestUserRegisteredMessage(t *testing.T, user *entity.User) *outbox.Message {
+	payload, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("error marshaling user: %v\n", err)
+	}
+	return &outbox.Message{
+		ID:      uuid.Must(uuid.NewV7()),
+		Topic:   entity.UserRegisteredTopic,
+		Status:  outbox.StatusProcessed,
+		Payload: payload,
+	}
 }
 
 func createUserRelayRegistrarSuite(ctrl *gomock.Controller) *UserRelayRegistrarSuite {
-	m := mock_uow.NewMockTxManager(ctrl)
-	u := mock_service.NewMockRelayRegisterUserOutboxRepository(ctrl)
 	o := mock_service.NewMockRelayRegisterUserOrchestration(ctrl)
-	r := service.NewUserRelayRegistrar(u, o, m)
+	r := service.NewUserRelayRegistrar(o)
 	return &UserRelayRegistrarSuite{
-		relayer:        r,
-		userOutboxRepo: u,
-		orchestration:  o,
-		txManager:      m,
-	}
-}
-
-func createTestUserOutbox() *entity.UserOutbox {
-	user := createTestUser()
-	return &entity.UserOutbox{
-		ID:      uuid.Must(uuid.NewV7()),
-		Status:  entity.UserOutboxStatusReady,
-		Payload: user,
+		relayer:       r,
+		orchestration: o,
 	}
 }
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// UpdateUser defines the interface to update a user.
+type UpdateUser interface {
+	// UpdateProfile updates the user's profile.
+	// The user's UpdatedAt must be the one that was read, so a concurrent change isn't overwritten.
+	UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error)
+}
+
+// UpdateUserRepository defines interface to update user in repository.
+type UpdateUserRepository interface {
+	// GetByID gets a user that isn't soft-deleted.
+	// It returns entity.ErrNotFound if the user can't be found.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// UpdateProfile updates the user's profile if it hasn't been changed since UpdatedAt.
+	// It returns entity.ErrUpdateConflict if the user has been changed.
+	UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error)
+}
+
+// UserUpdater is responsible for updating a user.
+type UserUpdater struct {
+	repo UpdateUserRepository
+}
+
+// NewUserUpdater creates an instance of UserUpdater.
+func NewUserUpdater(repo UpdateUserRepository) *UserUpdater {
+	return &UserUpdater{repo: repo}
+}
+
+// UpdateProfile updates the user's profile.
+// It returns entity.ErrNotFound if the user can't be found
+// and entity.ErrUpdateConflict if the user has been changed since UpdatedAt.
+func (uu *UserUpdater) UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if user == nil {
+		return nil, entity.ErrEmptyUser()
+	}
+	user.Name = strings.TrimSpace(user.Name)
+	if !isValidName(user.Name) {
+		return nil, entity.ErrInvalidName()
+	}
+
+	current, err := uu.repo.GetByID(ctx, user.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserUpdater-UpdateProfile] fail get user", "error", err)
+		return nil, err
+	}
+	if !current.UpdatedAt.Equal(user.UpdatedAt) {
+		return nil, entity.ErrUpdateConflict()
+	}
+
+	res, err := uu.repo.UpdateProfile(ctx, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "[UserUpdater-UpdateProfile] fail update user profile", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/user/entity"
+	"github.com/indrasaputra/arjuna/service/user/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/user/test/mock/service"
+)
+
+type UserUpdaterSuite struct {
+	updater *service.UserUpdater
+	repo    *mock_service.MockUpdateUserRepository
+}
+
+func TestNewUserUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of UserUpdater", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+		assert.NotNil(t, st.updater)
+	})
+}
+
+func TestUserUpdater_UpdateProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil user is prohibited", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+
+		res, err := st.updater.UpdateProfile(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid name is prohibited", func(t *testing.T) {
+		names := []string{"", "   ", "First User 1", "First_User", strings.Repeat("a", 101)}
+
+		for _, name := range names {
+			st := createUserUpdaterSuite(ctrl)
+			user := createTestUser()
+			user.Name = name
+
+			res, err := st.updater.UpdateProfile(testCtx, user)
+
+			assert.Equal(t, entity.ErrInvalidName(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("user can't be found", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+		user := createTestUser()
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.updater.UpdateProfile(testCtx, user)
+
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("user has been changed since it was read", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+		user := createTestUser()
+		user.UpdatedAt = time.Now().UTC()
+		current := createTestUser()
+		current.UpdatedAt = user.UpdatedAt.Add(time.Second)
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(current, nil)
+
+		res, err := st.updater.UpdateProfile(testCtx, user)
+
+		assert.Equal(t, entity.ErrUpdateConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+		user := createTestUser()
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(user, nil)
+		st.repo.EXPECT().UpdateProfile(testCtx, user).Return(nil, entity.ErrUpdateConflict())
+
+		res, err := st.updater.UpdateProfile(testCtx, user)
+
+		assert.Equal(t, entity.ErrUpdateConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully update profile", func(t *testing.T) {
+		st := createUserUpdaterSuite(ctrl)
+		user := createTestUser()
+		user.Name = "  Second User  "
+		updated := &entity.User{ID: user.ID, Name: "Second User"}
+		st.repo.EXPECT().GetByID(testCtx, user.ID).Return(createTestUser(), nil)
+		st.repo.EXPECT().UpdateProfile(testCtx, gomock.Any()).DoAndReturn(func(_ any, u *entity.User) (*entity.User, error) {
+			assert.Equal(t, "Second User", u.Name)
+			return updated, nil
+		})
+
+		res, err := st.updater.UpdateProfile(testCtx, user)
+
+		assert.NoError(t, err)
+		assert.Equal(t, updated, res)
+	})
+}
+
+func createUserUpdaterSuite(ctrl *gomock.Controller) *UserUpdaterSuite {
+	r := mock_service.NewMockUpdateUserRepository(ctrl)
+	u := service.NewUserUpdater(r)
+	return &UserUpdaterSuite{
+		updater: u,
+		repo:    r,
+	}
+}
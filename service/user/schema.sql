@@ -13,20 +13,36 @@ CREATE TABLE IF NOT EXISTS users (
 
 CREATE INDEX IF NOT EXISTS index_on_users_on_id ON users USING btree (id);
 
-CREATE TYPE user_outbox_status AS ENUM ('READY', 'PROCESSED', 'DELIVERED', 'FAILED');
+CREATE TYPE outbox_status AS ENUM ('READY', 'PROCESSED', 'DELIVERED', 'FAILED', 'DEAD_LETTER');
 
-CREATE TABLE IF NOT EXISTS users_outbox (
+CREATE TABLE IF NOT EXISTS outbox (
     id UUID PRIMARY KEY,
+    topic TEXT NOT NULL,
     payload JSONB NOT NULL,
-    status USER_OUTBOX_STATUS NOT NULL DEFAULT 'READY',
+    status OUTBOX_STATUS NOT NULL DEFAULT 'READY',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    deleted_at TIMESTAMP,
-    created_by UUID NOT NULL,
-    updated_by UUID NOT NULL,
-    deleted_by UUID
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS index_on_outbox_on_status_and_next_attempt_at ON outbox USING btree (
+    status, next_attempt_at
+);
+
+CREATE INDEX IF NOT EXISTS index_on_outbox_on_topic_and_payload_id ON outbox USING btree (
+    topic, (payload->>'id')
+);
+
+CREATE TABLE IF NOT EXISTS users_data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    archive JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL
 );
 
-CREATE INDEX IF NOT EXISTS index_on_users_outbox_on_status_and_created_at ON users_outbox USING btree (
-    status, created_at
+CREATE INDEX IF NOT EXISTS index_on_users_data_exports_on_expires_at ON users_data_exports USING btree (
+    expires_at
 );
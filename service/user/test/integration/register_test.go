@@ -50,7 +50,7 @@ func TestRegisterUser(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, status)
 		assert.Equal(t, float64(3), gjson.GetBytes(resp, "code").Float())
-		assert.Equal(t, "contain character outside of alphabet", gjson.GetBytes(resp, "details.0.fieldViolations.0.description").String())
+		assert.Equal(t, "must only contain alphabet and be at most 100 characters", gjson.GetBytes(resp, "details.0.fieldViolations.0.description").String())
 		assert.Equal(t, "USER_ERROR_CODE_INVALID_NAME", gjson.GetBytes(resp, "details.1.errorCode").String())
 	})
 
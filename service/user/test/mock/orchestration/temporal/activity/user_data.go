@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/orchestration/temporal/activity/user_data.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/orchestration/temporal/activity/user_data.go -destination=./service/user/test/mock//orchestration/temporal/activity/user_data.go
+//
+
+// Package mock_activity is a generated GoMock package.
+package mock_activity
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// MockUserDataAuthConnection is a mock of UserDataAuthConnection interface.
+type MockUserDataAuthConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUserDataAuthConnectionMockRecorder
+}
+
+// MockUserDataAuthConnectionMockRecorder is the mock recorder for MockUserDataAuthConnection.
+type MockUserDataAuthConnectionMockRecorder struct {
+	mock *MockUserDataAuthConnection
+}
+
+// NewMockUserDataAuthConnection creates a new mock instance.
+func NewMockUserDataAuthConnection(ctrl *gomock.Controller) *MockUserDataAuthConnection {
+	mock := &MockUserDataAuthConnection{ctrl: ctrl}
+	mock.recorder = &MockUserDataAuthConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataAuthConnection) EXPECT() *MockUserDataAuthConnectionMockRecorder {
+	return m.recorder
+}
+
+// EraseAccount mocks base method.
+func (m *MockUserDataAuthConnection) EraseAccount(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseAccount indicates an expected call of EraseAccount.
+func (mr *MockUserDataAuthConnectionMockRecorder) EraseAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseAccount", reflect.TypeOf((*MockUserDataAuthConnection)(nil).EraseAccount), ctx, userID)
+}
+
+// GetAccount mocks base method.
+func (m *MockUserDataAuthConnection) GetAccount(ctx context.Context, userID uuid.UUID) (*entity.AccountData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, userID)
+	ret0, _ := ret[0].(*entity.AccountData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockUserDataAuthConnectionMockRecorder) GetAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockUserDataAuthConnection)(nil).GetAccount), ctx, userID)
+}
+
+// MockUserDataWalletConnection is a mock of UserDataWalletConnection interface.
+type MockUserDataWalletConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUserDataWalletConnectionMockRecorder
+}
+
+// MockUserDataWalletConnectionMockRecorder is the mock recorder for MockUserDataWalletConnection.
+type MockUserDataWalletConnectionMockRecorder struct {
+	mock *MockUserDataWalletConnection
+}
+
+// NewMockUserDataWalletConnection creates a new mock instance.
+func NewMockUserDataWalletConnection(ctrl *gomock.Controller) *MockUserDataWalletConnection {
+	mock := &MockUserDataWalletConnection{ctrl: ctrl}
+	mock.recorder = &MockUserDataWalletConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataWalletConnection) EXPECT() *MockUserDataWalletConnectionMockRecorder {
+	return m.recorder
+}
+
+// FreezeWallets mocks base method.
+func (m *MockUserDataWalletConnection) FreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeWallets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeWallets indicates an expected call of FreezeWallets.
+func (mr *MockUserDataWalletConnectionMockRecorder) FreezeWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeWallets", reflect.TypeOf((*MockUserDataWalletConnection)(nil).FreezeWallets), ctx, userID)
+}
+
+// ListWallets mocks base method.
+func (m *MockUserDataWalletConnection) ListWallets(ctx context.Context, userID uuid.UUID) ([]*entity.WalletData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWallets", ctx, userID)
+	ret0, _ := ret[0].([]*entity.WalletData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWallets indicates an expected call of ListWallets.
+func (mr *MockUserDataWalletConnectionMockRecorder) ListWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWallets", reflect.TypeOf((*MockUserDataWalletConnection)(nil).ListWallets), ctx, userID)
+}
+
+// MockUserDataTransactionConnection is a mock of UserDataTransactionConnection interface.
+type MockUserDataTransactionConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUserDataTransactionConnectionMockRecorder
+}
+
+// MockUserDataTransactionConnectionMockRecorder is the mock recorder for MockUserDataTransactionConnection.
+type MockUserDataTransactionConnectionMockRecorder struct {
+	mock *MockUserDataTransactionConnection
+}
+
+// NewMockUserDataTransactionConnection creates a new mock instance.
+func NewMockUserDataTransactionConnection(ctrl *gomock.Controller) *MockUserDataTransactionConnection {
+	mock := &MockUserDataTransactionConnection{ctrl: ctrl}
+	mock.recorder = &MockUserDataTransactionConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataTransactionConnection) EXPECT() *MockUserDataTransactionConnectionMockRecorder {
+	return m.recorder
+}
+
+// ListTransactions mocks base method.
+func (m *MockUserDataTransactionConnection) ListTransactions(ctx context.Context, userID uuid.UUID) ([]*entity.TransactionData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, userID)
+	ret0, _ := ret[0].([]*entity.TransactionData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockUserDataTransactionConnectionMockRecorder) ListTransactions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockUserDataTransactionConnection)(nil).ListTransactions), ctx, userID)
+}
+
+// MockUserDataDatabase is a mock of UserDataDatabase interface.
+type MockUserDataDatabase struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUserDataDatabaseMockRecorder
+}
+
+// MockUserDataDatabaseMockRecorder is the mock recorder for MockUserDataDatabase.
+type MockUserDataDatabaseMockRecorder struct {
+	mock *MockUserDataDatabase
+}
+
+// NewMockUserDataDatabase creates a new mock instance.
+func NewMockUserDataDatabase(ctrl *gomock.Controller) *MockUserDataDatabase {
+	mock := &MockUserDataDatabase{ctrl: ctrl}
+	mock.recorder = &MockUserDataDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataDatabase) EXPECT() *MockUserDataDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Anonymize mocks base method.
+func (m *MockUserDataDatabase) Anonymize(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymize", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Anonymize indicates an expected call of Anonymize.
+func (mr *MockUserDataDatabaseMockRecorder) Anonymize(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymize", reflect.TypeOf((*MockUserDataDatabase)(nil).Anonymize), ctx, id)
+}
+
+// GetByIDIncludingDeleted mocks base method.
+func (m *MockUserDataDatabase) GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDIncludingDeleted", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDIncludingDeleted indicates an expected call of GetByIDIncludingDeleted.
+func (mr *MockUserDataDatabaseMockRecorder) GetByIDIncludingDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDIncludingDeleted", reflect.TypeOf((*MockUserDataDatabase)(nil).GetByIDIncludingDeleted), ctx, id)
+}
+
+// InsertDataExport mocks base method.
+func (m *MockUserDataDatabase) InsertDataExport(ctx context.Context, archive *entity.UserDataArchive, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDataExport", ctx, archive, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDataExport indicates an expected call of InsertDataExport.
+func (mr *MockUserDataDatabaseMockRecorder) InsertDataExport(ctx, archive, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDataExport", reflect.TypeOf((*MockUserDataDatabase)(nil).InsertDataExport), ctx, archive, expiresAt)
+}
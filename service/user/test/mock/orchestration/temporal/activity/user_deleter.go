@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/orchestration/temporal/activity/user_deleter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/orchestration/temporal/activity/user_deleter.go -destination=./service/user/test/mock//orchestration/temporal/activity/user_deleter.go
+//
+
+// Package mock_activity is a generated GoMock package.
+package mock_activity
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteUserAuthConnection is a mock of DeleteUserAuthConnection interface.
+type MockDeleteUserAuthConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserAuthConnectionMockRecorder
+}
+
+// MockDeleteUserAuthConnectionMockRecorder is the mock recorder for MockDeleteUserAuthConnection.
+type MockDeleteUserAuthConnectionMockRecorder struct {
+	mock *MockDeleteUserAuthConnection
+}
+
+// NewMockDeleteUserAuthConnection creates a new mock instance.
+func NewMockDeleteUserAuthConnection(ctrl *gomock.Controller) *MockDeleteUserAuthConnection {
+	mock := &MockDeleteUserAuthConnection{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserAuthConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserAuthConnection) EXPECT() *MockDeleteUserAuthConnectionMockRecorder {
+	return m.recorder
+}
+
+// RestoreAccount mocks base method.
+func (m *MockDeleteUserAuthConnection) RestoreAccount(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAccount indicates an expected call of RestoreAccount.
+func (mr *MockDeleteUserAuthConnectionMockRecorder) RestoreAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAccount", reflect.TypeOf((*MockDeleteUserAuthConnection)(nil).RestoreAccount), ctx, userID)
+}
+
+// SoftDeleteAccount mocks base method.
+func (m *MockDeleteUserAuthConnection) SoftDeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteAccount indicates an expected call of SoftDeleteAccount.
+func (mr *MockDeleteUserAuthConnectionMockRecorder) SoftDeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteAccount", reflect.TypeOf((*MockDeleteUserAuthConnection)(nil).SoftDeleteAccount), ctx, userID)
+}
+
+// MockDeleteUserWalletConnection is a mock of DeleteUserWalletConnection interface.
+type MockDeleteUserWalletConnection struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserWalletConnectionMockRecorder
+}
+
+// MockDeleteUserWalletConnectionMockRecorder is the mock recorder for MockDeleteUserWalletConnection.
+type MockDeleteUserWalletConnectionMockRecorder struct {
+	mock *MockDeleteUserWalletConnection
+}
+
+// NewMockDeleteUserWalletConnection creates a new mock instance.
+func NewMockDeleteUserWalletConnection(ctrl *gomock.Controller) *MockDeleteUserWalletConnection {
+	mock := &MockDeleteUserWalletConnection{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserWalletConnectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserWalletConnection) EXPECT() *MockDeleteUserWalletConnectionMockRecorder {
+	return m.recorder
+}
+
+// FreezeWallets mocks base method.
+func (m *MockDeleteUserWalletConnection) FreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeWallets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeWallets indicates an expected call of FreezeWallets.
+func (mr *MockDeleteUserWalletConnectionMockRecorder) FreezeWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeWallets", reflect.TypeOf((*MockDeleteUserWalletConnection)(nil).FreezeWallets), ctx, userID)
+}
+
+// UnfreezeWallets mocks base method.
+func (m *MockDeleteUserWalletConnection) UnfreezeWallets(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeWallets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfreezeWallets indicates an expected call of UnfreezeWallets.
+func (mr *MockDeleteUserWalletConnectionMockRecorder) UnfreezeWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeWallets", reflect.TypeOf((*MockDeleteUserWalletConnection)(nil).UnfreezeWallets), ctx, userID)
+}
+
+// MockDeleteUserDatabase is a mock of DeleteUserDatabase interface.
+type MockDeleteUserDatabase struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserDatabaseMockRecorder
+}
+
+// MockDeleteUserDatabaseMockRecorder is the mock recorder for MockDeleteUserDatabase.
+type MockDeleteUserDatabaseMockRecorder struct {
+	mock *MockDeleteUserDatabase
+}
+
+// NewMockDeleteUserDatabase creates a new mock instance.
+func NewMockDeleteUserDatabase(ctrl *gomock.Controller) *MockDeleteUserDatabase {
+	mock := &MockDeleteUserDatabase{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserDatabase) EXPECT() *MockDeleteUserDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Restore mocks base method.
+func (m *MockDeleteUserDatabase) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDeleteUserDatabaseMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDeleteUserDatabase)(nil).Restore), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockDeleteUserDatabase) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockDeleteUserDatabaseMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockDeleteUserDatabase)(nil).SoftDelete), ctx, id)
+}
@@ -57,6 +57,20 @@ func (mr *MockRegisterUserAuthConnectionMockRecorder) CreateAccount(ctx, user an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockRegisterUserAuthConnection)(nil).CreateAccount), ctx, user)
 }
 
+// DeleteAccount mocks base method.
+func (m *MockRegisterUserAuthConnection) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockRegisterUserAuthConnectionMockRecorder) DeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockRegisterUserAuthConnection)(nil).DeleteAccount), ctx, userID)
+}
+
 // MockRegisterUserWalletConnection is a mock of RegisterUserWalletConnection interface.
 type MockRegisterUserWalletConnection struct {
 	isgomock struct{}
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/service/registration_status_getter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/service/registration_status_getter.go -destination=./service/user/test/mock//service/registration_status_getter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// MockGetRegistrationStatus is a mock of GetRegistrationStatus interface.
+type MockGetRegistrationStatus struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetRegistrationStatusMockRecorder
+}
+
+// MockGetRegistrationStatusMockRecorder is the mock recorder for MockGetRegistrationStatus.
+type MockGetRegistrationStatusMockRecorder struct {
+	mock *MockGetRegistrationStatus
+}
+
+// NewMockGetRegistrationStatus creates a new mock instance.
+func NewMockGetRegistrationStatus(ctrl *gomock.Controller) *MockGetRegistrationStatus {
+	mock := &MockGetRegistrationStatus{ctrl: ctrl}
+	mock.recorder = &MockGetRegistrationStatusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRegistrationStatus) EXPECT() *MockGetRegistrationStatusMockRecorder {
+	return m.recorder
+}
+
+// GetRegistrationStatus mocks base method.
+func (m *MockGetRegistrationStatus) GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationStatus", ctx, userID)
+	ret0, _ := ret[0].(*entity.RegistrationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatus indicates an expected call of GetRegistrationStatus.
+func (mr *MockGetRegistrationStatusMockRecorder) GetRegistrationStatus(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatus", reflect.TypeOf((*MockGetRegistrationStatus)(nil).GetRegistrationStatus), ctx, userID)
+}
+
+// MockGetRegistrationStatusRepository is a mock of GetRegistrationStatusRepository interface.
+type MockGetRegistrationStatusRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetRegistrationStatusRepositoryMockRecorder
+}
+
+// MockGetRegistrationStatusRepositoryMockRecorder is the mock recorder for MockGetRegistrationStatusRepository.
+type MockGetRegistrationStatusRepositoryMockRecorder struct {
+	mock *MockGetRegistrationStatusRepository
+}
+
+// NewMockGetRegistrationStatusRepository creates a new mock instance.
+func NewMockGetRegistrationStatusRepository(ctrl *gomock.Controller) *MockGetRegistrationStatusRepository {
+	mock := &MockGetRegistrationStatusRepository{ctrl: ctrl}
+	mock.recorder = &MockGetRegistrationStatusRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRegistrationStatusRepository) EXPECT() *MockGetRegistrationStatusRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockGetRegistrationStatusRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.UserOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockGetRegistrationStatusRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockGetRegistrationStatusRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// MockGetRegistrationStatusOrchestration is a mock of GetRegistrationStatusOrchestration interface.
+type MockGetRegistrationStatusOrchestration struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetRegistrationStatusOrchestrationMockRecorder
+}
+
+// MockGetRegistrationStatusOrchestrationMockRecorder is the mock recorder for MockGetRegistrationStatusOrchestration.
+type MockGetRegistrationStatusOrchestrationMockRecorder struct {
+	mock *MockGetRegistrationStatusOrchestration
+}
+
+// NewMockGetRegistrationStatusOrchestration creates a new mock instance.
+func NewMockGetRegistrationStatusOrchestration(ctrl *gomock.Controller) *MockGetRegistrationStatusOrchestration {
+	mock := &MockGetRegistrationStatusOrchestration{ctrl: ctrl}
+	mock.recorder = &MockGetRegistrationStatusOrchestrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRegistrationStatusOrchestration) EXPECT() *MockGetRegistrationStatusOrchestrationMockRecorder {
+	return m.recorder
+}
+
+// GetRegistrationStatus mocks base method.
+func (m *MockGetRegistrationStatusOrchestration) GetRegistrationStatus(ctx context.Context, userID uuid.UUID) (*entity.RegistrationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationStatus", ctx, userID)
+	ret0, _ := ret[0].(*entity.RegistrationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatus indicates an expected call of GetRegistrationStatus.
+func (mr *MockGetRegistrationStatusOrchestrationMockRecorder) GetRegistrationStatus(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatus", reflect.TypeOf((*MockGetRegistrationStatusOrchestration)(nil).GetRegistrationStatus), ctx, userID)
+}
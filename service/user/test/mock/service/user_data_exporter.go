@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/service/user_data_exporter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/service/user_data_exporter.go -destination=./service/user/test/mock//service/user_data_exporter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// MockExportUserData is a mock of ExportUserData interface.
+type MockExportUserData struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockExportUserDataMockRecorder
+}
+
+// MockExportUserDataMockRecorder is the mock recorder for MockExportUserData.
+type MockExportUserDataMockRecorder struct {
+	mock *MockExportUserData
+}
+
+// NewMockExportUserData creates a new mock instance.
+func NewMockExportUserData(ctrl *gomock.Controller) *MockExportUserData {
+	mock := &MockExportUserData{ctrl: ctrl}
+	mock.recorder = &MockExportUserDataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUserData) EXPECT() *MockExportUserDataMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportUserData) Export(ctx context.Context, id uuid.UUID) (*entity.UserDataArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].(*entity.UserDataArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportUserDataMockRecorder) Export(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportUserData)(nil).Export), ctx, id)
+}
+
+// MockExportUserDataRepository is a mock of ExportUserDataRepository interface.
+type MockExportUserDataRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockExportUserDataRepositoryMockRecorder
+}
+
+// MockExportUserDataRepositoryMockRecorder is the mock recorder for MockExportUserDataRepository.
+type MockExportUserDataRepositoryMockRecorder struct {
+	mock *MockExportUserDataRepository
+}
+
+// NewMockExportUserDataRepository creates a new mock instance.
+func NewMockExportUserDataRepository(ctrl *gomock.Controller) *MockExportUserDataRepository {
+	mock := &MockExportUserDataRepository{ctrl: ctrl}
+	mock.recorder = &MockExportUserDataRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUserDataRepository) EXPECT() *MockExportUserDataRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByIDIncludingDeleted mocks base method.
+func (m *MockExportUserDataRepository) GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDIncludingDeleted", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDIncludingDeleted indicates an expected call of GetByIDIncludingDeleted.
+func (mr *MockExportUserDataRepositoryMockRecorder) GetByIDIncludingDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDIncludingDeleted", reflect.TypeOf((*MockExportUserDataRepository)(nil).GetByIDIncludingDeleted), ctx, id)
+}
+
+// TakeDataExport mocks base method.
+func (m *MockExportUserDataRepository) TakeDataExport(ctx context.Context, id, userID uuid.UUID) (*entity.UserDataArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeDataExport", ctx, id, userID)
+	ret0, _ := ret[0].(*entity.UserDataArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeDataExport indicates an expected call of TakeDataExport.
+func (mr *MockExportUserDataRepositoryMockRecorder) TakeDataExport(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeDataExport", reflect.TypeOf((*MockExportUserDataRepository)(nil).TakeDataExport), ctx, id, userID)
+}
+
+// MockExportUserDataOrchestration is a mock of ExportUserDataOrchestration interface.
+type MockExportUserDataOrchestration struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockExportUserDataOrchestrationMockRecorder
+}
+
+// MockExportUserDataOrchestrationMockRecorder is the mock recorder for MockExportUserDataOrchestration.
+type MockExportUserDataOrchestrationMockRecorder struct {
+	mock *MockExportUserDataOrchestration
+}
+
+// NewMockExportUserDataOrchestration creates a new mock instance.
+func NewMockExportUserDataOrchestration(ctrl *gomock.Controller) *MockExportUserDataOrchestration {
+	mock := &MockExportUserDataOrchestration{ctrl: ctrl}
+	mock.recorder = &MockExportUserDataOrchestrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportUserDataOrchestration) EXPECT() *MockExportUserDataOrchestrationMockRecorder {
+	return m.recorder
+}
+
+// ExportUserData mocks base method.
+func (m *MockExportUserDataOrchestration) ExportUserData(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockExportUserDataOrchestrationMockRecorder) ExportUserData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockExportUserDataOrchestration)(nil).ExportUserData), ctx, id)
+}
@@ -15,6 +15,8 @@ import (
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
 )
 
 // MockDeleteUser is a mock of DeleteUser interface.
@@ -55,6 +57,34 @@ func (mr *MockDeleteUserMockRecorder) HardDelete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockDeleteUser)(nil).HardDelete), ctx, id)
 }
 
+// Restore mocks base method.
+func (m *MockDeleteUser) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDeleteUserMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDeleteUser)(nil).Restore), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockDeleteUser) SoftDelete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockDeleteUserMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockDeleteUser)(nil).SoftDelete), ctx, id)
+}
+
 // MockDeleteUserRepository is a mock of DeleteUserRepository interface.
 type MockDeleteUserRepository struct {
 	isgomock struct{}
@@ -79,6 +109,36 @@ func (m *MockDeleteUserRepository) EXPECT() *MockDeleteUserRepositoryMockRecorde
 	return m.recorder
 }
 
+// GetByID mocks base method.
+func (m *MockDeleteUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDeleteUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDeleteUserRepository)(nil).GetByID), ctx, id)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockDeleteUserRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockDeleteUserRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockDeleteUserRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // HardDelete mocks base method.
 func (m *MockDeleteUserRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -92,3 +152,55 @@ func (mr *MockDeleteUserRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockDeleteUserRepository)(nil).HardDelete), ctx, id)
 }
+
+// MockDeleteUserOrchestration is a mock of DeleteUserOrchestration interface.
+type MockDeleteUserOrchestration struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserOrchestrationMockRecorder
+}
+
+// MockDeleteUserOrchestrationMockRecorder is the mock recorder for MockDeleteUserOrchestration.
+type MockDeleteUserOrchestrationMockRecorder struct {
+	mock *MockDeleteUserOrchestration
+}
+
+// NewMockDeleteUserOrchestration creates a new mock instance.
+func NewMockDeleteUserOrchestration(ctrl *gomock.Controller) *MockDeleteUserOrchestration {
+	mock := &MockDeleteUserOrchestration{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserOrchestrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserOrchestration) EXPECT() *MockDeleteUserOrchestrationMockRecorder {
+	return m.recorder
+}
+
+// DeleteUser mocks base method.
+func (m *MockDeleteUserOrchestration) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockDeleteUserOrchestrationMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDeleteUserOrchestration)(nil).DeleteUser), ctx, id)
+}
+
+// RestoreUser mocks base method.
+func (m *MockDeleteUserOrchestration) RestoreUser(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockDeleteUserOrchestrationMockRecorder) RestoreUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockDeleteUserOrchestration)(nil).RestoreUser), ctx, id)
+}
@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/service/user_eraser.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/service/user_eraser.go -destination=./service/user/test/mock//service/user_eraser.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// MockEraseUser is a mock of EraseUser interface.
+type MockEraseUser struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockEraseUserMockRecorder
+}
+
+// MockEraseUserMockRecorder is the mock recorder for MockEraseUser.
+type MockEraseUserMockRecorder struct {
+	mock *MockEraseUser
+}
+
+// NewMockEraseUser creates a new mock instance.
+func NewMockEraseUser(ctrl *gomock.Controller) *MockEraseUser {
+	mock := &MockEraseUser{ctrl: ctrl}
+	mock.recorder = &MockEraseUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEraseUser) EXPECT() *MockEraseUserMockRecorder {
+	return m.recorder
+}
+
+// Erase mocks base method.
+func (m *MockEraseUser) Erase(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Erase", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Erase indicates an expected call of Erase.
+func (mr *MockEraseUserMockRecorder) Erase(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Erase", reflect.TypeOf((*MockEraseUser)(nil).Erase), ctx, id)
+}
+
+// MockEraseUserRepository is a mock of EraseUserRepository interface.
+type MockEraseUserRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockEraseUserRepositoryMockRecorder
+}
+
+// MockEraseUserRepositoryMockRecorder is the mock recorder for MockEraseUserRepository.
+type MockEraseUserRepositoryMockRecorder struct {
+	mock *MockEraseUserRepository
+}
+
+// NewMockEraseUserRepository creates a new mock instance.
+func NewMockEraseUserRepository(ctrl *gomock.Controller) *MockEraseUserRepository {
+	mock := &MockEraseUserRepository{ctrl: ctrl}
+	mock.recorder = &MockEraseUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEraseUserRepository) EXPECT() *MockEraseUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByIDIncludingDeleted mocks base method.
+func (m *MockEraseUserRepository) GetByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDIncludingDeleted", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDIncludingDeleted indicates an expected call of GetByIDIncludingDeleted.
+func (mr *MockEraseUserRepositoryMockRecorder) GetByIDIncludingDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDIncludingDeleted", reflect.TypeOf((*MockEraseUserRepository)(nil).GetByIDIncludingDeleted), ctx, id)
+}
+
+// MockEraseUserOrchestration is a mock of EraseUserOrchestration interface.
+type MockEraseUserOrchestration struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockEraseUserOrchestrationMockRecorder
+}
+
+// MockEraseUserOrchestrationMockRecorder is the mock recorder for MockEraseUserOrchestration.
+type MockEraseUserOrchestrationMockRecorder struct {
+	mock *MockEraseUserOrchestration
+}
+
+// NewMockEraseUserOrchestration creates a new mock instance.
+func NewMockEraseUserOrchestration(ctrl *gomock.Controller) *MockEraseUserOrchestration {
+	mock := &MockEraseUserOrchestration{ctrl: ctrl}
+	mock.recorder = &MockEraseUserOrchestrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEraseUserOrchestration) EXPECT() *MockEraseUserOrchestrationMockRecorder {
+	return m.recorder
+}
+
+// EraseUserData mocks base method.
+func (m *MockEraseUserOrchestration) EraseUserData(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUserData", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUserData indicates an expected call of EraseUserData.
+func (mr *MockEraseUserOrchestrationMockRecorder) EraseUserData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUserData", reflect.TypeOf((*MockEraseUserOrchestration)(nil).EraseUserData), ctx, id)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 
 	entity "github.com/indrasaputra/arjuna/service/user/entity"
@@ -57,6 +58,21 @@ func (mr *MockGetUserMockRecorder) GetAll(ctx, limit any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetUser)(nil).GetAll), ctx, limit)
 }
 
+// GetByID mocks base method.
+func (m *MockGetUser) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetUserMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetUser)(nil).GetByID), ctx, id)
+}
+
 // MockGetUserRepository is a mock of GetUserRepository interface.
 type MockGetUserRepository struct {
 	isgomock struct{}
@@ -95,3 +111,18 @@ func (mr *MockGetUserRepositoryMockRecorder) GetAll(ctx, limit any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetUserRepository)(nil).GetAll), ctx, limit)
 }
+
+// GetByID mocks base method.
+func (m *MockGetUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetUserRepository)(nil).GetByID), ctx, id)
+}
@@ -96,40 +96,40 @@ func (mr *MockRegisterUserRepositoryMockRecorder) Insert(ctx, user any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRegisterUserRepository)(nil).Insert), ctx, user)
 }
 
-// MockRegisterUserOutboxRepository is a mock of RegisterUserOutboxRepository interface.
-type MockRegisterUserOutboxRepository struct {
+// MockRegisterUserOutbox is a mock of RegisterUserOutbox interface.
+type MockRegisterUserOutbox struct {
 	isgomock struct{}
 	ctrl     *gomock.Controller
-	recorder *MockRegisterUserOutboxRepositoryMockRecorder
+	recorder *MockRegisterUserOutboxMockRecorder
 }
 
-// MockRegisterUserOutboxRepositoryMockRecorder is the mock recorder for MockRegisterUserOutboxRepository.
-type MockRegisterUserOutboxRepositoryMockRecorder struct {
-	mock *MockRegisterUserOutboxRepository
+// MockRegisterUserOutboxMockRecorder is the mock recorder for MockRegisterUserOutbox.
+type MockRegisterUserOutboxMockRecorder struct {
+	mock *MockRegisterUserOutbox
 }
 
-// NewMockRegisterUserOutboxRepository creates a new mock instance.
-func NewMockRegisterUserOutboxRepository(ctrl *gomock.Controller) *MockRegisterUserOutboxRepository {
-	mock := &MockRegisterUserOutboxRepository{ctrl: ctrl}
-	mock.recorder = &MockRegisterUserOutboxRepositoryMockRecorder{mock}
+// NewMockRegisterUserOutbox creates a new mock instance.
+func NewMockRegisterUserOutbox(ctrl *gomock.Controller) *MockRegisterUserOutbox {
+	mock := &MockRegisterUserOutbox{ctrl: ctrl}
+	mock.recorder = &MockRegisterUserOutboxMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRegisterUserOutboxRepository) EXPECT() *MockRegisterUserOutboxRepositoryMockRecorder {
+func (m *MockRegisterUserOutbox) EXPECT() *MockRegisterUserOutboxMockRecorder {
 	return m.recorder
 }
 
-// Insert mocks base method.
-func (m *MockRegisterUserOutboxRepository) Insert(ctx context.Context, payload *entity.UserOutbox) error {
+// Enqueue mocks base method.
+func (m *MockRegisterUserOutbox) Enqueue(ctx context.Context, topic string, payload any) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", ctx, payload)
+	ret := m.ctrl.Call(m, "Enqueue", ctx, topic, payload)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Insert indicates an expected call of Insert.
-func (mr *MockRegisterUserOutboxRepositoryMockRecorder) Insert(ctx, payload any) *gomock.Call {
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockRegisterUserOutboxMockRecorder) Enqueue(ctx, topic, payload any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRegisterUserOutboxRepository)(nil).Insert), ctx, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockRegisterUserOutbox)(nil).Enqueue), ctx, topic, payload)
 }
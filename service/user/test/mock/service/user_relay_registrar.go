@@ -13,7 +13,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 
 	entity "github.com/indrasaputra/arjuna/service/user/entity"
@@ -96,84 +95,3 @@ func (mr *MockRelayRegisterUserOrchestrationMockRecorder) RegisterUser(ctx, inpu
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUser", reflect.TypeOf((*MockRelayRegisterUserOrchestration)(nil).RegisterUser), ctx, input)
 }
-
-// MockRelayRegisterUserOutboxRepository is a mock of RelayRegisterUserOutboxRepository interface.
-type MockRelayRegisterUserOutboxRepository struct {
-	isgomock struct{}
-	ctrl     *gomock.Controller
-	recorder *MockRelayRegisterUserOutboxRepositoryMockRecorder
-}
-
-// MockRelayRegisterUserOutboxRepositoryMockRecorder is the mock recorder for MockRelayRegisterUserOutboxRepository.
-type MockRelayRegisterUserOutboxRepositoryMockRecorder struct {
-	mock *MockRelayRegisterUserOutboxRepository
-}
-
-// NewMockRelayRegisterUserOutboxRepository creates a new mock instance.
-func NewMockRelayRegisterUserOutboxRepository(ctrl *gomock.Controller) *MockRelayRegisterUserOutboxRepository {
-	mock := &MockRelayRegisterUserOutboxRepository{ctrl: ctrl}
-	mock.recorder = &MockRelayRegisterUserOutboxRepositoryMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRelayRegisterUserOutboxRepository) EXPECT() *MockRelayRegisterUserOutboxRepositoryMockRecorder {
-	return m.recorder
-}
-
-// GetAllReady mocks base method.
-func (m *MockRelayRegisterUserOutboxRepository) GetAllReady(ctx context.Context, limit uint) ([]*entity.UserOutbox, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllReady", ctx, limit)
-	ret0, _ := ret[0].([]*entity.UserOutbox)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAllReady indicates an expected call of GetAllReady.
-func (mr *MockRelayRegisterUserOutboxRepositoryMockRecorder) GetAllReady(ctx, limit any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllReady", reflect.TypeOf((*MockRelayRegisterUserOutboxRepository)(nil).GetAllReady), ctx, limit)
-}
-
-// SetDelivered mocks base method.
-func (m *MockRelayRegisterUserOutboxRepository) SetDelivered(ctx context.Context, id uuid.UUID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetDelivered", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetDelivered indicates an expected call of SetDelivered.
-func (mr *MockRelayRegisterUserOutboxRepositoryMockRecorder) SetDelivered(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelivered", reflect.TypeOf((*MockRelayRegisterUserOutboxRepository)(nil).SetDelivered), ctx, id)
-}
-
-// SetFailed mocks base method.
-func (m *MockRelayRegisterUserOutboxRepository) SetFailed(ctx context.Context, id uuid.UUID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetFailed", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetFailed indicates an expected call of SetFailed.
-func (mr *MockRelayRegisterUserOutboxRepositoryMockRecorder) SetFailed(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFailed", reflect.TypeOf((*MockRelayRegisterUserOutboxRepository)(nil).SetFailed), ctx, id)
-}
-
-// SetProcessed mocks base method.
-func (m *MockRelayRegisterUserOutboxRepository) SetProcessed(ctx context.Context, id uuid.UUID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetProcessed", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetProcessed indicates an expected call of SetProcessed.
-func (mr *MockRelayRegisterUserOutboxRepositoryMockRecorder) SetProcessed(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProcessed", reflect.TypeOf((*MockRelayRegisterUserOutboxRepository)(nil).SetProcessed), ctx, id)
-}
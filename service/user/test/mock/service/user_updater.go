@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/user/internal/service/user_updater.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/user/internal/service/user_updater.go -destination=./service/user/test/mock//service/user_updater.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/user/entity"
+)
+
+// MockUpdateUser is a mock of UpdateUser interface.
+type MockUpdateUser struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUserMockRecorder
+}
+
+// MockUpdateUserMockRecorder is the mock recorder for MockUpdateUser.
+type MockUpdateUserMockRecorder struct {
+	mock *MockUpdateUser
+}
+
+// NewMockUpdateUser creates a new mock instance.
+func NewMockUpdateUser(ctrl *gomock.Controller) *MockUpdateUser {
+	mock := &MockUpdateUser{ctrl: ctrl}
+	mock.recorder = &MockUpdateUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUser) EXPECT() *MockUpdateUserMockRecorder {
+	return m.recorder
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUpdateUser) UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUpdateUserMockRecorder) UpdateProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUpdateUser)(nil).UpdateProfile), ctx, user)
+}
+
+// MockUpdateUserRepository is a mock of UpdateUserRepository interface.
+type MockUpdateUserRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockUpdateUserRepositoryMockRecorder
+}
+
+// MockUpdateUserRepositoryMockRecorder is the mock recorder for MockUpdateUserRepository.
+type MockUpdateUserRepositoryMockRecorder struct {
+	mock *MockUpdateUserRepository
+}
+
+// NewMockUpdateUserRepository creates a new mock instance.
+func NewMockUpdateUserRepository(ctrl *gomock.Controller) *MockUpdateUserRepository {
+	mock := &MockUpdateUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateUserRepository) EXPECT() *MockUpdateUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUpdateUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUpdateUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUpdateUserRepository)(nil).GetByID), ctx, id)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUpdateUserRepository) UpdateProfile(ctx context.Context, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUpdateUserRepositoryMockRecorder) UpdateProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUpdateUserRepository)(nil).UpdateProfile), ctx, user)
+}
@@ -4,28 +4,39 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/cache/redis"
 	"github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/server"
 	sdklog "github.com/indrasaputra/arjuna/pkg/sdk/log"
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	sdkauth "github.com/indrasaputra/arjuna/service/auth/pkg/sdk/auth"
 	"github.com/indrasaputra/arjuna/service/wallet/entity"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/builder"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/config"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/fxrate"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/grpc/handler"
+	orcact "github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/activity"
+	orcwork "github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/workflow"
 )
 
 func main() {
@@ -36,6 +47,17 @@ func main() {
 		Short: "Run the API server.",
 		Run:   API,
 	})
+	command.AddCommand(&cobra.Command{
+		Use:   "worker",
+		Short: "Run the worker.",
+		Run:   Worker,
+	})
+	command.AddCommand(&cobra.Command{
+		Use:   "audit [wallet-id...]",
+		Short: "Audit wallets' balance against the ledger.",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   Audit,
+	})
 	command.AddCommand(&cobra.Command{
 		Use:   "seed",
 		Short: "Run the seeder.",
@@ -67,26 +89,39 @@ func API(_ *cobra.Command, _ []string) {
 	checkError(err)
 	redisClient, err := redis.NewRedisClient(cfg.Redis)
 	checkError(err)
-	idempotencyStore := redis.NewIdempotency(redisClient, cfg.Redis.TTL)
+	// the cleanup must stop before the pool is closed.
+	cleanupCtx, stopCleanup := context.WithCancel(ctx)
+	defer stopCleanup()
+	idempotencyStore := buildIdempotencyStore(cleanupCtx, cfg, pool, txm, redisClient)
+
+	rates, err := fxrate.NewStaticFromFile(cfg.FX.RateFilePath)
+	checkError(err)
+	temporalClient, err := builder.BuildTemporalClient(cfg.Temporal.Address)
+	checkError(err)
+	defer temporalClient.Close()
 
 	queries := builder.BuildQueries(pool, uow.NewTxGetter())
 
 	dep := &builder.Dependency{
-		Config:    cfg,
-		TxManager: txm,
-		Queries:   queries,
+		Config:         cfg,
+		TxManager:      txm,
+		TemporalClient: temporalClient,
+		Queries:        queries,
+		RateProvider:   rates,
 	}
 
 	c := &server.Config{
 		Name:                      cfg.ServiceName,
 		Port:                      cfg.Port,
-		Secret:                    []byte(cfg.SecretKey),
+		KeySet:                    sdkauth.NewRemoteKeySet(cfg.JWKSURL, sdkauth.DefaultKeySetTTL),
 		Username:                  cfg.Username,
 		Password:                  cfg.Password,
 		AppliedBearerAuthMethods:  strings.Split(cfg.AppliedAuthBearer, ","),
 		AppliedBasicAuthMethods:   strings.Split(cfg.AppliedAuthBasic, ","),
 		AppliedIdempotencyMethods: strings.Split(cfg.AppliedIdempotency, ","),
 		IdempotencyStore:          idempotencyStore,
+		IdempotencyLockTTL:        cfg.IdempotencyLockTTL,
+		TokenRevocationStore:      interceptor.NewRevocationCache(redis.NewTokenRevocation(redisClient), interceptor.DefaultRevocationCacheTTL),
 	}
 	srv := server.NewServer(c)
 	registerGrpcService(srv, dep)
@@ -96,6 +131,120 @@ func API(_ *cobra.Command, _ []string) {
 	srv.GracefulStop()
 }
 
+func buildIdempotencyStore(ctx context.Context, cfg *config.Config, pool uow.Tr, txm uow.TxManager, client goredis.Cmdable) interceptor.IdempotencyStore {
+	if cfg.IdempotencyStore != config.IdempotencyStorePostgres {
+		return redis.NewIdempotency(client, cfg.Redis.TTL)
+	}
+
+	store := postgres.NewIdempotency(pool, txm, uow.NewTxGetter(), interceptor.DefaultIdempotencyTTL)
+	go cleanupIdempotency(ctx, store, cfg.IdempotencyCleanupInterval)
+	return store
+}
+
+func cleanupIdempotency(ctx context.Context, store *postgres.Idempotency, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := store.DeleteExpired(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "error during idempotency cleanup", "error", err)
+		} else {
+			slog.InfoContext(ctx, "deleted expired idempotency keys", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Worker is the entry point for running the worker server.
+func Worker(_ *cobra.Command, _ []string) {
+	ctx := context.Background()
+
+	cfg, err := config.NewConfig(".env")
+	checkError(err)
+
+	logger := sdklog.NewSlogLogger(cfg.ServiceName)
+	slog.SetDefault(logger)
+
+	_, err = trace.NewProvider(ctx, cfg.Tracer)
+	checkError(err)
+
+	temporalClient, err := builder.BuildTemporalClient(cfg.Temporal.Address)
+	checkError(err)
+	defer temporalClient.Close()
+	pool, err := postgres.NewPgxPool(cfg.Postgres)
+	checkError(err)
+	defer pool.Close()
+	txm, err := uow.NewTxManager(pool)
+	checkError(err)
+
+	dep := &builder.Dependency{
+		Config:         cfg,
+		TxManager:      txm,
+		TemporalClient: temporalClient,
+		Queries:        builder.BuildQueries(pool, uow.NewTxGetter()),
+	}
+	act := orcact.NewExpireHoldActivity(builder.BuildWalletHolder(dep))
+
+	w := worker.New(temporalClient, orcwork.TaskQueueExpireHold, worker.Options{
+		DisableRegistrationAliasing: true,
+	})
+	w.RegisterWorkflow(orcwork.ExpireHold)
+	w.RegisterActivityWithOptions(act, activity.RegisterOptions{Name: "ExpireHoldActivity", SkipInvalidStructFunctions: true})
+
+	err = w.Run(worker.InterruptCh())
+	if err != nil {
+		log.Panic("Unable to start worker", err)
+	}
+}
+
+// Audit is the entry point for auditing wallets' balance against the ledger.
+func Audit(_ *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	cfg, err := config.NewConfig(".env")
+	checkError(err)
+	pool, err := postgres.NewPgxPool(cfg.Postgres)
+	checkError(err)
+	defer pool.Close()
+
+	dep := &builder.Dependency{
+		Config:  cfg,
+		Queries: builder.BuildQueries(pool, uow.NewTxGetter()),
+	}
+	auditor := builder.BuildWalletAuditor(dep)
+
+	unbalanced := 0
+	for _, arg := range args {
+		id, err := uuid.Parse(arg)
+		checkError(err)
+
+		res, err := auditor.Audit(ctx, id)
+		checkError(err)
+		if !res.IsBalanced() {
+			unbalanced++
+		}
+		log.Printf("wallet %s: balance %s %s, ledger balances [%s], balanced %t\n", res.WalletID, res.Balance, res.Currency, formatLedgerBalances(res.LedgerBalances), res.IsBalanced())
+	}
+	if unbalanced > 0 {
+		log.Fatalf("%d of %d wallets are not balanced\n", unbalanced, len(args))
+	}
+}
+
+// formatLedgerBalances formats the ledger balances as "<balance> <currency>" separated by comma.
+func formatLedgerBalances(balances []*entity.LedgerBalance) string {
+	res := make([]string, 0, len(balances))
+	for _, lb := range balances {
+		res = append(res, fmt.Sprintf("%s %s", lb.Balance, lb.Currency))
+	}
+	return strings.Join(res, ", ")
+}
+
 // Seed is the entry point for running the seeder.
 func Seed(_ *cobra.Command, _ []string) {
 	ctx := context.Background()
@@ -114,10 +263,12 @@ func Seed(_ *cobra.Command, _ []string) {
 func registerGrpcService(srv *server.Server, dep *builder.Dependency) {
 	// start register all module's gRPC handlers
 	command := builder.BuildWalletCommandHandler(dep)
+	query := builder.BuildWalletQueryHandler(dep)
 	health := handler.NewHealth()
 
 	srv.AttachService(func(server *grpc.Server) {
 		apiv1.RegisterWalletCommandServiceServer(server, command)
+		apiv1.RegisterWalletQueryServiceServer(server, query)
 		grpc_health_v1.RegisterHealthServer(server, health)
 	})
 	// end of register all module's gRPC handlers
@@ -146,10 +297,34 @@ func insertWallets(ctx context.Context, db uow.Tr, val []byte) {
 	for _, wallet := range wallets {
 		_, err := db.Exec(ctx, query, wallet.ID, wallet.UserID, wallet.Balance, wallet.UserID, wallet.UserID)
 		checkError(err)
+		insertOpeningBalance(ctx, db, wallet)
 	}
 	log.Printf("Successfully insert %d wallets\n", len(wallets))
 }
 
+// insertOpeningBalance records seeded balance in the ledger so the wallet passes the audit.
+// Wallet's id is used as journal id to keep the seeder idempotent. Both entries take the wallet's currency.
+func insertOpeningBalance(ctx context.Context, db uow.Tr, wallet *entity.Wallet) {
+	if !wallet.Balance.IsPositive() {
+		return
+	}
+
+	query := `INSERT INTO ledger_entries (id, journal_id, journal_type, wallet_id, entry_type, amount, currency, created_at, updated_at, created_by, updated_by)
+				VALUES ($1, $2, $3, $4, $5, $6, (SELECT currency FROM wallets WHERE id = $2), NOW(), NOW(), $7, $8)
+				ON CONFLICT (journal_id, entry_type) DO NOTHING;`
+	entries := []struct {
+		entryType entity.LedgerEntryType
+		walletID  uuid.UUID
+	}{
+		{entryType: entity.LedgerEntryTypeDebit, walletID: entity.ExternalWalletID},
+		{entryType: entity.LedgerEntryTypeCredit, walletID: wallet.ID},
+	}
+	for _, entry := range entries {
+		_, err := db.Exec(ctx, query, uuid.Must(uuid.NewV7()), wallet.ID, entity.LedgerJournalTypeOpeningBalance, entry.walletID, entry.entryType, wallet.Balance, wallet.UserID, wallet.UserID)
+		checkError(err)
+	}
+}
+
 func checkError(err error) {
 	if err != nil {
 		panic(err)
@@ -0,0 +1,17 @@
+-- Create enum type "ledger_entry_type"
+CREATE TYPE public.ledger_entry_type AS ENUM ('DEBIT', 'CREDIT');
+-- Create enum type "ledger_journal_type"
+CREATE TYPE public.ledger_journal_type AS ENUM ('OPENING_BALANCE', 'TOPUP', 'TRANSFER');
+-- Create "ledger_entries" table
+CREATE TABLE public.ledger_entries (id uuid NOT NULL, journal_id uuid NOT NULL, journal_type public.ledger_journal_type NOT NULL, wallet_id uuid NOT NULL, entry_type public.ledger_entry_type NOT NULL, amount numeric(20, 2) NOT NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, deleted_at timestamp NULL, created_by uuid NOT NULL, updated_by uuid NOT NULL, deleted_by uuid NULL, PRIMARY KEY (id), CONSTRAINT unique_journal_entry_type UNIQUE (journal_id, entry_type), CONSTRAINT positive_amount CHECK (amount > (0)::numeric));
+-- Create index "index_on_ledger_entries_on_journal_id" to table: "ledger_entries"
+CREATE INDEX index_on_ledger_entries_on_journal_id ON public.ledger_entries (journal_id);
+-- Create index "index_on_ledger_entries_on_wallet_id" to table: "ledger_entries"
+CREATE INDEX index_on_ledger_entries_on_wallet_id ON public.ledger_entries (wallet_id);
+-- Backfill "ledger_entries" table
+-- Existing wallets have balance but no entries, hence each balance is recorded as an opening balance journal
+-- so the ledger rebuilds the same balance. Both entries of a journal share the same journal id.
+WITH journals AS MATERIALIZED (SELECT gen_random_uuid() AS journal_id, id AS wallet_id, balance, created_at, created_by FROM public.wallets WHERE balance > 0)
+INSERT INTO public.ledger_entries (id, journal_id, journal_type, wallet_id, entry_type, amount, created_at, updated_at, created_by, updated_by)
+SELECT gen_random_uuid(), j.journal_id, 'OPENING_BALANCE'::public.ledger_journal_type, e.wallet_id, e.entry_type, j.balance, j.created_at, j.created_at, j.created_by, j.created_by
+FROM journals j CROSS JOIN LATERAL (VALUES ('00000000-0000-0000-0000-000000000000'::uuid, 'DEBIT'::public.ledger_entry_type), (j.wallet_id, 'CREDIT'::public.ledger_entry_type)) AS e (wallet_id, entry_type);
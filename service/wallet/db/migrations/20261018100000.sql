@@ -0,0 +1,4 @@
+-- Create enum type "transfer_status"
+CREATE TYPE public.transfer_status AS ENUM ('APPLIED', 'CANCELLED');
+-- Create "transfers" table
+CREATE TABLE public.transfers (id uuid NOT NULL, status public.transfer_status NOT NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY (id));
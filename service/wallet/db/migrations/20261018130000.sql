@@ -0,0 +1,4 @@
+-- Create "idempotency_keys" table
+CREATE TABLE public.idempotency_keys (key text NOT NULL, value bytea NOT NULL, expires_at timestamp NOT NULL, PRIMARY KEY (key));
+-- Create index "index_on_idempotency_keys_on_expires_at" to table: "idempotency_keys"
+CREATE INDEX index_on_idempotency_keys_on_expires_at ON public.idempotency_keys (expires_at);
@@ -0,0 +1,4 @@
+-- Modify "ledger_entries" table
+ALTER TABLE public.ledger_entries ALTER COLUMN amount TYPE numeric(24, 4);
+-- Modify "wallets" table
+ALTER TABLE public.wallets ALTER COLUMN balance TYPE numeric(24, 4), ADD COLUMN currency character(3) NOT NULL DEFAULT 'IDR';
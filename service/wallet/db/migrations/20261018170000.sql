@@ -0,0 +1,4 @@
+-- Add value to enum type: "ledger_journal_type"
+ALTER TYPE public.ledger_journal_type ADD VALUE 'FX_TRANSFER';
+-- Create "fx_quotes" table
+CREATE TABLE public.fx_quotes (id uuid NOT NULL, user_id uuid NOT NULL, from_currency character(3) NOT NULL, to_currency character(3) NOT NULL, rate numeric(30, 12) NOT NULL, spread numeric(10, 8) NOT NULL, expires_at timestamp NOT NULL, used_at timestamp NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY (id), CONSTRAINT positive_rate CHECK (rate > (0)::numeric));
@@ -0,0 +1,10 @@
+-- Add value to enum type: "ledger_journal_type"
+ALTER TYPE public.ledger_journal_type ADD VALUE 'HOLD_CAPTURE';
+-- Create enum type "hold_status"
+CREATE TYPE public.hold_status AS ENUM ('AUTHORIZED', 'CAPTURED', 'VOIDED', 'EXPIRED');
+-- Modify "wallets" table
+ALTER TABLE public.wallets ADD COLUMN held_balance numeric(24, 4) NOT NULL DEFAULT 0, DROP CONSTRAINT non_negative_balance, ADD CONSTRAINT non_negative_balance CHECK ((held_balance >= (0)::numeric) AND (balance >= held_balance));
+-- Create "holds" table
+CREATE TABLE public.holds (id uuid NOT NULL, user_id uuid NOT NULL, wallet_id uuid NOT NULL, receiver_id uuid NOT NULL, receiver_wallet_id uuid NOT NULL, amount numeric(24, 4) NOT NULL, captured_amount numeric(24, 4) NOT NULL DEFAULT 0, currency character(3) NOT NULL, status public.hold_status NOT NULL DEFAULT 'AUTHORIZED', expires_at timestamp NOT NULL, created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, created_by uuid NOT NULL, updated_by uuid NOT NULL, PRIMARY KEY (id), CONSTRAINT positive_hold_amount CHECK (amount > (0)::numeric), CONSTRAINT valid_captured_amount CHECK ((captured_amount >= (0)::numeric) AND (captured_amount <= amount)));
+-- Create index "index_on_holds_on_wallet_id" to table: "holds"
+CREATE INDEX index_on_holds_on_wallet_id ON public.holds (wallet_id);
@@ -0,0 +1,2 @@
+-- Modify "wallets" table
+ALTER TABLE public.wallets ADD COLUMN frozen_at timestamp NULL;
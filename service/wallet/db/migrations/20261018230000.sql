@@ -0,0 +1,2 @@
+-- Add value to enum type: "ledger_journal_type"
+ALTER TYPE public.ledger_journal_type ADD VALUE 'FX_FEE';
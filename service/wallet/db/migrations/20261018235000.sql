@@ -0,0 +1,11 @@
+-- Modify "ledger_entries" table
+ALTER TABLE public.ledger_entries ADD COLUMN currency character(3) NOT NULL DEFAULT 'IDR';
+-- Backfill "ledger_entries" table
+-- Entries of a wallet take the wallet's currency. Entries of a ledger-only wallet, such as the external wallet,
+-- take the currency of the other entry of the same journal, since both entries of a journal share the currency.
+UPDATE public.ledger_entries e SET currency = w.currency FROM public.wallets w WHERE w.id = e.wallet_id;
+UPDATE public.ledger_entries e SET currency = o.currency FROM public.ledger_entries o
+WHERE o.journal_id = e.journal_id AND o.id <> e.id AND NOT EXISTS (SELECT 1 FROM public.wallets w WHERE w.id = e.wallet_id);
+-- Modify "ledger_entries" table
+-- The default is dropped so new entries must set the currency.
+ALTER TABLE public.ledger_entries ALTER COLUMN currency DROP DEFAULT;
@@ -1,10 +1,71 @@
 -- name: CreateWallet :exec
-INSERT INTO wallets (id, user_id, balance, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7);
+INSERT INTO wallets (id, user_id, balance, currency, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: GetUserWalletForUpdate :one
-SELECT * FROM wallets WHERE id = $1 AND user_id = $2 LIMIT 1 FOR NO KEY UPDATE; --noqa
+SELECT * FROM wallets WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL LIMIT 1 FOR NO KEY UPDATE; --noqa
 
 -- name: AddWalletBalance :one
-UPDATE wallets SET balance = balance + @amount WHERE id = $1 --noqa
+UPDATE wallets SET balance = balance + @amount WHERE id = $1 AND deleted_at IS NULL --noqa
 RETURNING *;
+
+-- name: GetWalletByID :one
+SELECT * FROM wallets WHERE id = $1 LIMIT 1;
+
+-- name: CreateLedgerEntry :exec
+INSERT INTO ledger_entries (id, journal_id, journal_type, wallet_id, entry_type, amount, currency, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: GetWalletLedgerBalances :many
+SELECT currency, SUM(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END)::NUMERIC(24, 4) AS balance
+FROM ledger_entries WHERE wallet_id = $1
+GROUP BY currency ORDER BY currency;
+
+-- name: CreateTransfer :execrows
+INSERT INTO transfers (id, status, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: GetTransferStatus :one
+SELECT status FROM transfers WHERE id = $1 LIMIT 1;
+
+-- name: GetUserWallet :one
+SELECT * FROM wallets WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetAllUserWallets :many
+SELECT * FROM wallets WHERE user_id = $1 AND deleted_at IS NULL ORDER BY created_at ASC LIMIT $2;
+
+-- name: GetAllUserWalletsIncludingDeleted :many
+SELECT * FROM wallets WHERE user_id = $1 ORDER BY created_at ASC;
+
+-- name: FreezeUserWallets :exec
+UPDATE wallets SET deleted_at = NOW(), deleted_by = user_id, frozen_at = NOW(), updated_at = NOW(), updated_by = user_id
+WHERE user_id = $1 AND deleted_at IS NULL;
+
+-- name: UnfreezeUserWallets :exec
+UPDATE wallets SET deleted_at = NULL, deleted_by = NULL, frozen_at = NULL, updated_at = NOW(), updated_by = user_id
+WHERE user_id = $1 AND frozen_at IS NOT NULL;
+
+-- name: CreateFXQuote :exec
+INSERT INTO fx_quotes (id, user_id, from_currency, to_currency, rate, spread, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UseFXQuote :one
+UPDATE fx_quotes SET used_at = NOW()
+WHERE id = $1 AND user_id = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING *;
+
+-- name: AddWalletHeldBalance :one
+UPDATE wallets SET held_balance = held_balance + @amount WHERE id = $1 --noqa
+RETURNING *;
+
+-- name: CreateHold :exec
+INSERT INTO holds (id, user_id, wallet_id, receiver_id, receiver_wallet_id, amount, currency, status, expires_at, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE; --noqa
+
+-- name: SettleHold :exec
+UPDATE holds SET status = $2, captured_amount = $3, updated_at = $4, updated_by = $5
+WHERE id = $1;
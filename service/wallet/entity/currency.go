@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency used when none is specified.
+// Wallets created before multi-currency is supported are in this currency.
+const DefaultCurrency = "IDR"
+
+// Currency defines an ISO-4217 currency.
+type Currency struct {
+	// Code is the alphabetic ISO-4217 code, e.g. IDR.
+	Code string
+	// Scale is the number of digits after the decimal separator of its minor unit.
+	Scale int32
+}
+
+// currencies registers all currencies supported by wallet.
+// The scale is the ISO-4217 minor unit and must not be greater than
+// the scale of balance and amount columns in database.
+var currencies = map[string]Currency{
+	"AUD": {Code: "AUD", Scale: 2},
+	"BHD": {Code: "BHD", Scale: 3},
+	"CLF": {Code: "CLF", Scale: 4},
+	"CNY": {Code: "CNY", Scale: 2},
+	"EUR": {Code: "EUR", Scale: 2},
+	"GBP": {Code: "GBP", Scale: 2},
+	"IDR": {Code: "IDR", Scale: 2},
+	"JOD": {Code: "JOD", Scale: 3},
+	"JPY": {Code: "JPY", Scale: 0},
+	"KRW": {Code: "KRW", Scale: 0},
+	"KWD": {Code: "KWD", Scale: 3},
+	"MYR": {Code: "MYR", Scale: 2},
+	"OMR": {Code: "OMR", Scale: 3},
+	"SGD": {Code: "SGD", Scale: 2},
+	"THB": {Code: "THB", Scale: 2},
+	"USD": {Code: "USD", Scale: 2},
+	"VND": {Code: "VND", Scale: 0},
+}
+
+// LookupCurrency returns the registered currency of the code.
+// It returns false if the currency is not supported.
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := currencies[code]
+	return c, ok
+}
+
+// NormalizeCurrencyCode trims and uppercases the code.
+// Empty code is normalized to DefaultCurrency.
+func NormalizeCurrencyCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency
+	}
+	return code
+}
+
+// IsValidAmount returns true if amount doesn't have more fractional digits than the currency's minor unit.
+func (c Currency) IsValidAmount(amount decimal.Decimal) bool {
+	return amount.Equal(amount.Truncate(c.Scale))
+}
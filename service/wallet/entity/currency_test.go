@@ -0,0 +1,60 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	t.Run("currency is supported", func(t *testing.T) {
+		c, ok := entity.LookupCurrency("JPY")
+
+		assert.True(t, ok)
+		assert.Equal(t, "JPY", c.Code)
+		assert.Equal(t, int32(0), c.Scale)
+	})
+
+	t.Run("currency is not supported", func(t *testing.T) {
+		_, ok := entity.LookupCurrency("XYZ")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestNormalizeCurrencyCode(t *testing.T) {
+	t.Run("empty code becomes default currency", func(t *testing.T) {
+		assert.Equal(t, entity.DefaultCurrency, entity.NormalizeCurrencyCode("  "))
+	})
+
+	t.Run("code is trimmed and uppercased", func(t *testing.T) {
+		assert.Equal(t, "USD", entity.NormalizeCurrencyCode(" usd "))
+	})
+}
+
+func TestCurrency_IsValidAmount(t *testing.T) {
+	tests := []struct {
+		code   string
+		amount string
+		valid  bool
+	}{
+		{code: "IDR", amount: "10.23", valid: true},
+		{code: "IDR", amount: "10.230", valid: true},
+		{code: "IDR", amount: "10.234", valid: false},
+		{code: "JPY", amount: "100", valid: true},
+		{code: "JPY", amount: "100.5", valid: false},
+		{code: "BHD", amount: "1.234", valid: true},
+		{code: "BHD", amount: "1.2345", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code+" "+tt.amount, func(t *testing.T) {
+			c, _ := entity.LookupCurrency(tt.code)
+
+			assert.Equal(t, tt.valid, c.IsValidAmount(decimal.RequireFromString(tt.amount)))
+		})
+	}
+}
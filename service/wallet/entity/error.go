@@ -145,6 +145,159 @@ func ErrInvalidTransfer() error {
 	return res.Err()
 }
 
+// ErrTransferApplied returns codes.AlreadyExists explained that the transfer with the reference has been applied.
+func ErrTransferApplied() error {
+	st := status.New(codes.AlreadyExists, "transfer has been applied")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_TRANSFER_APPLIED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrTransferCancelled returns codes.FailedPrecondition explained that the transfer with the reference has been cancelled.
+func ErrTransferCancelled() error {
+	st := status.New(codes.FailedPrecondition, "transfer has been cancelled")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_TRANSFER_CANCELLED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrNotFound returns codes.NotFound explained that the wallet is not found.
+func ErrNotFound() error {
+	st := status.New(codes.NotFound, "wallet not found")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidCurrency returns codes.InvalidArgument explained that the currency is not supported.
+func ErrInvalidCurrency() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "currency",
+		Description: "must be a supported ISO-4217 currency code",
+	})
+
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_INVALID_CURRENCY,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrCurrencyMismatch returns codes.InvalidArgument explained that the currencies are different.
+func ErrCurrencyMismatch() error {
+	st := status.New(codes.InvalidArgument, "currency of wallets and amount must be the same")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_CURRENCY_MISMATCH,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidAmountPrecision returns codes.InvalidArgument explained that the amount has too many fractional digits.
+func ErrInvalidAmountPrecision() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "amount",
+		Description: "must not have more fractional digits than the currency's minor unit",
+	})
+
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_INVALID_AMOUNT_PRECISION,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidQuote returns codes.InvalidArgument explained that the fx quote can't be used.
+func ErrInvalidQuote() error {
+	st := status.New(codes.InvalidArgument, "fx quote is not found, has expired, has been used, or doesn't match the transfer")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_INVALID_QUOTE,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrRateNotFound returns codes.NotFound explained that the exchange rate is not available.
+func ErrRateNotFound() error {
+	st := status.New(codes.NotFound, "exchange rate is not available")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_RATE_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrEmptyHold returns codes.InvalidArgument explained that the instance is empty or nil.
+func ErrEmptyHold() error {
+	st := status.New(codes.InvalidArgument, "empty hold")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_EMPTY_HOLD,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrHoldNotFound returns codes.NotFound explained that the hold is not found.
+func ErrHoldNotFound() error {
+	st := status.New(codes.NotFound, "hold not found")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_HOLD_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrHoldClosed returns codes.FailedPrecondition explained that the hold can't be captured or voided anymore.
+func ErrHoldClosed() error {
+	st := status.New(codes.FailedPrecondition, "hold has been captured, voided, or has expired")
+	te := &apiv1.WalletError{
+		ErrorCode: apiv1.WalletErrorCode_WALLET_ERROR_CODE_HOLD_CLOSED,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
 func createBadRequest(details ...*errdetails.BadRequest_FieldViolation) *errdetails.BadRequest {
 	return &errdetails.BadRequest{
 		FieldViolations: details,
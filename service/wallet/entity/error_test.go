@@ -79,3 +79,91 @@ func TestErrInvalidTransfer(t *testing.T) {
 		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
 	})
 }
+
+func TestErrTransferApplied(t *testing.T) {
+	t.Run("success get transfer applied error", func(t *testing.T) {
+		err := entity.ErrTransferApplied()
+
+		assert.Contains(t, err.Error(), "rpc error: code = AlreadyExists")
+	})
+}
+
+func TestErrTransferCancelled(t *testing.T) {
+	t.Run("success get transfer cancelled error", func(t *testing.T) {
+		err := entity.ErrTransferCancelled()
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
+
+func TestErrNotFound(t *testing.T) {
+	t.Run("success get not found error", func(t *testing.T) {
+		err := entity.ErrNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrInvalidCurrency(t *testing.T) {
+	t.Run("success get invalid currency error", func(t *testing.T) {
+		err := entity.ErrInvalidCurrency()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrCurrencyMismatch(t *testing.T) {
+	t.Run("success get currency mismatch error", func(t *testing.T) {
+		err := entity.ErrCurrencyMismatch()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidAmountPrecision(t *testing.T) {
+	t.Run("success get invalid amount precision error", func(t *testing.T) {
+		err := entity.ErrInvalidAmountPrecision()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidQuote(t *testing.T) {
+	t.Run("success get invalid quote error", func(t *testing.T) {
+		err := entity.ErrInvalidQuote()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrRateNotFound(t *testing.T) {
+	t.Run("success get rate not found error", func(t *testing.T) {
+		err := entity.ErrRateNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrEmptyHold(t *testing.T) {
+	t.Run("success get empty hold error", func(t *testing.T) {
+		err := entity.ErrEmptyHold()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrHoldNotFound(t *testing.T) {
+	t.Run("success get hold not found error", func(t *testing.T) {
+		err := entity.ErrHoldNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrHoldClosed(t *testing.T) {
+	t.Run("success get hold closed error", func(t *testing.T) {
+		err := entity.ErrHoldClosed()
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FXQuote defines an exchange rate locked for a user.
+// It can be used once in a cross-currency transfer before it expires.
+type FXQuote struct {
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	// Rate is how many units of ToCurrency one unit of FromCurrency gets.
+	Rate decimal.Decimal
+	// Spread is the fraction of the amount charged as fee, e.g. 0.005 means 0.5%.
+	Spread       decimal.Decimal
+	FromCurrency string
+	ToCurrency   string
+	ID           uuid.UUID
+	UserID       uuid.UUID
+}
+
+// FXConversion defines the conversion applied in a cross-currency transfer.
+type FXConversion struct {
+	Rate decimal.Decimal
+	// Fee is the spread fee in FromCurrency. It is deducted from the amount before conversion.
+	Fee decimal.Decimal
+	// ConvertedAmount is the amount received in ToCurrency.
+	ConvertedAmount decimal.Decimal
+	FromCurrency    string
+	ToCurrency      string
+	QuoteID         uuid.UUID
+}
+
+// Convert converts amount in FromCurrency to ToCurrency using the quote.
+// The fee is rounded up and the converted amount is rounded down to the currencies' minor unit,
+// so rounding never favors the receiver over the amount sent.
+func (q *FXQuote) Convert(amount decimal.Decimal) *FXConversion {
+	from, _ := LookupCurrency(q.FromCurrency)
+	to, _ := LookupCurrency(q.ToCurrency)
+
+	fee := amount.Mul(q.Spread).RoundCeil(from.Scale)
+	return &FXConversion{
+		QuoteID:         q.ID,
+		FromCurrency:    q.FromCurrency,
+		ToCurrency:      q.ToCurrency,
+		Rate:            q.Rate,
+		Fee:             fee,
+		ConvertedAmount: amount.Sub(fee).Mul(q.Rate).RoundFloor(to.Scale),
+	}
+}
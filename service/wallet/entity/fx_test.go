@@ -0,0 +1,43 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+func TestFXQuote_Convert(t *testing.T) {
+	t.Run("convert with spread fee", func(t *testing.T) {
+		quote := &entity.FXQuote{
+			ID:           uuid.Must(uuid.NewV7()),
+			FromCurrency: "USD",
+			ToCurrency:   "IDR",
+			Rate:         decimal.RequireFromString("16250.5"),
+			Spread:       decimal.RequireFromString("0.005"),
+		}
+
+		res := quote.Convert(decimal.RequireFromString("10"))
+
+		assert.Equal(t, quote.ID, res.QuoteID)
+		assert.True(t, decimal.RequireFromString("0.05").Equal(res.Fee))
+		assert.True(t, decimal.RequireFromString("161692.47").Equal(res.ConvertedAmount))
+	})
+
+	t.Run("fee is rounded up and converted amount is rounded down", func(t *testing.T) {
+		quote := &entity.FXQuote{
+			FromCurrency: "IDR",
+			ToCurrency:   "JPY",
+			Rate:         decimal.RequireFromString("0.0093"),
+			Spread:       decimal.RequireFromString("0.003"),
+		}
+
+		res := quote.Convert(decimal.RequireFromString("1000"))
+
+		assert.True(t, decimal.RequireFromString("3").Equal(res.Fee))
+		assert.True(t, decimal.RequireFromString("9").Equal(res.ConvertedAmount))
+	})
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HoldStatus enumerates the status of a hold.
+type HoldStatus string
+
+var (
+	// HoldStatusAuthorized means the amount is reserved.
+	HoldStatusAuthorized HoldStatus = "AUTHORIZED"
+	// HoldStatusCaptured means the amount is transferred to the receiver.
+	HoldStatusCaptured HoldStatus = "CAPTURED"
+	// HoldStatusVoided means the amount is released by request.
+	HoldStatusVoided HoldStatus = "VOIDED"
+	// HoldStatusExpired means the amount is released because the hold expired.
+	HoldStatusExpired HoldStatus = "EXPIRED"
+)
+
+// Hold defines an amount reserved from a wallet for a receiver.
+// The amount stays in the wallet, but it can't be spent until the hold is captured, voided, or expired.
+type Hold struct {
+	ExpiresAt time.Time
+	Amount    decimal.Decimal
+	// CapturedAmount is the amount transferred to the receiver. The rest is released.
+	CapturedAmount decimal.Decimal
+	Status         HoldStatus
+	Currency       string
+	Auditable
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	WalletID         uuid.UUID
+	ReceiverID       uuid.UUID
+	ReceiverWalletID uuid.UUID
+}
+
+// CaptureHold defines logical data related to capture hold.
+// UserID, WalletID, ReceiverID, and ReceiverWalletID must be the hold's, so the caller's record matches the hold.
+// ReferenceID is the id of the caller's record, e.g. transaction's id. A reference is captured at most once.
+type CaptureHold struct {
+	Amount           decimal.Decimal
+	HoldID           uuid.UUID
+	UserID           uuid.UUID
+	WalletID         uuid.UUID
+	ReceiverID       uuid.UUID
+	ReceiverWalletID uuid.UUID
+	ReferenceID      uuid.UUID
+}
+
+// ExpireHoldInput defines the input of the flow which releases a hold when it expires.
+type ExpireHoldInput struct {
+	ExpiresAt time.Time
+	HoldID    uuid.UUID
+}
+
+// IsActive returns true if the hold still reserves its amount and can be captured or voided.
+func (h *Hold) IsActive(now time.Time) bool {
+	return h.Status == HoldStatusAuthorized && now.Before(h.ExpiresAt)
+}
+
+// IsCapturedBy returns true if the capture is made by the hold's owner to the hold's receiver.
+func (h *Hold) IsCapturedBy(capture *CaptureHold) bool {
+	return h.UserID == capture.UserID && h.WalletID == capture.WalletID &&
+		h.ReceiverID == capture.ReceiverID && h.ReceiverWalletID == capture.ReceiverWalletID
+}
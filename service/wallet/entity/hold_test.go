@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+func TestHold_IsActive(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		expiresAt time.Time
+		name      string
+		status    entity.HoldStatus
+		active    bool
+	}{
+		{name: "authorized and not expired", status: entity.HoldStatusAuthorized, expiresAt: now.Add(time.Minute), active: true},
+		{name: "authorized but expired", status: entity.HoldStatusAuthorized, expiresAt: now.Add(-time.Minute), active: false},
+		{name: "captured", status: entity.HoldStatusCaptured, expiresAt: now.Add(time.Minute), active: false},
+		{name: "voided", status: entity.HoldStatusVoided, expiresAt: now.Add(time.Minute), active: false},
+		{name: "expired", status: entity.HoldStatusExpired, expiresAt: now.Add(-time.Minute), active: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hold := &entity.Hold{Status: tt.status, ExpiresAt: tt.expiresAt}
+
+			assert.Equal(t, tt.active, hold.IsActive(now))
+		})
+	}
+}
@@ -8,29 +8,54 @@ import (
 )
 
 // Wallet defines logical data related to wallet.
+// Balance is the total balance which always equals to the ledger balance.
+// HeldBalance is the part of the balance reserved by authorized holds.
 type Wallet struct {
-	Balance decimal.Decimal `json:"balance"`
+	Balance     decimal.Decimal `json:"balance"`
+	HeldBalance decimal.Decimal `json:"held_balance"`
+	Currency    string          `json:"currency"`
 	Auditable
 	ID     uuid.UUID `json:"id"`
 	UserID uuid.UUID `json:"user_id"`
 }
 
+// AvailableBalance returns the balance which can be spent.
+func (w *Wallet) AvailableBalance() decimal.Decimal {
+	return w.Balance.Sub(w.HeldBalance)
+}
+
 // TopupWallet defines logical data related to topup wallet.
 type TopupWallet struct {
 	Amount   decimal.Decimal
+	Currency string
 	WalletID uuid.UUID
 	UserID   uuid.UUID
 }
 
 // TransferWallet defines logical data related to transfer wallet.
+// QuoteID is uuid.Nil if both wallets have the same currency.
+// ReferenceID is the id of the caller's record, e.g. transaction's id. A reference is transferred at most once.
 type TransferWallet struct {
 	Amount           decimal.Decimal
+	Currency         string
 	SenderID         uuid.UUID
 	SenderWalletID   uuid.UUID
 	ReceiverID       uuid.UUID
 	ReceiverWalletID uuid.UUID
+	QuoteID          uuid.UUID
+	ReferenceID      uuid.UUID
 }
 
+// TransferStatus enumerates the outcome of a transfer's reference.
+type TransferStatus string
+
+var (
+	// TransferStatusApplied means the transfer has moved the balance.
+	TransferStatusApplied TransferStatus = "APPLIED"
+	// TransferStatusCancelled means the transfer has been resolved as not applied and will never be applied.
+	TransferStatusCancelled TransferStatus = "CANCELLED"
+)
+
 // Auditable defines logical data related to audit.
 type Auditable struct {
 	CreatedAt time.Time
@@ -40,3 +65,94 @@ type Auditable struct {
 	CreatedBy uuid.UUID
 	UpdatedBy uuid.UUID
 }
+
+// LedgerEntryType enumerates the side of a ledger entry.
+type LedgerEntryType string
+
+var (
+	// LedgerEntryTypeDebit decreases the wallet's balance.
+	LedgerEntryTypeDebit LedgerEntryType = "DEBIT"
+	// LedgerEntryTypeCredit increases the wallet's balance.
+	LedgerEntryTypeCredit LedgerEntryType = "CREDIT"
+)
+
+// LedgerJournalType enumerates the reason a journal is written.
+type LedgerJournalType string
+
+var (
+	// LedgerJournalTypeOpeningBalance means the wallet is created with initial balance.
+	LedgerJournalTypeOpeningBalance LedgerJournalType = "OPENING_BALANCE"
+	// LedgerJournalTypeTopup means the wallet is topped up.
+	LedgerJournalTypeTopup LedgerJournalType = "TOPUP"
+	// LedgerJournalTypeTransfer means balance is moved between two wallets.
+	LedgerJournalTypeTransfer LedgerJournalType = "TRANSFER"
+	// LedgerJournalTypeFXTransfer means balance is moved between two wallets of different currencies.
+	// Each side is booked against ExternalWalletID in its own currency.
+	LedgerJournalTypeFXTransfer LedgerJournalType = "FX_TRANSFER"
+	// LedgerJournalTypeFXFee means the fx spread is moved from the sender to FXFeeWalletID of the sender's currency.
+	LedgerJournalTypeFXFee LedgerJournalType = "FX_FEE"
+	// LedgerJournalTypeHoldCapture means held balance is moved to the hold's receiver.
+	LedgerJournalTypeHoldCapture LedgerJournalType = "HOLD_CAPTURE"
+)
+
+// ExternalWalletID represents money coming from outside the system, such as topup.
+// It is the counterpart of every entry which has no wallet in our system.
+// It is shared by every currency, so its entries are only summed per currency.
+var ExternalWalletID = uuid.Nil
+
+// fxFeeWalletNamespace is the namespace of FXFeeWalletID.
+var fxFeeWalletNamespace = uuid.MustParse("0191a6d2-6f3e-7c41-9d0b-3a5f2c8e1b7d")
+
+// FXFeeWalletID returns the ledger-only wallet collecting the fx spread in the currency.
+// It is derived from the currency, so every currency has exactly one fee wallet.
+func FXFeeWalletID(currency string) uuid.UUID {
+	return uuid.NewSHA1(fxFeeWalletNamespace, []byte(NormalizeCurrencyCode(currency)))
+}
+
+// LedgerJournal defines logical data of a balanced double-entry journal.
+// A journal always results in one debit entry and one credit entry with the same amount and currency.
+type LedgerJournal struct {
+	Amount   decimal.Decimal
+	Type     LedgerJournalType
+	Currency string
+	Auditable
+	ID             uuid.UUID
+	DebitWalletID  uuid.UUID
+	CreditWalletID uuid.UUID
+}
+
+// LedgerBalance defines the sum of a wallet's ledger entries in a currency.
+type LedgerBalance struct {
+	Balance  decimal.Decimal
+	Currency string
+}
+
+// WalletAudit defines the result of comparing wallet's balance to its ledger.
+// LedgerBalances holds the sum of the wallet's ledger entries grouped by currency.
+type WalletAudit struct {
+	Balance        decimal.Decimal
+	Currency       string
+	LedgerBalances []*LedgerBalance
+	WalletID       uuid.UUID
+}
+
+// LedgerBalance returns the sum of the wallet's ledger entries in the wallet's currency.
+func (w *WalletAudit) LedgerBalance() decimal.Decimal {
+	for _, lb := range w.LedgerBalances {
+		if lb.Currency == w.Currency {
+			return lb.Balance
+		}
+	}
+	return decimal.Zero
+}
+
+// IsBalanced returns true if the wallet's balance equals to the sum of its ledger entries in the wallet's currency
+// and its ledger entries in any other currency sum to zero.
+func (w *WalletAudit) IsBalanced() bool {
+	for _, lb := range w.LedgerBalances {
+		if lb.Currency != w.Currency && !lb.Balance.IsZero() {
+			return false
+		}
+	}
+	return w.Balance.Equal(w.LedgerBalance())
+}
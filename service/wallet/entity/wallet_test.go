@@ -1 +1,80 @@
 package entity_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+func TestWalletAudit_IsBalanced(t *testing.T) {
+	t.Run("balance equals ledger balance", func(t *testing.T) {
+		audit := &entity.WalletAudit{
+			Balance:        decimal.RequireFromString("10.20"),
+			Currency:       "IDR",
+			LedgerBalances: []*entity.LedgerBalance{{Currency: "IDR", Balance: decimal.RequireFromString("10.2")}},
+		}
+
+		assert.True(t, audit.IsBalanced())
+	})
+
+	t.Run("balance differs from ledger balance", func(t *testing.T) {
+		audit := &entity.WalletAudit{
+			Balance:        decimal.RequireFromString("10.20"),
+			Currency:       "IDR",
+			LedgerBalances: []*entity.LedgerBalance{{Currency: "IDR", Balance: decimal.Zero}},
+		}
+
+		assert.False(t, audit.IsBalanced())
+	})
+
+	t.Run("ledger balance is in other currency", func(t *testing.T) {
+		audit := &entity.WalletAudit{
+			Balance:        decimal.RequireFromString("10.20"),
+			Currency:       "IDR",
+			LedgerBalances: []*entity.LedgerBalance{{Currency: "USD", Balance: decimal.RequireFromString("10.20")}},
+		}
+
+		assert.False(t, audit.IsBalanced())
+	})
+
+	t.Run("ledger balance in other currency is zero", func(t *testing.T) {
+		audit := &entity.WalletAudit{
+			Balance:  decimal.RequireFromString("10.20"),
+			Currency: "IDR",
+			LedgerBalances: []*entity.LedgerBalance{
+				{Currency: "IDR", Balance: decimal.RequireFromString("10.20")},
+				{Currency: "USD", Balance: decimal.Zero},
+			},
+		}
+
+		assert.True(t, audit.IsBalanced())
+	})
+}
+
+func TestWallet_AvailableBalance(t *testing.T) {
+	t.Run("available balance excludes held balance", func(t *testing.T) {
+		wallet := &entity.Wallet{Balance: decimal.RequireFromString("10.20"), HeldBalance: decimal.RequireFromString("3.05")}
+
+		assert.True(t, decimal.RequireFromString("7.15").Equal(wallet.AvailableBalance()))
+	})
+
+	t.Run("nothing is held", func(t *testing.T) {
+		wallet := &entity.Wallet{Balance: decimal.RequireFromString("10.20")}
+
+		assert.True(t, wallet.Balance.Equal(wallet.AvailableBalance()))
+	})
+}
+
+func TestFXFeeWalletID(t *testing.T) {
+	t.Run("same currency has the same fee wallet", func(t *testing.T) {
+		assert.Equal(t, entity.FXFeeWalletID("USD"), entity.FXFeeWalletID(" usd "))
+	})
+
+	t.Run("each currency has its own fee wallet", func(t *testing.T) {
+		assert.NotEqual(t, entity.FXFeeWalletID("USD"), entity.FXFeeWalletID("IDR"))
+		assert.NotEqual(t, entity.ExternalWalletID, entity.FXFeeWalletID("USD"))
+	})
+}
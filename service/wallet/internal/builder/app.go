@@ -1,10 +1,14 @@
 package builder
 
 import (
+	"github.com/shopspring/decimal"
+	"go.temporal.io/sdk/client"
+
 	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
 	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/config"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/grpc/handler"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/workflow"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/postgres"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
@@ -12,18 +16,55 @@ import (
 
 // Dependency holds any dependency to build full use cases.
 type Dependency struct {
-	Config    *config.Config
-	TxManager uow.TxManager
-	Queries   *db.Queries
+	Config         *config.Config
+	TxManager      uow.TxManager
+	TemporalClient client.Client
+	Queries        *db.Queries
+	RateProvider   service.RateProvider
 }
 
 // BuildWalletCommandHandler builds wallet command handler including all of its dependencies.
 func BuildWalletCommandHandler(dep *Dependency) *handler.WalletCommand {
 	p := postgres.NewWallet(dep.Queries)
-	c := service.NewWalletCreator(p)
-	t := service.NewWalletTopup(p)
-	f := service.NewWalletTransferer(p, dep.TxManager)
-	return handler.NewWalletCommand(c, t, f)
+	l := postgres.NewLedger(dep.Queries)
+	c := service.NewWalletCreator(p, l, dep.TxManager)
+	t := service.NewWalletTopup(p, l, dep.TxManager)
+	q := postgres.NewFXQuote(dep.Queries)
+	tr := postgres.NewTransfer(dep.Queries)
+	f := service.NewWalletTransferer(p, l, q, tr, dep.TxManager)
+	fz := service.NewWalletFreezer(p)
+	fq := service.NewFXQuoter(dep.RateProvider, q, decimal.New(dep.Config.FX.SpreadBasisPoints, -4), dep.Config.FX.QuoteTTL)
+	h := BuildWalletHolder(dep)
+	return handler.NewWalletCommand(c, t, f, fz, fq, h)
+}
+
+// BuildWalletQueryHandler builds wallet query handler including all of its dependencies.
+func BuildWalletQueryHandler(dep *Dependency) *handler.WalletQuery {
+	p := postgres.NewWallet(dep.Queries)
+	g := service.NewWalletGetter(p)
+	return handler.NewWalletQuery(g)
+}
+
+// BuildWalletAuditor builds wallet auditor including all of its dependencies.
+func BuildWalletAuditor(dep *Dependency) *service.WalletAuditor {
+	p := postgres.NewWallet(dep.Queries)
+	l := postgres.NewLedger(dep.Queries)
+	return service.NewWalletAuditor(p, l)
+}
+
+// BuildWalletHolder builds wallet holder including all of its dependencies.
+func BuildWalletHolder(dep *Dependency) *service.WalletHolder {
+	p := postgres.NewWallet(dep.Queries)
+	h := postgres.NewHold(dep.Queries)
+	l := postgres.NewLedger(dep.Queries)
+	tr := postgres.NewTransfer(dep.Queries)
+	s := workflow.NewExpireHoldWorkflow(dep.TemporalClient)
+	return service.NewWalletHolder(p, h, l, tr, s, dep.TxManager, dep.Config.HoldTTL)
+}
+
+// BuildTemporalClient builds temporal client.
+func BuildTemporalClient(address string) (client.Client, error) {
+	return client.Dial(client.Options{HostPort: address})
 }
 
 // BuildQueries builds sqlc queries.
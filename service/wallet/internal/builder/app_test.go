@@ -10,12 +10,14 @@ import (
 	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/builder"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/config"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/fxrate"
 )
 
 func TestBuildWalletCommandHandler(t *testing.T) {
 	t.Run("success create wallet command handler", func(t *testing.T) {
 		dep := &builder.Dependency{
-			Config: &config.Config{},
+			Config:       &config.Config{},
+			RateProvider: fxrate.NewStatic(nil),
 		}
 
 		handler := builder.BuildWalletCommandHandler(dep)
@@ -24,6 +26,51 @@ func TestBuildWalletCommandHandler(t *testing.T) {
 	})
 }
 
+func TestBuildWalletQueryHandler(t *testing.T) {
+	t.Run("success create wallet query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			Config: &config.Config{},
+		}
+
+		handler := builder.BuildWalletQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildWalletAuditor(t *testing.T) {
+	t.Run("success create wallet auditor", func(t *testing.T) {
+		dep := &builder.Dependency{
+			Config: &config.Config{},
+		}
+
+		auditor := builder.BuildWalletAuditor(dep)
+
+		assert.NotNil(t, auditor)
+	})
+}
+
+func TestBuildWalletHolder(t *testing.T) {
+	t.Run("success create wallet holder", func(t *testing.T) {
+		dep := &builder.Dependency{
+			Config: &config.Config{},
+		}
+
+		holder := builder.BuildWalletHolder(dep)
+
+		assert.NotNil(t, holder)
+	})
+}
+
+func TestBuildTemporalClient(t *testing.T) {
+	t.Run("fail build a temporal client", func(t *testing.T) {
+		client, err := builder.BuildTemporalClient("localhost:7233")
+
+		assert.Error(t, err)
+		assert.Nil(t, client)
+	})
+}
+
 func TestBuildQueries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
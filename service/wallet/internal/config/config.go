@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/joeshaw/envdecode"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
@@ -10,9 +12,17 @@ import (
 	"github.com/indrasaputra/arjuna/pkg/sdk/trace"
 )
 
+const (
+	// IdempotencyStoreRedis keeps idempotency keys in Redis.
+	IdempotencyStoreRedis = "redis"
+	// IdempotencyStorePostgres keeps idempotency keys in PostgreSQL, so they survive Redis being flushed.
+	IdempotencyStorePostgres = "postgres"
+)
+
 // Config holds configuration for the project.
 type Config struct {
 	Tracer             trace.Config
+	Temporal           Temporal
 	ServiceName        string `env:"SERVICE_NAME,default=wallet-server"`
 	AppEnv             string `env:"APP_ENV,default=development"`
 	Port               string `env:"PORT,default=8004"`
@@ -22,9 +32,32 @@ type Config struct {
 	AppliedAuthBearer  string `env:"APPLIED_AUTH_BEARER"`
 	AppliedAuthBasic   string `env:"APPLIED_AUTH_BASIC"`
 	AppliedIdempotency string `env:"APPLIED_IDEMPOTENCY"`
-	SecretKey          string `env:"TOKEN_SECRET_KEY,required"`
+	IdempotencyStore   string `env:"IDEMPOTENCY_STORE,default=redis"`
+	JWKSURL            string `env:"TOKEN_JWKS_URL,required"`
 	Redis              sdkrds.Config
 	Postgres           sdkpg.Config
+	FX                 FX
+	// HoldTTL is how long a hold reserves the amount before it is released automatically.
+	HoldTTL time.Duration `env:"HOLD_TTL,default=168h"`
+	// IdempotencyLockTTL is how long an idempotency key is reserved while its request is in progress.
+	IdempotencyLockTTL time.Duration `env:"IDEMPOTENCY_LOCK_TTL,default=5m"`
+	// IdempotencyCleanupInterval is how often expired idempotency keys are deleted from PostgreSQL.
+	IdempotencyCleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL,default=10m"`
+}
+
+// Temporal holds configuration for Temporal.
+type Temporal struct {
+	Address string `env:"TEMPORAL_ADDRESS,default=localhost:7233"`
+}
+
+// FX holds configuration for cross-currency transfer.
+type FX struct {
+	// RateFilePath is the JSON file of the static rate provider, e.g. {"USD/IDR": "16250.5"}.
+	RateFilePath string `env:"FX_RATE_FILE_PATH"`
+	// QuoteTTL is how long a quote locks its rate.
+	QuoteTTL time.Duration `env:"FX_QUOTE_TTL,default=30s"`
+	// SpreadBasisPoints is the fee charged on the transferred amount, e.g. 50 means 0.5%.
+	SpreadBasisPoints int64 `env:"FX_SPREAD_BASIS_POINTS,default=50"`
 }
 
 // NewConfig creates an instance of Config.
@@ -37,6 +70,9 @@ func NewConfig(env string) (*Config, error) {
 	if err := envdecode.Decode(&config); err != nil {
 		return nil, errors.Wrap(err, "[NewConfig] error decoding env")
 	}
+	if config.IdempotencyCleanupInterval <= 0 {
+		return nil, errors.Errorf("[NewConfig] idempotency cleanup interval must be positive, got %s", config.IdempotencyCleanupInterval)
+	}
 
 	return &config, nil
 }
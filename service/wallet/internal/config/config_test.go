@@ -21,6 +21,16 @@ func TestNewConfig(t *testing.T) {
 		assert.Nil(t, cfg)
 	})
 
+	t.Run("fail to create an instance of Config due to non-positive idempotency cleanup interval", func(t *testing.T) {
+		for _, interval := range []string{"0s", "-1m"} {
+			t.Setenv("IDEMPOTENCY_CLEANUP_INTERVAL", interval)
+
+			cfg, err := config.NewConfig("../../env.example")
+			assert.NotNil(t, err)
+			assert.Nil(t, cfg)
+		}
+	})
+
 	t.Run("successfully read config", func(t *testing.T) {
 		cfg, err := config.NewConfig("../../env.example")
 		assert.Nil(t, err)
@@ -0,0 +1,2 @@
+// Package fxrate provides exchange rates to convert between currencies.
+package fxrate
@@ -0,0 +1,69 @@
+package fxrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// inverseRateScale is the number of decimal places kept when a rate is derived from its opposite pair.
+const inverseRateScale = 12
+
+// Static provides exchange rates from a fixed table.
+// It is meant for local development and testing, where rates don't need to follow the market.
+type Static struct {
+	rates map[string]decimal.Decimal
+}
+
+// NewStatic creates an instance of Static.
+// Rates are keyed by currency pair, e.g. "USD/IDR", and tell how many units of the second currency
+// one unit of the first currency gets.
+func NewStatic(rates map[string]decimal.Decimal) *Static {
+	normalized := make(map[string]decimal.Decimal, len(rates))
+	for pair, rate := range rates {
+		normalized[strings.ToUpper(pair)] = rate
+	}
+	return &Static{rates: normalized}
+}
+
+// NewStaticFromFile creates an instance of Static from a JSON file.
+// The file is an object of currency pair to rate, e.g. {"USD/IDR": "16250.5"}.
+// Empty path gives a provider without any rate.
+func NewStaticFromFile(path string) (*Static, error) {
+	rates := map[string]decimal.Decimal{}
+	if path == "" {
+		return NewStatic(rates), nil
+	}
+
+	val, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(val, &rates); err != nil {
+		return nil, err
+	}
+	return NewStatic(rates), nil
+}
+
+// GetRate gets how many units of to currency one unit of from currency gets.
+// If only the opposite pair is registered, its inverse is used.
+func (s *Static) GetRate(_ context.Context, from, to string) (decimal.Decimal, error) {
+	if rate, ok := s.rates[pair(from, to)]; ok && rate.IsPositive() {
+		return rate, nil
+	}
+	if rate, ok := s.rates[pair(to, from)]; ok && rate.IsPositive() {
+		return decimal.NewFromInt(1).DivRound(rate, inverseRateScale), nil
+	}
+	return decimal.Zero, entity.ErrRateNotFound()
+}
+
+func pair(from, to string) string {
+	return fmt.Sprintf("%s/%s", from, to)
+}
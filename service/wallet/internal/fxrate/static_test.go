@@ -0,0 +1,97 @@
+package fxrate_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/fxrate"
+)
+
+var (
+	testCtx = context.Background()
+)
+
+func TestNewStatic(t *testing.T) {
+	t.Run("successfully create an instance of Static", func(t *testing.T) {
+		s := fxrate.NewStatic(map[string]decimal.Decimal{})
+		assert.NotNil(t, s)
+	})
+}
+
+func TestNewStaticFromFile(t *testing.T) {
+	t.Run("empty path gives provider without rate", func(t *testing.T) {
+		s, err := fxrate.NewStaticFromFile("")
+
+		assert.NoError(t, err)
+		_, err = s.GetRate(testCtx, "USD", "IDR")
+		assert.Equal(t, entity.ErrRateNotFound(), err)
+	})
+
+	t.Run("file doesn't exist", func(t *testing.T) {
+		s, err := fxrate.NewStaticFromFile(filepath.Join(t.TempDir(), "rates.json"))
+
+		assert.Error(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("file is not valid json", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rates.json")
+		assert.NoError(t, os.WriteFile(path, []byte("rates"), 0o600))
+
+		s, err := fxrate.NewStaticFromFile(path)
+
+		assert.Error(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("success read rates from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rates.json")
+		assert.NoError(t, os.WriteFile(path, []byte(`{"usd/idr": "16250.5"}`), 0o600))
+
+		s, err := fxrate.NewStaticFromFile(path)
+
+		assert.NoError(t, err)
+		rate, err := s.GetRate(testCtx, "USD", "IDR")
+		assert.NoError(t, err)
+		assert.True(t, decimal.RequireFromString("16250.5").Equal(rate))
+	})
+}
+
+func TestStatic_GetRate(t *testing.T) {
+	s := fxrate.NewStatic(map[string]decimal.Decimal{
+		"USD/IDR": decimal.RequireFromString("16000"),
+		"JPY/IDR": decimal.Zero,
+	})
+
+	t.Run("pair is registered", func(t *testing.T) {
+		rate, err := s.GetRate(testCtx, "USD", "IDR")
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(16000).Equal(rate))
+	})
+
+	t.Run("opposite pair is registered", func(t *testing.T) {
+		rate, err := s.GetRate(testCtx, "IDR", "USD")
+
+		assert.NoError(t, err)
+		assert.True(t, decimal.RequireFromString("0.0000625").Equal(rate))
+	})
+
+	t.Run("rate is not positive", func(t *testing.T) {
+		_, err := s.GetRate(testCtx, "JPY", "IDR")
+
+		assert.Equal(t, entity.ErrRateNotFound(), err)
+	})
+
+	t.Run("pair is not registered", func(t *testing.T) {
+		_, err := s.GetRate(testCtx, "EUR", "IDR")
+
+		assert.Equal(t, entity.ErrRateNotFound(), err)
+	})
+}
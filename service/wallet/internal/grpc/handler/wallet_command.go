@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
 	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
@@ -19,11 +20,14 @@ type WalletCommand struct {
 	creator  service.CreateWallet
 	topup    service.TopupWallet
 	transfer service.TransferWallet
+	freezer  service.FreezeWallet
+	quoter   service.CreateFXQuote
+	holder   service.HoldWallet
 }
 
 // NewWalletCommand creates an instance of WalletCommand.
-func NewWalletCommand(c service.CreateWallet, t service.TopupWallet, tf service.TransferWallet) *WalletCommand {
-	return &WalletCommand{creator: c, topup: t, transfer: tf}
+func NewWalletCommand(c service.CreateWallet, t service.TopupWallet, tf service.TransferWallet, f service.FreezeWallet, q service.CreateFXQuote, h service.HoldWallet) *WalletCommand {
+	return &WalletCommand{creator: c, topup: t, transfer: tf, freezer: f, quoter: q, holder: h}
 }
 
 // CreateWallet handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
@@ -71,21 +75,155 @@ func (wc *WalletCommand) TransferBalance(ctx context.Context, request *apiv1.Tra
 		return nil, entity.ErrEmptyWallet()
 	}
 
+	quoteID, err := parseOptionalID(request.GetTransfer().GetQuoteId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-TransferBalance] invalid quote id", "error", err)
+		return nil, entity.ErrInvalidQuote()
+	}
+	referenceID, err := parseOptionalID(request.GetTransfer().GetReferenceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-TransferBalance] invalid reference id", "error", err)
+		return nil, entity.ErrInvalidTransfer()
+	}
+
 	amount, _ := decimal.NewFromString(request.GetTransfer().GetAmount())
-	req := createTransferWalletFromTransferBalanceRequest(request, amount)
+	req := createTransferWalletFromTransferBalanceRequest(request, amount, quoteID, referenceID)
 
-	err := wc.transfer.TransferBalance(ctx, req)
+	conversion, err := wc.transfer.TransferBalance(ctx, req)
 	if err != nil {
 		slog.ErrorContext(ctx, "[WalletCommand-TransferBalance] fail transfer wallet", "error", err)
 		return nil, err
 	}
-	return &apiv1.TransferBalanceResponse{}, nil
+	return &apiv1.TransferBalanceResponse{Conversion: createConversionProto(conversion)}, nil
+}
+
+// CreateFXQuote handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) CreateFXQuote(ctx context.Context, request *apiv1.CreateFXQuoteRequest) (*apiv1.CreateFXQuoteResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	if request == nil {
+		slog.ErrorContext(ctx, "[WalletCommand-CreateFXQuote] nil request")
+		return nil, entity.ErrInvalidCurrency()
+	}
+
+	quote, err := wc.quoter.Create(ctx, userID, request.GetFromCurrency(), request.GetToCurrency())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-CreateFXQuote] fail create quote", "error", err)
+		return nil, err
+	}
+	return &apiv1.CreateFXQuoteResponse{Data: createFXQuoteProto(quote)}, nil
+}
+
+// ResolveTransfer handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) ResolveTransfer(ctx context.Context, request *apiv1.ResolveTransferRequest) (*apiv1.ResolveTransferResponse, error) {
+	referenceID, err := uuid.Parse(request.GetReferenceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-ResolveTransfer] invalid reference id", "error", err)
+		return nil, entity.ErrInvalidTransfer()
+	}
+
+	applied, err := wc.transfer.ResolveTransfer(ctx, referenceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-ResolveTransfer] fail resolve transfer", "error", err)
+		return nil, err
+	}
+	return &apiv1.ResolveTransferResponse{Applied: applied}, nil
+}
+
+// AuthorizeHold handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) AuthorizeHold(ctx context.Context, request *apiv1.AuthorizeHoldRequest) (*apiv1.AuthorizeHoldResponse, error) {
+	if request == nil || request.GetHold() == nil {
+		slog.ErrorContext(ctx, "[WalletCommand-AuthorizeHold] empty or nil hold")
+		return nil, entity.ErrEmptyHold()
+	}
+
+	req, err := createHoldFromAuthorizeHoldRequest(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-AuthorizeHold] invalid hold", "error", err)
+		return nil, err
+	}
+
+	hold, err := wc.holder.Authorize(ctx, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-AuthorizeHold] fail authorize hold", "error", err)
+		return nil, err
+	}
+	return &apiv1.AuthorizeHoldResponse{Data: createHoldProto(hold)}, nil
+}
+
+// CaptureHold handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) CaptureHold(ctx context.Context, request *apiv1.CaptureHoldRequest) (*apiv1.CaptureHoldResponse, error) {
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-CaptureHold] invalid hold id", "error", err)
+		return nil, entity.ErrHoldNotFound()
+	}
+	referenceID, err := uuid.Parse(request.GetReferenceId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-CaptureHold] invalid reference id", "error", err)
+		return nil, entity.ErrInvalidTransfer()
+	}
+
+	capture := createCaptureHoldFromCaptureHoldRequest(request, id, referenceID)
+	hold, err := wc.holder.Capture(ctx, capture)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-CaptureHold] fail capture hold", "error", err)
+		return nil, err
+	}
+	return &apiv1.CaptureHoldResponse{Data: createHoldProto(hold)}, nil
+}
+
+// VoidHold handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) VoidHold(ctx context.Context, request *apiv1.VoidHoldRequest) (*apiv1.VoidHoldResponse, error) {
+	id, err := uuid.Parse(request.GetId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-VoidHold] invalid hold id", "error", err)
+		return nil, entity.ErrHoldNotFound()
+	}
+
+	hold, err := wc.holder.Void(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-VoidHold] fail void hold", "error", err)
+		return nil, err
+	}
+	return &apiv1.VoidHoldResponse{Data: createHoldProto(hold)}, nil
+}
+
+// FreezeUserWallets handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) FreezeUserWallets(ctx context.Context, request *apiv1.FreezeUserWalletsRequest) (*apiv1.FreezeUserWalletsResponse, error) {
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-FreezeUserWallets] invalid user id", "error", err)
+		return nil, entity.ErrInvalidUser()
+	}
+
+	if err := wc.freezer.Freeze(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-FreezeUserWallets] fail freeze wallets", "error", err)
+		return nil, err
+	}
+	return &apiv1.FreezeUserWalletsResponse{}, nil
+}
+
+// UnfreezeUserWallets handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (wc *WalletCommand) UnfreezeUserWallets(ctx context.Context, request *apiv1.UnfreezeUserWalletsRequest) (*apiv1.UnfreezeUserWalletsResponse, error) {
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-UnfreezeUserWallets] invalid user id", "error", err)
+		return nil, entity.ErrInvalidUser()
+	}
+
+	if err := wc.freezer.Unfreeze(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletCommand-UnfreezeUserWallets] fail unfreeze wallets", "error", err)
+		return nil, err
+	}
+	return &apiv1.UnfreezeUserWalletsResponse{}, nil
 }
 
 func createWalletFromCreateWalletRequest(request *apiv1.CreateWalletRequest, balance decimal.Decimal) *entity.Wallet {
 	return &entity.Wallet{
-		UserID:  uuid.MustParse(request.GetWallet().GetUserId()),
-		Balance: balance,
+		UserID:   uuid.MustParse(request.GetWallet().GetUserId()),
+		Balance:  balance,
+		Currency: request.GetWallet().GetCurrency(),
 	}
 }
 
@@ -94,23 +232,136 @@ func createTopupWalletFromTopupWalletRequest(request *apiv1.TopupWalletRequest,
 		WalletID: uuid.MustParse(request.GetTopup().GetWalletId()),
 		UserID:   userID,
 		Amount:   amount,
+		Currency: request.GetTopup().GetCurrency(),
 	}
 }
 
-func createTransferWalletFromTransferBalanceRequest(request *apiv1.TransferBalanceRequest, amount decimal.Decimal) *entity.TransferWallet {
+func createTransferWalletFromTransferBalanceRequest(request *apiv1.TransferBalanceRequest, amount decimal.Decimal, quoteID, referenceID uuid.UUID) *entity.TransferWallet {
 	return &entity.TransferWallet{
 		SenderID:         uuid.MustParse(request.GetTransfer().GetSenderId()),
 		SenderWalletID:   uuid.MustParse(request.GetTransfer().GetSenderWalletId()),
 		ReceiverID:       uuid.MustParse(request.GetTransfer().GetReceiverId()),
 		ReceiverWalletID: uuid.MustParse(request.GetTransfer().GetReceiverWalletId()),
 		Amount:           amount,
+		Currency:         request.GetTransfer().GetCurrency(),
+		QuoteID:          quoteID,
+		ReferenceID:      referenceID,
+	}
+}
+
+func createHoldFromAuthorizeHoldRequest(request *apiv1.AuthorizeHoldRequest) (*entity.Hold, error) {
+	userID, uerr := uuid.Parse(request.GetHold().GetUserId())
+	receiverID, rerr := uuid.Parse(request.GetHold().GetReceiverId())
+	if uerr != nil || rerr != nil {
+		return nil, entity.ErrInvalidUser()
+	}
+	walletID, werr := uuid.Parse(request.GetHold().GetWalletId())
+	receiverWalletID, rwerr := uuid.Parse(request.GetHold().GetReceiverWalletId())
+	if werr != nil || rwerr != nil {
+		return nil, entity.ErrEmptyWallet()
+	}
+
+	amount, _ := decimal.NewFromString(request.GetHold().GetAmount())
+	return &entity.Hold{
+		UserID:           userID,
+		WalletID:         walletID,
+		ReceiverID:       receiverID,
+		ReceiverWalletID: receiverWalletID,
+		Amount:           amount,
+		Currency:         request.GetHold().GetCurrency(),
+	}, nil
+}
+
+// createCaptureHoldFromCaptureHoldRequest leaves invalid user and wallet ids empty,
+// so they don't match the hold and the capture is rejected.
+func createCaptureHoldFromCaptureHoldRequest(request *apiv1.CaptureHoldRequest, id, referenceID uuid.UUID) *entity.CaptureHold {
+	userID, _ := uuid.Parse(request.GetUserId())
+	walletID, _ := uuid.Parse(request.GetWalletId())
+	receiverID, _ := uuid.Parse(request.GetReceiverId())
+	receiverWalletID, _ := uuid.Parse(request.GetReceiverWalletId())
+	amount, _ := decimal.NewFromString(request.GetAmount())
+	return &entity.CaptureHold{
+		HoldID:           id,
+		UserID:           userID,
+		WalletID:         walletID,
+		ReceiverID:       receiverID,
+		ReceiverWalletID: receiverWalletID,
+		Amount:           amount,
+		ReferenceID:      referenceID,
+	}
+}
+
+// parseOptionalID parses an optional id of a transfer, e.g. quote id or reference id.
+// Empty id means the transfer doesn't have it, e.g. it doesn't need conversion.
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
 	}
+	return uuid.Parse(id)
 }
 
 func createWalletProto(wallet *entity.Wallet) *apiv1.Wallet {
 	return &apiv1.Wallet{
-		Id:      wallet.ID.String(),
-		UserId:  wallet.UserID.String(),
-		Balance: wallet.Balance.String(),
+		Id:               wallet.ID.String(),
+		UserId:           wallet.UserID.String(),
+		Balance:          wallet.Balance.String(),
+		Currency:         wallet.Currency,
+		AvailableBalance: wallet.AvailableBalance().String(),
+		HeldBalance:      wallet.HeldBalance.String(),
+	}
+}
+
+func createFXQuoteProto(quote *entity.FXQuote) *apiv1.FXQuote {
+	return &apiv1.FXQuote{
+		Id:           quote.ID.String(),
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate.String(),
+		Spread:       quote.Spread.String(),
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+	}
+}
+
+func createConversionProto(conversion *entity.FXConversion) *apiv1.Conversion {
+	if conversion == nil {
+		return nil
+	}
+	return &apiv1.Conversion{
+		QuoteId:         conversion.QuoteID.String(),
+		FromCurrency:    conversion.FromCurrency,
+		ToCurrency:      conversion.ToCurrency,
+		Rate:            conversion.Rate.String(),
+		Fee:             conversion.Fee.String(),
+		ConvertedAmount: conversion.ConvertedAmount.String(),
+	}
+}
+
+func createHoldProto(hold *entity.Hold) *apiv1.Hold {
+	return &apiv1.Hold{
+		Id:               hold.ID.String(),
+		UserId:           hold.UserID.String(),
+		WalletId:         hold.WalletID.String(),
+		ReceiverId:       hold.ReceiverID.String(),
+		ReceiverWalletId: hold.ReceiverWalletID.String(),
+		Amount:           hold.Amount.String(),
+		Currency:         hold.Currency,
+		CapturedAmount:   hold.CapturedAmount.String(),
+		Status:           createHoldStatusProto(hold.Status),
+		ExpiresAt:        timestamppb.New(hold.ExpiresAt),
+	}
+}
+
+func createHoldStatusProto(status entity.HoldStatus) apiv1.HoldStatus {
+	switch status {
+	case entity.HoldStatusAuthorized:
+		return apiv1.HoldStatus_HOLD_STATUS_AUTHORIZED
+	case entity.HoldStatusCaptured:
+		return apiv1.HoldStatus_HOLD_STATUS_CAPTURED
+	case entity.HoldStatusVoided:
+		return apiv1.HoldStatus_HOLD_STATUS_VOIDED
+	case entity.HoldStatusExpired:
+		return apiv1.HoldStatus_HOLD_STATUS_EXPIRED
+	default:
+		return apiv1.HoldStatus_HOLD_STATUS_UNSPECIFIED
 	}
 }
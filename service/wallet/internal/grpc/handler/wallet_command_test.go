@@ -3,6 +3,7 @@ package handler_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -25,6 +26,9 @@ type WalletCommandSuite struct {
 	creator  *mock_service.MockCreateWallet
 	topup    *mock_service.MockTopupWallet
 	transfer *mock_service.MockTransferWallet
+	freezer  *mock_service.MockFreezeWallet
+	quoter   *mock_service.MockCreateFXQuote
+	holder   *mock_service.MockHoldWallet
 }
 
 func TestNewWalletCommand(t *testing.T) {
@@ -160,15 +164,21 @@ func TestWalletCommand_TopupWallet(t *testing.T) {
 		userID := uuid.Must(uuid.NewV7())
 
 		st := createWalletCommandSuite(ctrl)
-		st.topup.EXPECT().Topup(testCtxWithAuth, gomock.Any()).Return(&entity.Wallet{
-			ID:      walletID,
-			UserID:  userID,
-			Balance: decimal.NewFromFloat(10.23),
-		}, nil)
+		st.topup.EXPECT().Topup(testCtxWithAuth, gomock.Any()).
+			DoAndReturn(func(_ context.Context, topup *entity.TopupWallet) (*entity.Wallet, error) {
+				assert.Equal(t, "USD", topup.Currency)
+				return &entity.Wallet{
+					ID:       walletID,
+					UserID:   userID,
+					Balance:  decimal.NewFromFloat(10.23),
+					Currency: topup.Currency,
+				}, nil
+			})
 		request := &apiv1.TopupWalletRequest{
 			Topup: &apiv1.Topup{
 				WalletId: walletID.String(),
 				Amount:   "10.23",
+				Currency: "USD",
 			},
 		}
 
@@ -176,6 +186,7 @@ func TestWalletCommand_TopupWallet(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
+		assert.Equal(t, "USD", res.GetData().GetCurrency())
 	})
 }
 
@@ -222,7 +233,7 @@ func TestWalletCommand_TransferBalance(t *testing.T) {
 			entity.ErrInternal("error"),
 		}
 		for _, errRet := range errors {
-			st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).Return(errRet)
+			st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).Return(nil, errRet)
 
 			res, err := st.handler.TransferBalance(testCtx, request)
 
@@ -232,9 +243,110 @@ func TestWalletCommand_TransferBalance(t *testing.T) {
 		}
 	})
 
+	t.Run("quote id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := &apiv1.TransferBalanceRequest{
+			Transfer: &apiv1.Transfer{
+				Amount:           "10.23",
+				SenderId:         uuid.Must(uuid.NewV7()).String(),
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				QuoteId:          "invalid",
+			},
+		}
+
+		res, err := st.handler.TransferBalance(testCtx, request)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success transfer balance with conversion", func(t *testing.T) {
+		quoteID := uuid.Must(uuid.NewV7())
+		st := createWalletCommandSuite(ctrl)
+		st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error) {
+				assert.Equal(t, quoteID, transfer.QuoteID)
+				return &entity.FXConversion{
+					QuoteID:         quoteID,
+					FromCurrency:    "USD",
+					ToCurrency:      "IDR",
+					Rate:            decimal.RequireFromString("16250.5"),
+					Fee:             decimal.RequireFromString("0.06"),
+					ConvertedAmount: decimal.RequireFromString("165264.58"),
+				}, nil
+			})
+		request := &apiv1.TransferBalanceRequest{
+			Transfer: &apiv1.Transfer{
+				Amount:           "10.23",
+				SenderId:         uuid.Must(uuid.NewV7()).String(),
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				Currency:         "USD",
+				QuoteId:          quoteID.String(),
+			},
+		}
+
+		res, err := st.handler.TransferBalance(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, quoteID.String(), res.GetConversion().GetQuoteId())
+		assert.Equal(t, "16250.5", res.GetConversion().GetRate())
+		assert.Equal(t, "0.06", res.GetConversion().GetFee())
+		assert.Equal(t, "165264.58", res.GetConversion().GetConvertedAmount())
+	})
+
+	t.Run("reference id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := &apiv1.TransferBalanceRequest{
+			Transfer: &apiv1.Transfer{
+				Amount:           "10.23",
+				SenderId:         uuid.Must(uuid.NewV7()).String(),
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				ReferenceId:      "invalid",
+			},
+		}
+
+		res, err := st.handler.TransferBalance(testCtx, request)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidTransfer(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success transfer balance with reference", func(t *testing.T) {
+		referenceID := uuid.Must(uuid.NewV7())
+		st := createWalletCommandSuite(ctrl)
+		st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error) {
+				assert.Equal(t, referenceID, transfer.ReferenceID)
+				return nil, nil
+			})
+		request := &apiv1.TransferBalanceRequest{
+			Transfer: &apiv1.Transfer{
+				Amount:           "10.23",
+				SenderId:         uuid.Must(uuid.NewV7()).String(),
+				ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+				SenderWalletId:   uuid.Must(uuid.NewV7()).String(),
+				ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+				ReferenceId:      referenceID.String(),
+			},
+		}
+
+		res, err := st.handler.TransferBalance(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.Nil(t, res.GetConversion())
+	})
+
 	t.Run("success create wallet", func(t *testing.T) {
 		st := createWalletCommandSuite(ctrl)
-		st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).Return(nil)
+		st.transfer.EXPECT().TransferBalance(testCtx, gomock.Any()).Return(nil, nil)
 		request := &apiv1.TransferBalanceRequest{
 			Transfer: &apiv1.Transfer{
 				Amount:           "10.23",
@@ -249,18 +361,424 @@ func TestWalletCommand_TransferBalance(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
+		assert.Nil(t, res.GetConversion())
+	})
+}
+
+func TestWalletCommand_CreateFXQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+
+		res, err := st.handler.CreateFXQuote(testCtxWithAuth, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("quoter returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := &apiv1.CreateFXQuoteRequest{FromCurrency: "USD", ToCurrency: "IDR"}
+
+		errors := []error{
+			entity.ErrInvalidCurrency(),
+			entity.ErrRateNotFound(),
+			entity.ErrInternal("error"),
+		}
+		for _, errRet := range errors {
+			st.quoter.EXPECT().Create(testCtxWithAuth, gomock.Any(), "USD", "IDR").Return(nil, errRet)
+
+			res, err := st.handler.CreateFXQuote(testCtxWithAuth, request)
+
+			assert.Error(t, err)
+			assert.Equal(t, errRet, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success create fx quote", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		userID := testCtxWithAuth.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+		quote := &entity.FXQuote{
+			ID:           uuid.Must(uuid.NewV7()),
+			UserID:       userID,
+			FromCurrency: "USD",
+			ToCurrency:   "IDR",
+			Rate:         decimal.RequireFromString("16250.5"),
+			Spread:       decimal.RequireFromString("0.005"),
+			ExpiresAt:    time.Now().Add(30 * time.Second),
+		}
+		st.quoter.EXPECT().Create(testCtxWithAuth, userID, "USD", "IDR").Return(quote, nil)
+		request := &apiv1.CreateFXQuoteRequest{FromCurrency: "USD", ToCurrency: "IDR"}
+
+		res, err := st.handler.CreateFXQuote(testCtxWithAuth, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, quote.ID.String(), res.GetData().GetId())
+		assert.Equal(t, "16250.5", res.GetData().GetRate())
+		assert.Equal(t, "0.005", res.GetData().GetSpread())
+		assert.Equal(t, quote.ExpiresAt.Unix(), res.GetData().GetExpiresAt().GetSeconds())
 	})
 }
 
+func TestWalletCommand_AuthorizeHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.AuthorizeHoldRequest{nil, {}}
+
+		for _, request := range requests {
+			res, err := st.handler.AuthorizeHold(testCtx, request)
+
+			assert.Equal(t, entity.ErrEmptyHold(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("user id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := createAuthorizeHoldRequest()
+		request.Hold.ReceiverId = "not-a-uuid"
+
+		res, err := st.handler.AuthorizeHold(testCtx, request)
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("wallet id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := createAuthorizeHoldRequest()
+		request.Hold.WalletId = "not-a-uuid"
+
+		res, err := st.handler.AuthorizeHold(testCtx, request)
+
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("holder returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := createAuthorizeHoldRequest()
+
+		errors := []error{
+			entity.ErrInvalidAmount(),
+			entity.ErrInsufficientBalance(),
+			entity.ErrInternal("error"),
+		}
+		for _, errRet := range errors {
+			st.holder.EXPECT().Authorize(testCtx, gomock.Any()).Return(nil, errRet)
+
+			res, err := st.handler.AuthorizeHold(testCtx, request)
+
+			assert.Equal(t, errRet, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success authorize hold", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		request := createAuthorizeHoldRequest()
+		hold := createTestHold(entity.HoldStatusAuthorized)
+		st.holder.EXPECT().Authorize(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *entity.Hold) (*entity.Hold, error) {
+				assert.Equal(t, request.GetHold().GetUserId(), h.UserID.String())
+				assert.Equal(t, request.GetHold().GetReceiverWalletId(), h.ReceiverWalletID.String())
+				assert.Equal(t, request.GetHold().GetAmount(), h.Amount.String())
+				return hold, nil
+			})
+
+		res, err := st.handler.AuthorizeHold(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, hold.ID.String(), res.GetData().GetId())
+		assert.Equal(t, apiv1.HoldStatus_HOLD_STATUS_AUTHORIZED, res.GetData().GetStatus())
+		assert.Equal(t, hold.ExpiresAt.Unix(), res.GetData().GetExpiresAt().GetSeconds())
+	})
+}
+
+func TestWalletCommand_CaptureHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+	referenceID := uuid.Must(uuid.NewV7())
+	hold := createTestHold(entity.HoldStatusCaptured)
+	request := &apiv1.CaptureHoldRequest{
+		Id:               id.String(),
+		Amount:           "5",
+		UserId:           hold.UserID.String(),
+		WalletId:         hold.WalletID.String(),
+		ReceiverId:       hold.ReceiverID.String(),
+		ReceiverWalletId: hold.ReceiverWalletID.String(),
+		ReferenceId:      referenceID.String(),
+	}
+	capture := &entity.CaptureHold{
+		HoldID:           id,
+		Amount:           decimal.NewFromInt(5),
+		UserID:           hold.UserID,
+		WalletID:         hold.WalletID,
+		ReceiverID:       hold.ReceiverID,
+		ReceiverWalletID: hold.ReceiverWalletID,
+		ReferenceID:      referenceID,
+	}
+
+	t.Run("hold id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.CaptureHoldRequest{nil, {}, {Id: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.CaptureHold(testCtx, request)
+
+			assert.Equal(t, entity.ErrHoldNotFound(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("reference id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.CaptureHoldRequest{{Id: id.String()}, {Id: id.String(), ReferenceId: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.CaptureHold(testCtx, request)
+
+			assert.Equal(t, entity.ErrInvalidTransfer(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("invalid user and wallet ids are left empty", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.holder.EXPECT().Capture(testCtx, &entity.CaptureHold{HoldID: id, Amount: decimal.NewFromInt(5), ReferenceID: referenceID}).Return(nil, entity.ErrHoldNotFound())
+
+		res, err := st.handler.CaptureHold(testCtx, &apiv1.CaptureHoldRequest{Id: id.String(), Amount: "5", UserId: "not-a-uuid", ReferenceId: referenceID.String()})
+
+		assert.Equal(t, entity.ErrHoldNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("holder returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.holder.EXPECT().Capture(testCtx, capture).Return(nil, entity.ErrHoldClosed())
+
+		res, err := st.handler.CaptureHold(testCtx, request)
+
+		assert.Equal(t, entity.ErrHoldClosed(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success capture hold", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		hold.CapturedAmount = decimal.NewFromInt(5)
+		st.holder.EXPECT().Capture(testCtx, capture).Return(hold, nil)
+
+		res, err := st.handler.CaptureHold(testCtx, request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, apiv1.HoldStatus_HOLD_STATUS_CAPTURED, res.GetData().GetStatus())
+		assert.Equal(t, "5", res.GetData().GetCapturedAmount())
+	})
+}
+
+func TestWalletCommand_VoidHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("hold id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.VoidHoldRequest{nil, {}, {Id: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.VoidHold(testCtx, request)
+
+			assert.Equal(t, entity.ErrHoldNotFound(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("holder returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.holder.EXPECT().Void(testCtx, id).Return(nil, entity.ErrHoldClosed())
+
+		res, err := st.handler.VoidHold(testCtx, &apiv1.VoidHoldRequest{Id: id.String()})
+
+		assert.Equal(t, entity.ErrHoldClosed(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success void hold", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		hold := createTestHold(entity.HoldStatusVoided)
+		st.holder.EXPECT().Void(testCtx, id).Return(hold, nil)
+
+		res, err := st.handler.VoidHold(testCtx, &apiv1.VoidHoldRequest{Id: id.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, apiv1.HoldStatus_HOLD_STATUS_VOIDED, res.GetData().GetStatus())
+	})
+}
+
+func TestWalletCommand_ResolveTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("reference id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.ResolveTransferRequest{nil, {}, {ReferenceId: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.ResolveTransfer(testCtx, request)
+
+			assert.Equal(t, entity.ErrInvalidTransfer(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("transferer returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.transfer.EXPECT().ResolveTransfer(testCtx, id).Return(false, entity.ErrInternal(""))
+
+		res, err := st.handler.ResolveTransfer(testCtx, &apiv1.ResolveTransferRequest{ReferenceId: id.String()})
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success resolve transfer", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.transfer.EXPECT().ResolveTransfer(testCtx, id).Return(true, nil)
+
+		res, err := st.handler.ResolveTransfer(testCtx, &apiv1.ResolveTransferRequest{ReferenceId: id.String()})
+
+		assert.NoError(t, err)
+		assert.True(t, res.GetApplied())
+	})
+}
+
+func TestWalletCommand_FreezeUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := uuid.Must(uuid.NewV7())
+
+	t.Run("user id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.FreezeUserWalletsRequest{nil, {}, {UserId: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.FreezeUserWallets(testCtx, request)
+
+			assert.Equal(t, entity.ErrInvalidUser(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("freezer returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.freezer.EXPECT().Freeze(testCtx, userID).Return(entity.ErrInternal(""))
+
+		res, err := st.handler.FreezeUserWallets(testCtx, &apiv1.FreezeUserWalletsRequest{UserId: userID.String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success freeze user wallets", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.freezer.EXPECT().Freeze(testCtx, userID).Return(nil)
+
+		res, err := st.handler.FreezeUserWallets(testCtx, &apiv1.FreezeUserWalletsRequest{UserId: userID.String()})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestWalletCommand_UnfreezeUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := uuid.Must(uuid.NewV7())
+
+	t.Run("user id is invalid", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		requests := []*apiv1.UnfreezeUserWalletsRequest{nil, {}, {UserId: "not-a-uuid"}}
+
+		for _, request := range requests {
+			res, err := st.handler.UnfreezeUserWallets(testCtx, request)
+
+			assert.Equal(t, entity.ErrInvalidUser(), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("freezer returns error", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.freezer.EXPECT().Unfreeze(testCtx, userID).Return(entity.ErrInternal(""))
+
+		res, err := st.handler.UnfreezeUserWallets(testCtx, &apiv1.UnfreezeUserWalletsRequest{UserId: userID.String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success unfreeze user wallets", func(t *testing.T) {
+		st := createWalletCommandSuite(ctrl)
+		st.freezer.EXPECT().Unfreeze(testCtx, userID).Return(nil)
+
+		res, err := st.handler.UnfreezeUserWallets(testCtx, &apiv1.UnfreezeUserWalletsRequest{UserId: userID.String()})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func createAuthorizeHoldRequest() *apiv1.AuthorizeHoldRequest {
+	return &apiv1.AuthorizeHoldRequest{
+		Hold: &apiv1.Hold{
+			UserId:           uuid.Must(uuid.NewV7()).String(),
+			WalletId:         uuid.Must(uuid.NewV7()).String(),
+			ReceiverId:       uuid.Must(uuid.NewV7()).String(),
+			ReceiverWalletId: uuid.Must(uuid.NewV7()).String(),
+			Amount:           "10.5",
+			Currency:         entity.DefaultCurrency,
+		},
+	}
+}
+
+func createTestHold(status entity.HoldStatus) *entity.Hold {
+	return &entity.Hold{
+		ID:               uuid.Must(uuid.NewV7()),
+		UserID:           uuid.Must(uuid.NewV7()),
+		WalletID:         uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           decimal.RequireFromString("10.5"),
+		Currency:         entity.DefaultCurrency,
+		Status:           status,
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}
+}
+
 func createWalletCommandSuite(ctrl *gomock.Controller) *WalletCommandSuite {
 	c := mock_service.NewMockCreateWallet(ctrl)
 	t := mock_service.NewMockTopupWallet(ctrl)
 	tf := mock_service.NewMockTransferWallet(ctrl)
-	h := handler.NewWalletCommand(c, t, tf)
+	f := mock_service.NewMockFreezeWallet(ctrl)
+	q := mock_service.NewMockCreateFXQuote(ctrl)
+	hw := mock_service.NewMockHoldWallet(ctrl)
+	h := handler.NewWalletCommand(c, t, tf, f, q, hw)
 	return &WalletCommandSuite{
 		handler:  h,
 		creator:  c,
 		topup:    t,
 		transfer: tf,
+		freezer:  f,
+		quoter:   q,
+		holder:   hw,
 	}
 }
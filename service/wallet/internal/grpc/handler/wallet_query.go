@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+)
+
+// WalletQuery handles HTTP/2 gRPC request for retrieving wallet.
+type WalletQuery struct {
+	apiv1.UnimplementedWalletQueryServiceServer
+	getter service.GetWallet
+}
+
+// NewWalletQuery creates an instance of WalletQuery.
+func NewWalletQuery(getter service.GetWallet) *WalletQuery {
+	return &WalletQuery{getter: getter}
+}
+
+// GetWallet handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (wq *WalletQuery) GetWallet(ctx context.Context, request *apiv1.GetWalletRequest) (*apiv1.GetWalletResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	if request == nil {
+		return nil, entity.ErrEmptyWallet()
+	}
+
+	// invalid id is left as uuid.Nil and treated as not found by the service.
+	id, _ := uuid.Parse(request.GetId())
+	wallet, err := wq.getter.GetByID(ctx, userID, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletQuery-GetWallet] fail get wallet", "error", err)
+		return nil, err
+	}
+	return &apiv1.GetWalletResponse{Data: createWalletProto(wallet)}, nil
+}
+
+// ListMyWallets handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+func (wq *WalletQuery) ListMyWallets(ctx context.Context, request *apiv1.ListMyWalletsRequest) (*apiv1.ListMyWalletsResponse, error) {
+	userID := ctx.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	if request == nil {
+		return nil, entity.ErrEmptyWallet()
+	}
+
+	wallets, err := wq.getter.GetAllByUser(ctx, userID, uint(request.GetLimit()))
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletQuery-ListMyWallets] fail list wallets", "error", err)
+		return nil, err
+	}
+	return createListMyWalletsResponse(wallets), nil
+}
+
+// ListUserWallets handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It is used internally by other services, so the user is taken from the request instead of the token.
+// All wallets are listed, including the frozen ones, so the user's data can be exported after the wallets are frozen.
+func (wq *WalletQuery) ListUserWallets(ctx context.Context, request *apiv1.ListUserWalletsRequest) (*apiv1.ListUserWalletsResponse, error) {
+	userID, err := uuid.Parse(request.GetUserId())
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletQuery-ListUserWallets] user id is invalid", "error", err)
+		return nil, entity.ErrInvalidUser()
+	}
+
+	wallets, err := wq.getter.GetAllByUserIncludingDeleted(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletQuery-ListUserWallets] fail list wallets", "error", err)
+		return nil, err
+	}
+
+	resp := &apiv1.ListUserWalletsResponse{}
+	for _, wallet := range wallets {
+		resp.Data = append(resp.Data, createWalletProto(wallet))
+	}
+	return resp, nil
+}
+
+func createListMyWalletsResponse(wallets []*entity.Wallet) *apiv1.ListMyWalletsResponse {
+	resp := &apiv1.ListMyWalletsResponse{}
+	for _, wallet := range wallets {
+		resp.Data = append(resp.Data, createWalletProto(wallet))
+	}
+	return resp
+}
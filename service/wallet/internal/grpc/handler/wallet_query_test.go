@@ -0,0 +1,175 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/grpc/interceptor"
+	apiv1 "github.com/indrasaputra/arjuna/proto/api/v1"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/grpc/handler"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+type WalletQuerySuite struct {
+	handler *handler.WalletQuery
+	getter  *mock_service.MockGetWallet
+}
+
+func TestNewWalletQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of WalletQuery", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		assert.NotNil(t, st.handler)
+	})
+}
+
+func TestWalletQuery_GetWallet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := testCtxWithAuth.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+
+		res, err := st.handler.GetWallet(testCtxWithAuth, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid id is passed as empty id", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		st.getter.EXPECT().GetByID(testCtxWithAuth, userID, uuid.Nil).Return(nil, entity.ErrNotFound())
+
+		res, err := st.handler.GetWallet(testCtxWithAuth, &apiv1.GetWalletRequest{Id: "invalid"})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().GetByID(testCtxWithAuth, userID, id).Return(nil, entity.ErrInternal(""))
+
+		res, err := st.handler.GetWallet(testCtxWithAuth, &apiv1.GetWalletRequest{Id: id.String()})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get wallet", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		wallet := &entity.Wallet{ID: uuid.Must(uuid.NewV7()), UserID: userID, Balance: decimal.NewFromInt(10), HeldBalance: decimal.NewFromInt(4), Currency: "USD"}
+		st.getter.EXPECT().GetByID(testCtxWithAuth, userID, wallet.ID).Return(wallet, nil)
+
+		res, err := st.handler.GetWallet(testCtxWithAuth, &apiv1.GetWalletRequest{Id: wallet.ID.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.ID.String(), res.GetData().GetId())
+		assert.Equal(t, wallet.Balance.String(), res.GetData().GetBalance())
+		assert.Equal(t, "4", res.GetData().GetHeldBalance())
+		assert.Equal(t, "6", res.GetData().GetAvailableBalance())
+		assert.Equal(t, wallet.Currency, res.GetData().GetCurrency())
+	})
+}
+
+func TestWalletQuery_ListMyWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := testCtxWithAuth.Value(interceptor.HeaderKeyUserID).(uuid.UUID)
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+
+		res, err := st.handler.ListMyWallets(testCtxWithAuth, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, userID, uint(5)).Return([]*entity.Wallet{}, entity.ErrInternal(""))
+
+		res, err := st.handler.ListMyWallets(testCtxWithAuth, &apiv1.ListMyWalletsRequest{Limit: 5})
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success list wallets", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		wallets := []*entity.Wallet{
+			{ID: uuid.Must(uuid.NewV7()), UserID: userID, Balance: decimal.NewFromInt(10)},
+			{ID: uuid.Must(uuid.NewV7()), UserID: userID, Balance: decimal.NewFromInt(20)},
+		}
+		st.getter.EXPECT().GetAllByUser(testCtxWithAuth, userID, uint(5)).Return(wallets, nil)
+
+		res, err := st.handler.ListMyWallets(testCtxWithAuth, &apiv1.ListMyWalletsRequest{Limit: 5})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetData(), 2)
+		assert.Equal(t, wallets[1].ID.String(), res.GetData()[1].GetId())
+	})
+}
+
+func TestWalletQuery_ListUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := uuid.Must(uuid.NewV7())
+
+	t.Run("user id is invalid", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+
+		res, err := st.handler.ListUserWallets(testCtx, &apiv1.ListUserWalletsRequest{UserId: "invalid"})
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter returns error", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		st.getter.EXPECT().GetAllByUserIncludingDeleted(testCtx, userID).Return(nil, entity.ErrInternal(""))
+
+		res, err := st.handler.ListUserWallets(testCtx, &apiv1.ListUserWalletsRequest{UserId: userID.String()})
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success list user wallets", func(t *testing.T) {
+		st := createWalletQuerySuite(ctrl)
+		wallets := []*entity.Wallet{
+			{ID: uuid.Must(uuid.NewV7()), UserID: userID, Balance: decimal.NewFromInt(10)},
+		}
+		st.getter.EXPECT().GetAllByUserIncludingDeleted(testCtx, userID).Return(wallets, nil)
+
+		res, err := st.handler.ListUserWallets(testCtx, &apiv1.ListUserWalletsRequest{UserId: userID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.GetData(), 1)
+		assert.Equal(t, wallets[0].ID.String(), res.GetData()[0].GetId())
+	})
+}
+
+func createWalletQuerySuite(ctrl *gomock.Controller) *WalletQuerySuite {
+	g := mock_service.NewMockGetWallet(ctrl)
+	h := handler.NewWalletQuery(g)
+	return &WalletQuerySuite{
+		handler: h,
+		getter:  g,
+	}
+}
@@ -0,0 +1,33 @@
+package activity
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// ExpireHoldHolder defines interface to release an expired hold.
+type ExpireHoldHolder interface {
+	// Expire releases the hold if it is still authorized.
+	Expire(ctx context.Context, id uuid.UUID) error
+}
+
+// ExpireHoldActivity is responsible to execute expire hold workflow.
+type ExpireHoldActivity struct {
+	holder ExpireHoldHolder
+}
+
+// NewExpireHoldActivity creates an instance of ExpireHoldActivity.
+func NewExpireHoldActivity(h ExpireHoldHolder) *ExpireHoldActivity {
+	return &ExpireHoldActivity{holder: h}
+}
+
+// ExpireHold releases the reserved amount of the hold.
+func (e *ExpireHoldActivity) ExpireHold(ctx context.Context, id uuid.UUID) error {
+	err := e.holder.Expire(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[ExpireHoldActivity-ExpireHold] fail expire hold", "error", err)
+	}
+	return err
+}
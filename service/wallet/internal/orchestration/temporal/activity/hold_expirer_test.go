@@ -0,0 +1,66 @@
+package activity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/activity"
+	mock_activity "github.com/indrasaputra/arjuna/service/wallet/test/mock/orchestration/temporal/activity"
+)
+
+var (
+	testCtx = context.Background()
+)
+
+type ExpireHoldActivitySuite struct {
+	activity *activity.ExpireHoldActivity
+
+	holder *mock_activity.MockExpireHoldHolder
+}
+
+func TestNewExpireHoldActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ExpireHoldActivity", func(t *testing.T) {
+		st := createExpireHoldActivitySuite(ctrl)
+		assert.NotNil(t, st.activity)
+	})
+}
+
+func TestExpireHoldActivity_ExpireHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("holder returns error", func(t *testing.T) {
+		st := createExpireHoldActivitySuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.holder.EXPECT().Expire(testCtx, id).Return(assert.AnError)
+
+		err := st.activity.ExpireHold(testCtx, id)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success expire hold", func(t *testing.T) {
+		st := createExpireHoldActivitySuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.holder.EXPECT().Expire(testCtx, id).Return(nil)
+
+		err := st.activity.ExpireHold(testCtx, id)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createExpireHoldActivitySuite(ctrl *gomock.Controller) *ExpireHoldActivitySuite {
+	h := mock_activity.NewMockExpireHoldHolder(ctrl)
+	return &ExpireHoldActivitySuite{
+		activity: activity.NewExpireHoldActivity(h),
+		holder:   h,
+	}
+}
@@ -0,0 +1,2 @@
+// Package workflow defines the necessary step by step of the flow using Temporal.io.
+package workflow
@@ -0,0 +1,104 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	tempflow "go.temporal.io/sdk/workflow"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+const (
+	// TaskQueueExpireHold represents hold expiration.
+	TaskQueueExpireHold = "expire-hold"
+
+	// ActivityTimeoutDefault sets to 5 seconds.
+	ActivityTimeoutDefault = 5 * time.Second
+	// ActivityHoldExpire is derived from struct name + method name. See activity registration in worker.
+	ActivityHoldExpire = "ExpireHoldActivityExpireHold"
+	// ActivityRetryBackoffCoefficient sets to 2.
+	ActivityRetryBackoffCoefficient = 2
+	// ActivityRetryMaximumAttempts sets to 0 which means unlimited.
+	// It is safe to retry since expiring a hold which is already closed does nothing,
+	// and an expired hold must never keep the balance reserved.
+	ActivityRetryMaximumAttempts = 0
+	// ActivityRetryInitialInterval sets to 1 second.
+	ActivityRetryInitialInterval = 1 * time.Second
+	// ActivityRetryMaximumInterval sets to 5 minutes.
+	ActivityRetryMaximumInterval = 5 * time.Minute
+
+	// WorkflowNameExpireHold is derived from the process itself.
+	WorkflowNameExpireHold = "expire-hold"
+	// WorkflowRetryMaximumAttempts sets to 1.
+	WorkflowRetryMaximumAttempts = 1
+)
+
+// ExpireHoldWorkflow is responsible to schedule expire hold workflow.
+type ExpireHoldWorkflow struct {
+	client client.Client
+}
+
+// NewExpireHoldWorkflow creates an instance of ExpireHoldWorkflow.
+func NewExpireHoldWorkflow(client client.Client) *ExpireHoldWorkflow {
+	return &ExpireHoldWorkflow{client: client}
+}
+
+// ScheduleExpiry starts the expire hold workflow without waiting for its result.
+// The workflow sleeps until the hold expires, hence waiting is not an option.
+func (e *ExpireHoldWorkflow) ScheduleExpiry(ctx context.Context, hold *entity.Hold) error {
+	if hold == nil {
+		return entity.ErrEmptyHold()
+	}
+
+	opts := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("%s-%s", WorkflowNameExpireHold, hold.ID),
+		TaskQueue: TaskQueueExpireHold,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: WorkflowRetryMaximumAttempts,
+		},
+	}
+	input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: hold.ExpiresAt}
+	wr, err := e.client.ExecuteWorkflow(ctx, opts, ExpireHold, input)
+	if err != nil {
+		slog.ErrorContext(ctx, "[ExpireHoldWorkflow-ScheduleExpiry] fail to start workflow", "error", err)
+		return entity.ErrInternal("Something went wrong within our server. Please, try again")
+	}
+	slog.InfoContext(ctx, "[ExpireHoldWorkflow-ScheduleExpiry] started workflow", "workflow-id", wr.GetID(), "run-id", wr.GetRunID())
+	return nil
+}
+
+// ExpireHold runs the expire hold workflow.
+// It waits until the hold expires, then releases the reserved amount.
+// A hold which has been captured or voided in the meantime is left as it is.
+func ExpireHold(ctx tempflow.Context, input *entity.ExpireHoldInput) error {
+	if input == nil {
+		return entity.ErrEmptyHold()
+	}
+
+	if d := input.ExpiresAt.Sub(tempflow.Now(ctx)); d > 0 {
+		if err := tempflow.NewTimer(ctx, d).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	actx := tempflow.WithActivityOptions(ctx, createActivityOptions())
+	return tempflow.ExecuteActivity(actx, ActivityHoldExpire, input.HoldID).Get(actx, nil)
+}
+
+func createActivityOptions() tempflow.ActivityOptions {
+	return tempflow.ActivityOptions{
+		StartToCloseTimeout: ActivityTimeoutDefault,
+		TaskQueue:           TaskQueueExpireHold,
+		RetryPolicy: &temporal.RetryPolicy{
+			BackoffCoefficient: ActivityRetryBackoffCoefficient,
+			MaximumAttempts:    ActivityRetryMaximumAttempts,
+			InitialInterval:    ActivityRetryInitialInterval,
+			MaximumInterval:    ActivityRetryMaximumInterval,
+		},
+	}
+}
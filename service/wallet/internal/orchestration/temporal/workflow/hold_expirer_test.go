@@ -0,0 +1,178 @@
+package workflow_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/activity"
+	tempomock "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	orcact "github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/activity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/orchestration/temporal/workflow"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+)
+
+var (
+	testCtx = context.Background()
+)
+
+type ExpireHoldWorkflowSuite struct {
+	workflow *workflow.ExpireHoldWorkflow
+	client   *tempomock.Client
+}
+
+func TestNewExpireHoldWorkflow(t *testing.T) {
+	t.Run("successfully create an instance of ExpireHoldWorkflow", func(t *testing.T) {
+		st := createExpireHoldWorkflowSuite()
+		assert.NotNil(t, st.workflow)
+	})
+}
+
+func TestExpireHoldWorkflow_ScheduleExpiry(t *testing.T) {
+	workflowFuncType := mock.AnythingOfType("func(internal.Context, *entity.ExpireHoldInput) error")
+
+	t.Run("hold is nil", func(t *testing.T) {
+		st := createExpireHoldWorkflowSuite()
+
+		err := st.workflow.ScheduleExpiry(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyHold(), err)
+	})
+
+	t.Run("execute workflow returns error", func(t *testing.T) {
+		st := createExpireHoldWorkflowSuite()
+		hold := createTestHold()
+		input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: hold.ExpiresAt}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(nil, assert.AnError)
+
+		err := st.workflow.ScheduleExpiry(testCtx, hold)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("workflow is started successfully", func(t *testing.T) {
+		st := createExpireHoldWorkflowSuite()
+		hold := createTestHold()
+		input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: hold.ExpiresAt}
+		wr := &tempomock.WorkflowRun{}
+
+		st.client.
+			On("ExecuteWorkflow", testCtx, mock.Anything, workflowFuncType, input).
+			Return(wr, nil)
+		wr.On("GetID").Return("")
+		wr.On("GetRunID").Return("")
+
+		err := st.workflow.ScheduleExpiry(testCtx, hold)
+
+		assert.NoError(t, err)
+		wr.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+type ExpireHoldSuite struct {
+	env *testsuite.TestWorkflowEnvironment
+	testsuite.WorkflowTestSuite
+}
+
+func TestExpireHold(t *testing.T) {
+	t.Run("input is invalid", func(t *testing.T) {
+		st := createExpireHoldSuite()
+
+		st.env.ExecuteWorkflow(workflow.ExpireHold, nil)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.Error(t, st.env.GetWorkflowError())
+	})
+
+	t.Run("hold is expired after its expiry time", func(t *testing.T) {
+		st := createExpireHoldSuite()
+		hold := createTestHold()
+		input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: st.env.Now().Add(time.Hour)}
+
+		st.env.OnActivity(workflow.ActivityHoldExpire, mock.Anything, input.HoldID).
+			Return(func(_ context.Context, _ uuid.UUID) error {
+				assert.False(t, st.env.Now().Before(input.ExpiresAt))
+				return nil
+			}).Once()
+
+		st.env.ExecuteWorkflow(workflow.ExpireHold, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("hold is already past its expiry time", func(t *testing.T) {
+		st := createExpireHoldSuite()
+		hold := createTestHold()
+		input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: st.env.Now().Add(-time.Hour)}
+
+		st.env.OnActivity(workflow.ActivityHoldExpire, mock.Anything, input.HoldID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.ExpireHold, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+
+	t.Run("activity keeps retrying until it succeeds", func(t *testing.T) {
+		st := createExpireHoldSuite()
+		hold := createTestHold()
+		input := &entity.ExpireHoldInput{HoldID: hold.ID, ExpiresAt: st.env.Now()}
+
+		st.env.OnActivity(workflow.ActivityHoldExpire, mock.Anything, input.HoldID).Return(assert.AnError).Times(3)
+		st.env.OnActivity(workflow.ActivityHoldExpire, mock.Anything, input.HoldID).Return(nil).Once()
+
+		st.env.ExecuteWorkflow(workflow.ExpireHold, input)
+
+		assert.True(t, st.env.IsWorkflowCompleted())
+		assert.NoError(t, st.env.GetWorkflowError())
+		st.env.AssertExpectations(t)
+	})
+}
+
+func createTestHold() *entity.Hold {
+	return &entity.Hold{
+		ID:               uuid.Must(uuid.NewV7()),
+		UserID:           uuid.Must(uuid.NewV7()),
+		WalletID:         uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           decimal.NewFromInt(10),
+		Currency:         entity.DefaultCurrency,
+		Status:           entity.HoldStatusAuthorized,
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}
+}
+
+func createExpireHoldWorkflowSuite() *ExpireHoldWorkflowSuite {
+	c := &tempomock.Client{}
+	w := workflow.NewExpireHoldWorkflow(c)
+	return &ExpireHoldWorkflowSuite{
+		workflow: w,
+		client:   c,
+	}
+}
+
+func createExpireHoldSuite() *ExpireHoldSuite {
+	s := &ExpireHoldSuite{}
+	s.env = s.NewTestWorkflowEnvironment()
+
+	h := &service.WalletHolder{}
+	act := orcact.NewExpireHoldActivity(h)
+
+	s.env.RegisterActivityWithOptions(act, activity.RegisterOptions{Name: "ExpireHoldActivity", SkipInvalidStructFunctions: true})
+
+	return s
+}
@@ -5,20 +5,256 @@
 package db
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
-type Wallet struct {
+type HoldStatus string
+
+const (
+	HoldStatusAUTHORIZED HoldStatus = "AUTHORIZED"
+	HoldStatusCAPTURED   HoldStatus = "CAPTURED"
+	HoldStatusVOIDED     HoldStatus = "VOIDED"
+	HoldStatusEXPIRED    HoldStatus = "EXPIRED"
+)
+
+func (e *HoldStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HoldStatus(s)
+	case string:
+		*e = HoldStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HoldStatus: %T", src)
+	}
+	return nil
+}
+
+type NullHoldStatus struct {
+	HoldStatus HoldStatus
+	Valid      bool // Valid is true if HoldStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHoldStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.HoldStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HoldStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHoldStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HoldStatus), nil
+}
+
+type LedgerEntryType string
+
+const (
+	LedgerEntryTypeDEBIT  LedgerEntryType = "DEBIT"
+	LedgerEntryTypeCREDIT LedgerEntryType = "CREDIT"
+)
+
+func (e *LedgerEntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LedgerEntryType(s)
+	case string:
+		*e = LedgerEntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LedgerEntryType: %T", src)
+	}
+	return nil
+}
+
+type NullLedgerEntryType struct {
+	LedgerEntryType LedgerEntryType
+	Valid           bool // Valid is true if LedgerEntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLedgerEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.LedgerEntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LedgerEntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLedgerEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LedgerEntryType), nil
+}
+
+type LedgerJournalType string
+
+const (
+	LedgerJournalTypeOPENINGBALANCE LedgerJournalType = "OPENING_BALANCE"
+	LedgerJournalTypeTOPUP          LedgerJournalType = "TOPUP"
+	LedgerJournalTypeTRANSFER       LedgerJournalType = "TRANSFER"
+	LedgerJournalTypeFXTRANSFER     LedgerJournalType = "FX_TRANSFER"
+	LedgerJournalTypeHOLDCAPTURE    LedgerJournalType = "HOLD_CAPTURE"
+	LedgerJournalTypeFXFEE          LedgerJournalType = "FX_FEE"
+)
+
+func (e *LedgerJournalType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LedgerJournalType(s)
+	case string:
+		*e = LedgerJournalType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LedgerJournalType: %T", src)
+	}
+	return nil
+}
+
+type NullLedgerJournalType struct {
+	LedgerJournalType LedgerJournalType
+	Valid             bool // Valid is true if LedgerJournalType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLedgerJournalType) Scan(value interface{}) error {
+	if value == nil {
+		ns.LedgerJournalType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LedgerJournalType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLedgerJournalType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LedgerJournalType), nil
+}
+
+type TransferStatus string
+
+const (
+	TransferStatusAPPLIED   TransferStatus = "APPLIED"
+	TransferStatusCANCELLED TransferStatus = "CANCELLED"
+)
+
+func (e *TransferStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferStatus(s)
+	case string:
+		*e = TransferStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransferStatus struct {
+	TransferStatus TransferStatus
+	Valid          bool // Valid is true if TransferStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferStatus), nil
+}
+
+type FxQuote struct {
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+	UsedAt       *time.Time
+	FromCurrency string
+	ToCurrency   string
+	Rate         decimal.Decimal
+	Spread       decimal.Decimal
+	ID           uuid.UUID
+	UserID       uuid.UUID
+}
+
+type Hold struct {
+	ExpiresAt        time.Time
+	UpdatedAt        time.Time
+	CreatedAt        time.Time
+	CapturedAmount   decimal.Decimal
+	Amount           decimal.Decimal
+	Currency         string
+	Status           HoldStatus
+	ReceiverWalletID uuid.UUID
+	ID               uuid.UUID
+	ReceiverID       uuid.UUID
+	WalletID         uuid.UUID
+	UserID           uuid.UUID
+	CreatedBy        uuid.UUID
+	UpdatedBy        uuid.UUID
+}
+
+type IdempotencyKey struct {
+	ExpiresAt time.Time
+	Key       string
+	Value     []byte
+}
+
+type LedgerEntry struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+	DeletedBy   *uuid.UUID
+	Currency    string
+	JournalType LedgerJournalType
+	EntryType   LedgerEntryType
+	Amount      decimal.Decimal
+	WalletID    uuid.UUID
+	CreatedBy   uuid.UUID
+	UpdatedBy   uuid.UUID
+	ID          uuid.UUID
+	JournalID   uuid.UUID
+}
+
+type Transfer struct {
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
-	DeletedBy *uuid.UUID
-	Balance   decimal.Decimal
+	Status    TransferStatus
 	ID        uuid.UUID
-	UserID    uuid.UUID
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
+}
+
+type Wallet struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+	DeletedBy   *uuid.UUID
+	FrozenAt    *time.Time
+	Balance     decimal.Decimal
+	Currency    string
+	HeldBalance decimal.Decimal
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	CreatedBy   uuid.UUID
+	UpdatedBy   uuid.UUID
 }
@@ -15,8 +15,8 @@ import (
 
 const addWalletBalance = `-- name: AddWalletBalance :one
 
-UPDATE wallets SET balance = balance + $2 WHERE id = $1 --noqa
-RETURNING id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by
+UPDATE wallets SET balance = balance + $2 WHERE id = $1 AND deleted_at IS NULL --noqa
+RETURNING id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at
 `
 
 type AddWalletBalanceParams struct {
@@ -38,19 +38,179 @@ func (q *Queries) AddWalletBalance(ctx context.Context, arg AddWalletBalancePara
 		&i.CreatedBy,
 		&i.UpdatedBy,
 		&i.DeletedBy,
+		&i.Currency,
+		&i.HeldBalance,
+		&i.FrozenAt,
 	)
 	return &i, err
 }
 
+const addWalletHeldBalance = `-- name: AddWalletHeldBalance :one
+UPDATE wallets SET held_balance = held_balance + $2 WHERE id = $1 --noqa
+RETURNING id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at
+`
+
+type AddWalletHeldBalanceParams struct {
+	Amount decimal.Decimal
+	ID     uuid.UUID
+}
+
+func (q *Queries) AddWalletHeldBalance(ctx context.Context, arg AddWalletHeldBalanceParams) (*Wallet, error) {
+	row := q.db.QueryRow(ctx, addWalletHeldBalance, arg.ID, arg.Amount)
+	var i Wallet
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Balance,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.Currency,
+		&i.HeldBalance,
+		&i.FrozenAt,
+	)
+	return &i, err
+}
+
+const createFXQuote = `-- name: CreateFXQuote :exec
+INSERT INTO fx_quotes (id, user_id, from_currency, to_currency, rate, spread, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateFXQuoteParams struct {
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+	FromCurrency string
+	ToCurrency   string
+	Rate         decimal.Decimal
+	Spread       decimal.Decimal
+	ID           uuid.UUID
+	UserID       uuid.UUID
+}
+
+func (q *Queries) CreateFXQuote(ctx context.Context, arg CreateFXQuoteParams) error {
+	_, err := q.db.Exec(ctx, createFXQuote,
+		arg.ID,
+		arg.UserID,
+		arg.FromCurrency,
+		arg.ToCurrency,
+		arg.Rate,
+		arg.Spread,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createHold = `-- name: CreateHold :exec
+INSERT INTO holds (id, user_id, wallet_id, receiver_id, receiver_wallet_id, amount, currency, status, expires_at, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+`
+
+type CreateHoldParams struct {
+	ExpiresAt        time.Time
+	UpdatedAt        time.Time
+	CreatedAt        time.Time
+	Currency         string
+	Amount           decimal.Decimal
+	Status           HoldStatus
+	ReceiverWalletID uuid.UUID
+	ID               uuid.UUID
+	ReceiverID       uuid.UUID
+	WalletID         uuid.UUID
+	UserID           uuid.UUID
+	CreatedBy        uuid.UUID
+	UpdatedBy        uuid.UUID
+}
+
+func (q *Queries) CreateHold(ctx context.Context, arg CreateHoldParams) error {
+	_, err := q.db.Exec(ctx, createHold,
+		arg.ID,
+		arg.UserID,
+		arg.WalletID,
+		arg.ReceiverID,
+		arg.ReceiverWalletID,
+		arg.Amount,
+		arg.Currency,
+		arg.Status,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.CreatedBy,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const createLedgerEntry = `-- name: CreateLedgerEntry :exec
+INSERT INTO ledger_entries (id, journal_id, journal_type, wallet_id, entry_type, amount, currency, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateLedgerEntryParams struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	JournalType LedgerJournalType
+	EntryType   LedgerEntryType
+	Amount      decimal.Decimal
+	Currency    string
+	ID          uuid.UUID
+	JournalID   uuid.UUID
+	WalletID    uuid.UUID
+	CreatedBy   uuid.UUID
+	UpdatedBy   uuid.UUID
+}
+
+func (q *Queries) CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) error {
+	_, err := q.db.Exec(ctx, createLedgerEntry,
+		arg.ID,
+		arg.JournalID,
+		arg.JournalType,
+		arg.WalletID,
+		arg.EntryType,
+		arg.Amount,
+		arg.Currency,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.CreatedBy,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const createTransfer = `-- name: CreateTransfer :execrows
+INSERT INTO transfers (id, status, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING
+`
+
+type CreateTransferParams struct {
+	CreatedAt time.Time
+	Status    TransferStatus
+	ID        uuid.UUID
+}
+
+func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createTransfer, arg.ID, arg.Status, arg.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createWallet = `-- name: CreateWallet :exec
-INSERT INTO wallets (id, user_id, balance, created_at, updated_at, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO wallets (id, user_id, balance, currency, created_at, updated_at, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateWalletParams struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Balance   decimal.Decimal
+	Currency  string
 	ID        uuid.UUID
 	UserID    uuid.UUID
 	CreatedBy uuid.UUID
@@ -62,6 +222,7 @@ func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) erro
 		arg.ID,
 		arg.UserID,
 		arg.Balance,
+		arg.Currency,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.CreatedBy,
@@ -70,8 +231,163 @@ func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) erro
 	return err
 }
 
+const freezeUserWallets = `-- name: FreezeUserWallets :exec
+UPDATE wallets SET deleted_at = NOW(), deleted_by = user_id, frozen_at = NOW(), updated_at = NOW(), updated_by = user_id
+WHERE user_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) FreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, freezeUserWallets, userID)
+	return err
+}
+
+const getAllUserWallets = `-- name: GetAllUserWallets :many
+SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE user_id = $1 AND deleted_at IS NULL ORDER BY created_at ASC LIMIT $2
+`
+
+type GetAllUserWalletsParams struct {
+	UserID uuid.UUID
+	Limit  int32
+}
+
+func (q *Queries) GetAllUserWallets(ctx context.Context, arg GetAllUserWalletsParams) ([]*Wallet, error) {
+	rows, err := q.db.Query(ctx, getAllUserWallets, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Wallet
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Balance,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.Currency,
+			&i.HeldBalance,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllUserWalletsIncludingDeleted = `-- name: GetAllUserWalletsIncludingDeleted :many
+SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE user_id = $1 ORDER BY created_at ASC
+`
+
+func (q *Queries) GetAllUserWalletsIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*Wallet, error) {
+	rows, err := q.db.Query(ctx, getAllUserWalletsIncludingDeleted, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Wallet
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Balance,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.DeletedBy,
+			&i.Currency,
+			&i.HeldBalance,
+			&i.FrozenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHoldForUpdate = `-- name: GetHoldForUpdate :one
+SELECT id, user_id, wallet_id, receiver_id, receiver_wallet_id, amount, captured_amount, currency, status, expires_at, created_at, updated_at, created_by, updated_by FROM holds WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetHoldForUpdate(ctx context.Context, id uuid.UUID) (*Hold, error) {
+	row := q.db.QueryRow(ctx, getHoldForUpdate, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WalletID,
+		&i.ReceiverID,
+		&i.ReceiverWalletID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Currency,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+	)
+	return &i, err
+}
+
+const getTransferStatus = `-- name: GetTransferStatus :one
+SELECT status FROM transfers WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferStatus(ctx context.Context, id uuid.UUID) (TransferStatus, error) {
+	row := q.db.QueryRow(ctx, getTransferStatus, id)
+	var status TransferStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
+const getUserWallet = `-- name: GetUserWallet :one
+SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL LIMIT 1
+`
+
+type GetUserWalletParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) GetUserWallet(ctx context.Context, arg GetUserWalletParams) (*Wallet, error) {
+	row := q.db.QueryRow(ctx, getUserWallet, arg.ID, arg.UserID)
+	var i Wallet
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Balance,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.Currency,
+		&i.HeldBalance,
+		&i.FrozenAt,
+	)
+	return &i, err
+}
+
 const getUserWalletForUpdate = `-- name: GetUserWalletForUpdate :one
-SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM wallets WHERE id = $1 AND user_id = $2 LIMIT 1 FOR NO KEY UPDATE
+SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL LIMIT 1 FOR NO KEY UPDATE
 `
 
 type GetUserWalletForUpdateParams struct {
@@ -92,6 +408,128 @@ func (q *Queries) GetUserWalletForUpdate(ctx context.Context, arg GetUserWalletF
 		&i.CreatedBy,
 		&i.UpdatedBy,
 		&i.DeletedBy,
+		&i.Currency,
+		&i.HeldBalance,
+		&i.FrozenAt,
+	)
+	return &i, err
+}
+
+const getWalletByID = `-- name: GetWalletByID :one
+SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWalletByID(ctx context.Context, id uuid.UUID) (*Wallet, error) {
+	row := q.db.QueryRow(ctx, getWalletByID, id)
+	var i Wallet
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Balance,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.DeletedBy,
+		&i.Currency,
+		&i.HeldBalance,
+		&i.FrozenAt,
+	)
+	return &i, err
+}
+
+const getWalletLedgerBalances = `-- name: GetWalletLedgerBalances :many
+SELECT currency, SUM(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END)::NUMERIC(24, 4) AS balance
+FROM ledger_entries WHERE wallet_id = $1
+GROUP BY currency ORDER BY currency
+`
+
+type GetWalletLedgerBalancesRow struct {
+	Currency string
+	Balance  decimal.Decimal
+}
+
+func (q *Queries) GetWalletLedgerBalances(ctx context.Context, walletID uuid.UUID) ([]*GetWalletLedgerBalancesRow, error) {
+	rows, err := q.db.Query(ctx, getWalletLedgerBalances, walletID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetWalletLedgerBalancesRow
+	for rows.Next() {
+		var i GetWalletLedgerBalancesRow
+		if err := rows.Scan(&i.Currency, &i.Balance); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const settleHold = `-- name: SettleHold :exec
+
+UPDATE holds SET status = $2, captured_amount = $3, updated_at = $4, updated_by = $5
+WHERE id = $1
+`
+
+type SettleHoldParams struct {
+	UpdatedAt      time.Time
+	Status         HoldStatus
+	CapturedAmount decimal.Decimal
+	ID             uuid.UUID
+	UpdatedBy      uuid.UUID
+}
+
+// noqa
+func (q *Queries) SettleHold(ctx context.Context, arg SettleHoldParams) error {
+	_, err := q.db.Exec(ctx, settleHold,
+		arg.ID,
+		arg.Status,
+		arg.CapturedAmount,
+		arg.UpdatedAt,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const unfreezeUserWallets = `-- name: UnfreezeUserWallets :exec
+UPDATE wallets SET deleted_at = NULL, deleted_by = NULL, frozen_at = NULL, updated_at = NOW(), updated_by = user_id
+WHERE user_id = $1 AND frozen_at IS NOT NULL
+`
+
+func (q *Queries) UnfreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, unfreezeUserWallets, userID)
+	return err
+}
+
+const useFXQuote = `-- name: UseFXQuote :one
+UPDATE fx_quotes SET used_at = NOW()
+WHERE id = $1 AND user_id = $2 AND used_at IS NULL AND expires_at > NOW()
+RETURNING id, user_id, from_currency, to_currency, rate, spread, expires_at, used_at, created_at
+`
+
+type UseFXQuoteParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) UseFXQuote(ctx context.Context, arg UseFXQuoteParams) (*FxQuote, error) {
+	row := q.db.QueryRow(ctx, useFXQuote, arg.ID, arg.UserID)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.Spread,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
 	)
 	return &i, err
 }
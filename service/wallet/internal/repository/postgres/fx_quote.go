@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+)
+
+// FXQuote is responsible to connect fx quote entity with fx_quotes table in PostgreSQL.
+type FXQuote struct {
+	queries *db.Queries
+}
+
+// NewFXQuote creates an instance of FXQuote.
+func NewFXQuote(q *db.Queries) *FXQuote {
+	return &FXQuote{queries: q}
+}
+
+// Insert inserts a quote to the database.
+func (f *FXQuote) Insert(ctx context.Context, quote *entity.FXQuote) error {
+	if quote == nil {
+		return entity.ErrInvalidQuote()
+	}
+
+	param := db.CreateFXQuoteParams{
+		ID:           quote.ID,
+		UserID:       quote.UserID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate,
+		Spread:       quote.Spread,
+		ExpiresAt:    quote.ExpiresAt,
+		CreatedAt:    quote.CreatedAt,
+	}
+	err := f.queries.CreateFXQuote(ctx, param)
+
+	if sdkpostgres.IsUniqueViolationError(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresFXQuote-Insert] fail insert fx quote", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Use marks user's quote as used, so it can't be used again.
+// It should be called inside the transfer's transaction, so the quote is released if the transfer fails.
+// It returns invalid quote error if the quote doesn't belong to the user, has expired, or has been used.
+func (f *FXQuote) Use(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.FXQuote, error) {
+	param := db.UseFXQuoteParams{ID: id, UserID: userID}
+	quote, err := f.queries.UseFXQuote(ctx, param)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrInvalidQuote()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresFXQuote-Use] internal error", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return &entity.FXQuote{
+		ID:           quote.ID,
+		UserID:       quote.UserID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate,
+		Spread:       quote.Spread,
+		ExpiresAt:    quote.ExpiresAt,
+		UsedAt:       quote.UsedAt,
+		CreatedAt:    quote.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/postgres"
+)
+
+type FXQuoteSuite struct {
+	quote  *postgres.FXQuote
+	db     pgxmock.PgxPoolIface
+	getter *mock_uow.MockTxGetter
+}
+
+func TestNewFXQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of FXQuote", func(t *testing.T) {
+		st := createFXQuoteSuite(t, ctrl)
+		assert.NotNil(t, st.quote)
+	})
+}
+
+func TestFXQuote_Insert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO fx_quotes \(id, user_id, from_currency, to_currency, rate, spread, expires_at, created_at\)
+				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`
+
+	t.Run("nil quote is prohibited", func(t *testing.T) {
+		st := createFXQuoteSuite(t, ctrl)
+
+		err := st.quote.Insert(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+	})
+
+	t.Run("insert duplicate quote", func(t *testing.T) {
+		quote := createTestFXQuote()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(quote.ID, quote.UserID, quote.FromCurrency, quote.ToCurrency, quote.Rate, quote.Spread, quote.ExpiresAt, quote.CreatedAt).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err := st.quote.Insert(testCtx, quote)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("insert returns error", func(t *testing.T) {
+		quote := createTestFXQuote()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(quote.ID, quote.UserID, quote.FromCurrency, quote.ToCurrency, quote.Rate, quote.Spread, quote.ExpiresAt, quote.CreatedAt).
+			WillReturnError(assert.AnError)
+
+		err := st.quote.Insert(testCtx, quote)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success insert quote", func(t *testing.T) {
+		quote := createTestFXQuote()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(quote.ID, quote.UserID, quote.FromCurrency, quote.ToCurrency, quote.Rate, quote.Spread, quote.ExpiresAt, quote.CreatedAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.quote.Insert(testCtx, quote)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestFXQuote_Use(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE fx_quotes SET used_at = NOW\(\) WHERE id = \$1 AND user_id = \$2 AND used_at IS NULL AND expires_at > NOW\(\) RETURNING id, user_id, from_currency, to_currency, rate, spread, expires_at, used_at, created_at`
+
+	t.Run("quote can't be used", func(t *testing.T) {
+		quote := createTestFXQuote()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(quote.ID, quote.UserID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.quote.Use(testCtx, quote.ID, quote.UserID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("use returns error", func(t *testing.T) {
+		quote := createTestFXQuote()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(quote.ID, quote.UserID).WillReturnError(assert.AnError)
+
+		res, err := st.quote.Use(testCtx, quote.ID, quote.UserID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success use quote", func(t *testing.T) {
+		quote := createTestFXQuote()
+		usedAt := time.Now()
+		st := createFXQuoteSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(quote.ID, quote.UserID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "from_currency", "to_currency", "rate", "spread", "expires_at", "used_at", "created_at"}).
+			AddRow(quote.ID, quote.UserID, quote.FromCurrency, quote.ToCurrency, quote.Rate, quote.Spread, quote.ExpiresAt, &usedAt, quote.CreatedAt))
+
+		res, err := st.quote.Use(testCtx, quote.ID, quote.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, quote.ID, res.ID)
+		assert.Equal(t, quote.ToCurrency, res.ToCurrency)
+		assert.True(t, quote.Rate.Equal(res.Rate))
+		assert.NotNil(t, res.UsedAt)
+	})
+}
+
+func createFXQuoteSuite(t *testing.T, ctrl *gomock.Controller) *FXQuoteSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	tx := sdkpostgres.NewTxDB(pool, g)
+	q := db.New(tx)
+	f := postgres.NewFXQuote(q)
+	return &FXQuoteSuite{
+		quote:  f,
+		db:     pool,
+		getter: g,
+	}
+}
+
+func createTestFXQuote() *entity.FXQuote {
+	now := time.Now().UTC()
+	return &entity.FXQuote{
+		ID:           uuid.Must(uuid.NewV7()),
+		UserID:       uuid.Must(uuid.NewV7()),
+		FromCurrency: "USD",
+		ToCurrency:   "IDR",
+		Rate:         decimal.RequireFromString("16250.5"),
+		Spread:       decimal.RequireFromString("0.005"),
+		ExpiresAt:    now.Add(30 * time.Second),
+		CreatedAt:    now,
+	}
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+)
+
+// Hold is responsible to connect hold entity with holds table in PostgreSQL.
+type Hold struct {
+	queries *db.Queries
+}
+
+// NewHold creates an instance of Hold.
+func NewHold(q *db.Queries) *Hold {
+	return &Hold{queries: q}
+}
+
+// Insert inserts a hold to the database.
+func (h *Hold) Insert(ctx context.Context, hold *entity.Hold) error {
+	if hold == nil {
+		return entity.ErrEmptyHold()
+	}
+
+	param := db.CreateHoldParams{
+		ID:               hold.ID,
+		UserID:           hold.UserID,
+		WalletID:         hold.WalletID,
+		ReceiverID:       hold.ReceiverID,
+		ReceiverWalletID: hold.ReceiverWalletID,
+		Amount:           hold.Amount,
+		Currency:         hold.Currency,
+		Status:           db.HoldStatus(hold.Status),
+		ExpiresAt:        hold.ExpiresAt,
+		CreatedAt:        hold.CreatedAt,
+		UpdatedAt:        hold.UpdatedAt,
+		CreatedBy:        hold.CreatedBy,
+		UpdatedBy:        hold.UpdatedBy,
+	}
+	err := h.queries.CreateHold(ctx, param)
+
+	if sdkpostgres.IsUniqueViolationError(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresHold-Insert] fail insert hold", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// GetForUpdate gets a hold and locks it until the transaction ends.
+// It returns hold not found error if the hold doesn't exist.
+func (h *Hold) GetForUpdate(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	hold, err := h.queries.GetHoldForUpdate(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrHoldNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresHold-GetForUpdate] internal error", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	res := &entity.Hold{
+		ID:               hold.ID,
+		UserID:           hold.UserID,
+		WalletID:         hold.WalletID,
+		ReceiverID:       hold.ReceiverID,
+		ReceiverWalletID: hold.ReceiverWalletID,
+		Amount:           hold.Amount,
+		CapturedAmount:   hold.CapturedAmount,
+		Currency:         hold.Currency,
+		Status:           entity.HoldStatus(hold.Status),
+		ExpiresAt:        hold.ExpiresAt,
+	}
+	res.CreatedAt = hold.CreatedAt
+	res.UpdatedAt = hold.UpdatedAt
+	res.CreatedBy = hold.CreatedBy
+	res.UpdatedBy = hold.UpdatedBy
+	return res, nil
+}
+
+// Settle saves hold's status and captured amount.
+func (h *Hold) Settle(ctx context.Context, hold *entity.Hold) error {
+	if hold == nil {
+		return entity.ErrEmptyHold()
+	}
+
+	param := db.SettleHoldParams{
+		ID:             hold.ID,
+		Status:         db.HoldStatus(hold.Status),
+		CapturedAmount: hold.CapturedAmount,
+		UpdatedAt:      hold.UpdatedAt,
+		UpdatedBy:      hold.UpdatedBy,
+	}
+	if err := h.queries.SettleHold(ctx, param); err != nil {
+		slog.ErrorContext(ctx, "[PostgresHold-Settle] internal error", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
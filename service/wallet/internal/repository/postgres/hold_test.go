@@ -0,0 +1,220 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/postgres"
+)
+
+type HoldSuite struct {
+	hold   *postgres.Hold
+	db     pgxmock.PgxPoolIface
+	getter *mock_uow.MockTxGetter
+}
+
+func TestNewHold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of Hold", func(t *testing.T) {
+		st := createHoldSuite(t, ctrl)
+		assert.NotNil(t, st.hold)
+	})
+}
+
+func TestHold_Insert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO holds \(id, user_id, wallet_id, receiver_id, receiver_wallet_id, amount, currency, status, expires_at, created_at, updated_at, created_by, updated_by\)
+				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\)`
+
+	t.Run("nil hold is prohibited", func(t *testing.T) {
+		st := createHoldSuite(t, ctrl)
+
+		err := st.hold.Insert(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyHold(), err)
+	})
+
+	t.Run("insert duplicate hold", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(hold.ID, hold.UserID, hold.WalletID, hold.ReceiverID, hold.ReceiverWalletID, hold.Amount, hold.Currency, db.HoldStatusAUTHORIZED, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt, hold.CreatedBy, hold.UpdatedBy).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err := st.hold.Insert(testCtx, hold)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("insert returns error", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(hold.ID, hold.UserID, hold.WalletID, hold.ReceiverID, hold.ReceiverWalletID, hold.Amount, hold.Currency, db.HoldStatusAUTHORIZED, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt, hold.CreatedBy, hold.UpdatedBy).
+			WillReturnError(assert.AnError)
+
+		err := st.hold.Insert(testCtx, hold)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success insert hold", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(hold.ID, hold.UserID, hold.WalletID, hold.ReceiverID, hold.ReceiverWalletID, hold.Amount, hold.Currency, db.HoldStatusAUTHORIZED, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt, hold.CreatedBy, hold.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.hold.Insert(testCtx, hold)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestHold_GetForUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT id, user_id, wallet_id, receiver_id, receiver_wallet_id, amount, captured_amount, currency, status, expires_at, created_at, updated_at, created_by, updated_by FROM holds WHERE id = \$1 LIMIT 1 FOR NO KEY UPDATE`
+
+	t.Run("hold is not found", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(hold.ID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.hold.GetForUpdate(testCtx, hold.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrHoldNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get returns error", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(hold.ID).WillReturnError(assert.AnError)
+
+		res, err := st.hold.GetForUpdate(testCtx, hold.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get hold", func(t *testing.T) {
+		hold := createTestHold()
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(hold.ID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "wallet_id", "receiver_id", "receiver_wallet_id", "amount", "captured_amount", "currency", "status", "expires_at", "created_at", "updated_at", "created_by", "updated_by"}).
+			AddRow(hold.ID, hold.UserID, hold.WalletID, hold.ReceiverID, hold.ReceiverWalletID, hold.Amount, decimal.Zero, hold.Currency, db.HoldStatusAUTHORIZED, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt, hold.CreatedBy, hold.UpdatedBy))
+
+		res, err := st.hold.GetForUpdate(testCtx, hold.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, hold.ID, res.ID)
+		assert.Equal(t, hold.WalletID, res.WalletID)
+		assert.Equal(t, entity.HoldStatusAuthorized, res.Status)
+		assert.True(t, hold.Amount.Equal(res.Amount))
+	})
+}
+
+func TestHold_Settle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE holds SET status = \$2, captured_amount = \$3, updated_at = \$4, updated_by = \$5 WHERE id = \$1`
+
+	t.Run("nil hold is prohibited", func(t *testing.T) {
+		st := createHoldSuite(t, ctrl)
+
+		err := st.hold.Settle(testCtx, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyHold(), err)
+	})
+
+	t.Run("settle returns error", func(t *testing.T) {
+		hold := createTestHold()
+		hold.Status = entity.HoldStatusVoided
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(hold.ID, db.HoldStatusVOIDED, hold.CapturedAmount, hold.UpdatedAt, hold.UpdatedBy).
+			WillReturnError(assert.AnError)
+
+		err := st.hold.Settle(testCtx, hold)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success settle hold", func(t *testing.T) {
+		hold := createTestHold()
+		hold.Status = entity.HoldStatusCaptured
+		hold.CapturedAmount = hold.Amount
+		st := createHoldSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(hold.ID, db.HoldStatusCAPTURED, hold.CapturedAmount, hold.UpdatedAt, hold.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := st.hold.Settle(testCtx, hold)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createHoldSuite(t *testing.T, ctrl *gomock.Controller) *HoldSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	tx := sdkpostgres.NewTxDB(pool, g)
+	q := db.New(tx)
+	h := postgres.NewHold(q)
+	return &HoldSuite{
+		hold:   h,
+		db:     pool,
+		getter: g,
+	}
+}
+
+func createTestHold() *entity.Hold {
+	now := time.Now().UTC()
+	hold := &entity.Hold{
+		ID:               uuid.Must(uuid.NewV7()),
+		UserID:           uuid.Must(uuid.NewV7()),
+		WalletID:         uuid.Must(uuid.NewV7()),
+		ReceiverID:       uuid.Must(uuid.NewV7()),
+		ReceiverWalletID: uuid.Must(uuid.NewV7()),
+		Amount:           decimal.RequireFromString("10.23"),
+		Currency:         entity.DefaultCurrency,
+		Status:           entity.HoldStatusAuthorized,
+		ExpiresAt:        now.Add(time.Hour),
+	}
+	hold.CreatedAt = now
+	hold.UpdatedAt = now
+	hold.CreatedBy = hold.ReceiverID
+	hold.UpdatedBy = hold.ReceiverID
+	return hold
+}
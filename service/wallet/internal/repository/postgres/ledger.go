@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+)
+
+// Ledger is responsible to connect ledger journal with ledger_entries table in PostgreSQL.
+type Ledger struct {
+	queries *db.Queries
+}
+
+// NewLedger creates an instance of Ledger.
+func NewLedger(q *db.Queries) *Ledger {
+	return &Ledger{queries: q}
+}
+
+// Insert inserts a journal to the database as a pair of debit and credit entries.
+// It should be called inside a transaction so both entries are written atomically.
+func (l *Ledger) Insert(ctx context.Context, journal *entity.LedgerJournal) error {
+	if journal == nil {
+		return entity.ErrInternal("ledger journal is empty or nil")
+	}
+
+	if err := l.insertEntry(ctx, journal, journal.DebitWalletID, db.LedgerEntryTypeDEBIT); err != nil {
+		return err
+	}
+	return l.insertEntry(ctx, journal, journal.CreditWalletID, db.LedgerEntryTypeCREDIT)
+}
+
+// GetBalances rebuilds wallet's balance by summing all of its ledger entries per currency.
+// The balances are ordered by currency. A wallet without ledger entries has no balance.
+func (l *Ledger) GetBalances(ctx context.Context, walletID uuid.UUID) ([]*entity.LedgerBalance, error) {
+	rows, err := l.queries.GetWalletLedgerBalances(ctx, walletID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresLedger-GetBalances] fail get ledger balances", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+
+	res := make([]*entity.LedgerBalance, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &entity.LedgerBalance{Currency: row.Currency, Balance: row.Balance})
+	}
+	return res, nil
+}
+
+func (l *Ledger) insertEntry(ctx context.Context, journal *entity.LedgerJournal, walletID uuid.UUID, entryType db.LedgerEntryType) error {
+	param := db.CreateLedgerEntryParams{
+		ID:          uuid.Must(uuid.NewV7()),
+		JournalID:   journal.ID,
+		JournalType: db.LedgerJournalType(journal.Type),
+		WalletID:    walletID,
+		EntryType:   entryType,
+		Amount:      journal.Amount,
+		Currency:    journal.Currency,
+		CreatedAt:   journal.CreatedAt,
+		UpdatedAt:   journal.UpdatedAt,
+		CreatedBy:   journal.CreatedBy,
+		UpdatedBy:   journal.UpdatedBy,
+	}
+	err := l.queries.CreateLedgerEntry(ctx, param)
+
+	if sdkpostgres.IsUniqueViolationError(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresLedger-Insert] fail insert ledger entry", "error", err, "entry_type", entryType)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/postgres"
+)
+
+type LedgerSuite struct {
+	ledger *postgres.Ledger
+	db     pgxmock.PgxPoolIface
+	getter *mock_uow.MockTxGetter
+}
+
+func TestNewLedger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of Ledger", func(t *testing.T) {
+		st := createLedgerSuite(t, ctrl)
+		assert.NotNil(t, st.ledger)
+	})
+}
+
+func TestLedger_Insert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO ledger_entries \(id, journal_id, journal_type, wallet_id, entry_type, amount, currency, created_at, updated_at, created_by, updated_by\)
+				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`
+
+	t.Run("nil journal is prohibited", func(t *testing.T) {
+		st := createLedgerSuite(t, ctrl)
+
+		err := st.ledger.Insert(testCtx, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("insert debit entry returns error", func(t *testing.T) {
+		journal := createTestLedgerJournal()
+		st := createLedgerSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), journal.ID, db.LedgerJournalTypeTRANSFER, journal.DebitWalletID, db.LedgerEntryTypeDEBIT, journal.Amount, journal.Currency, journal.CreatedAt, journal.UpdatedAt, journal.CreatedBy, journal.UpdatedBy).
+			WillReturnError(assert.AnError)
+
+		err := st.ledger.Insert(testCtx, journal)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("insert duplicate credit entry", func(t *testing.T) {
+		journal := createTestLedgerJournal()
+		st := createLedgerSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db).Times(2)
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), journal.ID, db.LedgerJournalTypeTRANSFER, journal.DebitWalletID, db.LedgerEntryTypeDEBIT, journal.Amount, journal.Currency, journal.CreatedAt, journal.UpdatedAt, journal.CreatedBy, journal.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), journal.ID, db.LedgerJournalTypeTRANSFER, journal.CreditWalletID, db.LedgerEntryTypeCREDIT, journal.Amount, journal.Currency, journal.CreatedAt, journal.UpdatedAt, journal.CreatedBy, journal.UpdatedBy).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err := st.ledger.Insert(testCtx, journal)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("success insert journal", func(t *testing.T) {
+		journal := createTestLedgerJournal()
+		st := createLedgerSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db).Times(2)
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), journal.ID, db.LedgerJournalTypeTRANSFER, journal.DebitWalletID, db.LedgerEntryTypeDEBIT, journal.Amount, journal.Currency, journal.CreatedAt, journal.UpdatedAt, journal.CreatedBy, journal.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		st.db.ExpectExec(query).
+			WithArgs(pgxmock.AnyArg(), journal.ID, db.LedgerJournalTypeTRANSFER, journal.CreditWalletID, db.LedgerEntryTypeCREDIT, journal.Amount, journal.Currency, journal.CreatedAt, journal.UpdatedAt, journal.CreatedBy, journal.UpdatedBy).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.ledger.Insert(testCtx, journal)
+
+		assert.NoError(t, err)
+		assert.NoError(t, st.db.ExpectationsWereMet())
+	})
+}
+
+func TestLedger_GetBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT currency, SUM\(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END\)::NUMERIC\(24, 4\) AS balance
+FROM ledger_entries WHERE wallet_id = \$1
+GROUP BY currency ORDER BY currency`
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createLedgerSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		res, err := st.ledger.GetBalances(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get balances per currency", func(t *testing.T) {
+		st := createLedgerSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		idr, _ := decimal.NewFromString("10.23")
+		usd, _ := decimal.NewFromString("-1.5")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnRows(pgxmock.NewRows([]string{"currency", "balance"}).
+			AddRow("IDR", idr).
+			AddRow("USD", usd))
+
+		res, err := st.ledger.GetBalances(testCtx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entity.LedgerBalance{{Currency: "IDR", Balance: idr}, {Currency: "USD", Balance: usd}}, res)
+	})
+}
+
+func createTestLedgerJournal() *entity.LedgerJournal {
+	amount, _ := decimal.NewFromString("10.23")
+	userID := uuid.Must(uuid.NewV7())
+	return &entity.LedgerJournal{
+		ID:             uuid.Must(uuid.NewV7()),
+		Type:           entity.LedgerJournalTypeTransfer,
+		DebitWalletID:  uuid.Must(uuid.NewV7()),
+		CreditWalletID: uuid.Must(uuid.NewV7()),
+		Amount:         amount,
+		Currency:       entity.DefaultCurrency,
+		Auditable: entity.Auditable{
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+			CreatedBy: userID,
+			UpdatedBy: userID,
+		},
+	}
+}
+
+func createLedgerSuite(t *testing.T, ctrl *gomock.Controller) *LedgerSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	tx := sdkpostgres.NewTxDB(pool, g)
+	q := db.New(tx)
+	l := postgres.NewLedger(q)
+	return &LedgerSuite{
+		ledger: l,
+		db:     pool,
+		getter: g,
+	}
+}
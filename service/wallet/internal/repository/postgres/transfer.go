@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+)
+
+// Transfer is responsible to connect transfer's reference with transfers table in PostgreSQL.
+type Transfer struct {
+	queries *db.Queries
+}
+
+// NewTransfer creates an instance of Transfer.
+func NewTransfer(q *db.Queries) *Transfer {
+	return &Transfer{queries: q}
+}
+
+// Insert records the reference's status.
+// It returns already exists error if the reference has been recorded.
+// The insert waits for a concurrent transaction recording the same reference, so only one of them wins.
+func (t *Transfer) Insert(ctx context.Context, id uuid.UUID, status entity.TransferStatus) error {
+	param := db.CreateTransferParams{
+		ID:        id,
+		Status:    db.TransferStatus(status),
+		CreatedAt: time.Now().UTC(),
+	}
+	rows, err := t.queries.CreateTransfer(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransfer-Insert] fail insert transfer", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	if rows == 0 {
+		return entity.ErrAlreadyExists()
+	}
+	return nil
+}
+
+// GetStatus gets the reference's status.
+func (t *Transfer) GetStatus(ctx context.Context, id uuid.UUID) (entity.TransferStatus, error) {
+	status, err := t.queries.GetTransferStatus(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrInvalidTransfer()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[PostgresTransfer-GetStatus] fail get transfer status", "error", err)
+		return "", entity.ErrInternal(err.Error())
+	}
+	return entity.TransferStatus(status), nil
+}
@@ -0,0 +1,133 @@
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	sdkpostgres "github.com/indrasaputra/arjuna/pkg/sdk/database/postgres"
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/db"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/repository/postgres"
+)
+
+type TransferSuite struct {
+	transfer *postgres.Transfer
+	db       pgxmock.PgxPoolIface
+	getter   *mock_uow.MockTxGetter
+}
+
+func TestNewTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of Transfer", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		assert.NotNil(t, st.transfer)
+	})
+}
+
+func TestTransfer_Insert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `INSERT INTO transfers \(id, status, created_at\) VALUES \(\$1, \$2, \$3\) ON CONFLICT \(id\) DO NOTHING`
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("insert returns error", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(id, db.TransferStatusAPPLIED, pgxmock.AnyArg()).
+			WillReturnError(assert.AnError)
+
+		err := st.transfer.Insert(testCtx, id, entity.TransferStatusApplied)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("reference has been recorded", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(id, db.TransferStatusCANCELLED, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		err := st.transfer.Insert(testCtx, id, entity.TransferStatusCancelled)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("success insert transfer", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).
+			WithArgs(id, db.TransferStatusAPPLIED, pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := st.transfer.Insert(testCtx, id, entity.TransferStatusApplied)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransfer_GetStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `SELECT status FROM transfers WHERE id = \$1 LIMIT 1`
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("reference not found", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.transfer.GetStatus(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidTransfer(), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("get status returns error", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		res, err := st.transfer.GetStatus(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get status", func(t *testing.T) {
+		st := createTransferSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnRows(pgxmock.NewRows([]string{"status"}).AddRow(db.TransferStatusAPPLIED))
+
+		res, err := st.transfer.GetStatus(testCtx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.TransferStatusApplied, res)
+	})
+}
+
+func createTransferSuite(t *testing.T, ctrl *gomock.Controller) *TransferSuite {
+	pool, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("error opening a stub database connection: %v\n", err)
+	}
+	g := mock_uow.NewMockTxGetter(ctrl)
+	tx := sdkpostgres.NewTxDB(pool, g)
+	q := db.New(tx)
+	return &TransferSuite{
+		transfer: postgres.NewTransfer(q),
+		db:       pool,
+		getter:   g,
+	}
+}
@@ -33,6 +33,7 @@ func (w *Wallet) Insert(ctx context.Context, wallet *entity.Wallet) error {
 		ID:        wallet.ID,
 		UserID:    wallet.UserID,
 		Balance:   wallet.Balance,
+		Currency:  wallet.Currency,
 		CreatedAt: wallet.CreatedAt,
 		UpdatedAt: wallet.UpdatedAt,
 		CreatedBy: wallet.CreatedBy,
@@ -51,21 +52,44 @@ func (w *Wallet) Insert(ctx context.Context, wallet *entity.Wallet) error {
 }
 
 // AddWalletBalance adds some amount to specific user's wallet.
+// Frozen wallet is treated as empty wallet.
 func (w *Wallet) AddWalletBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error) {
 	param := db.AddWalletBalanceParams{ID: id, Amount: amount}
 	res, err := w.queries.AddWalletBalance(ctx, param)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrEmptyWallet()
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "[WalletPostgres-addWalletBalance] internal error", "error", err)
 		return nil, entity.ErrInternal(err.Error())
 	}
 	return &entity.Wallet{
-		ID:      res.ID,
-		UserID:  res.UserID,
-		Balance: res.Balance,
+		ID:          res.ID,
+		UserID:      res.UserID,
+		Balance:     res.Balance,
+		HeldBalance: res.HeldBalance,
+		Currency:    res.Currency,
 	}, nil
 }
 
+// AddWalletHeldBalance adds some amount to specific wallet's held balance.
+// Negative amount releases the held balance.
+// Frozen wallet's held balance can still be released, so holds can expire while the wallet is frozen.
+func (w *Wallet) AddWalletHeldBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error) {
+	param := db.AddWalletHeldBalanceParams{ID: id, Amount: amount}
+	res, err := w.queries.AddWalletHeldBalance(ctx, param)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrEmptyWallet()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-AddWalletHeldBalance] internal error", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createWalletFromModel(res), nil
+}
+
 // GetUserWalletForUpdate gets user's wallet for update.
+// Frozen wallet is treated as empty wallet.
 func (w *Wallet) GetUserWalletForUpdate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Wallet, error) {
 	param := db.GetUserWalletForUpdateParams{ID: id, UserID: userID}
 	wallet, err := w.queries.GetUserWalletForUpdate(ctx, param)
@@ -77,8 +101,116 @@ func (w *Wallet) GetUserWalletForUpdate(ctx context.Context, id uuid.UUID, userI
 		return nil, entity.ErrInternal(err.Error())
 	}
 	return &entity.Wallet{
-		ID:      wallet.ID,
-		UserID:  wallet.UserID,
-		Balance: wallet.Balance,
+		ID:          wallet.ID,
+		UserID:      wallet.UserID,
+		Balance:     wallet.Balance,
+		HeldBalance: wallet.HeldBalance,
+		Currency:    wallet.Currency,
 	}, nil
 }
+
+// GetByID gets a wallet by its id.
+// Frozen wallet is still returned, so it can be audited.
+func (w *Wallet) GetByID(ctx context.Context, id uuid.UUID) (*entity.Wallet, error) {
+	wallet, err := w.queries.GetWalletByID(ctx, id)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrEmptyWallet()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-GetByID] internal error", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return &entity.Wallet{
+		ID:          wallet.ID,
+		UserID:      wallet.UserID,
+		Balance:     wallet.Balance,
+		HeldBalance: wallet.HeldBalance,
+		Currency:    wallet.Currency,
+	}, nil
+}
+
+// GetUserWallet gets a wallet by its id which is owned by the user.
+// It returns not found error if the wallet doesn't belong to the user or is frozen.
+func (w *Wallet) GetUserWallet(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Wallet, error) {
+	param := db.GetUserWalletParams{ID: id, UserID: userID}
+	wallet, err := w.queries.GetUserWallet(ctx, param)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-GetUserWallet] internal error", "error", err)
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return createWalletFromModel(wallet), nil
+}
+
+// GetAllUserWallets gets all wallets owned by the user.
+// Frozen wallets are excluded.
+// If the user doesn't have any wallet, it returns empty list of wallet and nil error.
+func (w *Wallet) GetAllUserWallets(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error) {
+	param := db.GetAllUserWalletsParams{UserID: userID, Limit: int32(limit)}
+	wallets, err := w.queries.GetAllUserWallets(ctx, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-GetAllUserWallets] internal error", "error", err)
+		return []*entity.Wallet{}, entity.ErrInternal(err.Error())
+	}
+
+	result := make([]*entity.Wallet, len(wallets))
+	for i, wallet := range wallets {
+		result[i] = createWalletFromModel(wallet)
+	}
+	return result, nil
+}
+
+// GetAllUserWalletsIncludingDeleted gets all wallets owned by the user, including the frozen and soft-deleted ones.
+// It isn't limited since a user only has a few wallets.
+// If the user doesn't have any wallet, it returns empty list of wallet and nil error.
+func (w *Wallet) GetAllUserWalletsIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error) {
+	wallets, err := w.queries.GetAllUserWalletsIncludingDeleted(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-GetAllUserWalletsIncludingDeleted] internal error", "error", err)
+		return []*entity.Wallet{}, entity.ErrInternal(err.Error())
+	}
+
+	result := make([]*entity.Wallet, len(wallets))
+	for i, wallet := range wallets {
+		result[i] = createWalletFromModel(wallet)
+	}
+	return result, nil
+}
+
+// FreezeUserWallets freezes all wallets owned by the user.
+// Frozen wallets are marked, so unfreezing doesn't bring back wallets that have been deleted for other reasons.
+// It doesn't return error if the user doesn't have any wallet or the wallets have been frozen.
+func (w *Wallet) FreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	if err := w.queries.FreezeUserWallets(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-FreezeUserWallets] internal error", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// UnfreezeUserWallets unfreezes all wallets owned by the user that have been frozen.
+// It doesn't return error if the user doesn't have any wallet or the wallets aren't frozen.
+func (w *Wallet) UnfreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	if err := w.queries.UnfreezeUserWallets(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletPostgres-UnfreezeUserWallets] internal error", "error", err)
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+func createWalletFromModel(wallet *db.Wallet) *entity.Wallet {
+	res := &entity.Wallet{
+		ID:          wallet.ID,
+		UserID:      wallet.UserID,
+		Balance:     wallet.Balance,
+		HeldBalance: wallet.HeldBalance,
+		Currency:    wallet.Currency,
+	}
+	res.CreatedAt = wallet.CreatedAt
+	res.UpdatedAt = wallet.UpdatedAt
+	res.CreatedBy = wallet.CreatedBy
+	res.UpdatedBy = wallet.UpdatedBy
+	return res
+}
@@ -43,8 +43,8 @@ func TestNewWallet(t *testing.T) {
 func TestWallet_Insert(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	query := `INSERT INTO wallets \(id, user_id, balance, created_at, updated_at, created_by, updated_by\)
-				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`
+	query := `INSERT INTO wallets \(id, user_id, balance, currency, created_at, updated_at, created_by, updated_by\)
+				VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`
 
 	t.Run("nil wallets is prohibited", func(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
@@ -60,7 +60,7 @@ func TestWallet_Insert(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
+			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.Currency, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
 			WillReturnError(&pgconn.PgError{Code: "23505"})
 
 		err := st.wallet.Insert(testCtx, wallet)
@@ -74,7 +74,7 @@ func TestWallet_Insert(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
+			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.Currency, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
 			WillReturnError(assert.AnError)
 
 		err := st.wallet.Insert(testCtx, wallet)
@@ -87,7 +87,7 @@ func TestWallet_Insert(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectExec(query).
-			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
+			WithArgs(wallet.ID, wallet.UserID, wallet.Balance, wallet.Currency, wallet.CreatedAt, wallet.UpdatedAt, wallet.CreatedBy, wallet.UpdatedBy).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
 		err := st.wallet.Insert(testCtx, wallet)
@@ -100,7 +100,7 @@ func TestWallet_AddWalletBalance(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	query := `UPDATE wallets SET balance = balance \+ \$2 WHERE id = \$1`
+	query := `UPDATE wallets SET balance = balance \+ \$2 WHERE id = \$1 AND deleted_at IS NULL`
 
 	t.Run("add account balance returns internal error", func(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
@@ -117,6 +117,21 @@ func TestWallet_AddWalletBalance(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("wallet is frozen or doesn't exist", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		amount, _ := decimal.NewFromString("4.56")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(id, amount).
+			WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.wallet.AddWalletBalance(testCtx, id, amount)
+
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
 	t.Run("add account balance returns success", func(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
 		id := uuid.Must(uuid.NewV7())
@@ -127,8 +142,8 @@ func TestWallet_AddWalletBalance(t *testing.T) {
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectQuery(query).
 			WithArgs(id, amount).
-			WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
-				AddRow(id, userID, newBalance, time.Now(), time.Now(), nil, uuid.Nil, uuid.Nil, nil))
+			WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+				AddRow(id, userID, newBalance, time.Now(), time.Now(), nil, uuid.Nil, uuid.Nil, nil, entity.DefaultCurrency, decimal.Zero, nil))
 
 		res, err := st.wallet.AddWalletBalance(testCtx, id, amount)
 
@@ -140,11 +155,69 @@ func TestWallet_AddWalletBalance(t *testing.T) {
 	})
 }
 
+func TestWallet_AddWalletHeldBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `UPDATE wallets SET held_balance = held_balance \+ \$2 WHERE id = \$1`
+
+	t.Run("add held balance returns internal error", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		amount, _ := decimal.NewFromString("4.56")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(id, amount).
+			WillReturnError(assert.AnError)
+
+		res, err := st.wallet.AddWalletHeldBalance(testCtx, id, amount)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("wallet doesn't exist", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		amount, _ := decimal.NewFromString("4.56")
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(id, amount).
+			WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.wallet.AddWalletHeldBalance(testCtx, id, amount)
+
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("add held balance returns success", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		userID := uuid.Must(uuid.NewV7())
+		amount, _ := decimal.NewFromString("4.56")
+		balance, _ := decimal.NewFromString("14.56")
+
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).
+			WithArgs(id, amount).
+			WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+				AddRow(id, userID, balance, time.Now(), time.Now(), nil, uuid.Nil, uuid.Nil, nil, entity.DefaultCurrency, amount, nil))
+
+		res, err := st.wallet.AddWalletHeldBalance(testCtx, id, amount)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, res.ID)
+		assert.Equal(t, balance, res.Balance)
+		assert.Equal(t, amount, res.HeldBalance)
+	})
+}
+
 func TestWallet_GetUserWalletForUpdate(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by FROM wallets WHERE id = \$1 AND user_id = \$2 LIMIT 1 FOR NO KEY UPDATE`
+	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = \$1 AND user_id = \$2 AND deleted_at IS NULL LIMIT 1 FOR NO KEY UPDATE`
 
 	t.Run("wallet not found", func(t *testing.T) {
 		st := createWalletSuite(t, ctrl)
@@ -167,8 +240,8 @@ func TestWallet_GetUserWalletForUpdate(t *testing.T) {
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectQuery(query).WithArgs(id, userID).WillReturnError(assert.AnError)
 		// st.db.ExpectQuery(query).WithArgs(id, userID).WillReturnRows(pgxmock.
-		// 	NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
-		// 	AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy))
+		// 	NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+		// 	AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil))
 
 		res, err := st.wallet.GetUserWalletForUpdate(testCtx, id, userID)
 
@@ -183,8 +256,8 @@ func TestWallet_GetUserWalletForUpdate(t *testing.T) {
 		userID := uuid.Must(uuid.NewV7())
 		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
 		st.db.ExpectQuery(query).WithArgs(id, userID).WillReturnRows(pgxmock.
-			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by"}).
-			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy))
+			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil))
 
 		res, err := st.wallet.GetUserWalletForUpdate(testCtx, id, userID)
 
@@ -193,15 +266,239 @@ func TestWallet_GetUserWalletForUpdate(t *testing.T) {
 	})
 }
 
+func TestWallet_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = \$1 LIMIT 1`
+
+	t.Run("wallet not found", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.wallet.GetByID(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(id).WillReturnError(assert.AnError)
+
+		res, err := st.wallet.GetByID(testCtx, id)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get wallet", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.ID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil))
+
+		res, err := st.wallet.GetByID(testCtx, wallet.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.ID, res.ID)
+		assert.Equal(t, wallet.Balance, res.Balance)
+	})
+}
+
+func TestWallet_GetUserWallet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE id = \$1 AND user_id = \$2 AND deleted_at IS NULL LIMIT 1`
+
+	t.Run("wallet not found", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.ID, wallet.UserID).WillReturnError(pgx.ErrNoRows)
+
+		res, err := st.wallet.GetUserWallet(testCtx, wallet.ID, wallet.UserID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select returns error", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.ID, wallet.UserID).WillReturnError(assert.AnError)
+
+		res, err := st.wallet.GetUserWallet(testCtx, wallet.ID, wallet.UserID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get user's wallet", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.ID, wallet.UserID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil))
+
+		res, err := st.wallet.GetUserWallet(testCtx, wallet.ID, wallet.UserID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet.ID, res.ID)
+		assert.Equal(t, wallet.UserID, res.UserID)
+		assert.Equal(t, wallet.Balance, res.Balance)
+	})
+}
+
+func TestWallet_GetAllUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE user_id = \$1 AND deleted_at IS NULL ORDER BY created_at ASC LIMIT \$2`
+	limit := uint(10)
+
+	t.Run("select returns error", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.UserID, int32(limit)).WillReturnError(assert.AnError)
+
+		res, err := st.wallet.GetAllUserWallets(testCtx, wallet.UserID, limit)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get all user's wallets", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.UserID, int32(limit)).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil).
+			AddRow(uuid.Must(uuid.NewV7()), wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil))
+
+		res, err := st.wallet.GetAllUserWallets(testCtx, wallet.UserID, limit)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, wallet.ID, res[0].ID)
+	})
+}
+
+func TestWallet_GetAllUserWalletsIncludingDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := `SELECT id, user_id, balance, created_at, updated_at, deleted_at, created_by, updated_by, deleted_by, currency, held_balance, frozen_at FROM wallets WHERE user_id = \$1 ORDER BY created_at ASC`
+
+	t.Run("select returns error", func(t *testing.T) {
+		wallet := createTestWallet()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.UserID).WillReturnError(assert.AnError)
+
+		res, err := st.wallet.GetAllUserWalletsIncludingDeleted(testCtx, wallet.UserID)
+
+		assert.Error(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("success get all user's wallets including the frozen ones", func(t *testing.T) {
+		wallet := createTestWallet()
+		frozenAt := time.Now().UTC()
+		st := createWalletSuite(t, ctrl)
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectQuery(query).WithArgs(wallet.UserID).WillReturnRows(pgxmock.
+			NewRows([]string{"id", "user_id", "balance", "created_at", "updated_at", "deleted_at", "created_by", "updated_by", "deleted_by", "currency", "held_balance", "frozen_at"}).
+			AddRow(wallet.ID, wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, wallet.DeletedAt, wallet.CreatedBy, wallet.UpdatedBy, wallet.DeletedBy, wallet.Currency, wallet.HeldBalance, nil).
+			AddRow(uuid.Must(uuid.NewV7()), wallet.UserID, wallet.Balance, wallet.CreatedAt, wallet.UpdatedAt, &frozenAt, wallet.CreatedBy, wallet.UpdatedBy, &wallet.UserID, wallet.Currency, wallet.HeldBalance, &frozenAt))
+
+		res, err := st.wallet.GetAllUserWalletsIncludingDeleted(testCtx, wallet.UserID)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, wallet.ID, res[0].ID)
+	})
+}
+
 func createTestWallet() *entity.Wallet {
 	b, _ := decimal.NewFromString("10.23")
 	return &entity.Wallet{
-		ID:      uuid.Must(uuid.NewV7()),
-		UserID:  uuid.Must(uuid.NewV7()),
-		Balance: b,
+		ID:       uuid.Must(uuid.NewV7()),
+		UserID:   uuid.Must(uuid.NewV7()),
+		Balance:  b,
+		Currency: entity.DefaultCurrency,
 	}
 }
 
+func TestWallet_FreezeUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE wallets SET deleted_at = NOW\(\), deleted_by = user_id, frozen_at = NOW\(\), updated_at = NOW\(\), updated_by = user_id WHERE user_id = \$1 AND deleted_at IS NULL`
+
+	t.Run("freeze returns error", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		userID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(userID).WillReturnError(assert.AnError)
+
+		err := st.wallet.FreezeUserWallets(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success freeze wallets", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		userID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(userID).WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+		err := st.wallet.FreezeUserWallets(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestWallet_UnfreezeUserWallets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	query := `UPDATE wallets SET deleted_at = NULL, deleted_by = NULL, frozen_at = NULL, updated_at = NOW\(\), updated_by = user_id WHERE user_id = \$1 AND frozen_at IS NOT NULL`
+
+	t.Run("unfreeze returns error", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		userID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(userID).WillReturnError(assert.AnError)
+
+		err := st.wallet.UnfreezeUserWallets(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success unfreeze wallets", func(t *testing.T) {
+		st := createWalletSuite(t, ctrl)
+		userID := uuid.Must(uuid.NewV7())
+		st.getter.EXPECT().DefaultTrOrDB(testCtx, st.db).Return(st.db)
+		st.db.ExpectExec(query).WithArgs(userID).WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+		err := st.wallet.UnfreezeUserWallets(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
 func createWalletSuite(t *testing.T, ctrl *gomock.Controller) *WalletSuite {
 	pool, err := pgxmock.NewPool()
 	if err != nil {
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// CreateFXQuote defines interface to create fx quote.
+type CreateFXQuote interface {
+	// Create locks the exchange rate between two currencies for the user.
+	Create(ctx context.Context, userID uuid.UUID, from, to string) (*entity.FXQuote, error)
+}
+
+// RateProvider defines the interface to get exchange rate.
+type RateProvider interface {
+	// GetRate gets how many units of to currency one unit of from currency gets.
+	// It must return rate not found error if the pair is not available.
+	GetRate(ctx context.Context, from, to string) (decimal.Decimal, error)
+}
+
+// CreateFXQuoteRepository defines the interface to insert fx quote to repository.
+type CreateFXQuoteRepository interface {
+	// Insert inserts a quote.
+	Insert(ctx context.Context, quote *entity.FXQuote) error
+}
+
+// FXQuoter is responsible for creating fx quote.
+type FXQuoter struct {
+	provider RateProvider
+	repo     CreateFXQuoteRepository
+	spread   decimal.Decimal
+	ttl      time.Duration
+}
+
+// NewFXQuoter creates an instance of FXQuoter.
+// Spread is the fraction of the amount charged as fee and ttl is how long the quote can be used.
+func NewFXQuoter(p RateProvider, r CreateFXQuoteRepository, spread decimal.Decimal, ttl time.Duration) *FXQuoter {
+	return &FXQuoter{provider: p, repo: r, spread: spread, ttl: ttl}
+}
+
+// Create locks the exchange rate between two currencies for the user.
+// The quote must be used before it expires and can only be used once.
+func (fq *FXQuoter) Create(ctx context.Context, userID uuid.UUID, from, to string) (*entity.FXQuote, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrInvalidUser()
+	}
+	from = entity.NormalizeCurrencyCode(from)
+	to = entity.NormalizeCurrencyCode(to)
+	if err := validateFXQuoteCurrencies(from, to); err != nil {
+		slog.ErrorContext(ctx, "[FXQuoter-Create] currencies are invalid", "error", err)
+		return nil, err
+	}
+
+	rate, err := fq.provider.GetRate(ctx, from, to)
+	if err != nil {
+		slog.ErrorContext(ctx, "[FXQuoter-Create] fail get rate", "error", err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	quote := &entity.FXQuote{
+		ID:           generateUniqueID(),
+		UserID:       userID,
+		FromCurrency: from,
+		ToCurrency:   to,
+		Rate:         rate,
+		Spread:       fq.spread,
+		ExpiresAt:    now.Add(fq.ttl),
+		CreatedAt:    now,
+	}
+	if err := fq.repo.Insert(ctx, quote); err != nil {
+		slog.ErrorContext(ctx, "[FXQuoter-Create] fail save to repository", "error", err)
+		return nil, err
+	}
+	return quote, nil
+}
+
+func validateFXQuoteCurrencies(from, to string) error {
+	if _, ok := entity.LookupCurrency(from); !ok {
+		return entity.ErrInvalidCurrency()
+	}
+	if _, ok := entity.LookupCurrency(to); !ok {
+		return entity.ErrInvalidCurrency()
+	}
+	if from == to {
+		return entity.ErrRateNotFound()
+	}
+	return nil
+}
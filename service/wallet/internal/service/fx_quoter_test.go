@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+var (
+	testSpread   = decimal.RequireFromString("0.005")
+	testQuoteTTL = 30 * time.Second
+)
+
+type FXQuoterSuite struct {
+	quoter   *service.FXQuoter
+	provider *mock_service.MockRateProvider
+	repo     *mock_service.MockCreateFXQuoteRepository
+}
+
+func TestNewFXQuoter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of FXQuoter", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+		assert.NotNil(t, st.quoter)
+	})
+}
+
+func TestFXQuoter_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is invalid", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+
+		res, err := st.quoter.Create(testCtx, uuid.Nil, "USD", "IDR")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("from currency is not supported", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+
+		res, err := st.quoter.Create(testCtx, testUserID, "XYZ", "IDR")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("to currency is not supported", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+
+		res, err := st.quoter.Create(testCtx, testUserID, "USD", "XYZ")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("currencies are the same", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+
+		res, err := st.quoter.Create(testCtx, testUserID, "usd", "USD")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrRateNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rate provider returns error", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+		st.provider.EXPECT().GetRate(testCtx, "USD", "IDR").Return(decimal.Zero, entity.ErrRateNotFound())
+
+		res, err := st.quoter.Create(testCtx, testUserID, "USD", "IDR")
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrRateNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repo insert returns error", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+		st.provider.EXPECT().GetRate(testCtx, "USD", "IDR").Return(decimal.NewFromInt(16000), nil)
+		st.repo.EXPECT().Insert(testCtx, gomock.Any()).Return(assert.AnError)
+
+		res, err := st.quoter.Create(testCtx, testUserID, "USD", "IDR")
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success create a quote", func(t *testing.T) {
+		st := createFXQuoterSuite(ctrl)
+		rate := decimal.NewFromInt(16000)
+		st.provider.EXPECT().GetRate(testCtx, "USD", "IDR").Return(rate, nil)
+		st.repo.EXPECT().Insert(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, quote *entity.FXQuote) error {
+				assert.Equal(t, testUserID, quote.UserID)
+				assert.Equal(t, testQuoteTTL, quote.ExpiresAt.Sub(quote.CreatedAt))
+				return nil
+			})
+
+		res, err := st.quoter.Create(testCtx, testUserID, " usd", "idr ")
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, "USD", res.FromCurrency)
+		assert.Equal(t, "IDR", res.ToCurrency)
+		assert.True(t, rate.Equal(res.Rate))
+		assert.True(t, testSpread.Equal(res.Spread))
+	})
+}
+
+func createFXQuoterSuite(ctrl *gomock.Controller) *FXQuoterSuite {
+	p := mock_service.NewMockRateProvider(ctrl)
+	r := mock_service.NewMockCreateFXQuoteRepository(ctrl)
+	q := service.NewFXQuoter(p, r, testSpread, testQuoteTTL)
+	return &FXQuoterSuite{
+		quoter:   q,
+		provider: p,
+		repo:     r,
+	}
+}
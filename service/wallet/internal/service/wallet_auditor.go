@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// AuditWallet defines interface to audit wallet.
+type AuditWallet interface {
+	// Audit rebuilds wallet's balance from its ledger and compares it with the stored balance.
+	Audit(ctx context.Context, walletID uuid.UUID) (*entity.WalletAudit, error)
+}
+
+// AuditWalletRepository defines the interface to get wallet from repository.
+type AuditWalletRepository interface {
+	// GetByID gets a wallet by its id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Wallet, error)
+}
+
+// AuditWalletLedgerRepository defines the interface to get ledger balances from repository.
+type AuditWalletLedgerRepository interface {
+	// GetBalances sums all ledger entries of a wallet per currency.
+	GetBalances(ctx context.Context, walletID uuid.UUID) ([]*entity.LedgerBalance, error)
+}
+
+// WalletAuditor is responsible for auditing wallet's balance against its ledger.
+type WalletAuditor struct {
+	walletRepo AuditWalletRepository
+	ledgerRepo AuditWalletLedgerRepository
+}
+
+// NewWalletAuditor creates an instance of WalletAuditor.
+func NewWalletAuditor(w AuditWalletRepository, l AuditWalletLedgerRepository) *WalletAuditor {
+	return &WalletAuditor{walletRepo: w, ledgerRepo: l}
+}
+
+// Audit rebuilds wallet's balance from its ledger entries per currency.
+// The result tells whether the stored balance matches the ledger in the wallet's currency.
+func (wa *WalletAuditor) Audit(ctx context.Context, walletID uuid.UUID) (*entity.WalletAudit, error) {
+	if walletID == uuid.Nil {
+		return nil, entity.ErrEmptyWallet()
+	}
+
+	wallet, err := wa.walletRepo.GetByID(ctx, walletID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletAuditor-Audit] fail get wallet", "error", err)
+		return nil, err
+	}
+	balances, err := wa.ledgerRepo.GetBalances(ctx, walletID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletAuditor-Audit] fail get ledger balances", "error", err)
+		return nil, err
+	}
+
+	audit := &entity.WalletAudit{
+		WalletID:       wallet.ID,
+		Balance:        wallet.Balance,
+		Currency:       wallet.Currency,
+		LedgerBalances: balances,
+	}
+	if !audit.IsBalanced() {
+		slog.WarnContext(ctx, "[WalletAuditor-Audit] wallet balance does not match ledger", "wallet_id", wallet.ID, "currency", wallet.Currency, "balance", wallet.Balance, "ledger_balance", audit.LedgerBalance())
+	}
+	return audit, nil
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+type WalletAuditorSuite struct {
+	auditor    *service.WalletAuditor
+	walletRepo *mock_service.MockAuditWalletRepository
+	ledgerRepo *mock_service.MockAuditWalletLedgerRepository
+}
+
+func TestNewWalletAuditor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of WalletAuditor", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+		assert.NotNil(t, st.auditor)
+	})
+}
+
+func TestWalletAuditor_Audit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("wallet id is empty", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+
+		res, err := st.auditor.Audit(testCtx, uuid.Nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get wallet returns error", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+		wallet := createTestWallet()
+		st.walletRepo.EXPECT().GetByID(testCtx, wallet.ID).Return(nil, assert.AnError)
+
+		res, err := st.auditor.Audit(testCtx, wallet.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get ledger balances returns error", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+		wallet := createTestWallet()
+		st.walletRepo.EXPECT().GetByID(testCtx, wallet.ID).Return(wallet, nil)
+		st.ledgerRepo.EXPECT().GetBalances(testCtx, wallet.ID).Return(nil, assert.AnError)
+
+		res, err := st.auditor.Audit(testCtx, wallet.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("balance does not match ledger", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+		wallet := createTestWallet()
+		st.walletRepo.EXPECT().GetByID(testCtx, wallet.ID).Return(wallet, nil)
+		st.ledgerRepo.EXPECT().GetBalances(testCtx, wallet.ID).Return([]*entity.LedgerBalance{}, nil)
+
+		res, err := st.auditor.Audit(testCtx, wallet.ID)
+
+		assert.NoError(t, err)
+		assert.False(t, res.IsBalanced())
+	})
+
+	t.Run("balance matches ledger", func(t *testing.T) {
+		st := createWalletAuditorSuite(ctrl)
+		wallet := createTestWallet()
+		st.walletRepo.EXPECT().GetByID(testCtx, wallet.ID).Return(wallet, nil)
+		balances := []*entity.LedgerBalance{{Currency: wallet.Currency, Balance: wallet.Balance}}
+		st.ledgerRepo.EXPECT().GetBalances(testCtx, wallet.ID).Return(balances, nil)
+
+		res, err := st.auditor.Audit(testCtx, wallet.ID)
+
+		assert.NoError(t, err)
+		assert.True(t, res.IsBalanced())
+		assert.Equal(t, wallet.ID, res.WalletID)
+		assert.Equal(t, wallet.Currency, res.Currency)
+		assert.Equal(t, balances, res.LedgerBalances)
+	})
+}
+
+func createWalletAuditorSuite(ctrl *gomock.Controller) *WalletAuditorSuite {
+	w := mock_service.NewMockAuditWalletRepository(ctrl)
+	l := mock_service.NewMockAuditWalletLedgerRepository(ctrl)
+	a := service.NewWalletAuditor(w, l)
+	return &WalletAuditorSuite{
+		auditor:    a,
+		walletRepo: w,
+		ledgerRepo: l,
+	}
+}
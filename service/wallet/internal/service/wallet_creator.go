@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/entity"
 )
 
@@ -22,19 +24,28 @@ type CreateWalletRepository interface {
 	Insert(ctx context.Context, wallet *entity.Wallet) error
 }
 
+// CreateWalletLedgerRepository defines the interface to record ledger journal in repository.
+type CreateWalletLedgerRepository interface {
+	// Insert inserts a journal as a pair of debit and credit entries.
+	Insert(ctx context.Context, journal *entity.LedgerJournal) error
+}
+
 // WalletCreator is responsible for creating a new wallet.
 type WalletCreator struct {
 	walletRepo CreateWalletRepository
+	ledgerRepo CreateWalletLedgerRepository
+	txManager  uow.TxManager
 }
 
 // NewWalletCreator creates an instance of WalletCreator.
-func NewWalletCreator(t CreateWalletRepository) *WalletCreator {
-	return &WalletCreator{walletRepo: t}
+func NewWalletCreator(t CreateWalletRepository, l CreateWalletLedgerRepository, m uow.TxManager) *WalletCreator {
+	return &WalletCreator{walletRepo: t, ledgerRepo: l, txManager: m}
 }
 
 // Create creates a new wallet.
 // It needs idempotency key.
 func (wc *WalletCreator) Create(ctx context.Context, wallet *entity.Wallet) error {
+	sanitizeWallet(wallet)
 	if err := validateWallet(wallet); err != nil {
 		slog.ErrorContext(ctx, "[WalletCreator-Create] wallet is invalid", "error", err)
 		return err
@@ -43,12 +54,29 @@ func (wc *WalletCreator) Create(ctx context.Context, wallet *entity.Wallet) erro
 	setWalletID(wallet)
 	setWalletAuditableProperties(wallet)
 
-	err := wc.walletRepo.Insert(ctx, wallet)
-	if err != nil {
-		slog.ErrorContext(ctx, "[WalletCreator-Create] fail save to repository", "error", err)
-		return err
+	return wc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := wc.walletRepo.Insert(ctx, wallet); err != nil {
+			slog.ErrorContext(ctx, "[WalletCreator-Create] fail save to repository", "error", err)
+			return err
+		}
+		if !wallet.Balance.IsPositive() {
+			return nil
+		}
+
+		journal := createLedgerJournal(entity.LedgerJournalTypeOpeningBalance, entity.ExternalWalletID, wallet.ID, wallet.Balance, wallet.Currency, wallet.UserID)
+		if err := wc.ledgerRepo.Insert(ctx, journal); err != nil {
+			slog.ErrorContext(ctx, "[WalletCreator-Create] fail insert opening balance journal", "error", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func sanitizeWallet(wallet *entity.Wallet) {
+	if wallet == nil {
+		return
 	}
-	return nil
+	wallet.Currency = entity.NormalizeCurrencyCode(wallet.Currency)
 }
 
 func validateWallet(wallet *entity.Wallet) error {
@@ -58,6 +86,22 @@ func validateWallet(wallet *entity.Wallet) error {
 	if wallet.UserID == uuid.Nil {
 		return entity.ErrInvalidUser()
 	}
+	if wallet.Balance.IsNegative() {
+		return entity.ErrInvalidBalance()
+	}
+	return validateAmountCurrency(wallet.Balance, wallet.Currency)
+}
+
+// validateAmountCurrency validates that the currency is supported and
+// the amount fits into the currency's minor unit.
+func validateAmountCurrency(amount decimal.Decimal, code string) error {
+	currency, ok := entity.LookupCurrency(code)
+	if !ok {
+		return entity.ErrInvalidCurrency()
+	}
+	if !currency.IsValidAmount(amount) {
+		return entity.ErrInvalidAmountPrecision()
+	}
 	return nil
 }
 
@@ -75,3 +119,22 @@ func setWalletAuditableProperties(wallet *entity.Wallet) {
 	wallet.CreatedBy = wallet.UserID
 	wallet.UpdatedBy = wallet.UserID
 }
+
+// createLedgerJournal creates a journal which moves amount in the currency from debit wallet to credit wallet.
+func createLedgerJournal(journalType entity.LedgerJournalType, debitWalletID, creditWalletID uuid.UUID, amount decimal.Decimal, currency string, actor uuid.UUID) *entity.LedgerJournal {
+	now := time.Now().UTC()
+	return &entity.LedgerJournal{
+		ID:             generateUniqueID(),
+		Type:           journalType,
+		DebitWalletID:  debitWalletID,
+		CreditWalletID: creditWalletID,
+		Amount:         amount,
+		Currency:       currency,
+		Auditable: entity.Auditable{
+			CreatedAt: now,
+			UpdatedAt: now,
+			CreatedBy: actor,
+			UpdatedBy: actor,
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/entity"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
 	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
@@ -23,6 +24,8 @@ var (
 type WalletCreatorSuite struct {
 	wallet     *service.WalletCreator
 	walletRepo *mock_service.MockCreateWalletRepository
+	ledgerRepo *mock_service.MockCreateWalletLedgerRepository
+	txManager  *mock_uow.MockTxManager
 }
 
 func TestNewWalletCreator(t *testing.T) {
@@ -58,20 +61,101 @@ func TestWalletCreator_Create(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("balance is negative", func(t *testing.T) {
+		st := createWalletCreatorSuite(ctrl)
+		wallet := createTestWallet()
+		wallet.Balance = testBalance.Neg()
+
+		err := st.wallet.Create(testCtx, wallet)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidBalance(), err)
+	})
+
+	t.Run("currency is not supported", func(t *testing.T) {
+		st := createWalletCreatorSuite(ctrl)
+		wallet := createTestWallet()
+		wallet.Currency = "XYZ"
+
+		err := st.wallet.Create(testCtx, wallet)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+	})
+
+	t.Run("balance exceeds currency's precision", func(t *testing.T) {
+		st := createWalletCreatorSuite(ctrl)
+		wallet := createTestWallet()
+		wallet.Currency = "JPY"
+		wallet.Balance = decimal.RequireFromString("10.5")
+
+		err := st.wallet.Create(testCtx, wallet)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmountPrecision(), err)
+	})
+
 	t.Run("wallet repo insert returns error", func(t *testing.T) {
 		st := createWalletCreatorSuite(ctrl)
 		wallet := createTestWallet()
-		st.walletRepo.EXPECT().Insert(testCtx, wallet).Return(assert.AnError)
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.walletRepo.EXPECT().Insert(testCtxTx, wallet).Return(assert.AnError)
+
+		err := st.wallet.Create(testCtx, wallet)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ledger repo insert returns error", func(t *testing.T) {
+		st := createWalletCreatorSuite(ctrl)
+		wallet := createTestWallet()
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.walletRepo.EXPECT().Insert(testCtxTx, wallet).Return(nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(assert.AnError)
 
 		err := st.wallet.Create(testCtx, wallet)
 
 		assert.Error(t, err)
 	})
 
-	t.Run("success create a wallet", func(t *testing.T) {
+	t.Run("success create a wallet without balance", func(t *testing.T) {
+		st := createWalletCreatorSuite(ctrl)
+		wallet := createTestWallet()
+		wallet.Balance = decimal.Zero
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.walletRepo.EXPECT().Insert(testCtxTx, wallet).Return(nil)
+
+		err := st.wallet.Create(testCtx, wallet)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("success create a wallet with opening balance", func(t *testing.T) {
 		st := createWalletCreatorSuite(ctrl)
 		wallet := createTestWallet()
-		st.walletRepo.EXPECT().Insert(testCtx, wallet).Return(nil)
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.walletRepo.EXPECT().Insert(testCtxTx, wallet).Return(nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+				assert.Equal(t, entity.LedgerJournalTypeOpeningBalance, journal.Type)
+				assert.Equal(t, entity.ExternalWalletID, journal.DebitWalletID)
+				assert.Equal(t, wallet.ID, journal.CreditWalletID)
+				assert.True(t, wallet.Balance.Equal(journal.Amount))
+				assert.Equal(t, wallet.Currency, journal.Currency)
+				return nil
+			})
 
 		err := st.wallet.Create(testCtx, wallet)
 
@@ -81,17 +165,22 @@ func TestWalletCreator_Create(t *testing.T) {
 
 func createWalletCreatorSuite(ctrl *gomock.Controller) *WalletCreatorSuite {
 	r := mock_service.NewMockCreateWalletRepository(ctrl)
-	w := service.NewWalletCreator(r)
+	l := mock_service.NewMockCreateWalletLedgerRepository(ctrl)
+	m := mock_uow.NewMockTxManager(ctrl)
+	w := service.NewWalletCreator(r, l, m)
 	return &WalletCreatorSuite{
 		wallet:     w,
 		walletRepo: r,
+		ledgerRepo: l,
+		txManager:  m,
 	}
 }
 
 func createTestWallet() *entity.Wallet {
 	return &entity.Wallet{
-		ID:      uuid.Must(uuid.NewV7()),
-		UserID:  testUserID,
-		Balance: testBalance,
+		ID:       uuid.Must(uuid.NewV7()),
+		UserID:   testUserID,
+		Balance:  testBalance,
+		Currency: entity.DefaultCurrency,
 	}
 }
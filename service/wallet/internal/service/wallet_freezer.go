@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// FreezeWallet defines interface to freeze and unfreeze user's wallets.
+type FreezeWallet interface {
+	// Freeze freezes all wallets owned by the user.
+	Freeze(ctx context.Context, userID uuid.UUID) error
+	// Unfreeze unfreezes all wallets owned by the user.
+	Unfreeze(ctx context.Context, userID uuid.UUID) error
+}
+
+// FreezeWalletRepository defines the interface to freeze and unfreeze wallets in repository.
+type FreezeWalletRepository interface {
+	// FreezeUserWallets freezes all wallets owned by the user.
+	// It must not return error if the user doesn't have any wallet or the wallets have been frozen.
+	FreezeUserWallets(ctx context.Context, userID uuid.UUID) error
+	// UnfreezeUserWallets unfreezes all wallets owned by the user.
+	// It must not return error if the user doesn't have any wallet or the wallets aren't frozen.
+	UnfreezeUserWallets(ctx context.Context, userID uuid.UUID) error
+}
+
+// WalletFreezer is responsible for freezing and unfreezing user's wallets.
+// Frozen wallet keeps its balance, but it can't be read, topped up, or used in transfer.
+type WalletFreezer struct {
+	repo FreezeWalletRepository
+}
+
+// NewWalletFreezer creates an instance of WalletFreezer.
+func NewWalletFreezer(r FreezeWalletRepository) *WalletFreezer {
+	return &WalletFreezer{repo: r}
+}
+
+// Freeze freezes all wallets owned by the user.
+// Freezing frozen wallets succeeds, so it is safe to retry.
+func (wf *WalletFreezer) Freeze(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrInvalidUser()
+	}
+	if err := wf.repo.FreezeUserWallets(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletFreezer-Freeze] fail freeze wallets", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Unfreeze unfreezes all wallets owned by the user.
+// Unfreezing wallets that aren't frozen succeeds, so it is safe to retry.
+func (wf *WalletFreezer) Unfreeze(ctx context.Context, userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return entity.ErrInvalidUser()
+	}
+	if err := wf.repo.UnfreezeUserWallets(ctx, userID); err != nil {
+		slog.ErrorContext(ctx, "[WalletFreezer-Unfreeze] fail unfreeze wallets", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+type WalletFreezerSuite struct {
+	freezer *service.WalletFreezer
+	repo    *mock_service.MockFreezeWalletRepository
+}
+
+func TestNewWalletFreezer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of WalletFreezer", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+		assert.NotNil(t, st.freezer)
+	})
+}
+
+func TestWalletFreezer_Freeze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := uuid.Must(uuid.NewV7())
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+
+		err := st.freezer.Freeze(testCtx, uuid.Nil)
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+		st.repo.EXPECT().FreezeUserWallets(testCtx, userID).Return(entity.ErrInternal(""))
+
+		err := st.freezer.Freeze(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success freeze wallets", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+		st.repo.EXPECT().FreezeUserWallets(testCtx, userID).Return(nil)
+
+		err := st.freezer.Freeze(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestWalletFreezer_Unfreeze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userID := uuid.Must(uuid.NewV7())
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+
+		err := st.freezer.Unfreeze(testCtx, uuid.Nil)
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+		st.repo.EXPECT().UnfreezeUserWallets(testCtx, userID).Return(entity.ErrInternal(""))
+
+		err := st.freezer.Unfreeze(testCtx, userID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success unfreeze wallets", func(t *testing.T) {
+		st := createWalletFreezerSuite(ctrl)
+		st.repo.EXPECT().UnfreezeUserWallets(testCtx, userID).Return(nil)
+
+		err := st.freezer.Unfreeze(testCtx, userID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func createWalletFreezerSuite(ctrl *gomock.Controller) *WalletFreezerSuite {
+	r := mock_service.NewMockFreezeWalletRepository(ctrl)
+	return &WalletFreezerSuite{
+		freezer: service.NewWalletFreezer(r),
+		repo:    r,
+	}
+}
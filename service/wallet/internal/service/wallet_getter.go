@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+const (
+	defaultLimit = 10
+	// DefaultListMyWalletsLimit is deliberately set to 10 because a user rarely has more wallets than that.
+	DefaultListMyWalletsLimit = uint(defaultLimit)
+)
+
+// GetWallet defines the interface to get wallet.
+type GetWallet interface {
+	// GetByID gets a wallet owned by the user.
+	GetByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*entity.Wallet, error)
+	// GetAllByUser gets all wallets owned by the user.
+	GetAllByUser(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error)
+	// GetAllByUserIncludingDeleted gets all wallets owned by the user, including the frozen and soft-deleted ones.
+	GetAllByUserIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error)
+}
+
+// GetWalletRepository defines the interface to get wallet from the repository.
+type GetWalletRepository interface {
+	// GetUserWallet gets a wallet owned by the user.
+	// It returns not found error if the wallet doesn't belong to the user.
+	GetUserWallet(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Wallet, error)
+	// GetAllUserWallets gets all wallets owned by the user.
+	// If the user doesn't have any wallet, it returns empty list of wallet and nil error.
+	GetAllUserWallets(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error)
+	// GetAllUserWalletsIncludingDeleted gets all wallets owned by the user, including the frozen and soft-deleted ones.
+	// If the user doesn't have any wallet, it returns empty list of wallet and nil error.
+	GetAllUserWalletsIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error)
+}
+
+// WalletGetter is responsible for getting wallet.
+type WalletGetter struct {
+	repo GetWalletRepository
+}
+
+// NewWalletGetter creates an instance of WalletGetter.
+func NewWalletGetter(repo GetWalletRepository) *WalletGetter {
+	return &WalletGetter{repo: repo}
+}
+
+// GetByID gets a wallet owned by the user.
+// Other user's wallet is treated as not found so its existence is not leaked.
+func (wg *WalletGetter) GetByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*entity.Wallet, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrInvalidUser()
+	}
+	if id == uuid.Nil {
+		return nil, entity.ErrNotFound()
+	}
+	return wg.repo.GetUserWallet(ctx, id, userID)
+}
+
+// GetAllByUser gets all wallets owned by the user.
+// If the user doesn't have any wallet, it returns empty list of wallet and nil error.
+func (wg *WalletGetter) GetAllByUser(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrInvalidUser()
+	}
+	if limit == 0 || limit > DefaultListMyWalletsLimit {
+		limit = DefaultListMyWalletsLimit
+	}
+	return wg.repo.GetAllUserWallets(ctx, userID, limit)
+}
+
+// GetAllByUserIncludingDeleted gets all wallets owned by the user, including the frozen and soft-deleted ones.
+// Unlike GetAllByUser, it isn't limited, so every wallet of the user is returned.
+func (wg *WalletGetter) GetAllByUserIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error) {
+	if userID == uuid.Nil {
+		return nil, entity.ErrInvalidUser()
+	}
+	return wg.repo.GetAllUserWalletsIncludingDeleted(ctx, userID)
+}
@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+type WalletGetterSuite struct {
+	getter *service.WalletGetter
+	repo   *mock_service.MockGetWalletRepository
+}
+
+func TestNewWalletGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of WalletGetter", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		assert.NotNil(t, st.getter)
+	})
+}
+
+func TestWalletGetter_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		wallet := createTestWallet()
+
+		res, err := st.getter.GetByID(testCtx, uuid.Nil, wallet.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("wallet id is empty", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+
+		res, err := st.getter.GetByID(testCtx, testUserID, uuid.Nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		wallet := createTestWallet()
+		st.repo.EXPECT().GetUserWallet(testCtx, wallet.ID, testUserID).Return(nil, entity.ErrNotFound())
+
+		res, err := st.getter.GetByID(testCtx, testUserID, wallet.ID)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully get wallet", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		wallet := createTestWallet()
+		st.repo.EXPECT().GetUserWallet(testCtx, wallet.ID, testUserID).Return(wallet, nil)
+
+		res, err := st.getter.GetByID(testCtx, testUserID, wallet.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet, res)
+	})
+}
+
+func TestWalletGetter_GetAllByUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+
+		res, err := st.getter.GetAllByUser(testCtx, uuid.Nil, service.DefaultListMyWalletsLimit)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		st.repo.EXPECT().GetAllUserWallets(testCtx, testUserID, service.DefaultListMyWalletsLimit).Return([]*entity.Wallet{}, entity.ErrInternal(""))
+
+		res, err := st.getter.GetAllByUser(testCtx, testUserID, service.DefaultListMyWalletsLimit)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all wallets", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		st.repo.EXPECT().GetAllUserWallets(testCtx, testUserID, service.DefaultListMyWalletsLimit).Return([]*entity.Wallet{createTestWallet()}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, testUserID, service.DefaultListMyWalletsLimit)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res)
+	})
+
+	t.Run("successfully get all wallets with limit = 0", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		st.repo.EXPECT().GetAllUserWallets(testCtx, testUserID, service.DefaultListMyWalletsLimit).Return([]*entity.Wallet{createTestWallet()}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, testUserID, 0)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res)
+	})
+
+	t.Run("successfully get all wallets with limit > 10", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		st.repo.EXPECT().GetAllUserWallets(testCtx, testUserID, service.DefaultListMyWalletsLimit).Return([]*entity.Wallet{createTestWallet()}, nil)
+
+		res, err := st.getter.GetAllByUser(testCtx, testUserID, 100)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res)
+	})
+}
+
+func TestWalletGetter_GetAllByUserIncludingDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("user id is empty", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+
+		res, err := st.getter.GetAllByUserIncludingDeleted(testCtx, uuid.Nil)
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		st.repo.EXPECT().GetAllUserWalletsIncludingDeleted(testCtx, testUserID).Return([]*entity.Wallet{}, entity.ErrInternal(""))
+
+		res, err := st.getter.GetAllByUserIncludingDeleted(testCtx, testUserID)
+
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all wallets without limit", func(t *testing.T) {
+		st := createWalletGetterSuite(ctrl)
+		wallets := make([]*entity.Wallet, service.DefaultListMyWalletsLimit+1)
+		for i := range wallets {
+			wallets[i] = createTestWallet()
+		}
+		st.repo.EXPECT().GetAllUserWalletsIncludingDeleted(testCtx, testUserID).Return(wallets, nil)
+
+		res, err := st.getter.GetAllByUserIncludingDeleted(testCtx, testUserID)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, len(wallets))
+	})
+}
+
+func createWalletGetterSuite(ctrl *gomock.Controller) *WalletGetterSuite {
+	r := mock_service.NewMockGetWalletRepository(ctrl)
+	g := service.NewWalletGetter(r)
+	return &WalletGetterSuite{
+		getter: g,
+		repo:   r,
+	}
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// HoldWallet defines interface to hold wallet's balance.
+type HoldWallet interface {
+	// Authorize reserves hold's amount from the wallet.
+	Authorize(ctx context.Context, hold *entity.Hold) (*entity.Hold, error)
+	// Capture transfers some amount of the hold to its receiver and releases the rest.
+	Capture(ctx context.Context, capture *entity.CaptureHold) (*entity.Hold, error)
+	// Void releases the hold.
+	Void(ctx context.Context, id uuid.UUID) (*entity.Hold, error)
+}
+
+// ExpireHold defines interface to expire hold.
+type ExpireHold interface {
+	// Expire releases the hold if it is still authorized.
+	Expire(ctx context.Context, id uuid.UUID) error
+}
+
+// WalletHolderRepository defines the interface to update wallet's balance and held balance in repository.
+type WalletHolderRepository interface {
+	// GetUserWalletForUpdate gets user's wallet for update.
+	GetUserWalletForUpdate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Wallet, error)
+	// AddWalletBalance adds some amount to specific wallet.
+	AddWalletBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error)
+	// AddWalletHeldBalance adds some amount to specific wallet's held balance.
+	AddWalletHeldBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error)
+}
+
+// WalletHolderHoldRepository defines the interface to save hold in repository.
+type WalletHolderHoldRepository interface {
+	// Insert inserts a hold.
+	Insert(ctx context.Context, hold *entity.Hold) error
+	// GetForUpdate gets a hold and locks it until the transaction ends.
+	// It must return hold not found error if the hold doesn't exist.
+	GetForUpdate(ctx context.Context, id uuid.UUID) (*entity.Hold, error)
+	// Settle saves hold's status and captured amount.
+	Settle(ctx context.Context, hold *entity.Hold) error
+}
+
+// WalletHolderLedgerRepository defines the interface to record ledger journal in repository.
+type WalletHolderLedgerRepository interface {
+	// Insert inserts a balanced journal.
+	Insert(ctx context.Context, journal *entity.LedgerJournal) error
+}
+
+// WalletHolderTransferRepository defines the interface to record capture's reference in repository.
+type WalletHolderTransferRepository interface {
+	// Insert records the reference's status.
+	// It must return already exists error if the reference has been recorded.
+	Insert(ctx context.Context, id uuid.UUID, status entity.TransferStatus) error
+	// GetStatus gets the reference's status.
+	GetStatus(ctx context.Context, id uuid.UUID) (entity.TransferStatus, error)
+}
+
+// HoldExpiryScheduler defines the interface to release hold when it expires.
+type HoldExpiryScheduler interface {
+	// ScheduleExpiry schedules the hold to be expired at its expiry time.
+	ScheduleExpiry(ctx context.Context, hold *entity.Hold) error
+}
+
+// WalletHolder is responsible for reserving wallet's balance until it is captured, voided, or expired.
+// Held balance stays in the wallet, so it doesn't touch the ledger until the hold is captured.
+type WalletHolder struct {
+	walletRepo   WalletHolderRepository
+	holdRepo     WalletHolderHoldRepository
+	ledgerRepo   WalletHolderLedgerRepository
+	transferRepo WalletHolderTransferRepository
+	scheduler    HoldExpiryScheduler
+	txManager    uow.TxManager
+	ttl          time.Duration
+}
+
+// NewWalletHolder creates an instance of WalletHolder.
+// Ttl is how long a hold reserves its amount if it is neither captured nor voided.
+func NewWalletHolder(w WalletHolderRepository, h WalletHolderHoldRepository, l WalletHolderLedgerRepository, t WalletHolderTransferRepository, s HoldExpiryScheduler, m uow.TxManager, ttl time.Duration) *WalletHolder {
+	return &WalletHolder{walletRepo: w, holdRepo: h, ledgerRepo: l, transferRepo: t, scheduler: s, txManager: m, ttl: ttl}
+}
+
+// Authorize reserves hold's amount from the wallet for the receiver.
+// Wallet's available balance must be sufficient and both wallets must have the same currency as the hold.
+// The hold's expiry is scheduled in the same transaction, so an authorized hold is always released eventually.
+func (wh *WalletHolder) Authorize(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	if hold == nil {
+		return nil, entity.ErrEmptyHold()
+	}
+	hold.Currency = entity.NormalizeCurrencyCode(hold.Currency)
+	if err := validateHold(hold); err != nil {
+		return nil, err
+	}
+	setHoldAuthorizedProperties(hold, wh.ttl)
+
+	err := wh.txManager.Do(ctx, func(ctx context.Context) error {
+		wallet, recWallet, err := wh.getHoldWallets(ctx, hold)
+		if err != nil {
+			return err
+		}
+
+		if wallet == nil || recWallet == nil {
+			return entity.ErrInvalidUser()
+		}
+		if wallet.Currency != hold.Currency || recWallet.Currency != hold.Currency {
+			return entity.ErrCurrencyMismatch()
+		}
+		if wallet.AvailableBalance().LessThan(hold.Amount) {
+			return entity.ErrInsufficientBalance()
+		}
+
+		if _, err := wh.walletRepo.AddWalletHeldBalance(ctx, hold.WalletID, hold.Amount); err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-Authorize] add held balance fail", "error", err)
+			return err
+		}
+		if err := wh.holdRepo.Insert(ctx, hold); err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-Authorize] insert hold fail", "error", err)
+			return err
+		}
+		if err := wh.scheduler.ScheduleExpiry(ctx, hold); err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-Authorize] schedule expiry fail", "error", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// Capture transfers amount of the hold to its receiver and releases the rest.
+// Amount must not be greater than the held amount and a hold can only be captured once.
+// The capture's parties must be the hold's, otherwise the hold is treated as not found.
+// The reference is recorded in the same transaction as the transfer's, so it can be resolved the same way.
+func (wh *WalletHolder) Capture(ctx context.Context, capture *entity.CaptureHold) (*entity.Hold, error) {
+	if capture == nil {
+		return nil, entity.ErrEmptyHold()
+	}
+	if capture.ReferenceID == uuid.Nil {
+		return nil, entity.ErrInvalidTransfer()
+	}
+	if !capture.Amount.IsPositive() {
+		return nil, entity.ErrInvalidAmount()
+	}
+
+	var hold *entity.Hold
+	err := wh.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := applyReference(ctx, wh.transferRepo, capture.ReferenceID); err != nil {
+			return err
+		}
+
+		var err error
+		hold, err = wh.getActiveHold(ctx, capture.HoldID)
+		if err != nil {
+			return err
+		}
+		if !hold.IsCapturedBy(capture) {
+			return entity.ErrHoldNotFound()
+		}
+		if capture.Amount.GreaterThan(hold.Amount) {
+			return entity.ErrInvalidAmount()
+		}
+		if err := validateAmountCurrency(capture.Amount, hold.Currency); err != nil {
+			return err
+		}
+
+		if _, _, err := wh.getHoldWallets(ctx, hold); err != nil {
+			return err
+		}
+		if err := wh.captureBalance(ctx, hold, capture.Amount); err != nil {
+			return err
+		}
+		return wh.settle(ctx, hold, entity.HoldStatusCaptured, capture.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// Void releases the hold's amount back to the wallet's available balance.
+func (wh *WalletHolder) Void(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	var hold *entity.Hold
+	err := wh.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		hold, err = wh.getActiveHold(ctx, id)
+		if err != nil {
+			return err
+		}
+		return wh.release(ctx, hold, entity.HoldStatusVoided)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// Expire releases the hold if it is still authorized.
+// It doesn't return error if the hold doesn't exist or has been captured or voided,
+// since the expiry is scheduled before the authorization is committed.
+func (wh *WalletHolder) Expire(ctx context.Context, id uuid.UUID) error {
+	return wh.txManager.Do(ctx, func(ctx context.Context) error {
+		hold, err := wh.holdRepo.GetForUpdate(ctx, id)
+		if errors.Is(err, entity.ErrHoldNotFound()) {
+			return nil
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-Expire] get hold fail", "error", err)
+			return err
+		}
+		if hold.Status != entity.HoldStatusAuthorized {
+			return nil
+		}
+		return wh.release(ctx, hold, entity.HoldStatusExpired)
+	})
+}
+
+func (wh *WalletHolder) getActiveHold(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	hold, err := wh.holdRepo.GetForUpdate(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-getActiveHold] get hold fail", "error", err)
+		return nil, err
+	}
+	if !hold.IsActive(time.Now().UTC()) {
+		return nil, entity.ErrHoldClosed()
+	}
+	return hold, nil
+}
+
+// getHoldWallets locks both wallets in the same order as transfer does, so they can't deadlock.
+func (wh *WalletHolder) getHoldWallets(ctx context.Context, hold *entity.Hold) (*entity.Wallet, *entity.Wallet, error) {
+	if hold.WalletID.String() < hold.ReceiverWalletID.String() {
+		wallet, err := wh.walletRepo.GetUserWalletForUpdate(ctx, hold.WalletID, hold.UserID)
+		if err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-getHoldWallets] wallet < receiver; get wallet fail", "error", err)
+			return nil, nil, err
+		}
+		recWallet, err := wh.walletRepo.GetUserWalletForUpdate(ctx, hold.ReceiverWalletID, hold.ReceiverID)
+		if err != nil {
+			slog.ErrorContext(ctx, "[WalletHolder-getHoldWallets] wallet < receiver; get receiver wallet fail", "error", err)
+			return nil, nil, err
+		}
+		return wallet, recWallet, nil
+	}
+
+	recWallet, err := wh.walletRepo.GetUserWalletForUpdate(ctx, hold.ReceiverWalletID, hold.ReceiverID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-getHoldWallets] wallet >= receiver; get receiver wallet fail", "error", err)
+		return nil, nil, err
+	}
+	wallet, err := wh.walletRepo.GetUserWalletForUpdate(ctx, hold.WalletID, hold.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-getHoldWallets] wallet >= receiver; get wallet fail", "error", err)
+		return nil, nil, err
+	}
+	return wallet, recWallet, nil
+}
+
+// captureBalance releases the whole held amount and moves the captured amount to the receiver.
+func (wh *WalletHolder) captureBalance(ctx context.Context, hold *entity.Hold, amount decimal.Decimal) error {
+	if _, err := wh.walletRepo.AddWalletHeldBalance(ctx, hold.WalletID, hold.Amount.Neg()); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-captureBalance] release held balance fail", "error", err)
+		return err
+	}
+	if _, err := wh.walletRepo.AddWalletBalance(ctx, hold.WalletID, amount.Neg()); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-captureBalance] subtract wallet balance fail", "error", err)
+		return err
+	}
+	if _, err := wh.walletRepo.AddWalletBalance(ctx, hold.ReceiverWalletID, amount); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-captureBalance] add receiver balance fail", "error", err)
+		return err
+	}
+
+	journal := createLedgerJournal(entity.LedgerJournalTypeHoldCapture, hold.WalletID, hold.ReceiverWalletID, amount, hold.Currency, hold.ReceiverID)
+	if err := wh.ledgerRepo.Insert(ctx, journal); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-captureBalance] insert ledger journal fail", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (wh *WalletHolder) release(ctx context.Context, hold *entity.Hold, status entity.HoldStatus) error {
+	if _, err := wh.walletRepo.AddWalletHeldBalance(ctx, hold.WalletID, hold.Amount.Neg()); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-release] release held balance fail", "error", err)
+		return err
+	}
+	return wh.settle(ctx, hold, status, decimal.Zero)
+}
+
+func (wh *WalletHolder) settle(ctx context.Context, hold *entity.Hold, status entity.HoldStatus, captured decimal.Decimal) error {
+	hold.Status = status
+	hold.CapturedAmount = captured
+	hold.UpdatedAt = time.Now().UTC()
+	hold.UpdatedBy = hold.ReceiverID
+	if err := wh.holdRepo.Settle(ctx, hold); err != nil {
+		slog.ErrorContext(ctx, "[WalletHolder-settle] settle hold fail", "error", err)
+		return err
+	}
+	return nil
+}
+
+func validateHold(hold *entity.Hold) error {
+	if hold.UserID == uuid.Nil || hold.ReceiverID == uuid.Nil {
+		return entity.ErrInvalidUser()
+	}
+	if hold.WalletID == hold.ReceiverWalletID {
+		return entity.ErrSameAccount()
+	}
+	if !hold.Amount.IsPositive() {
+		return entity.ErrInvalidAmount()
+	}
+	return validateAmountCurrency(hold.Amount, hold.Currency)
+}
+
+// setHoldAuthorizedProperties sets the properties of a new hold.
+// The receiver is the actor since it is the one asking for the hold.
+func setHoldAuthorizedProperties(hold *entity.Hold, ttl time.Duration) {
+	now := time.Now().UTC()
+	hold.ID = generateUniqueID()
+	hold.Status = entity.HoldStatusAuthorized
+	hold.CapturedAmount = decimal.Zero
+	hold.ExpiresAt = now.Add(ttl)
+	hold.CreatedAt = now
+	hold.UpdatedAt = now
+	hold.CreatedBy = hold.ReceiverID
+	hold.UpdatedBy = hold.ReceiverID
+}
@@ -0,0 +1,626 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
+	"github.com/indrasaputra/arjuna/service/wallet/entity"
+	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
+	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
+)
+
+const testHoldTTL = 24 * time.Hour
+
+type WalletHolderSuite struct {
+	holder       *service.WalletHolder
+	walletRepo   *mock_service.MockWalletHolderRepository
+	holdRepo     *mock_service.MockWalletHolderHoldRepository
+	ledgerRepo   *mock_service.MockWalletHolderLedgerRepository
+	transferRepo *mock_service.MockWalletHolderTransferRepository
+	scheduler    *mock_service.MockHoldExpiryScheduler
+	txManager    *mock_uow.MockTxManager
+}
+
+func TestNewWalletHolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of WalletHolder", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		assert.NotNil(t, st.holder)
+	})
+}
+
+func TestWalletHolder_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("hold is nil", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+
+		res, err := st.holder.Authorize(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyHold(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("receiver is empty", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		hold.ReceiverID = uuid.Nil
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrInvalidUser(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("wallet and receiver's wallet are same", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		hold.ReceiverWalletID = hold.WalletID
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrSameAccount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount is not positive", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		hold.Amount = decimal.Zero
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount exceeds currency's precision", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		hold.Amount = decimal.RequireFromString("1.234")
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrInvalidAmountPrecision(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get wallet returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		st.walletRepo.EXPECT().GetUserWalletForUpdate(testCtxTx, hold.WalletID, hold.UserID).Return(nil, entity.ErrEmptyWallet())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("wallets have different currency from the hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		hold.Currency = "USD"
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrCurrencyMismatch(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("available balance is insufficient", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		wallet := createTestWallet()
+		wallet.HeldBalance = wallet.Balance.Sub(hold.Amount).Add(decimal.RequireFromString("0.01"))
+		expectGetHoldWallets(st, hold, wallet, createTestWallet())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Equal(t, entity.ErrInsufficientBalance(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("add held balance returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount).Return(nil, assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("insert hold returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount).Return(nil, nil)
+		st.holdRepo.EXPECT().Insert(testCtxTx, hold).Return(assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("schedule expiry returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount).Return(nil, nil)
+		st.holdRepo.EXPECT().Insert(testCtxTx, hold).Return(nil)
+		st.scheduler.EXPECT().ScheduleExpiry(testCtxTx, hold).Return(assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success authorize hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestHoldRequest()
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount).Return(nil, nil)
+		st.holdRepo.EXPECT().Insert(testCtxTx, hold).Return(nil)
+		st.scheduler.EXPECT().ScheduleExpiry(testCtxTx, hold).Return(nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Authorize(testCtx, hold)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, entity.HoldStatusAuthorized, res.Status)
+		assert.Equal(t, testHoldTTL, res.ExpiresAt.Sub(res.CreatedAt))
+		assert.Equal(t, hold.ReceiverID, res.CreatedBy)
+	})
+}
+
+func TestWalletHolder_Capture(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("capture is nil", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+
+		res, err := st.holder.Capture(testCtx, nil)
+
+		assert.Equal(t, entity.ErrEmptyHold(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("reference is empty", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		capture := createTestCaptureHold(createTestAuthorizedHold(), decimal.NewFromInt(1))
+		capture.ReferenceID = uuid.Nil
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrInvalidTransfer(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount is not positive", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+
+		capture := createTestCaptureHold(createTestAuthorizedHold(), decimal.Zero)
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("reference has been applied", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		capture := createTestCaptureHold(createTestAuthorizedHold(), decimal.NewFromInt(1))
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, capture.ReferenceID).Return(entity.TransferStatusApplied, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrTransferApplied(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("get hold returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		capture := createTestCaptureHold(hold, hold.Amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(nil, entity.ErrHoldNotFound())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrHoldNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("hold is closed", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		hold.Status = entity.HoldStatusVoided
+		capture := createTestCaptureHold(hold, hold.Amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrHoldClosed(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("hold has expired but not released yet", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		hold.ExpiresAt = time.Now().Add(-time.Second)
+		capture := createTestCaptureHold(hold, hold.Amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrHoldClosed(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("capture doesn't match the hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		capture := createTestCaptureHold(hold, hold.Amount)
+		capture.ReceiverWalletID = uuid.Must(uuid.NewV7())
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrHoldNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("amount is greater than held amount", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		capture := createTestCaptureHold(hold, hold.Amount.Add(decimal.NewFromInt(1)))
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("release held balance returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		capture := createTestCaptureHold(hold, hold.Amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("insert ledger journal returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		amount := decimal.RequireFromString("2.5")
+		capture := createTestCaptureHold(hold, amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, nil)
+		st.walletRepo.EXPECT().AddWalletBalance(testCtxTx, hold.WalletID, amount.Neg()).Return(nil, nil)
+		st.walletRepo.EXPECT().AddWalletBalance(testCtxTx, hold.ReceiverWalletID, amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success capture part of the hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		amount := decimal.RequireFromString("2.5")
+		capture := createTestCaptureHold(hold, amount)
+		st.transferRepo.EXPECT().Insert(testCtxTx, capture.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		expectGetHoldWallets(st, hold, createTestWallet(), createTestWallet())
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, nil)
+		st.walletRepo.EXPECT().AddWalletBalance(testCtxTx, hold.WalletID, amount.Neg()).Return(nil, nil)
+		st.walletRepo.EXPECT().AddWalletBalance(testCtxTx, hold.ReceiverWalletID, amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+				assert.Equal(t, entity.LedgerJournalTypeHoldCapture, journal.Type)
+				assert.Equal(t, hold.WalletID, journal.DebitWalletID)
+				assert.Equal(t, hold.ReceiverWalletID, journal.CreditWalletID)
+				assert.True(t, amount.Equal(journal.Amount))
+				assert.Equal(t, hold.Currency, journal.Currency)
+				return nil
+			})
+		st.holdRepo.EXPECT().Settle(testCtxTx, hold).Return(nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Capture(testCtx, capture)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.HoldStatusCaptured, res.Status)
+		assert.True(t, amount.Equal(res.CapturedAmount))
+	})
+}
+
+func TestWalletHolder_Void(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("hold is closed", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		hold.Status = entity.HoldStatusCaptured
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Void(testCtx, hold.ID)
+
+		assert.Equal(t, entity.ErrHoldClosed(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("settle hold returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, nil)
+		st.holdRepo.EXPECT().Settle(testCtxTx, hold).Return(assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Void(testCtx, hold.ID)
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success void hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, nil)
+		st.holdRepo.EXPECT().Settle(testCtxTx, hold).Return(nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.holder.Void(testCtx, hold.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entity.HoldStatusVoided, res.Status)
+		assert.True(t, res.CapturedAmount.IsZero())
+	})
+}
+
+func TestWalletHolder_Expire(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("hold is not found", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, id).Return(nil, entity.ErrHoldNotFound())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		err := st.holder.Expire(testCtx, id)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get hold returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		id := uuid.Must(uuid.NewV7())
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, id).Return(nil, entity.ErrInternal("error"))
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		err := st.holder.Expire(testCtx, id)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("hold has been captured", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		hold.Status = entity.HoldStatusCaptured
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		err := st.holder.Expire(testCtx, hold.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("release held balance returns error", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		err := st.holder.Expire(testCtx, hold.ID)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("success expire hold", func(t *testing.T) {
+		st := createWalletHolderSuite(ctrl)
+		hold := createTestAuthorizedHold()
+		hold.ExpiresAt = time.Now().Add(-time.Second)
+		st.holdRepo.EXPECT().GetForUpdate(testCtxTx, hold.ID).Return(hold, nil)
+		st.walletRepo.EXPECT().AddWalletHeldBalance(testCtxTx, hold.WalletID, hold.Amount.Neg()).Return(nil, nil)
+		st.holdRepo.EXPECT().Settle(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *entity.Hold) error {
+				assert.Equal(t, entity.HoldStatusExpired, h.Status)
+				return nil
+			})
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		err := st.holder.Expire(testCtx, hold.ID)
+
+		assert.NoError(t, err)
+	})
+}
+
+func expectGetHoldWallets(st *WalletHolderSuite, hold *entity.Hold, wallet, recWallet *entity.Wallet) {
+	st.walletRepo.EXPECT().GetUserWalletForUpdate(testCtxTx, hold.WalletID, hold.UserID).Return(wallet, nil)
+	st.walletRepo.EXPECT().GetUserWalletForUpdate(testCtxTx, hold.ReceiverWalletID, hold.ReceiverID).Return(recWallet, nil)
+}
+
+func createTestHoldRequest() *entity.Hold {
+	return &entity.Hold{
+		UserID:           uuid.MustParse("01917a52-86af-73aa-817f-46baf900d0e8"),
+		WalletID:         uuid.MustParse("01917a52-86af-7d6f-994f-771bcf2ffa8b"),
+		ReceiverID:       uuid.MustParse("01917a52-86af-7f42-8ef5-2c3a1d8e9b10"),
+		ReceiverWalletID: uuid.MustParse("01917a52-86af-7f8a-9d7e-0c5b3e2f1a20"),
+		Amount:           decimal.RequireFromString("3.4"),
+		Currency:         entity.DefaultCurrency,
+	}
+}
+
+func createTestAuthorizedHold() *entity.Hold {
+	hold := createTestHoldRequest()
+	hold.ID = uuid.Must(uuid.NewV7())
+	hold.Status = entity.HoldStatusAuthorized
+	hold.ExpiresAt = time.Now().Add(testHoldTTL)
+	return hold
+}
+
+func createTestCaptureHold(hold *entity.Hold, amount decimal.Decimal) *entity.CaptureHold {
+	return &entity.CaptureHold{
+		HoldID:           hold.ID,
+		Amount:           amount,
+		UserID:           hold.UserID,
+		WalletID:         hold.WalletID,
+		ReceiverID:       hold.ReceiverID,
+		ReceiverWalletID: hold.ReceiverWalletID,
+		ReferenceID:      uuid.Must(uuid.NewV7()),
+	}
+}
+
+func createWalletHolderSuite(ctrl *gomock.Controller) *WalletHolderSuite {
+	w := mock_service.NewMockWalletHolderRepository(ctrl)
+	h := mock_service.NewMockWalletHolderHoldRepository(ctrl)
+	l := mock_service.NewMockWalletHolderLedgerRepository(ctrl)
+	t := mock_service.NewMockWalletHolderTransferRepository(ctrl)
+	s := mock_service.NewMockHoldExpiryScheduler(ctrl)
+	m := mock_uow.NewMockTxManager(ctrl)
+	return &WalletHolderSuite{
+		holder:       service.NewWalletHolder(w, h, l, t, s, m, testHoldTTL),
+		walletRepo:   w,
+		holdRepo:     h,
+		ledgerRepo:   l,
+		transferRepo: t,
+		scheduler:    s,
+		txManager:    m,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/indrasaputra/arjuna/pkg/sdk/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/entity"
 )
 
@@ -19,35 +20,71 @@ type TopupWallet interface {
 
 // TopupWalletRepository defines the interface to update wallet in repository.
 type TopupWalletRepository interface {
+	// GetByID gets a wallet by its id.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Wallet, error)
 	// AddWalletBalance adds certain amount (can be negative) to certain wallet.
 	AddWalletBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error)
 }
 
+// TopupWalletLedgerRepository defines the interface to record ledger journal in repository.
+type TopupWalletLedgerRepository interface {
+	// Insert inserts a journal as a pair of debit and credit entries.
+	Insert(ctx context.Context, journal *entity.LedgerJournal) error
+}
+
 // WalletTopup is responsible for topup a new wallet.
 type WalletTopup struct {
 	walletRepo TopupWalletRepository
+	ledgerRepo TopupWalletLedgerRepository
+	txManager  uow.TxManager
 }
 
 // NewWalletTopup topups an instance of WalletTopup.
-func NewWalletTopup(t TopupWalletRepository) *WalletTopup {
-	return &WalletTopup{walletRepo: t}
+func NewWalletTopup(t TopupWalletRepository, l TopupWalletLedgerRepository, m uow.TxManager) *WalletTopup {
+	return &WalletTopup{walletRepo: t, ledgerRepo: l, txManager: m}
 }
 
 // Topup topups wallet's balance.
 // It needs idempotency key.
+// The topup's currency must be the wallet's currency. It is checked before the balance is changed.
 func (wt *WalletTopup) Topup(ctx context.Context, topup *entity.TopupWallet) (*entity.Wallet, error) {
 	if topup == nil {
 		return nil, entity.ErrEmptyWallet()
 	}
 
+	topup.Currency = entity.NormalizeCurrencyCode(topup.Currency)
 	if err := validateTopupWallet(topup); err != nil {
 		slog.ErrorContext(ctx, "[WalletTopup-Topup] wallet is invalid", "error", err)
 		return nil, err
 	}
 
-	wallet, err := wt.walletRepo.AddWalletBalance(ctx, topup.WalletID, topup.Amount)
+	// wallet's currency never changes, hence it doesn't need to be locked.
+	current, err := wt.walletRepo.GetByID(ctx, topup.WalletID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletTopup-Topup] fail get wallet", "error", err)
+		return nil, err
+	}
+	if current.Currency != topup.Currency {
+		return nil, entity.ErrCurrencyMismatch()
+	}
+
+	var wallet *entity.Wallet
+	err = wt.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		wallet, err = wt.walletRepo.AddWalletBalance(ctx, topup.WalletID, topup.Amount)
+		if err != nil {
+			slog.ErrorContext(ctx, "[WalletTopup-Topup] fail update wallet balance", "error", err)
+			return err
+		}
+
+		journal := createLedgerJournal(entity.LedgerJournalTypeTopup, entity.ExternalWalletID, topup.WalletID, topup.Amount, topup.Currency, topup.UserID)
+		if err := wt.ledgerRepo.Insert(ctx, journal); err != nil {
+			slog.ErrorContext(ctx, "[WalletTopup-Topup] fail insert ledger journal", "error", err)
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		slog.ErrorContext(ctx, "[WalletTopup-Topup] fail update wallet balance", "error", err)
 		return nil, err
 	}
 	return wallet, nil
@@ -60,8 +97,8 @@ func validateTopupWallet(topup *entity.TopupWallet) error {
 	if topup.UserID == uuid.Nil {
 		return entity.ErrInvalidUser()
 	}
-	if decimal.Zero.Equal(topup.Amount) {
+	if !topup.Amount.IsPositive() {
 		return entity.ErrInvalidAmount()
 	}
-	return nil
+	return validateAmountCurrency(topup.Amount, topup.Currency)
 }
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	mock_uow "github.com/indrasaputra/arjuna/pkg/sdk/test/mock/uow"
 	"github.com/indrasaputra/arjuna/service/wallet/entity"
 	"github.com/indrasaputra/arjuna/service/wallet/internal/service"
 	mock_service "github.com/indrasaputra/arjuna/service/wallet/test/mock/service"
@@ -19,8 +21,10 @@ var (
 )
 
 type WalletTopupSuite struct {
-	topup     *service.WalletTopup
-	topupRepo *mock_service.MockTopupWalletRepository
+	topup      *service.WalletTopup
+	topupRepo  *mock_service.MockTopupWalletRepository
+	ledgerRepo *mock_service.MockTopupWalletLedgerRepository
+	txManager  *mock_uow.MockTxManager
 }
 
 func TestNewWalletTopup(t *testing.T) {
@@ -80,10 +84,92 @@ func TestWalletTopup_Topup(t *testing.T) {
 		assert.Nil(t, wallet)
 	})
 
+	t.Run("amount is negative", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		topup.Amount = testAmount.Neg()
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+		assert.Nil(t, wallet)
+	})
+
+	t.Run("currency is not supported", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		topup.Currency = "XYZ"
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+		assert.Nil(t, wallet)
+	})
+
+	t.Run("amount exceeds currency's precision", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		topup.Amount = decimal.RequireFromString("1.234")
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmountPrecision(), err)
+		assert.Nil(t, wallet)
+	})
+
+	t.Run("wallet repo get wallet returns error", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		st.topupRepo.EXPECT().GetByID(testCtx, topup.WalletID).Return(nil, entity.ErrEmptyWallet())
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Equal(t, entity.ErrEmptyWallet(), err)
+		assert.Nil(t, wallet)
+	})
+
+	t.Run("wallet currency is different", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		topup.Currency = "usd"
+		st.topupRepo.EXPECT().GetByID(testCtx, topup.WalletID).Return(createTestWallet(), nil)
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrCurrencyMismatch(), err)
+		assert.Nil(t, wallet)
+	})
+
 	t.Run("wallet repo update balance returns error", func(t *testing.T) {
 		st := createWalletTopupSuite(ctrl)
 		topup := createTestTopupWallet()
-		st.topupRepo.EXPECT().AddWalletBalance(testCtx, topup.WalletID, topup.Amount).Return(nil, assert.AnError)
+		st.topupRepo.EXPECT().GetByID(testCtx, topup.WalletID).Return(createTestWallet(), nil)
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.topupRepo.EXPECT().AddWalletBalance(testCtxTx, topup.WalletID, topup.Amount).Return(nil, assert.AnError)
+
+		wallet, err := st.topup.Topup(testCtx, topup)
+
+		assert.Error(t, err)
+		assert.Nil(t, wallet)
+	})
+
+	t.Run("ledger repo insert returns error", func(t *testing.T) {
+		st := createWalletTopupSuite(ctrl)
+		topup := createTestTopupWallet()
+		st.topupRepo.EXPECT().GetByID(testCtx, topup.WalletID).Return(createTestWallet(), nil)
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.topupRepo.EXPECT().AddWalletBalance(testCtxTx, topup.WalletID, topup.Amount).Return(createTestWallet(), nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(assert.AnError)
 
 		wallet, err := st.topup.Topup(testCtx, topup)
 
@@ -94,7 +180,21 @@ func TestWalletTopup_Topup(t *testing.T) {
 	t.Run("success create a topup", func(t *testing.T) {
 		st := createWalletTopupSuite(ctrl)
 		topup := createTestTopupWallet()
-		st.topupRepo.EXPECT().AddWalletBalance(testCtx, topup.WalletID, topup.Amount).Return(createTestWallet(), nil)
+		st.topupRepo.EXPECT().GetByID(testCtx, topup.WalletID).Return(createTestWallet(), nil)
+		st.txManager.EXPECT().Do(testCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+		st.topupRepo.EXPECT().AddWalletBalance(testCtxTx, topup.WalletID, topup.Amount).Return(createTestWallet(), nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+				assert.Equal(t, entity.LedgerJournalTypeTopup, journal.Type)
+				assert.Equal(t, entity.ExternalWalletID, journal.DebitWalletID)
+				assert.Equal(t, topup.WalletID, journal.CreditWalletID)
+				assert.True(t, topup.Amount.Equal(journal.Amount))
+				assert.Equal(t, topup.Currency, journal.Currency)
+				return nil
+			})
 
 		wallet, err := st.topup.Topup(testCtx, topup)
 
@@ -105,10 +205,14 @@ func TestWalletTopup_Topup(t *testing.T) {
 
 func createWalletTopupSuite(ctrl *gomock.Controller) *WalletTopupSuite {
 	r := mock_service.NewMockTopupWalletRepository(ctrl)
-	t := service.NewWalletTopup(r)
+	l := mock_service.NewMockTopupWalletLedgerRepository(ctrl)
+	m := mock_uow.NewMockTxManager(ctrl)
+	t := service.NewWalletTopup(r, l, m)
 	return &WalletTopupSuite{
-		topup:     t,
-		topupRepo: r,
+		topup:      t,
+		topupRepo:  r,
+		ledgerRepo: l,
+		txManager:  m,
 	}
 }
 
@@ -117,5 +221,6 @@ func createTestTopupWallet() *entity.TopupWallet {
 		WalletID: testWalletID,
 		UserID:   testUserID,
 		Amount:   testAmount,
+		Currency: entity.DefaultCurrency,
 	}
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/google/uuid"
@@ -14,7 +15,11 @@ import (
 // TransferWallet defines interface to transfer wallet.
 type TransferWallet interface {
 	// TransferBalance transfers a wallet's balance.
-	TransferBalance(ctx context.Context, transfer *entity.TransferWallet) error
+	// It returns nil conversion if both wallets have the same currency.
+	TransferBalance(ctx context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error)
+	// ResolveTransfer tells whether the transfer with the reference has been applied.
+	// If it hasn't, the reference is cancelled so the transfer can never be applied afterwards.
+	ResolveTransfer(ctx context.Context, referenceID uuid.UUID) (bool, error)
 }
 
 // WalletTransfererRepository defines the interface to get wallet in repository.
@@ -25,34 +30,67 @@ type WalletTransfererRepository interface {
 	AddWalletBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error)
 }
 
+// WalletTransfererLedgerRepository defines the interface to record ledger journal in repository.
+type WalletTransfererLedgerRepository interface {
+	// Insert inserts a journal as a pair of debit and credit entries.
+	Insert(ctx context.Context, journal *entity.LedgerJournal) error
+}
+
+// WalletTransfererFXQuoteRepository defines the interface to use fx quote in repository.
+type WalletTransfererFXQuoteRepository interface {
+	// Use marks user's quote as used and returns it.
+	// It must return invalid quote error if the quote doesn't belong to the user, has expired, or has been used.
+	Use(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.FXQuote, error)
+}
+
+// WalletTransfererTransferRepository defines the interface to record transfer's reference in repository.
+type WalletTransfererTransferRepository interface {
+	// Insert records the reference's status.
+	// It must return already exists error if the reference has been recorded.
+	Insert(ctx context.Context, id uuid.UUID, status entity.TransferStatus) error
+	// GetStatus gets the reference's status.
+	GetStatus(ctx context.Context, id uuid.UUID) (entity.TransferStatus, error)
+}
+
 // WalletTransferer is responsible for transfer balance between wallets.
 type WalletTransferer struct {
-	walletRepo WalletTransfererRepository
-	txManager  uow.TxManager
+	walletRepo   WalletTransfererRepository
+	ledgerRepo   WalletTransfererLedgerRepository
+	quoteRepo    WalletTransfererFXQuoteRepository
+	transferRepo WalletTransfererTransferRepository
+	txManager    uow.TxManager
 }
 
 // NewWalletTransferer creates an instance of WalletTransferer.
-func NewWalletTransferer(w WalletTransfererRepository, m uow.TxManager) *WalletTransferer {
-	return &WalletTransferer{walletRepo: w, txManager: m}
+func NewWalletTransferer(w WalletTransfererRepository, l WalletTransfererLedgerRepository, q WalletTransfererFXQuoteRepository, t WalletTransfererTransferRepository, m uow.TxManager) *WalletTransferer {
+	return &WalletTransferer{walletRepo: w, ledgerRepo: l, quoteRepo: q, transferRepo: t, txManager: m}
 }
 
 // TransferBalance transfers certain amount of balance from sender to receiver.
 // Sender's balance must be sufficient to make a transfer.
-func (wt *WalletTransferer) TransferBalance(ctx context.Context, transfer *entity.TransferWallet) error {
+// Sender's wallet must have the same currency as the transfer.
+// If receiver's wallet has different currency, the transfer must have sender's fx quote
+// and the amount is converted using the quote.
+// If the transfer has a reference, it is applied at most once. Transferring an applied reference
+// returns transfer applied error and transferring a cancelled reference returns transfer cancelled error.
+func (wt *WalletTransferer) TransferBalance(ctx context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error) {
 	if transfer == nil {
-		return entity.ErrInvalidTransfer()
+		return nil, entity.ErrInvalidTransfer()
 	}
+	transfer.Currency = entity.NormalizeCurrencyCode(transfer.Currency)
 	if err := validateTransferWalletRequest(transfer); err != nil {
-		return err
+		return nil, err
 	}
-	if err := wt.processTransferBalance(ctx, transfer); err != nil {
-		return err
-	}
-	return nil
+	return wt.processTransferBalance(ctx, transfer)
 }
 
-func (wt *WalletTransferer) processTransferBalance(ctx context.Context, transfer *entity.TransferWallet) error {
+func (wt *WalletTransferer) processTransferBalance(ctx context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error) {
+	var conversion *entity.FXConversion
 	err := wt.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := applyReference(ctx, wt.transferRepo, transfer.ReferenceID); err != nil {
+			return err
+		}
+
 		senWallet, recWallet, err := wt.getSenderAndReceiverWallet(ctx, transfer)
 		if err != nil {
 			return err
@@ -61,16 +99,114 @@ func (wt *WalletTransferer) processTransferBalance(ctx context.Context, transfer
 		if senWallet == nil || recWallet == nil {
 			return entity.ErrInvalidUser()
 		}
-		if senWallet.Balance.LessThan(transfer.Amount) {
+		if senWallet.Currency != transfer.Currency {
+			return entity.ErrCurrencyMismatch()
+		}
+		if transfer.QuoteID == uuid.Nil && recWallet.Currency != transfer.Currency {
+			return entity.ErrCurrencyMismatch()
+		}
+		if senWallet.AvailableBalance().LessThan(transfer.Amount) {
 			return entity.ErrInsufficientBalance()
 		}
 
-		if err := wt.updateUserBalances(ctx, transfer); err != nil {
+		received := transfer.Amount
+		if transfer.QuoteID != uuid.Nil {
+			conversion, err = wt.convert(ctx, transfer, recWallet.Currency)
+			if err != nil {
+				return err
+			}
+			received = conversion.ConvertedAmount
+		}
+
+		if err := wt.updateUserBalances(ctx, transfer, received); err != nil {
+			return err
+		}
+		if err := wt.recordLedgerJournal(ctx, transfer, conversion); err != nil {
 			return err
 		}
 		return nil
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return conversion, nil
+}
+
+// convert converts transfer's amount to receiver's currency using sender's quote.
+// The quote is used inside the transfer's transaction, so it is released if the transfer fails.
+func (wt *WalletTransferer) convert(ctx context.Context, transfer *entity.TransferWallet, currency string) (*entity.FXConversion, error) {
+	quote, err := wt.quoteRepo.Use(ctx, transfer.QuoteID, transfer.SenderID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletTransferer-convert] use quote fail", "error", err)
+		return nil, err
+	}
+	if quote.FromCurrency != transfer.Currency || quote.ToCurrency != currency {
+		return nil, entity.ErrInvalidQuote()
+	}
+
+	conversion := quote.Convert(transfer.Amount)
+	if !conversion.ConvertedAmount.IsPositive() {
+		return nil, entity.ErrInvalidAmount()
+	}
+	return conversion, nil
+}
+
+// ResolveTransfer tells whether the transfer with the reference has been applied.
+// It cancels the reference if it hasn't been recorded, so a transfer still in flight can't apply it afterwards.
+// Hence, the caller can safely treat a not applied transfer as failed.
+func (wt *WalletTransferer) ResolveTransfer(ctx context.Context, referenceID uuid.UUID) (bool, error) {
+	if referenceID == uuid.Nil {
+		return false, entity.ErrInvalidTransfer()
+	}
+
+	var applied bool
+	err := wt.txManager.Do(ctx, func(ctx context.Context) error {
+		err := wt.transferRepo.Insert(ctx, referenceID, entity.TransferStatusCancelled)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, entity.ErrAlreadyExists()) {
+			return err
+		}
+		status, err := wt.transferRepo.GetStatus(ctx, referenceID)
+		if err != nil {
+			return err
+		}
+		applied = status == entity.TransferStatusApplied
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[WalletTransferer-ResolveTransfer] resolve transfer fail", "error", err)
+		return false, err
+	}
+	return applied, nil
+}
+
+// applyReference records the reference as applied inside the transfer's or capture's transaction,
+// so the record is rolled back if the transfer or capture fails.
+func applyReference(ctx context.Context, repo WalletTransfererTransferRepository, referenceID uuid.UUID) error {
+	if referenceID == uuid.Nil {
+		return nil
+	}
+
+	err := repo.Insert(ctx, referenceID, entity.TransferStatusApplied)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, entity.ErrAlreadyExists()) {
+		slog.ErrorContext(ctx, "[applyReference] insert reference fail", "error", err)
+		return err
+	}
+
+	status, err := repo.GetStatus(ctx, referenceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[applyReference] get reference status fail", "error", err)
+		return err
+	}
+	if status == entity.TransferStatusApplied {
+		return entity.ErrTransferApplied()
+	}
+	return entity.ErrTransferCancelled()
 }
 
 // Prevent deadlocks by consistently ordering wallet lock acquisition:
@@ -109,24 +245,63 @@ func (wt *WalletTransferer) getSenderAndReceiverWallet(ctx context.Context, tran
 	return senWallet, recWallet, nil
 }
 
-func (wt *WalletTransferer) updateUserBalances(ctx context.Context, transfer *entity.TransferWallet) error {
+// updateUserBalances subtracts transfer's amount from sender and adds received amount to receiver.
+// Received amount differs from transfer's amount only if it is converted.
+func (wt *WalletTransferer) updateUserBalances(ctx context.Context, transfer *entity.TransferWallet, received decimal.Decimal) error {
 	if _, err := wt.walletRepo.AddWalletBalance(ctx, transfer.SenderWalletID, transfer.Amount.Neg()); err != nil {
 		slog.ErrorContext(ctx, "[WalletTransferer-updateUserBalances] subtract sender balance fail", "error", err)
 		return err
 	}
-	if _, err := wt.walletRepo.AddWalletBalance(ctx, transfer.ReceiverWalletID, transfer.Amount); err != nil {
+	if _, err := wt.walletRepo.AddWalletBalance(ctx, transfer.ReceiverWalletID, received); err != nil {
 		slog.ErrorContext(ctx, "[WalletTransferer-updateUserBalances] add receiver balance fail", "error", err)
 		return err
 	}
 	return nil
 }
 
+// recordLedgerJournal records a journal from sender to receiver.
+// Converted transfer can't be a single journal because both sides have different amount,
+// so each side is booked against external wallet in its own currency.
+func (wt *WalletTransferer) recordLedgerJournal(ctx context.Context, transfer *entity.TransferWallet, conversion *entity.FXConversion) error {
+	journals := []*entity.LedgerJournal{
+		createLedgerJournal(entity.LedgerJournalTypeTransfer, transfer.SenderWalletID, transfer.ReceiverWalletID, transfer.Amount, transfer.Currency, transfer.SenderID),
+	}
+	if conversion != nil {
+		journals = createFXLedgerJournals(transfer, conversion)
+	}
+
+	for _, journal := range journals {
+		if err := wt.ledgerRepo.Insert(ctx, journal); err != nil {
+			slog.ErrorContext(ctx, "[WalletTransferer-recordLedgerJournal] insert ledger journal fail", "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// createFXLedgerJournals books the converted part of the amount against ExternalWalletID on each side
+// and the spread to the fee wallet of the sender's currency.
+func createFXLedgerJournals(transfer *entity.TransferWallet, conversion *entity.FXConversion) []*entity.LedgerJournal {
+	journals := []*entity.LedgerJournal{
+		createLedgerJournal(entity.LedgerJournalTypeFXTransfer, transfer.SenderWalletID, entity.ExternalWalletID, transfer.Amount.Sub(conversion.Fee), conversion.FromCurrency, transfer.SenderID),
+	}
+	if conversion.Fee.IsPositive() {
+		journals = append(journals, createLedgerJournal(entity.LedgerJournalTypeFXFee, transfer.SenderWalletID, entity.FXFeeWalletID(conversion.FromCurrency), conversion.Fee, conversion.FromCurrency, transfer.SenderID))
+	}
+	return append(journals, createLedgerJournal(entity.LedgerJournalTypeFXTransfer, entity.ExternalWalletID, transfer.ReceiverWalletID, conversion.ConvertedAmount, conversion.ToCurrency, transfer.SenderID))
+}
+
+// validateTransferWalletRequest validates the transfer.
+// Converting between user's own wallets of different currencies is allowed.
 func validateTransferWalletRequest(transfer *entity.TransferWallet) error {
-	if transfer.SenderID == transfer.ReceiverID {
+	if transfer.SenderWalletID == transfer.ReceiverWalletID {
+		return entity.ErrSameAccount()
+	}
+	if transfer.QuoteID == uuid.Nil && transfer.SenderID == transfer.ReceiverID {
 		return entity.ErrSameAccount()
 	}
-	if transfer.Amount.Equal(decimal.Zero) {
+	if !transfer.Amount.IsPositive() {
 		return entity.ErrInvalidAmount()
 	}
-	return nil
+	return validateAmountCurrency(transfer.Amount, transfer.Currency)
 }
@@ -17,12 +17,18 @@ import (
 
 type ctxKey string
 
-var testCtxTx = context.WithValue(testCtx, ctxKey("tx"), true)
+var (
+	testCtxTx       = context.WithValue(testCtx, ctxKey("tx"), true)
+	testReferenceID = uuid.MustParse("01917a52-86af-7c1e-9a3b-4d5e6f708192")
+)
 
 type WalletTransfererSuite struct {
-	wallet    *service.WalletTransferer
-	repo      *mock_service.MockWalletTransfererRepository
-	txManager *mock_uow.MockTxManager
+	wallet       *service.WalletTransferer
+	repo         *mock_service.MockWalletTransfererRepository
+	ledgerRepo   *mock_service.MockWalletTransfererLedgerRepository
+	quoteRepo    *mock_service.MockWalletTransfererFXQuoteRepository
+	transferRepo *mock_service.MockWalletTransfererTransferRepository
+	txManager    *mock_uow.MockTxManager
 }
 
 func TestNewWalletTransferer(t *testing.T) {
@@ -42,7 +48,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 	t.Run("transfer is nil", func(t *testing.T) {
 		st := createWalletTransfererSuite(ctrl)
 
-		err := st.wallet.TransferBalance(testCtx, nil)
+		_, err := st.wallet.TransferBalance(testCtx, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrInvalidTransfer(), err)
@@ -53,7 +59,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
 		trf.ReceiverID = trf.SenderID
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrSameAccount(), err)
@@ -64,12 +70,45 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
 		trf.Amount = decimal.Zero
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+	})
+
+	t.Run("negative amount", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.Amount = trf.Amount.Neg()
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrInvalidAmount(), err)
 	})
 
+	t.Run("currency is not supported", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.Currency = "XYZ"
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidCurrency(), err)
+	})
+
+	t.Run("amount exceeds currency's precision", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.Amount = decimal.RequireFromString("3.456")
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmountPrecision(), err)
+	})
+
 	t.Run("get sender returns error; swid < rwid", func(t *testing.T) {
 		st := createWalletTransfererSuite(ctrl)
 		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
@@ -80,7 +119,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -97,7 +136,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -113,7 +152,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -130,7 +169,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -147,7 +186,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return entity.ErrInvalidUser()
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrInvalidUser(), err)
@@ -165,12 +204,50 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return entity.ErrInvalidUser()
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrInvalidUser(), err)
 	})
 
+	t.Run("wallets have different currencies", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		sw := createTestWallet()
+		rw := createTestWallet()
+		rw.Currency = "USD"
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrCurrencyMismatch(), err)
+	})
+
+	t.Run("transfer currency differs from wallets' currency", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.Currency = "USD"
+		sw := createTestWallet()
+		rw := createTestWallet()
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrCurrencyMismatch(), err)
+	})
+
 	t.Run("sender balance is insufficient", func(t *testing.T) {
 		st := createWalletTransfererSuite(ctrl)
 		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
@@ -185,12 +262,30 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return entity.ErrInsufficientBalance()
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 		assert.Equal(t, entity.ErrInsufficientBalance(), err)
 	})
 
+	t.Run("sender available balance is insufficient due to holds", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		sw := createTestWallet()
+		sw.HeldBalance = sw.Balance.Sub(trf.Amount).Add(decimal.RequireFromString("0.01"))
+		rw := createTestWallet()
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Equal(t, entity.ErrInsufficientBalance(), err)
+	})
+
 	t.Run("add sender wallet returns error", func(t *testing.T) {
 		st := createWalletTransfererSuite(ctrl)
 		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
@@ -205,7 +300,7 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -225,7 +320,28 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("insert ledger journal returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		sw := createTestWallet()
+		rw := createTestWallet()
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.SenderWalletID, trf.Amount.Neg()).Return(nil, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.ReceiverWalletID, trf.Amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(assert.AnError)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				assert.Error(t, fn(testCtxTx))
+				return assert.AnError
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -239,13 +355,14 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
 		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.SenderWalletID, trf.Amount.Neg()).Return(nil, nil)
 		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.ReceiverWalletID, trf.Amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(nil)
 		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 				assert.NoError(t, fn(testCtxTx))
 				return assert.AnError
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		_, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.Error(t, err)
 	})
@@ -259,15 +376,329 @@ func TestWalletTransferer_TransferBalance(t *testing.T) {
 		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
 		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.SenderWalletID, trf.Amount.Neg()).Return(nil, nil)
 		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.ReceiverWalletID, trf.Amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+				assert.Equal(t, entity.LedgerJournalTypeTransfer, journal.Type)
+				assert.Equal(t, trf.SenderWalletID, journal.DebitWalletID)
+				assert.Equal(t, trf.ReceiverWalletID, journal.CreditWalletID)
+				assert.True(t, trf.Amount.Equal(journal.Amount))
+				assert.Equal(t, trf.Currency, journal.Currency)
+				return nil
+			})
 		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
 				assert.NoError(t, fn(testCtxTx))
 				return nil
 			})
 
-		err := st.wallet.TransferBalance(testCtx, trf)
+		res, err := st.wallet.TransferBalance(testCtx, trf)
 
 		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("insert reference returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.ReferenceID = testReferenceID
+		st.transferRepo.EXPECT().Insert(testCtxTx, trf.ReferenceID, entity.TransferStatusApplied).Return(entity.ErrInternal(""))
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("get reference status returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.ReferenceID = testReferenceID
+		st.transferRepo.EXPECT().Insert(testCtxTx, trf.ReferenceID, entity.TransferStatusApplied).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, trf.ReferenceID).Return(entity.TransferStatus(""), entity.ErrInternal(""))
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("reference has been applied", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.ReferenceID = testReferenceID
+		st.transferRepo.EXPECT().Insert(testCtxTx, trf.ReferenceID, entity.TransferStatusApplied).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, trf.ReferenceID).Return(entity.TransferStatusApplied, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrTransferApplied(), err)
+	})
+
+	t.Run("reference has been cancelled", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.ReferenceID = testReferenceID
+		st.transferRepo.EXPECT().Insert(testCtxTx, trf.ReferenceID, entity.TransferStatusApplied).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, trf.ReferenceID).Return(entity.TransferStatusCancelled, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrTransferCancelled(), err)
+	})
+
+	t.Run("success transfer balance with reference", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+		trf.ReferenceID = testReferenceID
+		sw := createTestWallet()
+		rw := createTestWallet()
+		st.transferRepo.EXPECT().Insert(testCtxTx, trf.ReferenceID, entity.TransferStatusApplied).Return(nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.SenderWalletID, trf.Amount.Neg()).Return(nil, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.ReceiverWalletID, trf.Amount).Return(nil, nil)
+		st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).Return(nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("sender and receiver wallets are same in converted transfer", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestFXTransferWallet()
+		trf.ReceiverWalletID = trf.SenderWalletID
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrSameAccount(), err)
+	})
+
+	t.Run("use quote returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestFXTransferWallet()
+		sw, rw := createTestFXWallets()
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.quoteRepo.EXPECT().Use(testCtxTx, trf.QuoteID, trf.SenderID).Return(nil, entity.ErrInvalidQuote())
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+	})
+
+	t.Run("quote doesn't match wallets' currencies", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestFXTransferWallet()
+		sw, rw := createTestFXWallets()
+		quote := createTestFXQuote(trf.QuoteID)
+		quote.ToCurrency = "SGD"
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.quoteRepo.EXPECT().Use(testCtxTx, trf.QuoteID, trf.SenderID).Return(quote, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidQuote(), err)
+	})
+
+	t.Run("converted amount is too small", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestFXTransferWallet()
+		trf.Amount = decimal.RequireFromString("0.01")
+		sw, rw := createTestFXWallets()
+		quote := createTestFXQuote(trf.QuoteID)
+		quote.Rate = decimal.RequireFromString("0.0001")
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.quoteRepo.EXPECT().Use(testCtxTx, trf.QuoteID, trf.SenderID).Return(quote, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		_, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidAmount(), err)
+	})
+
+	t.Run("success transfer balance with conversion", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		trf := createTestFXTransferWallet()
+		sw, rw := createTestFXWallets()
+		quote := createTestFXQuote(trf.QuoteID)
+		converted := decimal.RequireFromString("54926.69")
+		fee := decimal.RequireFromString("0.02")
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.SenderWalletID, trf.SenderID).Return(sw, nil)
+		st.repo.EXPECT().GetUserWalletForUpdate(testCtxTx, trf.ReceiverWalletID, trf.ReceiverID).Return(rw, nil)
+		st.quoteRepo.EXPECT().Use(testCtxTx, trf.QuoteID, trf.SenderID).Return(quote, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.SenderWalletID, trf.Amount.Neg()).Return(nil, nil)
+		st.repo.EXPECT().AddWalletBalance(testCtxTx, trf.ReceiverWalletID, gomock.Cond(func(x any) bool {
+			return converted.Equal(x.(decimal.Decimal))
+		})).Return(nil, nil)
+		gomock.InOrder(
+			st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+					assert.Equal(t, entity.LedgerJournalTypeFXTransfer, journal.Type)
+					assert.Equal(t, trf.SenderWalletID, journal.DebitWalletID)
+					assert.Equal(t, entity.ExternalWalletID, journal.CreditWalletID)
+					assert.True(t, trf.Amount.Sub(fee).Equal(journal.Amount))
+					assert.Equal(t, quote.FromCurrency, journal.Currency)
+					return nil
+				}),
+			st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+					assert.Equal(t, entity.LedgerJournalTypeFXFee, journal.Type)
+					assert.Equal(t, trf.SenderWalletID, journal.DebitWalletID)
+					assert.Equal(t, entity.FXFeeWalletID("USD"), journal.CreditWalletID)
+					assert.True(t, fee.Equal(journal.Amount))
+					assert.Equal(t, quote.FromCurrency, journal.Currency)
+					return nil
+				}),
+			st.ledgerRepo.EXPECT().Insert(testCtxTx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, journal *entity.LedgerJournal) error {
+					assert.Equal(t, entity.LedgerJournalTypeFXTransfer, journal.Type)
+					assert.Equal(t, entity.ExternalWalletID, journal.DebitWalletID)
+					assert.Equal(t, trf.ReceiverWalletID, journal.CreditWalletID)
+					assert.True(t, converted.Equal(journal.Amount))
+					assert.Equal(t, quote.ToCurrency, journal.Currency)
+					return nil
+				}),
+		)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				assert.NoError(t, fn(testCtxTx))
+				return nil
+			})
+
+		res, err := st.wallet.TransferBalance(testCtx, trf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, trf.QuoteID, res.QuoteID)
+		assert.True(t, fee.Equal(res.Fee))
+		assert.True(t, converted.Equal(res.ConvertedAmount))
+	})
+}
+
+func TestWalletTransferer_ResolveTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("reference is empty", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+
+		res, err := st.wallet.ResolveTransfer(testCtx, uuid.Nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, entity.ErrInvalidTransfer(), err)
+		assert.False(t, res)
+	})
+
+	t.Run("insert reference returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		st.transferRepo.EXPECT().Insert(testCtxTx, testReferenceID, entity.TransferStatusCancelled).Return(entity.ErrInternal(""))
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.wallet.ResolveTransfer(testCtx, testReferenceID)
+
+		assert.Error(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("get reference status returns error", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		st.transferRepo.EXPECT().Insert(testCtxTx, testReferenceID, entity.TransferStatusCancelled).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, testReferenceID).Return(entity.TransferStatus(""), entity.ErrInternal(""))
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.wallet.ResolveTransfer(testCtx, testReferenceID)
+
+		assert.Error(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("transfer hasn't been applied, so it is cancelled", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		st.transferRepo.EXPECT().Insert(testCtxTx, testReferenceID, entity.TransferStatusCancelled).Return(nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.wallet.ResolveTransfer(testCtx, testReferenceID)
+
+		assert.NoError(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("transfer has been cancelled", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		st.transferRepo.EXPECT().Insert(testCtxTx, testReferenceID, entity.TransferStatusCancelled).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, testReferenceID).Return(entity.TransferStatusCancelled, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.wallet.ResolveTransfer(testCtx, testReferenceID)
+
+		assert.NoError(t, err)
+		assert.False(t, res)
+	})
+
+	t.Run("transfer has been applied", func(t *testing.T) {
+		st := createWalletTransfererSuite(ctrl)
+		st.transferRepo.EXPECT().Insert(testCtxTx, testReferenceID, entity.TransferStatusCancelled).Return(entity.ErrAlreadyExists())
+		st.transferRepo.EXPECT().GetStatus(testCtxTx, testReferenceID).Return(entity.TransferStatusApplied, nil)
+		st.txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(context.Context) error) error {
+				return fn(testCtxTx)
+			})
+
+		res, err := st.wallet.ResolveTransfer(testCtx, testReferenceID)
+
+		assert.NoError(t, err)
+		assert.True(t, res)
 	})
 }
 
@@ -279,16 +710,49 @@ func createTestTransferWallet(swid, rwid string) *entity.TransferWallet {
 		ReceiverID:       uuid.MustParse("01917a52-86af-7d6f-994f-771bcf2ffa8b"),
 		ReceiverWalletID: uuid.MustParse(rwid),
 		Amount:           amount,
+		Currency:         entity.DefaultCurrency,
+	}
+}
+
+func createTestFXTransferWallet() *entity.TransferWallet {
+	trf := createTestTransferWallet("01917a52-86af-73aa-817f-46baf900d0e8", "01917a52-86af-7d6f-994f-771bcf2ffa8b")
+	trf.ReceiverID = trf.SenderID
+	trf.Currency = "USD"
+	trf.QuoteID = uuid.MustParse("01917a52-86af-7f42-8ef5-2c3a1d8e9b10")
+	return trf
+}
+
+func createTestFXWallets() (*entity.Wallet, *entity.Wallet) {
+	sw := createTestWallet()
+	sw.Currency = "USD"
+	rw := createTestWallet()
+	return sw, rw
+}
+
+func createTestFXQuote(id uuid.UUID) *entity.FXQuote {
+	return &entity.FXQuote{
+		ID:           id,
+		UserID:       testUserID,
+		FromCurrency: "USD",
+		ToCurrency:   entity.DefaultCurrency,
+		Rate:         decimal.RequireFromString("16250.5"),
+		Spread:       testSpread,
 	}
 }
 
 func createWalletTransfererSuite(ctrl *gomock.Controller) *WalletTransfererSuite {
 	r := mock_service.NewMockWalletTransfererRepository(ctrl)
+	l := mock_service.NewMockWalletTransfererLedgerRepository(ctrl)
+	q := mock_service.NewMockWalletTransfererFXQuoteRepository(ctrl)
+	tr := mock_service.NewMockWalletTransfererTransferRepository(ctrl)
 	m := mock_uow.NewMockTxManager(ctrl)
-	w := service.NewWalletTransferer(r, m)
+	w := service.NewWalletTransferer(r, l, q, tr, m)
 	return &WalletTransfererSuite{
-		wallet:    w,
-		repo:      r,
-		txManager: m,
+		wallet:       w,
+		repo:         r,
+		ledgerRepo:   l,
+		quoteRepo:    q,
+		transferRepo: tr,
+		txManager:    m,
 	}
 }
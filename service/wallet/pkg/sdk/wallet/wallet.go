@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -15,7 +17,8 @@ import (
 )
 
 const (
-	headerAuthorization = "authorization"
+	headerAuthorization  = "authorization"
+	headerIdempotencyKey = "x-idempotency-key"
 )
 
 // Config defines configuration to work with Client.
@@ -28,8 +31,9 @@ type Config struct {
 
 // Client is responsible to connect to wallet use cases.
 type Client struct {
-	handler apiv1.WalletCommandServiceClient
-	config  *Config
+	handler      apiv1.WalletCommandServiceClient
+	queryHandler apiv1.WalletQueryServiceClient
+	config       *Config
 }
 
 // NewClient creates an instance of Client.
@@ -40,16 +44,18 @@ func NewClient(cfg *Config) (*Client, error) {
 	}
 
 	return &Client{
-		handler: apiv1.NewWalletCommandServiceClient(conn),
-		config:  cfg,
+		handler:      apiv1.NewWalletCommandServiceClient(conn),
+		queryHandler: apiv1.NewWalletQueryServiceClient(conn),
+		config:       cfg,
 	}, nil
 }
 
 // CreateWallet creates a wallet.
 func (c *Client) CreateWallet(ctx context.Context, wallet *entity.Wallet) error {
 	req := &apiv1.CreateWalletRequest{Wallet: &apiv1.Wallet{
-		UserId:  wallet.UserID.String(),
-		Balance: wallet.Balance.String(),
+		UserId:   wallet.UserID.String(),
+		Balance:  wallet.Balance.String(),
+		Currency: wallet.Currency,
 	}}
 
 	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
@@ -58,3 +64,117 @@ func (c *Client) CreateWallet(ctx context.Context, wallet *entity.Wallet) error
 	_, err := c.handler.CreateWallet(ctx, req)
 	return err
 }
+
+// FreezeUserWallets freezes all wallets owned by the user.
+func (c *Client) FreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.FreezeUserWalletsRequest{UserId: userID.String()}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+
+	_, err := c.handler.FreezeUserWallets(ctx, req)
+	return err
+}
+
+// UnfreezeUserWallets unfreezes all wallets owned by the user.
+func (c *Client) UnfreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	req := &apiv1.UnfreezeUserWalletsRequest{UserId: userID.String()}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+
+	_, err := c.handler.UnfreezeUserWallets(ctx, req)
+	return err
+}
+
+// TransferBalance transfers balance from sender's wallet to receiver's wallet.
+// The key is sent as idempotency key so the same transfer can be retried safely.
+// If the transfer has a reference, it is applied at most once and ResolveTransfer tells whether it has been applied.
+func (c *Client) TransferBalance(ctx context.Context, transfer *entity.TransferWallet, key string) error {
+	req := &apiv1.TransferBalanceRequest{Transfer: &apiv1.Transfer{
+		SenderId:         transfer.SenderID.String(),
+		SenderWalletId:   transfer.SenderWalletID.String(),
+		ReceiverId:       transfer.ReceiverID.String(),
+		ReceiverWalletId: transfer.ReceiverWalletID.String(),
+		Amount:           transfer.Amount.String(),
+		Currency:         transfer.Currency,
+	}}
+	if transfer.QuoteID != uuid.Nil {
+		req.Transfer.QuoteId = transfer.QuoteID.String()
+	}
+	if transfer.ReferenceID != uuid.Nil {
+		req.Transfer.ReferenceId = transfer.ReferenceID.String()
+	}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		headerAuthorization, fmt.Sprintf("basic %s", token),
+		headerIdempotencyKey, key,
+	))
+
+	_, err := c.handler.TransferBalance(ctx, req)
+	return err
+}
+
+// ResolveTransfer tells whether the transfer with the reference has been applied.
+// If it hasn't, the reference is cancelled so the transfer can never be applied afterwards.
+func (c *Client) ResolveTransfer(ctx context.Context, referenceID uuid.UUID) (bool, error) {
+	req := &apiv1.ResolveTransferRequest{ReferenceId: referenceID.String()}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+
+	resp, err := c.handler.ResolveTransfer(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	return resp.GetApplied(), nil
+}
+
+// CaptureHold transfers amount of the hold to its receiver and releases the rest.
+// The key is sent as idempotency key so the same capture can be retried safely.
+// The reference is applied at most once and ResolveTransfer tells whether it has been applied.
+func (c *Client) CaptureHold(ctx context.Context, capture *entity.CaptureHold, key string) error {
+	req := &apiv1.CaptureHoldRequest{
+		Id:               capture.HoldID.String(),
+		Amount:           capture.Amount.String(),
+		UserId:           capture.UserID.String(),
+		WalletId:         capture.WalletID.String(),
+		ReceiverId:       capture.ReceiverID.String(),
+		ReceiverWalletId: capture.ReceiverWalletID.String(),
+		ReferenceId:      capture.ReferenceID.String(),
+	}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		headerAuthorization, fmt.Sprintf("basic %s", token),
+		headerIdempotencyKey, key,
+	))
+
+	_, err := c.handler.CaptureHold(ctx, req)
+	return err
+}
+
+// ListUserWallets lists all wallets owned by the user.
+func (c *Client) ListUserWallets(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error) {
+	req := &apiv1.ListUserWalletsRequest{UserId: userID.String()}
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.config.Username, c.config.Password)))
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorization, fmt.Sprintf("basic %s", token)))
+
+	resp, err := c.queryHandler.ListUserWallets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	wallets := make([]*entity.Wallet, len(resp.GetData()))
+	for i, data := range resp.GetData() {
+		wallet := &entity.Wallet{}
+		wallet.ID, _ = uuid.Parse(data.GetId())
+		wallet.UserID, _ = uuid.Parse(data.GetUserId())
+		wallet.Balance, _ = decimal.NewFromString(data.GetBalance())
+		wallet.Currency = data.GetCurrency()
+		wallets[i] = wallet
+	}
+	return wallets, nil
+}
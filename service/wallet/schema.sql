@@ -1,7 +1,10 @@
 CREATE TABLE IF NOT EXISTS wallets (
     id UUID PRIMARY KEY,
     user_id UUID NOT NULL,
-    balance NUMERIC(20, 2) NOT NULL,
+    balance NUMERIC(24, 4) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'IDR',
+    held_balance NUMERIC(24, 4) NOT NULL DEFAULT 0,
+    frozen_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     deleted_at TIMESTAMP,
@@ -9,9 +12,98 @@ CREATE TABLE IF NOT EXISTS wallets (
     updated_by UUID NOT NULL,
     deleted_by UUID,
 
-    CONSTRAINT non_negative_balance CHECK (balance >= 0)
+    CONSTRAINT non_negative_balance CHECK (held_balance >= 0 AND balance >= held_balance)
 );
 
 CREATE INDEX IF NOT EXISTS index_on_wallets_on_id_and_user_id ON wallets USING btree (
     id, user_id
 );
+
+CREATE TYPE ledger_entry_type AS ENUM ('DEBIT', 'CREDIT');
+
+CREATE TYPE ledger_journal_type AS ENUM ('OPENING_BALANCE', 'TOPUP', 'TRANSFER', 'FX_TRANSFER', 'HOLD_CAPTURE', 'FX_FEE');
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id UUID PRIMARY KEY,
+    journal_id UUID NOT NULL,
+    journal_type LEDGER_JOURNAL_TYPE NOT NULL,
+    wallet_id UUID NOT NULL,
+    entry_type LEDGER_ENTRY_TYPE NOT NULL,
+    amount NUMERIC(24, 4) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    created_by UUID NOT NULL,
+    updated_by UUID NOT NULL,
+    deleted_by UUID,
+
+    CONSTRAINT positive_amount CHECK (amount > 0),
+    CONSTRAINT unique_journal_entry_type UNIQUE (journal_id, entry_type)
+);
+
+CREATE INDEX IF NOT EXISTS index_on_ledger_entries_on_wallet_id ON ledger_entries USING btree (
+    wallet_id
+);
+
+CREATE INDEX IF NOT EXISTS index_on_ledger_entries_on_journal_id ON ledger_entries USING btree (
+    journal_id
+);
+
+CREATE TABLE IF NOT EXISTS fx_quotes (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    from_currency CHAR(3) NOT NULL,
+    to_currency CHAR(3) NOT NULL,
+    rate NUMERIC(30, 12) NOT NULL,
+    spread NUMERIC(10, 8) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT positive_rate CHECK (rate > 0)
+);
+
+CREATE TYPE hold_status AS ENUM ('AUTHORIZED', 'CAPTURED', 'VOIDED', 'EXPIRED');
+
+CREATE TABLE IF NOT EXISTS holds (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    wallet_id UUID NOT NULL,
+    receiver_id UUID NOT NULL,
+    receiver_wallet_id UUID NOT NULL,
+    amount NUMERIC(24, 4) NOT NULL,
+    captured_amount NUMERIC(24, 4) NOT NULL DEFAULT 0,
+    currency CHAR(3) NOT NULL,
+    status HOLD_STATUS NOT NULL DEFAULT 'AUTHORIZED',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by UUID NOT NULL,
+    updated_by UUID NOT NULL,
+
+    CONSTRAINT positive_hold_amount CHECK (amount > 0),
+    CONSTRAINT valid_captured_amount CHECK (captured_amount >= 0 AND captured_amount <= amount)
+);
+
+CREATE INDEX IF NOT EXISTS index_on_holds_on_wallet_id ON holds USING btree (
+    wallet_id
+);
+
+CREATE TYPE transfer_status AS ENUM ('APPLIED', 'CANCELLED');
+
+CREATE TABLE IF NOT EXISTS transfers (
+    id UUID PRIMARY KEY,
+    status TRANSFER_STATUS NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT PRIMARY KEY,
+    value BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS index_on_idempotency_keys_on_expires_at ON idempotency_keys USING btree (
+    expires_at
+);
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/orchestration/temporal/activity/hold_expirer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/orchestration/temporal/activity/hold_expirer.go -destination=./service/wallet/test/mock//orchestration/temporal/activity/hold_expirer.go
+//
+
+// Package mock_activity is a generated GoMock package.
+package mock_activity
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExpireHoldHolder is a mock of ExpireHoldHolder interface.
+type MockExpireHoldHolder struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockExpireHoldHolderMockRecorder
+}
+
+// MockExpireHoldHolderMockRecorder is the mock recorder for MockExpireHoldHolder.
+type MockExpireHoldHolderMockRecorder struct {
+	mock *MockExpireHoldHolder
+}
+
+// NewMockExpireHoldHolder creates a new mock instance.
+func NewMockExpireHoldHolder(ctrl *gomock.Controller) *MockExpireHoldHolder {
+	mock := &MockExpireHoldHolder{ctrl: ctrl}
+	mock.recorder = &MockExpireHoldHolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpireHoldHolder) EXPECT() *MockExpireHoldHolderMockRecorder {
+	return m.recorder
+}
+
+// Expire mocks base method.
+func (m *MockExpireHoldHolder) Expire(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockExpireHoldHolderMockRecorder) Expire(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockExpireHoldHolder)(nil).Expire), ctx, id)
+}
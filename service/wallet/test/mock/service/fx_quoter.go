@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/service/fx_quoter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/service/fx_quoter.go -destination=./service/wallet/test/mock//service/fx_quoter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// MockCreateFXQuote is a mock of CreateFXQuote interface.
+type MockCreateFXQuote struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockCreateFXQuoteMockRecorder
+}
+
+// MockCreateFXQuoteMockRecorder is the mock recorder for MockCreateFXQuote.
+type MockCreateFXQuoteMockRecorder struct {
+	mock *MockCreateFXQuote
+}
+
+// NewMockCreateFXQuote creates a new mock instance.
+func NewMockCreateFXQuote(ctrl *gomock.Controller) *MockCreateFXQuote {
+	mock := &MockCreateFXQuote{ctrl: ctrl}
+	mock.recorder = &MockCreateFXQuoteMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateFXQuote) EXPECT() *MockCreateFXQuoteMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateFXQuote) Create(ctx context.Context, userID uuid.UUID, from, to string) (*entity.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, from, to)
+	ret0, _ := ret[0].(*entity.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateFXQuoteMockRecorder) Create(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateFXQuote)(nil).Create), ctx, userID, from, to)
+}
+
+// MockRateProvider is a mock of RateProvider interface.
+type MockRateProvider struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockRateProviderMockRecorder
+}
+
+// MockRateProviderMockRecorder is the mock recorder for MockRateProvider.
+type MockRateProviderMockRecorder struct {
+	mock *MockRateProvider
+}
+
+// NewMockRateProvider creates a new mock instance.
+func NewMockRateProvider(ctrl *gomock.Controller) *MockRateProvider {
+	mock := &MockRateProvider{ctrl: ctrl}
+	mock.recorder = &MockRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateProvider) EXPECT() *MockRateProviderMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockRateProvider) GetRate(ctx context.Context, from, to string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, from, to)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockRateProviderMockRecorder) GetRate(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockRateProvider)(nil).GetRate), ctx, from, to)
+}
+
+// MockCreateFXQuoteRepository is a mock of CreateFXQuoteRepository interface.
+type MockCreateFXQuoteRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockCreateFXQuoteRepositoryMockRecorder
+}
+
+// MockCreateFXQuoteRepositoryMockRecorder is the mock recorder for MockCreateFXQuoteRepository.
+type MockCreateFXQuoteRepositoryMockRecorder struct {
+	mock *MockCreateFXQuoteRepository
+}
+
+// NewMockCreateFXQuoteRepository creates a new mock instance.
+func NewMockCreateFXQuoteRepository(ctrl *gomock.Controller) *MockCreateFXQuoteRepository {
+	mock := &MockCreateFXQuoteRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateFXQuoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateFXQuoteRepository) EXPECT() *MockCreateFXQuoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateFXQuoteRepository) Insert(ctx context.Context, quote *entity.FXQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateFXQuoteRepositoryMockRecorder) Insert(ctx, quote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateFXQuoteRepository)(nil).Insert), ctx, quote)
+}
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/service/wallet_auditor.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/service/wallet_auditor.go -destination=./service/wallet/test/mock//service/wallet_auditor.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// MockAuditWallet is a mock of AuditWallet interface.
+type MockAuditWallet struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockAuditWalletMockRecorder
+}
+
+// MockAuditWalletMockRecorder is the mock recorder for MockAuditWallet.
+type MockAuditWalletMockRecorder struct {
+	mock *MockAuditWallet
+}
+
+// NewMockAuditWallet creates a new mock instance.
+func NewMockAuditWallet(ctrl *gomock.Controller) *MockAuditWallet {
+	mock := &MockAuditWallet{ctrl: ctrl}
+	mock.recorder = &MockAuditWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditWallet) EXPECT() *MockAuditWalletMockRecorder {
+	return m.recorder
+}
+
+// Audit mocks base method.
+func (m *MockAuditWallet) Audit(ctx context.Context, walletID uuid.UUID) (*entity.WalletAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Audit", ctx, walletID)
+	ret0, _ := ret[0].(*entity.WalletAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Audit indicates an expected call of Audit.
+func (mr *MockAuditWalletMockRecorder) Audit(ctx, walletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Audit", reflect.TypeOf((*MockAuditWallet)(nil).Audit), ctx, walletID)
+}
+
+// MockAuditWalletRepository is a mock of AuditWalletRepository interface.
+type MockAuditWalletRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockAuditWalletRepositoryMockRecorder
+}
+
+// MockAuditWalletRepositoryMockRecorder is the mock recorder for MockAuditWalletRepository.
+type MockAuditWalletRepositoryMockRecorder struct {
+	mock *MockAuditWalletRepository
+}
+
+// NewMockAuditWalletRepository creates a new mock instance.
+func NewMockAuditWalletRepository(ctrl *gomock.Controller) *MockAuditWalletRepository {
+	mock := &MockAuditWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditWalletRepository) EXPECT() *MockAuditWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockAuditWalletRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAuditWalletRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAuditWalletRepository)(nil).GetByID), ctx, id)
+}
+
+// MockAuditWalletLedgerRepository is a mock of AuditWalletLedgerRepository interface.
+type MockAuditWalletLedgerRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockAuditWalletLedgerRepositoryMockRecorder
+}
+
+// MockAuditWalletLedgerRepositoryMockRecorder is the mock recorder for MockAuditWalletLedgerRepository.
+type MockAuditWalletLedgerRepositoryMockRecorder struct {
+	mock *MockAuditWalletLedgerRepository
+}
+
+// NewMockAuditWalletLedgerRepository creates a new mock instance.
+func NewMockAuditWalletLedgerRepository(ctrl *gomock.Controller) *MockAuditWalletLedgerRepository {
+	mock := &MockAuditWalletLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditWalletLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditWalletLedgerRepository) EXPECT() *MockAuditWalletLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalances mocks base method.
+func (m *MockAuditWalletLedgerRepository) GetBalances(ctx context.Context, walletID uuid.UUID) ([]*entity.LedgerBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", ctx, walletID)
+	ret0, _ := ret[0].([]*entity.LedgerBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockAuditWalletLedgerRepositoryMockRecorder) GetBalances(ctx, walletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockAuditWalletLedgerRepository)(nil).GetBalances), ctx, walletID)
+}
@@ -93,3 +93,41 @@ func (mr *MockCreateWalletRepositoryMockRecorder) Insert(ctx, wallet any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateWalletRepository)(nil).Insert), ctx, wallet)
 }
+
+// MockCreateWalletLedgerRepository is a mock of CreateWalletLedgerRepository interface.
+type MockCreateWalletLedgerRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockCreateWalletLedgerRepositoryMockRecorder
+}
+
+// MockCreateWalletLedgerRepositoryMockRecorder is the mock recorder for MockCreateWalletLedgerRepository.
+type MockCreateWalletLedgerRepositoryMockRecorder struct {
+	mock *MockCreateWalletLedgerRepository
+}
+
+// NewMockCreateWalletLedgerRepository creates a new mock instance.
+func NewMockCreateWalletLedgerRepository(ctrl *gomock.Controller) *MockCreateWalletLedgerRepository {
+	mock := &MockCreateWalletLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateWalletLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateWalletLedgerRepository) EXPECT() *MockCreateWalletLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateWalletLedgerRepository) Insert(ctx context.Context, journal *entity.LedgerJournal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, journal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateWalletLedgerRepositoryMockRecorder) Insert(ctx, journal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateWalletLedgerRepository)(nil).Insert), ctx, journal)
+}
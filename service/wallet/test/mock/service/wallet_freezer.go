@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/service/wallet_freezer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/service/wallet_freezer.go -destination=./service/wallet/test/mock//service/wallet_freezer.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFreezeWallet is a mock of FreezeWallet interface.
+type MockFreezeWallet struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockFreezeWalletMockRecorder
+}
+
+// MockFreezeWalletMockRecorder is the mock recorder for MockFreezeWallet.
+type MockFreezeWalletMockRecorder struct {
+	mock *MockFreezeWallet
+}
+
+// NewMockFreezeWallet creates a new mock instance.
+func NewMockFreezeWallet(ctrl *gomock.Controller) *MockFreezeWallet {
+	mock := &MockFreezeWallet{ctrl: ctrl}
+	mock.recorder = &MockFreezeWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreezeWallet) EXPECT() *MockFreezeWalletMockRecorder {
+	return m.recorder
+}
+
+// Freeze mocks base method.
+func (m *MockFreezeWallet) Freeze(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Freeze", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Freeze indicates an expected call of Freeze.
+func (mr *MockFreezeWalletMockRecorder) Freeze(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Freeze", reflect.TypeOf((*MockFreezeWallet)(nil).Freeze), ctx, userID)
+}
+
+// Unfreeze mocks base method.
+func (m *MockFreezeWallet) Unfreeze(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfreeze", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfreeze indicates an expected call of Unfreeze.
+func (mr *MockFreezeWalletMockRecorder) Unfreeze(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfreeze", reflect.TypeOf((*MockFreezeWallet)(nil).Unfreeze), ctx, userID)
+}
+
+// MockFreezeWalletRepository is a mock of FreezeWalletRepository interface.
+type MockFreezeWalletRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockFreezeWalletRepositoryMockRecorder
+}
+
+// MockFreezeWalletRepositoryMockRecorder is the mock recorder for MockFreezeWalletRepository.
+type MockFreezeWalletRepositoryMockRecorder struct {
+	mock *MockFreezeWalletRepository
+}
+
+// NewMockFreezeWalletRepository creates a new mock instance.
+func NewMockFreezeWalletRepository(ctrl *gomock.Controller) *MockFreezeWalletRepository {
+	mock := &MockFreezeWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockFreezeWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreezeWalletRepository) EXPECT() *MockFreezeWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FreezeUserWallets mocks base method.
+func (m *MockFreezeWalletRepository) FreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeUserWallets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FreezeUserWallets indicates an expected call of FreezeUserWallets.
+func (mr *MockFreezeWalletRepositoryMockRecorder) FreezeUserWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeUserWallets", reflect.TypeOf((*MockFreezeWalletRepository)(nil).FreezeUserWallets), ctx, userID)
+}
+
+// UnfreezeUserWallets mocks base method.
+func (m *MockFreezeWalletRepository) UnfreezeUserWallets(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeUserWallets", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfreezeUserWallets indicates an expected call of UnfreezeUserWallets.
+func (mr *MockFreezeWalletRepositoryMockRecorder) UnfreezeUserWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeUserWallets", reflect.TypeOf((*MockFreezeWalletRepository)(nil).UnfreezeUserWallets), ctx, userID)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/service/wallet_getter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/service/wallet_getter.go -destination=./service/wallet/test/mock//service/wallet_getter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// MockGetWallet is a mock of GetWallet interface.
+type MockGetWallet struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetWalletMockRecorder
+}
+
+// MockGetWalletMockRecorder is the mock recorder for MockGetWallet.
+type MockGetWalletMockRecorder struct {
+	mock *MockGetWallet
+}
+
+// NewMockGetWallet creates a new mock instance.
+func NewMockGetWallet(ctrl *gomock.Controller) *MockGetWallet {
+	mock := &MockGetWallet{ctrl: ctrl}
+	mock.recorder = &MockGetWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetWallet) EXPECT() *MockGetWalletMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method.
+func (m *MockGetWallet) GetAllByUser(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx, userID, limit)
+	ret0, _ := ret[0].([]*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser.
+func (mr *MockGetWalletMockRecorder) GetAllByUser(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockGetWallet)(nil).GetAllByUser), ctx, userID, limit)
+}
+
+// GetAllByUserIncludingDeleted mocks base method.
+func (m *MockGetWallet) GetAllByUserIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserIncludingDeleted", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserIncludingDeleted indicates an expected call of GetAllByUserIncludingDeleted.
+func (mr *MockGetWalletMockRecorder) GetAllByUserIncludingDeleted(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserIncludingDeleted", reflect.TypeOf((*MockGetWallet)(nil).GetAllByUserIncludingDeleted), ctx, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockGetWallet) GetByID(ctx context.Context, userID, id uuid.UUID) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGetWalletMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGetWallet)(nil).GetByID), ctx, userID, id)
+}
+
+// MockGetWalletRepository is a mock of GetWalletRepository interface.
+type MockGetWalletRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockGetWalletRepositoryMockRecorder
+}
+
+// MockGetWalletRepositoryMockRecorder is the mock recorder for MockGetWalletRepository.
+type MockGetWalletRepositoryMockRecorder struct {
+	mock *MockGetWalletRepository
+}
+
+// NewMockGetWalletRepository creates a new mock instance.
+func NewMockGetWalletRepository(ctrl *gomock.Controller) *MockGetWalletRepository {
+	mock := &MockGetWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockGetWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetWalletRepository) EXPECT() *MockGetWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllUserWallets mocks base method.
+func (m *MockGetWalletRepository) GetAllUserWallets(ctx context.Context, userID uuid.UUID, limit uint) ([]*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUserWallets", ctx, userID, limit)
+	ret0, _ := ret[0].([]*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUserWallets indicates an expected call of GetAllUserWallets.
+func (mr *MockGetWalletRepositoryMockRecorder) GetAllUserWallets(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserWallets", reflect.TypeOf((*MockGetWalletRepository)(nil).GetAllUserWallets), ctx, userID, limit)
+}
+
+// GetAllUserWalletsIncludingDeleted mocks base method.
+func (m *MockGetWalletRepository) GetAllUserWalletsIncludingDeleted(ctx context.Context, userID uuid.UUID) ([]*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUserWalletsIncludingDeleted", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUserWalletsIncludingDeleted indicates an expected call of GetAllUserWalletsIncludingDeleted.
+func (mr *MockGetWalletRepositoryMockRecorder) GetAllUserWalletsIncludingDeleted(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserWalletsIncludingDeleted", reflect.TypeOf((*MockGetWalletRepository)(nil).GetAllUserWalletsIncludingDeleted), ctx, userID)
+}
+
+// GetUserWallet mocks base method.
+func (m *MockGetWalletRepository) GetUserWallet(ctx context.Context, id, userID uuid.UUID) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWallet", ctx, id, userID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWallet indicates an expected call of GetUserWallet.
+func (mr *MockGetWalletRepositoryMockRecorder) GetUserWallet(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWallet", reflect.TypeOf((*MockGetWalletRepository)(nil).GetUserWallet), ctx, id, userID)
+}
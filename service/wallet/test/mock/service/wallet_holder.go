@@ -0,0 +1,393 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/wallet/internal/service/wallet_holder.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./service/wallet/internal/service/wallet_holder.go -destination=./service/wallet/test/mock//service/wallet_holder.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/indrasaputra/arjuna/service/wallet/entity"
+)
+
+// MockHoldWallet is a mock of HoldWallet interface.
+type MockHoldWallet struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockHoldWalletMockRecorder
+}
+
+// MockHoldWalletMockRecorder is the mock recorder for MockHoldWallet.
+type MockHoldWalletMockRecorder struct {
+	mock *MockHoldWallet
+}
+
+// NewMockHoldWallet creates a new mock instance.
+func NewMockHoldWallet(ctrl *gomock.Controller) *MockHoldWallet {
+	mock := &MockHoldWallet{ctrl: ctrl}
+	mock.recorder = &MockHoldWalletMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldWallet) EXPECT() *MockHoldWalletMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockHoldWallet) Authorize(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, hold)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockHoldWalletMockRecorder) Authorize(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockHoldWallet)(nil).Authorize), ctx, hold)
+}
+
+// Capture mocks base method.
+func (m *MockHoldWallet) Capture(ctx context.Context, capture *entity.CaptureHold) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, capture)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockHoldWalletMockRecorder) Capture(ctx, capture any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockHoldWallet)(nil).Capture), ctx, capture)
+}
+
+// Void mocks base method.
+func (m *MockHoldWallet) Void(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, id)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockHoldWalletMockRecorder) Void(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockHoldWallet)(nil).Void), ctx, id)
+}
+
+// MockExpireHold is a mock of ExpireHold interface.
+type MockExpireHold struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockExpireHoldMockRecorder
+}
+
+// MockExpireHoldMockRecorder is the mock recorder for MockExpireHold.
+type MockExpireHoldMockRecorder struct {
+	mock *MockExpireHold
+}
+
+// NewMockExpireHold creates a new mock instance.
+func NewMockExpireHold(ctrl *gomock.Controller) *MockExpireHold {
+	mock := &MockExpireHold{ctrl: ctrl}
+	mock.recorder = &MockExpireHoldMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpireHold) EXPECT() *MockExpireHoldMockRecorder {
+	return m.recorder
+}
+
+// Expire mocks base method.
+func (m *MockExpireHold) Expire(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockExpireHoldMockRecorder) Expire(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockExpireHold)(nil).Expire), ctx, id)
+}
+
+// MockWalletHolderRepository is a mock of WalletHolderRepository interface.
+type MockWalletHolderRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletHolderRepositoryMockRecorder
+}
+
+// MockWalletHolderRepositoryMockRecorder is the mock recorder for MockWalletHolderRepository.
+type MockWalletHolderRepositoryMockRecorder struct {
+	mock *MockWalletHolderRepository
+}
+
+// NewMockWalletHolderRepository creates a new mock instance.
+func NewMockWalletHolderRepository(ctrl *gomock.Controller) *MockWalletHolderRepository {
+	mock := &MockWalletHolderRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletHolderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletHolderRepository) EXPECT() *MockWalletHolderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddWalletBalance mocks base method.
+func (m *MockWalletHolderRepository) AddWalletBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWalletBalance", ctx, id, amount)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWalletBalance indicates an expected call of AddWalletBalance.
+func (mr *MockWalletHolderRepositoryMockRecorder) AddWalletBalance(ctx, id, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWalletBalance", reflect.TypeOf((*MockWalletHolderRepository)(nil).AddWalletBalance), ctx, id, amount)
+}
+
+// AddWalletHeldBalance mocks base method.
+func (m *MockWalletHolderRepository) AddWalletHeldBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWalletHeldBalance", ctx, id, amount)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWalletHeldBalance indicates an expected call of AddWalletHeldBalance.
+func (mr *MockWalletHolderRepositoryMockRecorder) AddWalletHeldBalance(ctx, id, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWalletHeldBalance", reflect.TypeOf((*MockWalletHolderRepository)(nil).AddWalletHeldBalance), ctx, id, amount)
+}
+
+// GetUserWalletForUpdate mocks base method.
+func (m *MockWalletHolderRepository) GetUserWalletForUpdate(ctx context.Context, id, userID uuid.UUID) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWalletForUpdate", ctx, id, userID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWalletForUpdate indicates an expected call of GetUserWalletForUpdate.
+func (mr *MockWalletHolderRepositoryMockRecorder) GetUserWalletForUpdate(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWalletForUpdate", reflect.TypeOf((*MockWalletHolderRepository)(nil).GetUserWalletForUpdate), ctx, id, userID)
+}
+
+// MockWalletHolderHoldRepository is a mock of WalletHolderHoldRepository interface.
+type MockWalletHolderHoldRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletHolderHoldRepositoryMockRecorder
+}
+
+// MockWalletHolderHoldRepositoryMockRecorder is the mock recorder for MockWalletHolderHoldRepository.
+type MockWalletHolderHoldRepositoryMockRecorder struct {
+	mock *MockWalletHolderHoldRepository
+}
+
+// NewMockWalletHolderHoldRepository creates a new mock instance.
+func NewMockWalletHolderHoldRepository(ctrl *gomock.Controller) *MockWalletHolderHoldRepository {
+	mock := &MockWalletHolderHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletHolderHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletHolderHoldRepository) EXPECT() *MockWalletHolderHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetForUpdate mocks base method.
+func (m *MockWalletHolderHoldRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockWalletHolderHoldRepositoryMockRecorder) GetForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockWalletHolderHoldRepository)(nil).GetForUpdate), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockWalletHolderHoldRepository) Insert(ctx context.Context, hold *entity.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWalletHolderHoldRepositoryMockRecorder) Insert(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWalletHolderHoldRepository)(nil).Insert), ctx, hold)
+}
+
+// Settle mocks base method.
+func (m *MockWalletHolderHoldRepository) Settle(ctx context.Context, hold *entity.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settle", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Settle indicates an expected call of Settle.
+func (mr *MockWalletHolderHoldRepositoryMockRecorder) Settle(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settle", reflect.TypeOf((*MockWalletHolderHoldRepository)(nil).Settle), ctx, hold)
+}
+
+// MockWalletHolderLedgerRepository is a mock of WalletHolderLedgerRepository interface.
+type MockWalletHolderLedgerRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletHolderLedgerRepositoryMockRecorder
+}
+
+// MockWalletHolderLedgerRepositoryMockRecorder is the mock recorder for MockWalletHolderLedgerRepository.
+type MockWalletHolderLedgerRepositoryMockRecorder struct {
+	mock *MockWalletHolderLedgerRepository
+}
+
+// NewMockWalletHolderLedgerRepository creates a new mock instance.
+func NewMockWalletHolderLedgerRepository(ctrl *gomock.Controller) *MockWalletHolderLedgerRepository {
+	mock := &MockWalletHolderLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletHolderLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletHolderLedgerRepository) EXPECT() *MockWalletHolderLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockWalletHolderLedgerRepository) Insert(ctx context.Context, journal *entity.LedgerJournal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, journal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWalletHolderLedgerRepositoryMockRecorder) Insert(ctx, journal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWalletHolderLedgerRepository)(nil).Insert), ctx, journal)
+}
+
+// MockWalletHolderTransferRepository is a mock of WalletHolderTransferRepository interface.
+type MockWalletHolderTransferRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletHolderTransferRepositoryMockRecorder
+}
+
+// MockWalletHolderTransferRepositoryMockRecorder is the mock recorder for MockWalletHolderTransferRepository.
+type MockWalletHolderTransferRepositoryMockRecorder struct {
+	mock *MockWalletHolderTransferRepository
+}
+
+// NewMockWalletHolderTransferRepository creates a new mock instance.
+func NewMockWalletHolderTransferRepository(ctrl *gomock.Controller) *MockWalletHolderTransferRepository {
+	mock := &MockWalletHolderTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletHolderTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletHolderTransferRepository) EXPECT() *MockWalletHolderTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetStatus mocks base method.
+func (m *MockWalletHolderTransferRepository) GetStatus(ctx context.Context, id uuid.UUID) (entity.TransferStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, id)
+	ret0, _ := ret[0].(entity.TransferStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockWalletHolderTransferRepositoryMockRecorder) GetStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockWalletHolderTransferRepository)(nil).GetStatus), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockWalletHolderTransferRepository) Insert(ctx context.Context, id uuid.UUID, status entity.TransferStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWalletHolderTransferRepositoryMockRecorder) Insert(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWalletHolderTransferRepository)(nil).Insert), ctx, id, status)
+}
+
+// MockHoldExpiryScheduler is a mock of HoldExpiryScheduler interface.
+type MockHoldExpiryScheduler struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockHoldExpirySchedulerMockRecorder
+}
+
+// MockHoldExpirySchedulerMockRecorder is the mock recorder for MockHoldExpiryScheduler.
+type MockHoldExpirySchedulerMockRecorder struct {
+	mock *MockHoldExpiryScheduler
+}
+
+// NewMockHoldExpiryScheduler creates a new mock instance.
+func NewMockHoldExpiryScheduler(ctrl *gomock.Controller) *MockHoldExpiryScheduler {
+	mock := &MockHoldExpiryScheduler{ctrl: ctrl}
+	mock.recorder = &MockHoldExpirySchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldExpiryScheduler) EXPECT() *MockHoldExpirySchedulerMockRecorder {
+	return m.recorder
+}
+
+// ScheduleExpiry mocks base method.
+func (m *MockHoldExpiryScheduler) ScheduleExpiry(ctx context.Context, hold *entity.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleExpiry", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleExpiry indicates an expected call of ScheduleExpiry.
+func (mr *MockHoldExpirySchedulerMockRecorder) ScheduleExpiry(ctx, hold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleExpiry", reflect.TypeOf((*MockHoldExpiryScheduler)(nil).ScheduleExpiry), ctx, hold)
+}
@@ -97,3 +97,56 @@ func (mr *MockTopupWalletRepositoryMockRecorder) AddWalletBalance(ctx, id, amoun
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWalletBalance", reflect.TypeOf((*MockTopupWalletRepository)(nil).AddWalletBalance), ctx, id, amount)
 }
+
+// GetByID mocks base method.
+func (m *MockTopupWalletRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTopupWalletRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTopupWalletRepository)(nil).GetByID), ctx, id)
+}
+
+// MockTopupWalletLedgerRepository is a mock of TopupWalletLedgerRepository interface.
+type MockTopupWalletLedgerRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockTopupWalletLedgerRepositoryMockRecorder
+}
+
+// MockTopupWalletLedgerRepositoryMockRecorder is the mock recorder for MockTopupWalletLedgerRepository.
+type MockTopupWalletLedgerRepositoryMockRecorder struct {
+	mock *MockTopupWalletLedgerRepository
+}
+
+// NewMockTopupWalletLedgerRepository creates a new mock instance.
+func NewMockTopupWalletLedgerRepository(ctrl *gomock.Controller) *MockTopupWalletLedgerRepository {
+	mock := &MockTopupWalletLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockTopupWalletLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopupWalletLedgerRepository) EXPECT() *MockTopupWalletLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockTopupWalletLedgerRepository) Insert(ctx context.Context, journal *entity.LedgerJournal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, journal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockTopupWalletLedgerRepositoryMockRecorder) Insert(ctx, journal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTopupWalletLedgerRepository)(nil).Insert), ctx, journal)
+}
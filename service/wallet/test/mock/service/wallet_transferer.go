@@ -44,12 +44,28 @@ func (m *MockTransferWallet) EXPECT() *MockTransferWalletMockRecorder {
 	return m.recorder
 }
 
+// ResolveTransfer mocks base method.
+func (m *MockTransferWallet) ResolveTransfer(ctx context.Context, referenceID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTransfer", ctx, referenceID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTransfer indicates an expected call of ResolveTransfer.
+func (mr *MockTransferWalletMockRecorder) ResolveTransfer(ctx, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTransfer", reflect.TypeOf((*MockTransferWallet)(nil).ResolveTransfer), ctx, referenceID)
+}
+
 // TransferBalance mocks base method.
-func (m *MockTransferWallet) TransferBalance(ctx context.Context, transfer *entity.TransferWallet) error {
+func (m *MockTransferWallet) TransferBalance(ctx context.Context, transfer *entity.TransferWallet) (*entity.FXConversion, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TransferBalance", ctx, transfer)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*entity.FXConversion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // TransferBalance indicates an expected call of TransferBalance.
@@ -111,3 +127,133 @@ func (mr *MockWalletTransfererRepositoryMockRecorder) GetUserWalletForUpdate(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWalletForUpdate", reflect.TypeOf((*MockWalletTransfererRepository)(nil).GetUserWalletForUpdate), ctx, id, userID)
 }
+
+// MockWalletTransfererLedgerRepository is a mock of WalletTransfererLedgerRepository interface.
+type MockWalletTransfererLedgerRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletTransfererLedgerRepositoryMockRecorder
+}
+
+// MockWalletTransfererLedgerRepositoryMockRecorder is the mock recorder for MockWalletTransfererLedgerRepository.
+type MockWalletTransfererLedgerRepositoryMockRecorder struct {
+	mock *MockWalletTransfererLedgerRepository
+}
+
+// NewMockWalletTransfererLedgerRepository creates a new mock instance.
+func NewMockWalletTransfererLedgerRepository(ctrl *gomock.Controller) *MockWalletTransfererLedgerRepository {
+	mock := &MockWalletTransfererLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletTransfererLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletTransfererLedgerRepository) EXPECT() *MockWalletTransfererLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockWalletTransfererLedgerRepository) Insert(ctx context.Context, journal *entity.LedgerJournal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, journal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWalletTransfererLedgerRepositoryMockRecorder) Insert(ctx, journal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWalletTransfererLedgerRepository)(nil).Insert), ctx, journal)
+}
+
+// MockWalletTransfererFXQuoteRepository is a mock of WalletTransfererFXQuoteRepository interface.
+type MockWalletTransfererFXQuoteRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletTransfererFXQuoteRepositoryMockRecorder
+}
+
+// MockWalletTransfererFXQuoteRepositoryMockRecorder is the mock recorder for MockWalletTransfererFXQuoteRepository.
+type MockWalletTransfererFXQuoteRepositoryMockRecorder struct {
+	mock *MockWalletTransfererFXQuoteRepository
+}
+
+// NewMockWalletTransfererFXQuoteRepository creates a new mock instance.
+func NewMockWalletTransfererFXQuoteRepository(ctrl *gomock.Controller) *MockWalletTransfererFXQuoteRepository {
+	mock := &MockWalletTransfererFXQuoteRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletTransfererFXQuoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletTransfererFXQuoteRepository) EXPECT() *MockWalletTransfererFXQuoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Use mocks base method.
+func (m *MockWalletTransfererFXQuoteRepository) Use(ctx context.Context, id, userID uuid.UUID) (*entity.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Use", ctx, id, userID)
+	ret0, _ := ret[0].(*entity.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Use indicates an expected call of Use.
+func (mr *MockWalletTransfererFXQuoteRepositoryMockRecorder) Use(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Use", reflect.TypeOf((*MockWalletTransfererFXQuoteRepository)(nil).Use), ctx, id, userID)
+}
+
+// MockWalletTransfererTransferRepository is a mock of WalletTransfererTransferRepository interface.
+type MockWalletTransfererTransferRepository struct {
+	isgomock struct{}
+	ctrl     *gomock.Controller
+	recorder *MockWalletTransfererTransferRepositoryMockRecorder
+}
+
+// MockWalletTransfererTransferRepositoryMockRecorder is the mock recorder for MockWalletTransfererTransferRepository.
+type MockWalletTransfererTransferRepositoryMockRecorder struct {
+	mock *MockWalletTransfererTransferRepository
+}
+
+// NewMockWalletTransfererTransferRepository creates a new mock instance.
+func NewMockWalletTransfererTransferRepository(ctrl *gomock.Controller) *MockWalletTransfererTransferRepository {
+	mock := &MockWalletTransfererTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletTransfererTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletTransfererTransferRepository) EXPECT() *MockWalletTransfererTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetStatus mocks base method.
+func (m *MockWalletTransfererTransferRepository) GetStatus(ctx context.Context, id uuid.UUID) (entity.TransferStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, id)
+	ret0, _ := ret[0].(entity.TransferStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockWalletTransfererTransferRepositoryMockRecorder) GetStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockWalletTransfererTransferRepository)(nil).GetStatus), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockWalletTransfererTransferRepository) Insert(ctx context.Context, id uuid.UUID, status entity.TransferStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockWalletTransfererTransferRepositoryMockRecorder) Insert(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWalletTransfererTransferRepository)(nil).Insert), ctx, id, status)
+}